@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	aggregaterebuildservice "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+)
+
+// rebuild recomputes leaderboard_weeks, user_stats and user_level_history
+// from xp_events. It creates an aggregate rebuild job (or resumes an existing
+// one with -job_id) and processes it chunk by chunk, printing progress.
+//
+// examples:
+//
+//	go run ./cmd/rebuild -config config.yaml -scope user -telegram_id 123 -dry_run=false
+//	go run ./cmd/rebuild -config config.yaml -scope week_range -week_from 2025-09-01 -week_to 2025-09-15
+//	go run ./cmd/rebuild -config config.yaml -job_id 7
+func main() {
+	var (
+		scope      = flag.String("scope", aggregaterebuild.ScopeAll, "rebuild scope: user, week_range or all")
+		telegramID = flag.String("telegram_id", "", "telegram id of the user (scope user)")
+		weekFrom   = flag.String("week_from", "", "first week, YYYY-MM-DD (scope week_range)")
+		weekTo     = flag.String("week_to", "", "last week, YYYY-MM-DD (scope week_range)")
+		dryRun     = flag.Bool("dry_run", true, "only count mismatches without writing")
+		chunkSize  = flag.Int64("chunk_size", 0, "users per chunk (default from config)")
+		jobID      = flag.Int64("job_id", 0, "resume an existing job instead of creating a new one")
+	)
+
+	ctx := context.Background()
+
+	// initialize config (parses flags).
+	cfg, err := config.GetConfig()
+	if err != nil {
+		log.Fatalf("failed to init config: %v", err)
+	}
+
+	l := logger.New(cfg.Logger)
+
+	// initialize postgres.
+	p, err := postgres.New(ctx, cfg.Postgres, l)
+	if err != nil {
+		log.Fatalf("failed to init postgres: %v", err)
+	}
+
+	aggregateRebuildService := aggregaterebuildservice.New(
+		aggregaterebuildrepository.New(p.QueryTimeout, l),
+		userrepository.New(p.QueryTimeout, l),
+		l,
+		p,
+	)
+
+	if *chunkSize <= 0 {
+		*chunkSize = cfg.Cron.AggregateRebuild.ChunkSize
+	}
+
+	if *jobID == 0 { // create new job.
+		dto := aggregaterebuild.CreateDTO{
+			Scope:  *scope,
+			DryRun: *dryRun,
+		}
+		if *telegramID != "" {
+			dto.TelegramID = telegramID
+		}
+		if *weekFrom != "" {
+			dto.WeekFrom = weekFrom
+		}
+		if *weekTo != "" {
+			dto.WeekTo = weekTo
+		}
+
+		if err := validator.New().StructCtx(ctx, dto); err != nil {
+			log.Fatalf("invalid flags: %v", err)
+		}
+
+		job, err := aggregateRebuildService.Create.Execute(ctx, dto)
+		if err != nil {
+			log.Fatalf("failed to create aggregate rebuild job: %v", err)
+		}
+
+		*jobID = job.ID
+	}
+
+	log.Printf("aggregate rebuild job %d started", *jobID)
+
+	for {
+		job, err := aggregateRebuildService.ProcessChunk.Execute(ctx, aggregaterebuild.ProcessChunkDTO{
+			JobID:     jobID,
+			ChunkSize: *chunkSize,
+		})
+		if err != nil {
+			log.Fatalf("failed to process aggregate rebuild job chunk: %v", err)
+		}
+
+		if job == nil { // job is finished or locked by another worker.
+			result, err := aggregateRebuildService.GetByID.Execute(ctx, *jobID)
+			if err != nil {
+				log.Fatalf("failed to get aggregate rebuild job: %v", err)
+			}
+
+			if !result.IsFinished() {
+				log.Fatalf("aggregate rebuild job %d is being processed by another worker", *jobID)
+			}
+
+			job = &result
+		}
+
+		log.Printf(
+			"job %d: %s, users %d/%d, diff: leaderboard_weeks=%d user_stats=%d user_level_history=%d",
+			job.ID, job.Status, job.ProcessedUsers, job.TotalUsers,
+			job.LeaderboardWeeksDiff, job.UserStatsDiff, job.UserLevelHistoryDiff,
+		)
+
+		if job.Status == aggregaterebuild.StatusFailed {
+			reason := "unknown error"
+			if job.Error != nil {
+				reason = *job.Error
+			}
+			log.Fatalf("aggregate rebuild job %d failed: %s", job.ID, reason)
+		}
+
+		if job.IsFinished() {
+			if job.DryRun {
+				log.Printf("dry run: nothing was written, re-run with -dry_run=false to apply")
+			}
+			return
+		}
+	}
+}
@@ -183,6 +183,16 @@ type UnDeleteFileAwardConfig struct {
 	Expiration   int64 `yaml:"expiration"`
 }
 
+type AchievementProgressConfig struct {
+	QueryTimeout int64 `yaml:"query_timeout"`
+	Expiration   int64 `yaml:"expiration"`
+}
+
+type SubscriptionSnapshotConfig struct {
+	QueryTimeout int64 `yaml:"query_timeout"`
+	Expiration   int64 `yaml:"expiration"`
+}
+
 type UserPresenceConfig struct {
 	QueryTimeout int64 `yaml:"query_timeout"`
 	Expiration   int64 `yaml:"expiration"`
@@ -206,6 +216,8 @@ type RedisConfig struct {
 	UnDeleteFileAchievement UnDeleteFileAchievementConfig `yaml:"un_delete_file_achievement"`
 	UnDeleteFileAward       UnDeleteFileAwardConfig       `yaml:"un_delete_file_award"`
 	UserPresence            UserPresenceConfig            `yaml:"user_presence"`
+	AchievementProgress     AchievementProgressConfig     `yaml:"achievement_progress"`
+	SubscriptionSnapshot    SubscriptionSnapshotConfig    `yaml:"subscription_snapshot"`
 }
 
 type ClientAssets struct {
@@ -281,9 +293,82 @@ type CronConfig struct {
 		StatementTimeoutMS int64  `yaml:"statement_timeout_ms"`
 		LockTimeoutMS      int64  `yaml:"lock_timeout_ms"`
 		TimeoutReliefCPU   int64  `yaml:"timeout_relief_cpu"`
+		LagAlertThreshold  int64  `yaml:"lag_alert_threshold"`
 		SleepDuration      int    `yaml:"sleep_duration"`
 		Timeout            int    `yaml:"timeout"`
 	} `yaml:"leaderboard_weeks_process_batch"`
+	LeagueWeeksFinalize struct {
+		BatchSize     int64 `yaml:"batch_size"`
+		SleepDuration int   `yaml:"sleep_duration"`
+		Timeout       int   `yaml:"timeout"`
+	} `yaml:"league_weeks_finalize"`
+	AggregateRebuild struct {
+		ChunkSize     int64 `yaml:"chunk_size"`
+		SleepDuration int   `yaml:"sleep_duration"`
+		Timeout       int   `yaml:"timeout"`
+	} `yaml:"aggregate_rebuild"`
+	LedgerReconciliation struct {
+		ChunkSize        int64 `yaml:"chunk_size"`
+		ScheduleInterval int64 `yaml:"schedule_interval"`
+		SleepDuration    int   `yaml:"sleep_duration"`
+		Timeout          int   `yaml:"timeout"`
+	} `yaml:"ledger_reconciliation"`
+	AchievementEvaluation struct {
+		ChunkSize     int64 `yaml:"chunk_size"`
+		SleepDuration int   `yaml:"sleep_duration"`
+		Timeout       int   `yaml:"timeout"`
+		ActiveDays    int   `yaml:"active_days"`
+	} `yaml:"achievement_evaluation"`
+	AchievementStatsRefresh struct {
+		SleepDuration int `yaml:"sleep_duration"`
+		Timeout       int `yaml:"timeout"`
+		ActiveDays    int `yaml:"active_days"`
+	} `yaml:"achievement_stats_refresh"`
+	SubscriptionExpiry struct {
+		BatchSize         int64 `yaml:"batch_size"`
+		RemindBeforeHours int64 `yaml:"remind_before_hours"`
+		SleepDuration     int   `yaml:"sleep_duration"`
+		Timeout           int   `yaml:"timeout"`
+	} `yaml:"subscription_expiry"`
+}
+
+type DailyTaskConfig struct {
+	Assignment struct {
+		LevelWeight          float64 `yaml:"level_weight"`
+		CompletionRateWeight float64 `yaml:"completion_rate_weight"`
+		MaxLevel             int64   `yaml:"max_level"`
+		CompletionRateDays   int     `yaml:"completion_rate_days"`
+		MediumThreshold      float64 `yaml:"medium_threshold"`
+		HardThreshold        float64 `yaml:"hard_threshold"`
+	} `yaml:"assignment"`
+}
+
+type CurrencyTransferConfig struct {
+	MinAccountAgeDays int64 `yaml:"min_account_age_days"`
+	DailyLimit        struct {
+		Amount int64 `yaml:"amount"`
+		Count  int64 `yaml:"count"`
+	} `yaml:"daily_limit"`
+	FeePercent int64 `yaml:"fee_percent"`
+}
+
+type ReferralConfig struct {
+	Milestone struct {
+		Level      int64 `yaml:"level"`
+		StreakDays int64 `yaml:"streak_days"`
+	} `yaml:"milestone"`
+	Reward struct {
+		Referrer int64 `yaml:"referrer"`
+		Invitee  int64 `yaml:"invitee"`
+	} `yaml:"reward"`
+	MaxRewardedReferrals int64 `yaml:"max_rewarded_referrals"`
+}
+
+type TelegramConfig struct {
+	BotToken      string `yaml:"bot_token"`
+	APIURL        string `yaml:"api_url"`
+	WebhookSecret string `yaml:"webhook_secret"`
+	Timeout       int    `yaml:"timeout"`
 }
 
 type MiddlewareConfig struct {
@@ -328,19 +413,23 @@ type HTTPServerConfig struct {
 }
 
 type Config struct {
-	Logger        LoggerConfig        `yaml:"logger"`
-	JWT           JWTConfig           `yaml:"jwt"`
-	RabbitMQ      RabbitMQConfig      `yaml:"rabbitmq"`
-	Postgres      PostgresConfig      `yaml:"postgres"`
-	BigCache      BigCacheConfig      `yaml:"big_cache"`
-	Redis         RedisConfig         `yaml:"redis"`
-	FileServer    FileServerConfig    `yaml:"file_server"`
-	Cron          CronConfig          `yaml:"cron"`
-	Middleware    MiddlewareConfig    `yaml:"middleware"`
-	Cookie        CookieConfig        `yaml:"cookie"`
-	IPs           IPsConfig           `yaml:"ips"`
-	SwaggerServer SwaggerServerConfig `yaml:"swagger_server"`
-	HTTPServer    HTTPServerConfig    `yaml:"httpserver"`
+	Logger           LoggerConfig           `yaml:"logger"`
+	JWT              JWTConfig              `yaml:"jwt"`
+	RabbitMQ         RabbitMQConfig         `yaml:"rabbitmq"`
+	Postgres         PostgresConfig         `yaml:"postgres"`
+	BigCache         BigCacheConfig         `yaml:"big_cache"`
+	Redis            RedisConfig            `yaml:"redis"`
+	FileServer       FileServerConfig       `yaml:"file_server"`
+	Cron             CronConfig             `yaml:"cron"`
+	DailyTask        DailyTaskConfig        `yaml:"daily_task"`
+	Referral         ReferralConfig         `yaml:"referral"`
+	CurrencyTransfer CurrencyTransferConfig `yaml:"currency_transfer"`
+	Telegram         TelegramConfig         `yaml:"telegram"`
+	Middleware       MiddlewareConfig       `yaml:"middleware"`
+	Cookie           CookieConfig           `yaml:"cookie"`
+	IPs              IPsConfig              `yaml:"ips"`
+	SwaggerServer    SwaggerServerConfig    `yaml:"swagger_server"`
+	HTTPServer       HTTPServerConfig       `yaml:"httpserver"`
 }
 
 // LoadConfig load config file.
@@ -353,7 +442,7 @@ func LoadConfig() string {
 	return cf
 }
 
-// ParseConfig parse config file.
+// ParseConfig parse and validate config file.
 func ParseConfig(configFile string) (config Config, err error) {
 	f, err := os.Open(configFile) // #nosec G304
 	if err != nil {
@@ -365,9 +454,11 @@ func ParseConfig(configFile string) (config Config, err error) {
 		}
 	}(f)
 
-	err = yaml.NewDecoder(f).Decode(&config)
+	if err := yaml.NewDecoder(f).Decode(&config); err != nil {
+		return config, err
+	}
 
-	return config, err
+	return config, config.Validate()
 }
 
 // GetConfig get config.
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidConfig is returned when a value of config is out of its allowed range.
+var ErrInvalidConfig = errors.New("invalid config")
+
+// Validate checks values of config sections that can not be fixed by defaults.
+// Zero values are allowed where they mean a default or a disabled check.
+func (c Config) Validate() error {
+	validators := []func() error{
+		c.Redis.validate,
+		c.Cron.validate,
+		c.DailyTask.validate,
+		c.Referral.validate,
+		c.CurrencyTransfer.validate,
+		c.Telegram.validate,
+	}
+
+	for _, validate := range validators {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c RedisConfig) validate() error {
+	if c.AchievementProgress.QueryTimeout <= 0 || c.AchievementProgress.Expiration <= 0 {
+		return invalid("redis.achievement_progress query_timeout and expiration must be positive")
+	}
+
+	if c.SubscriptionSnapshot.QueryTimeout <= 0 || c.SubscriptionSnapshot.Expiration <= 0 {
+		return invalid("redis.subscription_snapshot query_timeout and expiration must be positive")
+	}
+
+	return nil
+}
+
+func (c CronConfig) validate() error {
+	if c.LeaderboardWeeksProcessBatch.LagAlertThreshold < 0 {
+		return invalid("cron.leaderboard_weeks_process_batch.lag_alert_threshold must not be negative")
+	}
+
+	jobs := []struct {
+		name          string
+		size          int64
+		sleepDuration int
+		timeout       int
+	}{
+		{"league_weeks_finalize", c.LeagueWeeksFinalize.BatchSize, c.LeagueWeeksFinalize.SleepDuration, c.LeagueWeeksFinalize.Timeout},
+		{"aggregate_rebuild", c.AggregateRebuild.ChunkSize, c.AggregateRebuild.SleepDuration, c.AggregateRebuild.Timeout},
+		{"ledger_reconciliation", c.LedgerReconciliation.ChunkSize, c.LedgerReconciliation.SleepDuration, c.LedgerReconciliation.Timeout},
+		{"achievement_evaluation", c.AchievementEvaluation.ChunkSize, c.AchievementEvaluation.SleepDuration, c.AchievementEvaluation.Timeout},
+		{"subscription_expiry", c.SubscriptionExpiry.BatchSize, c.SubscriptionExpiry.SleepDuration, c.SubscriptionExpiry.Timeout},
+	}
+
+	for _, job := range jobs {
+		if job.size <= 0 || job.sleepDuration <= 0 || job.timeout <= 0 {
+			return invalid(fmt.Sprintf("cron.%s batch or chunk size, sleep_duration and timeout must be positive", job.name))
+		}
+	}
+
+	if c.AchievementStatsRefresh.SleepDuration <= 0 || c.AchievementStatsRefresh.Timeout <= 0 {
+		return invalid("cron.achievement_stats_refresh sleep_duration and timeout must be positive")
+	}
+
+	if c.LedgerReconciliation.ScheduleInterval < 0 {
+		return invalid("cron.ledger_reconciliation.schedule_interval must not be negative")
+	}
+
+	if c.AchievementEvaluation.ActiveDays < 0 || c.AchievementStatsRefresh.ActiveDays < 0 {
+		return invalid("cron active_days must not be negative")
+	}
+
+	if c.SubscriptionExpiry.RemindBeforeHours < 0 {
+		return invalid("cron.subscription_expiry.remind_before_hours must not be negative")
+	}
+
+	return nil
+}
+
+func (c DailyTaskConfig) validate() error {
+	a := c.Assignment
+
+	if a.LevelWeight < 0 || a.CompletionRateWeight < 0 {
+		return invalid("daily_task.assignment weights must not be negative")
+	}
+
+	if a.MaxLevel < 0 || a.CompletionRateDays < 0 {
+		return invalid("daily_task.assignment max_level and completion_rate_days must not be negative")
+	}
+
+	// difficulty score is from 0 to 1, so thresholds out of it make a difficulty unreachable.
+	if a.MediumThreshold < 0 || a.HardThreshold > 1 || a.MediumThreshold > a.HardThreshold {
+		return invalid("daily_task.assignment thresholds must be from 0 to 1 and medium_threshold must not exceed hard_threshold")
+	}
+
+	return nil
+}
+
+func (c ReferralConfig) validate() error {
+	if c.Milestone.Level < 0 || c.Milestone.StreakDays < 0 {
+		return invalid("referral.milestone level and streak_days must not be negative")
+	}
+
+	if c.Reward.Referrer < 0 || c.Reward.Invitee < 0 {
+		return invalid("referral.reward must not be negative")
+	}
+
+	if c.MaxRewardedReferrals < 0 {
+		return invalid("referral.max_rewarded_referrals must not be negative")
+	}
+
+	return nil
+}
+
+func (c CurrencyTransferConfig) validate() error {
+	if c.FeePercent < 0 || c.FeePercent > 100 {
+		return invalid("currency_transfer.fee_percent must be from 0 to 100")
+	}
+
+	if c.MinAccountAgeDays < 0 || c.DailyLimit.Amount < 0 || c.DailyLimit.Count < 0 {
+		return invalid("currency_transfer min_account_age_days and daily_limit must not be negative")
+	}
+
+	return nil
+}
+
+func (c TelegramConfig) validate() error {
+	if c.Timeout < 0 {
+		return invalid("telegram.timeout must not be negative")
+	}
+
+	return nil
+}
+
+func invalid(msg string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidConfig, msg)
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	valid, err := ParseConfig("../testdata/config.yaml")
+	if err != nil {
+		t.Fatalf("failed to parse test config: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(c *Config)
+		want   want
+	}{
+		{
+			name:   "ok",
+			mutate: func(_ *Config) {},
+			want:   want{err: nil},
+		},
+		{
+			name: "zero values disable checks or fall back to defaults",
+			mutate: func(c *Config) {
+				c.DailyTask = DailyTaskConfig{}
+				c.Referral = ReferralConfig{}
+				c.CurrencyTransfer = CurrencyTransferConfig{}
+				c.Telegram.Timeout = 0
+			},
+			want: want{err: nil},
+		},
+		{
+			name:   "fee percent above 100",
+			mutate: func(c *Config) { c.CurrencyTransfer.FeePercent = 101 },
+			want:   want{err: ErrInvalidConfig},
+		},
+		{
+			name:   "negative daily transfer limit",
+			mutate: func(c *Config) { c.CurrencyTransfer.DailyLimit.Count = -1 },
+			want:   want{err: ErrInvalidConfig},
+		},
+		{
+			name:   "negative referral reward",
+			mutate: func(c *Config) { c.Referral.Reward.Invitee = -1 },
+			want:   want{err: ErrInvalidConfig},
+		},
+		{
+			name:   "negative daily task weight",
+			mutate: func(c *Config) { c.DailyTask.Assignment.LevelWeight = -0.1 },
+			want:   want{err: ErrInvalidConfig},
+		},
+		{
+			name: "medium threshold above hard threshold",
+			mutate: func(c *Config) {
+				c.DailyTask.Assignment.MediumThreshold = 0.8
+				c.DailyTask.Assignment.HardThreshold = 0.7
+			},
+			want: want{err: ErrInvalidConfig},
+		},
+		{
+			name:   "cron job without sleep duration",
+			mutate: func(c *Config) { c.Cron.AchievementEvaluation.SleepDuration = 0 },
+			want:   want{err: ErrInvalidConfig},
+		},
+		{
+			name:   "redis cache without expiration",
+			mutate: func(c *Config) { c.Redis.SubscriptionSnapshot.Expiration = 0 },
+			want:   want{err: ErrInvalidConfig},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid
+			tt.mutate(&c)
+
+			err := c.Validate()
+			assert.ErrorIs(t, err, tt.want.err)
+		})
+	}
+}
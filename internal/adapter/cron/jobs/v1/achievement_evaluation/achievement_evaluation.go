@@ -0,0 +1,102 @@
+package achievementevaluation
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	achievementevaluationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+// AchievementEvaluation periodically calls the DB function
+// public.achievement_evaluation_job_process_chunk to retroactively unlock
+// created or updated achievements for users who already qualify.
+// Progress is stored in the job itself, so a restart continues from the last chunk.
+type AchievementEvaluation struct {
+	achievementEvaluationService *achievementevaluationservice.Service
+	logger                       *logger.Logger
+	chunkSize                    int64
+	sleepDuration                int
+	timeout                      int
+	activeDays                   int
+}
+
+// New constructs the cron job and starts it in a background goroutine.
+func New(
+	ctx context.Context,
+	achievementEvaluationService *achievementevaluationservice.Service,
+	cfg config.CronConfig,
+	logger *logger.Logger,
+) *AchievementEvaluation {
+	c := &AchievementEvaluation{
+		achievementEvaluationService: achievementEvaluationService,
+		logger:                       logger,
+		chunkSize:                    cfg.AchievementEvaluation.ChunkSize,
+		sleepDuration:                cfg.AchievementEvaluation.SleepDuration,
+		timeout:                      cfg.AchievementEvaluation.Timeout,
+		activeDays:                   cfg.AchievementEvaluation.ActiveDays,
+	}
+
+	go c.start(ctx)
+
+	return c
+}
+
+func (c *AchievementEvaluation) start(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(c.sleepDuration) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logger.Info("cron achievement evaluation stopped", slog.String("reason", ctx.Err().Error()))
+			return
+		case <-ticker.C:
+			c.logger.Debug("[cron achievement evaluation] tick")
+
+			ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.timeout)*time.Second)
+
+			if err := c.process(ctxTimeout); err != nil {
+				// log but keep the cron alive; next tick continues from the saved cursor.
+				c.logger.Error("error achievement evaluation", "err", err)
+			}
+
+			cancel()
+		}
+	}
+}
+
+// process handles chunks until there are no unfinished jobs left or the tick timeout expires.
+func (c *AchievementEvaluation) process(ctx context.Context) error {
+	for ctx.Err() == nil {
+		job, err := c.achievementEvaluationService.ProcessChunk.Execute(ctx, achievementevaluation.ProcessChunkDTO{
+			ChunkSize:   c.chunkSize,
+			ActiveSince: time.Now().AddDate(0, 0, -c.activeDays),
+		})
+		if err != nil {
+			return err
+		}
+
+		if job == nil { // nothing to evaluate.
+			return nil
+		}
+
+		c.logger.Debug("achievement evaluation chunk",
+			slog.Int64("job id", job.ID),
+			slog.Int64("achievement id", job.AchievementID),
+			slog.String("status", job.Status),
+			slog.Int64("processed users", job.ProcessedUsers),
+			slog.Int64("total users", job.TotalUsers),
+			slog.Int64("unlocked users", job.UnlockedUsers),
+		)
+
+		if job.Status == achievementevaluation.StatusFailed {
+			c.logger.Error("achievement evaluation job failed", slog.Int64("job id", job.ID), slog.Any("error", job.Error))
+		}
+	}
+
+	return nil
+}
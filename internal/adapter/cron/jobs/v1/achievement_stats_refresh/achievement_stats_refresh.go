@@ -0,0 +1,70 @@
+package achievementstatsrefresh
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	achievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+// AchievementStatsRefresh periodically calls the DB function
+// public.achievement_stats_refresh to recalculate rarity statistics
+// (unlock count, percentage of active users, first unlocker and
+// median time to unlock) of every achievement.
+type AchievementStatsRefresh struct {
+	achievementService *achievementservice.Service
+	logger             *logger.Logger
+	sleepDuration      int
+	timeout            int
+	activeDays         int
+}
+
+// New constructs the cron job and starts it in a background goroutine.
+func New(
+	ctx context.Context,
+	achievementService *achievementservice.Service,
+	cfg config.CronConfig,
+	logger *logger.Logger,
+) *AchievementStatsRefresh {
+	c := &AchievementStatsRefresh{
+		achievementService: achievementService,
+		logger:             logger,
+		sleepDuration:      cfg.AchievementStatsRefresh.SleepDuration,
+		timeout:            cfg.AchievementStatsRefresh.Timeout,
+		activeDays:         cfg.AchievementStatsRefresh.ActiveDays,
+	}
+
+	go c.start(ctx)
+
+	return c
+}
+
+func (c *AchievementStatsRefresh) start(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(c.sleepDuration) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logger.Info("cron achievement stats refresh stopped", slog.String("reason", ctx.Err().Error()))
+			return
+		case <-ticker.C:
+			c.logger.Debug("[cron achievement stats refresh] tick")
+
+			ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.timeout)*time.Second)
+
+			count, err := c.achievementService.RefreshStats.Execute(ctxTimeout, c.activeDays)
+			if err != nil {
+				// log but keep the cron alive; next tick will retry.
+				c.logger.Error("error achievement stats refresh", "err", err)
+			} else {
+				c.logger.Debug("achievement stats refreshed", slog.Int64("achievements count", count))
+			}
+
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package aggregaterebuild
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildservice "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+// AggregateRebuild periodically calls the DB function
+// public.aggregate_rebuild_job_process_chunk to move unfinished
+// aggregate rebuild jobs forward chunk by chunk. Progress is stored
+// in the job itself, so a restart continues from the last chunk.
+type AggregateRebuild struct {
+	aggregateRebuildService *aggregaterebuildservice.Service
+	logger                  *logger.Logger
+	chunkSize               int64
+	sleepDuration           int
+	timeout                 int
+}
+
+// New constructs the cron job and starts it in a background goroutine.
+func New(
+	ctx context.Context,
+	aggregateRebuildService *aggregaterebuildservice.Service,
+	cfg config.CronConfig,
+	logger *logger.Logger,
+) *AggregateRebuild {
+	c := &AggregateRebuild{
+		aggregateRebuildService: aggregateRebuildService,
+		logger:                  logger,
+		chunkSize:               cfg.AggregateRebuild.ChunkSize,
+		sleepDuration:           cfg.AggregateRebuild.SleepDuration,
+		timeout:                 cfg.AggregateRebuild.Timeout,
+	}
+
+	go c.start(ctx)
+
+	return c
+}
+
+func (c *AggregateRebuild) start(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(c.sleepDuration) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logger.Info("cron aggregate rebuild stopped", slog.String("reason", ctx.Err().Error()))
+			return
+		case <-ticker.C:
+			c.logger.Debug("[cron aggregate rebuild] tick")
+
+			ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.timeout)*time.Second)
+
+			if err := c.process(ctxTimeout); err != nil {
+				// log but keep the cron alive; next tick continues from the saved cursor.
+				c.logger.Error("error aggregate rebuild", "err", err)
+			}
+
+			cancel()
+		}
+	}
+}
+
+// process handles chunks until there are no unfinished jobs left or the tick timeout expires.
+func (c *AggregateRebuild) process(ctx context.Context) error {
+	for ctx.Err() == nil {
+		job, err := c.aggregateRebuildService.ProcessChunk.Execute(ctx, aggregaterebuild.ProcessChunkDTO{
+			ChunkSize: c.chunkSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if job == nil { // nothing to rebuild.
+			return nil
+		}
+
+		c.logger.Debug("aggregate rebuild chunk",
+			slog.Int64("job id", job.ID),
+			slog.String("status", job.Status),
+			slog.Int64("processed users", job.ProcessedUsers),
+			slog.Int64("total users", job.TotalUsers),
+		)
+
+		if job.Status == aggregaterebuild.StatusFailed {
+			c.logger.Error("aggregate rebuild job failed", slog.Int64("job id", job.ID), slog.Any("error", job.Error))
+		}
+	}
+
+	return nil
+}
@@ -18,6 +18,11 @@ const minTOAddMillisecond = 1500 // extra client-side headroom added to DB state
 // leaderboard aggregate. It runs in a burst: multiple back-to-back calls
 // within a single tick until we catch up to the fixed "ceiling" (to_id),
 // then sleeps until the next tick.
+//
+// Every replica of the service runs this cron with the same worker name.
+// The DB function elects a leader per call through a non-blocking advisory
+// lock: only one replica folds a batch at a time, the others get
+// is_leader = false and skip the tick instead of waiting on the checkpoint row.
 type LeaderboardWeeksProcessBatch struct {
 	experiencePointService *experiencepointservice.Service
 	logger                 *logger.Logger
@@ -26,6 +31,7 @@ type LeaderboardWeeksProcessBatch struct {
 	statementTimeoutMS     int64
 	lockTimeoutMS          int64
 	timeoutReliefCPU       int64
+	lagAlertThreshold      int64
 	sleepDuration          int
 	timeout                int
 }
@@ -46,6 +52,7 @@ func New(
 		statementTimeoutMS:     cfg.LeaderboardWeeksProcessBatch.StatementTimeoutMS,
 		lockTimeoutMS:          cfg.LeaderboardWeeksProcessBatch.LockTimeoutMS,
 		timeoutReliefCPU:       cfg.LeaderboardWeeksProcessBatch.TimeoutReliefCPU,
+		lagAlertThreshold:      cfg.LeaderboardWeeksProcessBatch.LagAlertThreshold,
 		sleepDuration:          cfg.LeaderboardWeeksProcessBatch.SleepDuration,
 		timeout:                cfg.LeaderboardWeeksProcessBatch.Timeout,
 	}
@@ -86,6 +93,11 @@ func (c *LeaderboardWeeksProcessBatch) start(ctx context.Context) {
 				c.logger.Error("error leaderboard weeks process batch", "err", err)
 			}
 
+			// report worker lag (last processed event id vs max xp event id).
+			if err := c.checkLag(ctxTimeout); err != nil {
+				c.logger.Error("error check leaderboard weeks worker lag", "err", err)
+			}
+
 			cancel()
 		}
 	}
@@ -115,6 +127,12 @@ func (c *LeaderboardWeeksProcessBatch) processBatch(ctx context.Context) error {
 			return err
 		}
 
+		if !result.IsLeader {
+			// another replica holds the worker lock right now — end the burst.
+			c.logger.Debug("lbw batch skipped: worker is locked by another instance", slog.String("worker name", c.workerName))
+			break
+		}
+
 		// batch metrics for debugging/observability.
 		c.logger.Debug("lbw batch",
 			slog.Bool("processed", result.Processed),
@@ -153,3 +171,31 @@ func (c *LeaderboardWeeksProcessBatch) processBatch(ctx context.Context) error {
 
 	return nil
 }
+
+// checkLag fetches the worker lag and warns when it exceeds the configured threshold,
+// so that log based alerting can pick it up.
+func (c *LeaderboardWeeksProcessBatch) checkLag(ctx context.Context) error {
+	result, err := c.experiencePointService.GetLeaderboardWeeksWorkerLag.Execute(ctx, c.workerName)
+	if err != nil {
+		return err
+	}
+
+	attrs := []any{
+		slog.String("worker name", result.WorkerName),
+		slog.Int64("last event id", result.LastEventID),
+		slog.Int64("max event id", result.MaxEventID),
+		slog.Int64("lag events", result.LagEvents),
+	}
+	if result.LagSeconds != nil {
+		attrs = append(attrs, slog.Int64("lag seconds", *result.LagSeconds))
+	}
+
+	if c.lagAlertThreshold > 0 && result.LagEvents > c.lagAlertThreshold {
+		c.logger.Warn("lbw worker lag exceeds threshold", append(attrs, slog.Int64("threshold", c.lagAlertThreshold))...)
+		return nil
+	}
+
+	c.logger.Debug("lbw worker lag", attrs...)
+
+	return nil
+}
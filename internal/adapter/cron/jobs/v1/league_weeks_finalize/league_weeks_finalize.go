@@ -0,0 +1,86 @@
+package leagueweeksfinalize
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	leagueservice "github.com/go-jedi/lingramm_backend/internal/service/v1/league"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+// LeagueWeeksFinalize periodically calls the DB function
+// public.league_weeks_finalize to close finished league weeks:
+// every cohort of a past week gets final positions and
+// promoted/stayed/demoted results for its members.
+type LeagueWeeksFinalize struct {
+	leagueService *leagueservice.Service
+	logger        *logger.Logger
+	batchSize     int64
+	sleepDuration int
+	timeout       int
+}
+
+// New constructs the cron job and starts it in a background goroutine.
+func New(
+	ctx context.Context,
+	leagueService *leagueservice.Service,
+	cfg config.CronConfig,
+	logger *logger.Logger,
+) *LeagueWeeksFinalize {
+	c := &LeagueWeeksFinalize{
+		leagueService: leagueService,
+		logger:        logger,
+		batchSize:     cfg.LeagueWeeksFinalize.BatchSize,
+		sleepDuration: cfg.LeagueWeeksFinalize.SleepDuration,
+		timeout:       cfg.LeagueWeeksFinalize.Timeout,
+	}
+
+	go c.start(ctx)
+
+	return c
+}
+
+func (c *LeagueWeeksFinalize) start(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(c.sleepDuration) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logger.Info("cron league weeks finalize stopped", slog.String("reason", ctx.Err().Error()))
+			return
+		case <-ticker.C:
+			c.logger.Debug("[cron league weeks finalize] tick")
+
+			ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.timeout)*time.Second)
+
+			if err := c.finalize(ctxTimeout); err != nil {
+				// log but keep the cron alive; next tick will retry.
+				c.logger.Error("error league weeks finalize", "err", err)
+			}
+
+			cancel()
+		}
+	}
+}
+
+// finalize closes cohorts batch by batch until there is nothing left to finalize.
+func (c *LeagueWeeksFinalize) finalize(ctx context.Context) error {
+	for {
+		result, err := c.leagueService.WeeksFinalize.Execute(ctx, c.batchSize)
+		if err != nil {
+			return err
+		}
+
+		c.logger.Debug("league weeks finalize batch",
+			slog.Int64("cohorts count", result.CohortsCount),
+			slog.Int64("members count", result.MembersCount),
+		)
+
+		if result.CohortsCount < c.batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package ledgerreconciliation
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+// LedgerReconciliation periodically schedules a dry run reconciliation
+// of all users and calls the DB function public.ledger_reconciliation_job_process_chunk
+// to move unfinished reconciliation jobs forward chunk by chunk.
+// Progress is stored in the job itself, so a restart continues from the last chunk.
+type LedgerReconciliation struct {
+	ledgerReconciliationService *ledgerreconciliationservice.Service
+	logger                      *logger.Logger
+	chunkSize                   int64
+	scheduleInterval            int64
+	sleepDuration               int
+	timeout                     int
+}
+
+// New constructs the cron job and starts it in a background goroutine.
+func New(
+	ctx context.Context,
+	ledgerReconciliationService *ledgerreconciliationservice.Service,
+	cfg config.CronConfig,
+	logger *logger.Logger,
+) *LedgerReconciliation {
+	c := &LedgerReconciliation{
+		ledgerReconciliationService: ledgerReconciliationService,
+		logger:                      logger,
+		chunkSize:                   cfg.LedgerReconciliation.ChunkSize,
+		scheduleInterval:            cfg.LedgerReconciliation.ScheduleInterval,
+		sleepDuration:               cfg.LedgerReconciliation.SleepDuration,
+		timeout:                     cfg.LedgerReconciliation.Timeout,
+	}
+
+	go c.start(ctx)
+
+	return c
+}
+
+func (c *LedgerReconciliation) start(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(c.sleepDuration) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logger.Info("cron ledger reconciliation stopped", slog.String("reason", ctx.Err().Error()))
+			return
+		case <-ticker.C:
+			c.logger.Debug("[cron ledger reconciliation] tick")
+
+			ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.timeout)*time.Second)
+
+			if err := c.schedule(ctxTimeout); err != nil {
+				c.logger.Error("error schedule ledger reconciliation", "err", err)
+			}
+
+			if err := c.process(ctxTimeout); err != nil {
+				// log but keep the cron alive; next tick continues from the saved cursor.
+				c.logger.Error("error ledger reconciliation", "err", err)
+			}
+
+			cancel()
+		}
+	}
+}
+
+// schedule creates a dry run reconciliation of all users once per schedule interval.
+func (c *LedgerReconciliation) schedule(ctx context.Context) error {
+	if c.scheduleInterval <= 0 { // scheduled reconciliation is disabled.
+		return nil
+	}
+
+	job, err := c.ledgerReconciliationService.Schedule.Execute(ctx, c.scheduleInterval)
+	if err != nil {
+		return err
+	}
+
+	if job != nil {
+		c.logger.Info("ledger reconciliation job scheduled",
+			slog.Int64("job id", job.ID),
+			slog.Int64("total users", job.TotalUsers),
+		)
+	}
+
+	return nil
+}
+
+// process handles chunks until there are no unfinished jobs left or the tick timeout expires.
+func (c *LedgerReconciliation) process(ctx context.Context) error {
+	for ctx.Err() == nil {
+		job, err := c.ledgerReconciliationService.ProcessChunk.Execute(ctx, ledgerreconciliation.ProcessChunkDTO{
+			ChunkSize: c.chunkSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if job == nil { // nothing to reconcile.
+			return nil
+		}
+
+		c.logger.Debug("ledger reconciliation chunk",
+			slog.Int64("job id", job.ID),
+			slog.String("status", job.Status),
+			slog.Int64("processed users", job.ProcessedUsers),
+			slog.Int64("total users", job.TotalUsers),
+		)
+
+		switch {
+		case job.Status == ledgerreconciliation.StatusFailed:
+			c.logger.Error("ledger reconciliation job failed", slog.Int64("job id", job.ID), slog.Any("error", job.Error))
+		case job.IsFinished():
+			// metrics of the ledger state, drift is logged as a warning so that it can be alerted on.
+			attrs := []any{
+				slog.Int64("job id", job.ID),
+				slog.Bool("dry run", job.DryRun),
+				slog.Int64("processed users", job.ProcessedUsers),
+				slog.Int64("drift users", job.DriftUsers),
+				slog.Int64("broken chain users", job.BrokenChainUsers),
+				slog.Int64("corrected users", job.CorrectedUsers),
+				slog.String("drift amount", job.DriftAmount.String()),
+			}
+
+			if job.HasIssues() {
+				c.logger.Warn("ledger reconciliation job found issues", attrs...)
+			} else {
+				c.logger.Info("ledger reconciliation job completed", attrs...)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package subscriptionexpiry
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+// SubscriptionExpiry periodically calls the DB functions
+// public.subscriptions_remind and public.subscriptions_expire:
+// users get a reminder before their subscription expires, and
+// subscriptions whose grace period has ended are deactivated
+// with a record in subscription_history.
+type SubscriptionExpiry struct {
+	subscriptionService *subscriptionservice.Service
+	logger              *logger.Logger
+	batchSize           int64
+	remindBeforeHours   int64
+	sleepDuration       int
+	timeout             int
+}
+
+// New constructs the cron job and starts it in a background goroutine.
+func New(
+	ctx context.Context,
+	subscriptionService *subscriptionservice.Service,
+	cfg config.CronConfig,
+	logger *logger.Logger,
+) *SubscriptionExpiry {
+	c := &SubscriptionExpiry{
+		subscriptionService: subscriptionService,
+		logger:              logger,
+		batchSize:           cfg.SubscriptionExpiry.BatchSize,
+		remindBeforeHours:   cfg.SubscriptionExpiry.RemindBeforeHours,
+		sleepDuration:       cfg.SubscriptionExpiry.SleepDuration,
+		timeout:             cfg.SubscriptionExpiry.Timeout,
+	}
+
+	go c.start(ctx)
+
+	return c
+}
+
+func (c *SubscriptionExpiry) start(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(c.sleepDuration) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logger.Info("cron subscription expiry stopped", slog.String("reason", ctx.Err().Error()))
+			return
+		case <-ticker.C:
+			c.logger.Debug("[cron subscription expiry] tick")
+
+			ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.timeout)*time.Second)
+
+			if err := c.remind(ctxTimeout); err != nil {
+				// log but keep the cron alive; next tick will retry.
+				c.logger.Error("error subscription remind", "err", err)
+			}
+
+			if err := c.expire(ctxTimeout); err != nil {
+				// log but keep the cron alive; next tick will retry.
+				c.logger.Error("error subscription expire", "err", err)
+			}
+
+			cancel()
+		}
+	}
+}
+
+// remind notifies users batch by batch until there are no subscriptions left to remind about.
+func (c *SubscriptionExpiry) remind(ctx context.Context) error {
+	for {
+		result, err := c.subscriptionService.Remind.Execute(ctx, subscription.RemindDTO{
+			RemindBeforeHours: c.remindBeforeHours,
+			Limit:             c.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		c.logger.Debug("subscription remind batch", slog.Int("reminded count", len(result)))
+
+		if int64(len(result)) < c.batchSize {
+			return nil
+		}
+	}
+}
+
+// expire deactivates subscriptions batch by batch until there is nothing left to expire.
+func (c *SubscriptionExpiry) expire(ctx context.Context) error {
+	for {
+		result, err := c.subscriptionService.Expire.Execute(ctx, subscription.ExpireDTO{
+			Limit: c.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		c.logger.Debug("subscription expire batch", slog.Int("expired count", len(result)))
+
+		if int64(len(result)) < c.batchSize {
+			return nil
+		}
+	}
+}
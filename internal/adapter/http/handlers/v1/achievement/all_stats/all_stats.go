@@ -0,0 +1,55 @@
+package allstats
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllStats struct {
+	achievementService *achievementservice.Service
+	logger             logger.ILogger
+}
+
+func New(
+	achievementService *achievementservice.Service,
+	logger logger.ILogger,
+) *AllStats {
+	return &AllStats{
+		achievementService: achievementService,
+		logger:             logger,
+	}
+}
+
+// Execute returns rarity statistics of all achievements (admin).
+// @Summary Get achievement rarity statistics (admin)
+// @Description Returns unlock count, percentage of active users, first unlocker and median time to unlock for every achievement, rarest first. Statistics are recalculated periodically by a background job.
+// @Tags Achievement
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} achievement.AllStatsSwaggerResponse "Successful response"
+// @Failure 500 {object} achievement.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement/stats [get]
+func (h *AllStats) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all achievement stats] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementService.AllStats.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all achievement stats", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all achievement stats", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]achievement.StatsDetail](true, "success", "", result))
+}
@@ -3,6 +3,7 @@ package create
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -47,6 +48,11 @@ func New(
 // @Param name formData string true "Achievement name"
 // @Param achievement_type formData string true "Achievement type identifier"
 // @Param description formData string false "Optional description"
+// @Param is_secret formData bool false "Secret achievement: shown as ??? until unlocked"
+// @Param available_from formData string false "Start of the window when achievement can be earned (RFC3339)"
+// @Param available_to formData string false "End of the window when achievement can be earned (RFC3339)"
+// @Param tier_group formData string false "Tier chain name (bronze/silver/gold of the same metric)"
+// @Param tier_level formData int false "Tier level in the chain, starting from 1"
 // @Param file_achievement formData file true "Achievement image file"
 // @Param file_award formData file true "Award image file"
 // @Success 200 {object} achievement.DetailSwaggerResponse "Successful response"
@@ -98,6 +104,12 @@ func (h *Create) Execute(c fiber.Ctx) error {
 		AchievementType:       achievementType,
 	}
 
+	if err := parseVisibility(c, &dto); err != nil {
+		h.logger.Error("failed to parse form values", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to parse form values", err.Error(), nil))
+	}
+
 	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
 		h.logger.Error("failed to validate struct", "error", err)
 		c.Status(fiber.StatusBadRequest)
@@ -116,3 +128,44 @@ func (h *Create) Execute(c fiber.Ctx) error {
 
 	return c.JSON(response.New[achievement.Detail](true, "success", "", result))
 }
+
+// parseVisibility parse optional secret, available window and tier form values.
+func parseVisibility(c fiber.Ctx, dto *achievement.CreateDTO) error {
+	if v := c.FormValue("is_secret"); v != "" {
+		isSecret, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid is_secret: %w", err)
+		}
+		dto.IsSecret = isSecret
+	}
+
+	if v := c.FormValue("available_from"); v != "" {
+		availableFrom, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid available_from: %w", err)
+		}
+		dto.AvailableFrom = &availableFrom
+	}
+
+	if v := c.FormValue("available_to"); v != "" {
+		availableTo, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid available_to: %w", err)
+		}
+		dto.AvailableTo = &availableTo
+	}
+
+	if v := c.FormValue("tier_group"); v != "" {
+		dto.TierGroup = &v
+	}
+
+	if v := c.FormValue("tier_level"); v != "" {
+		tierLevel, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tier_level: %w", err)
+		}
+		dto.TierLevel = &tierLevel
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package createreward
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type CreateReward struct {
+	achievementService *achievementservice.Service
+	logger             logger.ILogger
+	validator          validator.IValidator
+}
+
+func New(
+	achievementService *achievementservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *CreateReward {
+	return &CreateReward{
+		achievementService: achievementService,
+		logger:             logger,
+		validator:          validator,
+	}
+}
+
+// Execute adds a reward to an achievement (admin).
+// @Summary Create achievement reward (admin)
+// @Description Adds an internal currency, experience points, subscription days or streak freezes reward that is granted once to every user unlocking the achievement after the reward was created.
+// @Tags Achievement
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body achievement.CreateRewardDTO true "Achievement reward data"
+// @Success 200 {object} achievement.RewardSwaggerResponse "Successful response"
+// @Failure 400 {object} achievement.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} achievement.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement/reward [post]
+func (h *CreateReward) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create a new achievement reward] execute handler")
+
+	var dto achievement.CreateRewardDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementService.CreateReward.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create a new achievement reward", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create a new achievement reward", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[achievement.Reward](true, "success", "", result))
+}
@@ -0,0 +1,79 @@
+package deleterewardbyid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type DeleteRewardByID struct {
+	achievementService *achievementservice.Service
+	logger             logger.ILogger
+}
+
+func New(
+	achievementService *achievementservice.Service,
+	logger logger.ILogger,
+) *DeleteRewardByID {
+	return &DeleteRewardByID{
+		achievementService: achievementService,
+		logger:             logger,
+	}
+}
+
+// Execute deletes a achievement reward (admin).
+// @Summary Delete achievement reward by id (admin)
+// @Description Deletes a achievement reward. Rewards already granted to users stay in their history.
+// @Tags Achievement
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param rewardID path integer true "Achievement reward ID"
+// @Success 200 {object} achievement.RewardSwaggerResponse "Successful response"
+// @Failure 400 {object} achievement.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} achievement.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement/reward/id/{rewardID} [delete]
+func (h *DeleteRewardByID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[delete achievement reward by id] execute handler")
+
+	rewardIDStr := c.Params("rewardID")
+	if rewardIDStr == "" {
+		h.logger.Error("failed to get param rewardID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param rewardID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	rewardID, err := strconv.ParseInt(rewardIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if rewardID <= 0 {
+		h.logger.Error("invalid rewardID", "error", "reward id must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid reward id", "reward id must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementService.DeleteRewardByID.Execute(ctxTimeout, rewardID)
+	if err != nil {
+		h.logger.Error("failed to delete achievement reward by id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to delete achievement reward by id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[achievement.Reward](true, "success", "", result))
+}
@@ -2,9 +2,13 @@ package achievement
 
 import (
 	alldetail "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement/all_detail"
+	allstats "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement/all_stats"
 	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement/create"
+	createreward "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement/create_reward"
 	deletedetailbyachievementid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement/delete_detail_by_achievement_id"
+	deleterewardbyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement/delete_reward_by_id"
 	getdetailbyachievementid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement/get_detail_by_achievement_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement/update"
 	"github.com/go-jedi/lingramm_backend/internal/middleware"
 	achievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
@@ -14,9 +18,13 @@ import (
 
 type Handler struct {
 	allDetail                   *alldetail.AllDetail
+	allStats                    *allstats.AllStats
 	create                      *create.Create
+	createReward                *createreward.CreateReward
 	deleteDetailByAchievementID *deletedetailbyachievementid.DeleteDetailByAchievementID
+	deleteRewardByID            *deleterewardbyid.DeleteRewardByID
 	getDetailByAchievementID    *getdetailbyachievementid.GetDetailByAchievementID
+	update                      *update.Update
 }
 
 func New(
@@ -28,9 +36,13 @@ func New(
 ) *Handler {
 	h := &Handler{
 		allDetail:                   alldetail.New(achievementService, logger),
+		allStats:                    allstats.New(achievementService, logger),
 		create:                      create.New(achievementService, logger, validator),
+		createReward:                createreward.New(achievementService, logger, validator),
 		deleteDetailByAchievementID: deletedetailbyachievementid.New(achievementService, logger),
+		deleteRewardByID:            deleterewardbyid.New(achievementService, logger),
 		getDetailByAchievementID:    getdetailbyachievementid.New(achievementService, logger),
+		update:                      update.New(achievementService, logger, validator),
 	}
 
 	h.initRoutes(app, middleware)
@@ -50,8 +62,16 @@ func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware)
 			middleware.ContentLengthLimiter.ContentLengthLimiterMiddleware,
 			h.create.Execute,
 		)
+		api.Put(
+			"",
+			middleware.ContentLengthLimiter.ContentLengthLimiterMiddleware,
+			h.update.Execute,
+		)
 		api.Get("/all", h.allDetail.Execute)
+		api.Get("/stats", h.allStats.Execute)
 		api.Get("/id/:achievementID", h.getDetailByAchievementID.Execute)
 		api.Delete("/id/:achievementID", h.deleteDetailByAchievementID.Execute)
+		api.Post("/reward", h.createReward.Execute)
+		api.Delete("/reward/id/:rewardID", h.deleteRewardByID.Execute)
 	}
 }
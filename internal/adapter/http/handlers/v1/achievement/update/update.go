@@ -0,0 +1,187 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementassets "github.com/go-jedi/lingramm_backend/internal/domain/file_server/achievement_assets"
+	awardassets "github.com/go-jedi/lingramm_backend/internal/domain/file_server/award_assets"
+	achievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+	"github.com/valyala/fasthttp"
+)
+
+const timeout = 5 * time.Second
+
+type Update struct {
+	achievementService *achievementservice.Service
+	logger             logger.ILogger
+	validator          validator.IValidator
+}
+
+func New(
+	achievementService *achievementservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Update {
+	return &Update{
+		achievementService: achievementService,
+		logger:             logger,
+		validator:          validator,
+	}
+}
+
+// Execute updates achievement metadata and optionally swaps images.
+// @Summary Update achievement (admin)
+// @Description Updates name, type and description of the achievement. Achievement and award images are optional: when passed, the old image is replaced and its file is removed (multipart/form-data).
+// @Tags Achievement
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param id formData int true "Achievement ID"
+// @Param name formData string true "Achievement name"
+// @Param achievement_type formData string true "Achievement type identifier"
+// @Param description formData string false "Optional description"
+// @Param is_secret formData bool false "Secret achievement: shown as ??? until unlocked"
+// @Param available_from formData string false "Start of the window when achievement can be earned (RFC3339)"
+// @Param available_to formData string false "End of the window when achievement can be earned (RFC3339)"
+// @Param tier_group formData string false "Tier chain name (bronze/silver/gold of the same metric)"
+// @Param tier_level formData int false "Tier level in the chain, starting from 1"
+// @Param file_achievement formData file false "New achievement image file"
+// @Param file_award formData file false "New award image file"
+// @Success 200 {object} achievement.DetailSwaggerResponse "Successful response"
+// @Failure 400 {object} achievement.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} achievement.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement [put]
+func (h *Update) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[update a achievement] execute handler")
+
+	var (
+		idStr           = c.FormValue("id")
+		description     = c.FormValue("description")
+		name            = c.FormValue("name")
+		achievementType = c.FormValue("achievement_type")
+	)
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	fileAchievementHeader, err := c.FormFile("file_achievement")
+	if err != nil && !errors.Is(err, fasthttp.ErrMissingFile) {
+		h.logger.Error("failed to get the file achievement for the provided form key", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get the file achievement for the provided form key", err.Error(), nil))
+	}
+
+	fileAwardHeader, err := c.FormFile("file_award")
+	if err != nil && !errors.Is(err, fasthttp.ErrMissingFile) {
+		h.logger.Error("failed to get the file award for the provided form key", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get the file award for the provided form key", err.Error(), nil))
+	}
+
+	if fileAchievementHeader != nil {
+		contentTypeAchievement := strings.ToLower(fileAchievementHeader.Header.Get("Content-Type"))
+		if _, ok := achievementassets.SupportedImageTypes[contentTypeAchievement]; !ok {
+			h.logger.Error(fmt.Sprintf("unsupported file achievement type: %s", contentTypeAchievement), "error")
+			c.Status(fiber.StatusBadRequest)
+			return c.JSON(response.New[any](false, "unsupported file achievement type", fmt.Errorf("%w: %s", apperrors.ErrUnsupportedFormat, contentTypeAchievement).Error(), nil))
+		}
+	}
+
+	if fileAwardHeader != nil {
+		contentTypeAward := strings.ToLower(fileAwardHeader.Header.Get("Content-Type"))
+		if _, ok := awardassets.SupportedImageTypes[contentTypeAward]; !ok {
+			h.logger.Error(fmt.Sprintf("unsupported file award type: %s", contentTypeAward), "error")
+			c.Status(fiber.StatusBadRequest)
+			return c.JSON(response.New[any](false, "unsupported file award type", fmt.Errorf("%w: %s", apperrors.ErrUnsupportedFormat, contentTypeAward).Error(), nil))
+		}
+	}
+
+	dto := achievement.UpdateDTO{
+		FileAchievementHeader: fileAchievementHeader,
+		FileAwardHeader:       fileAwardHeader,
+		ID:                    id,
+		Description:           &description,
+		Name:                  name,
+		AchievementType:       achievementType,
+	}
+
+	if err := parseVisibility(c, &dto); err != nil {
+		h.logger.Error("failed to parse form values", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to parse form values", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementService.Update.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to update a achievement", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to update a achievement", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[achievement.Detail](true, "success", "", result))
+}
+
+// parseVisibility parse optional secret, available window and tier form values.
+func parseVisibility(c fiber.Ctx, dto *achievement.UpdateDTO) error {
+	if v := c.FormValue("is_secret"); v != "" {
+		isSecret, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid is_secret: %w", err)
+		}
+		dto.IsSecret = isSecret
+	}
+
+	if v := c.FormValue("available_from"); v != "" {
+		availableFrom, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid available_from: %w", err)
+		}
+		dto.AvailableFrom = &availableFrom
+	}
+
+	if v := c.FormValue("available_to"); v != "" {
+		availableTo, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("invalid available_to: %w", err)
+		}
+		dto.AvailableTo = &availableTo
+	}
+
+	if v := c.FormValue("tier_group"); v != "" {
+		dto.TierGroup = &v
+	}
+
+	if v := c.FormValue("tier_level"); v != "" {
+		tierLevel, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tier_level: %w", err)
+		}
+		dto.TierLevel = &tierLevel
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	achievementevaluationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	achievementEvaluationService *achievementevaluationservice.Service
+	logger                       logger.ILogger
+}
+
+func New(
+	achievementEvaluationService *achievementevaluationservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		achievementEvaluationService: achievementEvaluationService,
+		logger:                       logger,
+	}
+}
+
+// Execute returns the latest achievement evaluation jobs.
+// @Summary Get all achievement evaluation jobs (admin)
+// @Description Returns the latest achievement evaluation jobs, newest first.
+// @Tags Achievement evaluation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} achievementevaluation.AllSwaggerResponse "Successful response"
+// @Failure 500 {object} achievementevaluation.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement_evaluation/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all achievement evaluation jobs] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementEvaluationService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all achievement evaluation jobs", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all achievement evaluation jobs", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]achievementevaluation.Job](true, "success", "", result))
+}
@@ -0,0 +1,76 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	achievementevaluationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Create struct {
+	achievementEvaluationService *achievementevaluationservice.Service
+	logger                       logger.ILogger
+	validator                    validator.IValidator
+}
+
+func New(
+	achievementEvaluationService *achievementevaluationservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Create {
+	return &Create{
+		achievementEvaluationService: achievementEvaluationService,
+		logger:                       logger,
+		validator:                    validator,
+	}
+}
+
+// Execute creates a job that retroactively evaluates an achievement for all users.
+// @Summary Create achievement evaluation job (admin)
+// @Description Creates a job that checks the achievement criteria for every user and unlocks the achievement (with its rewards) for users who already qualify. Jobs are also created automatically when an achievement is created or updated. The job is processed in resumable chunks by the background worker; only recently active users get notifications.
+// @Tags Achievement evaluation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body achievementevaluation.CreateDTO true "Achievement evaluation job data"
+// @Success 200 {object} achievementevaluation.JobSwaggerResponse "Successful response"
+// @Failure 400 {object} achievementevaluation.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} achievementevaluation.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement_evaluation [post]
+func (h *Create) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create achievement evaluation job] execute handler")
+
+	var dto achievementevaluation.CreateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	dto.Reason = achievementevaluation.ReasonManual
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementEvaluationService.Create.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create achievement evaluation job", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create achievement evaluation job", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[achievementevaluation.Job](true, "success", "", result))
+}
@@ -0,0 +1,79 @@
+package getbyid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	achievementevaluationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type GetByID struct {
+	achievementEvaluationService *achievementevaluationservice.Service
+	logger                       logger.ILogger
+}
+
+func New(
+	achievementEvaluationService *achievementevaluationservice.Service,
+	logger logger.ILogger,
+) *GetByID {
+	return &GetByID{
+		achievementEvaluationService: achievementEvaluationService,
+		logger:                       logger,
+	}
+}
+
+// Execute returns an achievement evaluation job with its progress.
+// @Summary Get achievement evaluation job by id (admin)
+// @Description Returns status, processed/total users, how many users unlocked the achievement and how many of them were notified.
+// @Tags Achievement evaluation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param jobID path integer true "Achievement evaluation job ID"
+// @Success 200 {object} achievementevaluation.JobSwaggerResponse "Successful response"
+// @Failure 400 {object} achievementevaluation.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} achievementevaluation.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement_evaluation/id/{jobID} [get]
+func (h *GetByID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get achievement evaluation job by id] execute handler")
+
+	jobIDStr := c.Params("jobID")
+	if jobIDStr == "" {
+		h.logger.Error("failed to get param jobID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param jobID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	jobID, err := strconv.ParseInt(jobIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if jobID <= 0 {
+		h.logger.Error("invalid jobID", "error", "job id must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid job id", "job id must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementEvaluationService.GetByID.Execute(ctxTimeout, jobID)
+	if err != nil {
+		h.logger.Error("failed to get achievement evaluation job by id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get achievement evaluation job by id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[achievementevaluation.Job](true, "success", "", result))
+}
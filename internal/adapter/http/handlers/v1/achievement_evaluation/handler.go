@@ -0,0 +1,49 @@
+package achievementevaluation
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_evaluation/all"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_evaluation/create"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_evaluation/get_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	achievementevaluationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	all     *all.All
+	create  *create.Create
+	getByID *getbyid.GetByID
+}
+
+func New(
+	achievementEvaluationService *achievementevaluationservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		all:     all.New(achievementEvaluationService, logger),
+		create:  create.New(achievementEvaluationService, logger, validator),
+		getByID: getbyid.New(achievementEvaluationService, logger),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/achievement_evaluation",
+		middleware.Auth.AuthMiddleware,
+		middleware.AdminGuard.AdminGuardMiddleware,
+	)
+	{
+		api.Post("", h.create.Execute)
+		api.Get("/all", h.all.Execute)
+		api.Get("/id/:jobID", h.getByID.Execute)
+	}
+}
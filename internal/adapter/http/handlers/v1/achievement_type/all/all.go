@@ -0,0 +1,55 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtypeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	achievementTypeService *achievementtypeservice.Service
+	logger                 logger.ILogger
+}
+
+func New(
+	achievementTypeService *achievementtypeservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		achievementTypeService: achievementTypeService,
+		logger:                 logger,
+	}
+}
+
+// Execute returns all achievement types (admin).
+// @Summary Get all achievement types (admin)
+// @Description Returns all achievement types with their current criteria and criteria version.
+// @Tags AchievementType
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} achievementtype.AllSwaggerResponse "Successful response"
+// @Failure 500 {object} achievementtype.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement_type/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all achievement types] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementTypeService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all achievement types", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all achievement types", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]achievementtype.AchievementType](true, "success", "", result))
+}
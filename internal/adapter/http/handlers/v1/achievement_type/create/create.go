@@ -0,0 +1,74 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtypeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Create struct {
+	achievementTypeService *achievementtypeservice.Service
+	logger                 logger.ILogger
+	validator              validator.IValidator
+}
+
+func New(
+	achievementTypeService *achievementtypeservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Create {
+	return &Create{
+		achievementTypeService: achievementTypeService,
+		logger:                 logger,
+		validator:              validator,
+	}
+}
+
+// Execute creates a new achievement type (admin).
+// @Summary Create achievement type (admin)
+// @Description Creates an achievement type with at least one *_need criterion. The first criteria version is recorded.
+// @Tags AchievementType
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body achievementtype.CreateDTO true "Achievement type data"
+// @Success 200 {object} achievementtype.AchievementTypeSwaggerResponse "Successful response"
+// @Failure 400 {object} achievementtype.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} achievementtype.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement_type [post]
+func (h *Create) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create achievement type] execute handler")
+
+	var dto achievementtype.CreateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementTypeService.Create.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create achievement type", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create achievement type", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[achievementtype.AchievementType](true, "success", "", result))
+}
@@ -0,0 +1,79 @@
+package deletebyid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtypeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type DeleteByID struct {
+	achievementTypeService *achievementtypeservice.Service
+	logger                 logger.ILogger
+}
+
+func New(
+	achievementTypeService *achievementtypeservice.Service,
+	logger logger.ILogger,
+) *DeleteByID {
+	return &DeleteByID{
+		achievementTypeService: achievementTypeService,
+		logger:                 logger,
+	}
+}
+
+// Execute deletes achievement type by ID (admin).
+// @Summary Delete achievement type by ID (admin)
+// @Description Deletes the achievement type and its criteria versions. Achievement types used by achievements cannot be deleted. Returns the deleted record.
+// @Tags AchievementType
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param achievementTypeID path int true "Achievement type ID"
+// @Success 200 {object} achievementtype.AchievementTypeSwaggerResponse "Successful response"
+// @Failure 400 {object} achievementtype.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} achievementtype.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement_type/id/{achievementTypeID} [delete]
+func (h *DeleteByID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[delete achievement type by id] execute handler")
+
+	achievementTypeIDStr := c.Params("achievementTypeID")
+	if achievementTypeIDStr == "" {
+		h.logger.Error("failed to get param achievementTypeID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param achievementTypeID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	achievementTypeID, err := strconv.ParseInt(achievementTypeIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if achievementTypeID <= 0 {
+		h.logger.Error("invalid achievementTypeID", "error", "achievement type id must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid achievement type id", "achievement type id must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementTypeService.DeleteByID.Execute(ctxTimeout, achievementTypeID)
+	if err != nil {
+		h.logger.Error("failed to delete achievement type by id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to delete achievement type by id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[achievementtype.AchievementType](true, "success", "", result))
+}
@@ -0,0 +1,61 @@
+package achievementtype
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_type/all"
+	allversionsbyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_type/all_versions_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_type/create"
+	deletebyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_type/delete_by_id"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_type/get_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_type/update"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	achievementtypeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	all             *all.All
+	allVersionsByID *allversionsbyid.AllVersionsByID
+	create          *create.Create
+	deleteByID      *deletebyid.DeleteByID
+	getByID         *getbyid.GetByID
+	update          *update.Update
+}
+
+func New(
+	achievementTypeService *achievementtypeservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		all:             all.New(achievementTypeService, logger),
+		allVersionsByID: allversionsbyid.New(achievementTypeService, logger),
+		create:          create.New(achievementTypeService, logger, validator),
+		deleteByID:      deletebyid.New(achievementTypeService, logger),
+		getByID:         getbyid.New(achievementTypeService, logger),
+		update:          update.New(achievementTypeService, logger, validator),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/achievement_type",
+		middleware.Auth.AuthMiddleware,
+		middleware.AdminGuard.AdminGuardMiddleware,
+	)
+	{
+		api.Post("", h.create.Execute)
+		api.Put("", h.update.Execute)
+		api.Get("/all", h.all.Execute)
+		api.Get("/id/:achievementTypeID", h.getByID.Execute)
+		api.Get("/versions/id/:achievementTypeID", h.allVersionsByID.Execute)
+		api.Delete("/id/:achievementTypeID", h.deleteByID.Execute)
+	}
+}
@@ -0,0 +1,74 @@
+package update
+
+import (
+	"context"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtypeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Update struct {
+	achievementTypeService *achievementtypeservice.Service
+	logger                 logger.ILogger
+	validator              validator.IValidator
+}
+
+func New(
+	achievementTypeService *achievementtypeservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Update {
+	return &Update{
+		achievementTypeService: achievementTypeService,
+		logger:                 logger,
+		validator:              validator,
+	}
+}
+
+// Execute updates achievement type (admin).
+// @Summary Update achievement type (admin)
+// @Description Replaces name, description, activity and criteria of the achievement type. Criteria that are not passed are cleared. When criteria change, a new criteria version is recorded.
+// @Tags AchievementType
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body achievementtype.UpdateDTO true "Achievement type data"
+// @Success 200 {object} achievementtype.AchievementTypeSwaggerResponse "Successful response"
+// @Failure 400 {object} achievementtype.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} achievementtype.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/achievement_type [put]
+func (h *Update) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[update achievement type] execute handler")
+
+	var dto achievementtype.UpdateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.achievementTypeService.Update.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to update achievement type", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to update achievement type", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[achievementtype.AchievementType](true, "success", "", result))
+}
@@ -0,0 +1,55 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildservice "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	aggregateRebuildService *aggregaterebuildservice.Service
+	logger                  logger.ILogger
+}
+
+func New(
+	aggregateRebuildService *aggregaterebuildservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		aggregateRebuildService: aggregateRebuildService,
+		logger:                  logger,
+	}
+}
+
+// Execute returns the latest aggregate rebuild jobs.
+// @Summary Get all aggregate rebuild jobs (admin)
+// @Description Returns the latest aggregate rebuild jobs, newest first.
+// @Tags Aggregate rebuild
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} aggregaterebuild.AllSwaggerResponse "Successful response"
+// @Failure 500 {object} aggregaterebuild.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/aggregate_rebuild/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all aggregate rebuild jobs] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.aggregateRebuildService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all aggregate rebuild jobs", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all aggregate rebuild jobs", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]aggregaterebuild.Job](true, "success", "", result))
+}
@@ -0,0 +1,74 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildservice "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Create struct {
+	aggregateRebuildService *aggregaterebuildservice.Service
+	logger                  logger.ILogger
+	validator               validator.IValidator
+}
+
+func New(
+	aggregateRebuildService *aggregaterebuildservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Create {
+	return &Create{
+		aggregateRebuildService: aggregateRebuildService,
+		logger:                  logger,
+		validator:               validator,
+	}
+}
+
+// Execute creates a job that rebuilds leaderboard and stats aggregates from xp_events.
+// @Summary Create aggregate rebuild job (admin)
+// @Description Creates a job that recomputes leaderboard_weeks, user_stats and user_level_history from xp_events for one user, a week range or everything. With dry_run the job only counts mismatches. The job is processed in resumable chunks by the background worker or the rebuild command.
+// @Tags Aggregate rebuild
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body aggregaterebuild.CreateDTO true "Aggregate rebuild job data"
+// @Success 200 {object} aggregaterebuild.JobSwaggerResponse "Successful response"
+// @Failure 400 {object} aggregaterebuild.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} aggregaterebuild.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/aggregate_rebuild [post]
+func (h *Create) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create aggregate rebuild job] execute handler")
+
+	var dto aggregaterebuild.CreateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.aggregateRebuildService.Create.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create aggregate rebuild job", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create aggregate rebuild job", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[aggregaterebuild.Job](true, "success", "", result))
+}
@@ -0,0 +1,79 @@
+package getbyid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildservice "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type GetByID struct {
+	aggregateRebuildService *aggregaterebuildservice.Service
+	logger                  logger.ILogger
+}
+
+func New(
+	aggregateRebuildService *aggregaterebuildservice.Service,
+	logger logger.ILogger,
+) *GetByID {
+	return &GetByID{
+		aggregateRebuildService: aggregateRebuildService,
+		logger:                  logger,
+	}
+}
+
+// Execute returns an aggregate rebuild job with its progress and diff counters.
+// @Summary Get aggregate rebuild job by id (admin)
+// @Description Returns status, processed/total users and the number of mismatches found in leaderboard_weeks, user_stats and user_level_history.
+// @Tags Aggregate rebuild
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param jobID path integer true "Aggregate rebuild job ID"
+// @Success 200 {object} aggregaterebuild.JobSwaggerResponse "Successful response"
+// @Failure 400 {object} aggregaterebuild.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} aggregaterebuild.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/aggregate_rebuild/id/{jobID} [get]
+func (h *GetByID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get aggregate rebuild job by id] execute handler")
+
+	jobIDStr := c.Params("jobID")
+	if jobIDStr == "" {
+		h.logger.Error("failed to get param jobID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param jobID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	jobID, err := strconv.ParseInt(jobIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if jobID <= 0 {
+		h.logger.Error("invalid jobID", "error", "job id must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid job id", "job id must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.aggregateRebuildService.GetByID.Execute(ctxTimeout, jobID)
+	if err != nil {
+		h.logger.Error("failed to get aggregate rebuild job by id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get aggregate rebuild job by id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[aggregaterebuild.Job](true, "success", "", result))
+}
@@ -0,0 +1,49 @@
+package aggregaterebuild
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/aggregate_rebuild/all"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/aggregate_rebuild/create"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/aggregate_rebuild/get_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	aggregaterebuildservice "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	all     *all.All
+	create  *create.Create
+	getByID *getbyid.GetByID
+}
+
+func New(
+	aggregateRebuildService *aggregaterebuildservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		all:     all.New(aggregateRebuildService, logger),
+		create:  create.New(aggregateRebuildService, logger, validator),
+		getByID: getbyid.New(aggregateRebuildService, logger),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/aggregate_rebuild",
+		middleware.Auth.AuthMiddleware,
+		middleware.AdminGuard.AdminGuardMiddleware,
+	)
+	{
+		api.Post("", h.create.Execute)
+		api.Get("/all", h.all.Execute)
+		api.Get("/id/:jobID", h.getByID.Execute)
+	}
+}
@@ -0,0 +1,56 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	currencyRateService *currencyrateservice.Service
+	logger              logger.ILogger
+}
+
+func New(
+	currencyRateService *currencyrateservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		currencyRateService: currencyRateService,
+		logger:              logger,
+	}
+}
+
+// Execute returns all currency rates (admin).
+// @Summary Get all currency rates (admin)
+// @Description Returns all currency rates ordered by currency code. Rate is how many units of the currency one internal unit costs.
+// @Tags Currency rate
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} currencyrate.AllSwaggerResponse "Successful response"
+// @Failure 400 {object} currencyrate.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} currencyrate.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/currency_rate/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all currency rates] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.currencyRateService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all currency rates", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all currency rates", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]currencyrate.CurrencyRate](true, "success", "", result))
+}
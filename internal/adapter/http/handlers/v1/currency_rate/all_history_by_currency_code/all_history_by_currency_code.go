@@ -0,0 +1,66 @@
+package allhistorybycurrencycode
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllHistoryByCurrencyCode struct {
+	currencyRateService *currencyrateservice.Service
+	logger              logger.ILogger
+}
+
+func New(
+	currencyRateService *currencyrateservice.Service,
+	logger logger.ILogger,
+) *AllHistoryByCurrencyCode {
+	return &AllHistoryByCurrencyCode{
+		currencyRateService: currencyRateService,
+		logger:              logger,
+	}
+}
+
+// Execute returns currency rate change history (admin).
+// @Summary Get currency rate history by currency code (admin)
+// @Description Returns changes of the currency rate from newest to oldest with old and new rate and the admin who made the change. History is kept after the rate is deleted.
+// @Tags Currency rate
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param currencyCode path string true "Currency code" example(USD)
+// @Success 200 {object} currencyrate.AllHistorySwaggerResponse "Successful response"
+// @Failure 400 {object} currencyrate.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} currencyrate.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/currency_rate/history/code/{currencyCode} [get]
+func (h *AllHistoryByCurrencyCode) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all currency rate history by currency code] execute handler")
+
+	currencyCode := c.Params("currencyCode")
+	if currencyCode == "" {
+		h.logger.Error("failed to get param currencyCode", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param currencyCode", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.currencyRateService.AllHistoryByCurrencyCode.Execute(ctxTimeout, strings.ToUpper(currencyCode))
+	if err != nil {
+		h.logger.Error("failed to get all currency rate history by currency code", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all currency rate history by currency code", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]currencyrate.History](true, "success", "", result))
+}
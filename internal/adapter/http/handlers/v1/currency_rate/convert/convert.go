@@ -0,0 +1,77 @@
+package convert
+
+import (
+	"context"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Convert struct {
+	currencyRateService *currencyrateservice.Service
+	logger              logger.ILogger
+	validator           validator.IValidator
+}
+
+func New(
+	currencyRateService *currencyrateservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Convert {
+	return &Convert{
+		currencyRateService: currencyRateService,
+		logger:              logger,
+		validator:           validator,
+	}
+}
+
+// Execute converts amount between internal currency and the currency.
+// @Summary Convert amount
+// @Description Converts amount using the current currency rate. Rules:
+// @Description • `direction` is `to_fiat` (internal → currency) or `to_internal` (currency → internal)
+// @Description • `amount` must be positive
+// @Description Amount in the currency is rounded half away from zero to 2 decimal places, internal amount is rounded up to 2 decimal places.
+// @Tags Currency rate
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body currencyrate.ConvertDTO true "Convert data"
+// @Success 200 {object} currencyrate.ConvertSwaggerResponse "Successful response"
+// @Failure 400 {object} currencyrate.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} currencyrate.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/currency_rate/convert [post]
+func (h *Convert) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[convert amount] execute handler")
+
+	var dto currencyrate.ConvertDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.currencyRateService.Convert.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to convert amount", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to convert amount", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[currencyrate.ConvertResponse](true, "success", "", result))
+}
@@ -0,0 +1,90 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Create struct {
+	currencyRateService *currencyrateservice.Service
+	logger              logger.ILogger
+	validator           validator.IValidator
+	middleware          *middleware.Middleware
+}
+
+func New(
+	currencyRateService *currencyrateservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Create {
+	return &Create{
+		currencyRateService: currencyRateService,
+		logger:              logger,
+		validator:           validator,
+		middleware:          middleware,
+	}
+}
+
+// Execute creates a currency rate (admin).
+// @Summary Create currency rate (admin)
+// @Description Creates a rate for the currency. Rules:
+// @Description • `currency_code` is an uppercase ISO 4217 code, for example `USD`
+// @Description • `rate` is how many units of the currency one internal unit costs, must be positive
+// @Description The change is recorded in the currency rate history with the admin who made it.
+// @Tags Currency rate
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body currencyrate.CreateDTO true "Currency rate data"
+// @Success 200 {object} currencyrate.CurrencyRateSwaggerResponse "Successful response"
+// @Failure 400 {object} currencyrate.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} currencyrate.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/currency_rate [post]
+func (h *Create) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create currency rate] execute handler")
+
+	var dto currencyrate.CreateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	changedBy, err := h.middleware.Auth.GetTelegramIDFromContext(c)
+	if err != nil {
+		h.logger.Error("failed to get telegram id from context", "error", err)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+	}
+
+	dto.ChangedBy = changedBy
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.currencyRateService.Create.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create currency rate", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create currency rate", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[currencyrate.CurrencyRate](true, "success", "", result))
+}
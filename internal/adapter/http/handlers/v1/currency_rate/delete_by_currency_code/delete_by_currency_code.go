@@ -0,0 +1,81 @@
+package deletebycurrencycode
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type DeleteByCurrencyCode struct {
+	currencyRateService *currencyrateservice.Service
+	logger              logger.ILogger
+	middleware          *middleware.Middleware
+}
+
+func New(
+	currencyRateService *currencyrateservice.Service,
+	logger logger.ILogger,
+	middleware *middleware.Middleware,
+) *DeleteByCurrencyCode {
+	return &DeleteByCurrencyCode{
+		currencyRateService: currencyRateService,
+		logger:              logger,
+		middleware:          middleware,
+	}
+}
+
+// Execute deletes a currency rate by currency code (admin).
+// @Summary Delete currency rate by currency code (admin)
+// @Description Deletes the rate of the currency and returns the deleted record.
+// @Description The change is recorded in the currency rate history with the admin who made it.
+// @Tags Currency rate
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param currencyCode path string true "Currency code" example(USD)
+// @Success 200 {object} currencyrate.CurrencyRateSwaggerResponse "Successful response"
+// @Failure 400 {object} currencyrate.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} currencyrate.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/currency_rate/code/{currencyCode} [delete]
+func (h *DeleteByCurrencyCode) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[delete currency rate by currency code] execute handler")
+
+	currencyCode := c.Params("currencyCode")
+	if currencyCode == "" {
+		h.logger.Error("failed to get param currencyCode", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param currencyCode", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	changedBy, err := h.middleware.Auth.GetTelegramIDFromContext(c)
+	if err != nil {
+		h.logger.Error("failed to get telegram id from context", "error", err)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.currencyRateService.DeleteByCurrencyCode.Execute(ctxTimeout, currencyrate.DeleteByCurrencyCodeDTO{
+		CurrencyCode: strings.ToUpper(currencyCode),
+		ChangedBy:    changedBy,
+	})
+	if err != nil {
+		h.logger.Error("failed to delete currency rate by currency code", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to delete currency rate by currency code", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[currencyrate.CurrencyRate](true, "success", "", result))
+}
@@ -0,0 +1,64 @@
+package currencyrate
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate/all"
+	allhistorybycurrencycode "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate/all_history_by_currency_code"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate/convert"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate/create"
+	deletebycurrencycode "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate/delete_by_currency_code"
+	pricesbycurrencycode "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate/prices_by_currency_code"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate/update"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	all                      *all.All
+	allHistoryByCurrencyCode *allhistorybycurrencycode.AllHistoryByCurrencyCode
+	convert                  *convert.Convert
+	create                   *create.Create
+	deleteByCurrencyCode     *deletebycurrencycode.DeleteByCurrencyCode
+	pricesByCurrencyCode     *pricesbycurrencycode.PricesByCurrencyCode
+	update                   *update.Update
+}
+
+func New(
+	currencyRateService *currencyrateservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		all:                      all.New(currencyRateService, logger),
+		allHistoryByCurrencyCode: allhistorybycurrencycode.New(currencyRateService, logger),
+		convert:                  convert.New(currencyRateService, logger, validator),
+		create:                   create.New(currencyRateService, logger, validator, middleware),
+		deleteByCurrencyCode:     deletebycurrencycode.New(currencyRateService, logger, middleware),
+		pricesByCurrencyCode:     pricesbycurrencycode.New(currencyRateService, logger),
+		update:                   update.New(currencyRateService, logger, validator, middleware),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/currency_rate",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Get("/all", middleware.AdminGuard.AdminGuardMiddleware, h.all.Execute)
+		api.Get("/history/code/:currencyCode", middleware.AdminGuard.AdminGuardMiddleware, h.allHistoryByCurrencyCode.Execute)
+		api.Get("/prices/code/:currencyCode", h.pricesByCurrencyCode.Execute)
+		api.Post("", middleware.AdminGuard.AdminGuardMiddleware, h.create.Execute)
+		api.Post("/convert", h.convert.Execute)
+		api.Put("", middleware.AdminGuard.AdminGuardMiddleware, h.update.Execute)
+		api.Delete("/code/:currencyCode", middleware.AdminGuard.AdminGuardMiddleware, h.deleteByCurrencyCode.Execute)
+	}
+}
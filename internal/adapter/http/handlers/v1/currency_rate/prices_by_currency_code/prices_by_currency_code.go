@@ -0,0 +1,67 @@
+package pricesbycurrencycode
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type PricesByCurrencyCode struct {
+	currencyRateService *currencyrateservice.Service
+	logger              logger.ILogger
+}
+
+func New(
+	currencyRateService *currencyrateservice.Service,
+	logger logger.ILogger,
+) *PricesByCurrencyCode {
+	return &PricesByCurrencyCode{
+		currencyRateService: currencyRateService,
+		logger:              logger,
+	}
+}
+
+// Execute returns offers priced in the currency.
+// @Summary Get prices by currency code
+// @Description Returns available shop items (including premium days offers) with price in internal currency and in the requested currency.
+// @Description Price in the currency is rounded half away from zero to 2 decimal places.
+// @Tags Currency rate
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param currencyCode path string true "Currency code" example(USD)
+// @Success 200 {object} currencyrate.PricesSwaggerResponse "Successful response"
+// @Failure 400 {object} currencyrate.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} currencyrate.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/currency_rate/prices/code/{currencyCode} [get]
+func (h *PricesByCurrencyCode) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get prices by currency code] execute handler")
+
+	currencyCode := c.Params("currencyCode")
+	if currencyCode == "" {
+		h.logger.Error("failed to get param currencyCode", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param currencyCode", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.currencyRateService.PricesByCurrencyCode.Execute(ctxTimeout, strings.ToUpper(currencyCode))
+	if err != nil {
+		h.logger.Error("failed to get prices by currency code", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get prices by currency code", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]currencyrate.OfferPrice](true, "success", "", result))
+}
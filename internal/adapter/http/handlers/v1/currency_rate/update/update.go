@@ -0,0 +1,88 @@
+package update
+
+import (
+	"context"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Update struct {
+	currencyRateService *currencyrateservice.Service
+	logger              logger.ILogger
+	validator           validator.IValidator
+	middleware          *middleware.Middleware
+}
+
+func New(
+	currencyRateService *currencyrateservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Update {
+	return &Update{
+		currencyRateService: currencyRateService,
+		logger:              logger,
+		validator:           validator,
+		middleware:          middleware,
+	}
+}
+
+// Execute updates a currency rate (admin).
+// @Summary Update currency rate (admin)
+// @Description Updates the rate of the currency. `rate` must be positive.
+// @Description The old and new rate are recorded in the currency rate history with the admin who made the change.
+// @Tags Currency rate
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body currencyrate.UpdateDTO true "Currency rate data"
+// @Success 200 {object} currencyrate.CurrencyRateSwaggerResponse "Successful response"
+// @Failure 400 {object} currencyrate.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} currencyrate.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/currency_rate [put]
+func (h *Update) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[update currency rate] execute handler")
+
+	var dto currencyrate.UpdateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	changedBy, err := h.middleware.Auth.GetTelegramIDFromContext(c)
+	if err != nil {
+		h.logger.Error("failed to get telegram id from context", "error", err)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+	}
+
+	dto.ChangedBy = changedBy
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.currencyRateService.Update.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to update currency rate", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to update currency rate", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[currencyrate.CurrencyRate](true, "success", "", result))
+}
@@ -0,0 +1,40 @@
+package currencytransfer
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_transfer/send"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	currencytransferservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_transfer"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	send *send.Send
+}
+
+func New(
+	currencyTransferService *currencytransferservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		send: send.New(currencyTransferService, logger, validator, middleware),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/currency_transfer",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Post("", h.send.Execute)
+	}
+}
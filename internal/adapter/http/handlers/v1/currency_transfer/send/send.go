@@ -0,0 +1,93 @@
+package send
+
+import (
+	"context"
+	"time"
+
+	currencytransfer "github.com/go-jedi/lingramm_backend/internal/domain/currency_transfer"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	currencytransferservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_transfer"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Send struct {
+	currencyTransferService *currencytransferservice.Service
+	logger                  logger.ILogger
+	validator               validator.IValidator
+	middleware              *middleware.Middleware
+}
+
+func New(
+	currencyTransferService *currencytransferservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Send {
+	return &Send{
+		currencyTransferService: currencyTransferService,
+		logger:                  logger,
+		validator:               validator,
+		middleware:              middleware,
+	}
+}
+
+// Execute transfers internal currency from the user making request to another user.
+// @Summary Send currency transfer
+// @Description Transfers internal currency from the user making request to another user. Rules:
+// @Description • `amount` must be positive with at most two decimal places, the recipient receives the whole amount
+// @Description • the fee (configured percent of the amount) is paid by the sender on top of the amount
+// @Description • `comment` is optional, up to 255 characters, shown to the recipient
+// @Description The transfer is rejected for a transfer to yourself, an unknown or banned recipient, a banned sender, a sender account younger than the minimum age, the daily amount or count limit of the sender being reached or an insufficient balance.
+// @Description Both users get a notification, both balance transactions reference the transfer id.
+// @Tags Currency transfer
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body currencytransfer.SendDTO true "Currency transfer data"
+// @Success 200 {object} currencytransfer.CurrencyTransferSwaggerResponse "Successful response"
+// @Failure 400 {object} currencytransfer.ErrorSwaggerResponse "Bad request error"
+// @Failure 401 {object} currencytransfer.ErrorSwaggerResponse "Unauthorized error"
+// @Failure 500 {object} currencytransfer.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/currency_transfer [post]
+func (h *Send) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[send currency transfer] execute handler")
+
+	var dto currencytransfer.SendDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	senderTelegramID, err := h.middleware.Auth.GetTelegramIDFromContext(c)
+	if err != nil {
+		h.logger.Error("failed to get telegram id from context", "error", err)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+	}
+
+	dto.SenderTelegramID = senderTelegramID
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.currencyTransferService.Send.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to send currency transfer", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to send currency transfer", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[currencytransfer.CurrencyTransfer](true, "success", "", result))
+}
@@ -0,0 +1,56 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
+	dailytaskservice "github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	dailyTaskService *dailytaskservice.Service
+	logger           logger.ILogger
+}
+
+func New(
+	dailyTaskService *dailytaskservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		dailyTaskService: dailyTaskService,
+		logger:           logger,
+	}
+}
+
+// Execute returns all daily tasks (admin).
+// @Summary Get all daily tasks (admin)
+// @Description Returns all daily tasks including inactive ones, ordered by ID.
+// @Tags Daily task
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} dailytask.AllDailyTasksSwaggerResponse "Successful response"
+// @Failure 400 {object} dailytask.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} dailytask.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/daily_task/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all daily tasks] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.dailyTaskService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all daily tasks", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all daily tasks", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]dailytask.DailyTask](true, "success", "", result))
+}
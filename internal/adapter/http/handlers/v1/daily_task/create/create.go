@@ -35,6 +35,7 @@ func New(
 // Execute creates a new daily task (admin).
 // @Summary Create daily task (admin)
 // @Description Creates a daily task record. **At least one** of the `*_need` fields must be provided and greater than 0.
+// @Description `difficulty` is one of `easy`, `medium`, `hard` (default `medium`); it is used by adaptive daily task assignment.
 // @Tags Daily task
 // @Accept json
 // @Produce json
@@ -0,0 +1,79 @@
+package deactivatebyid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
+	dailytaskservice "github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type DeactivateByID struct {
+	dailyTaskService *dailytaskservice.Service
+	logger           logger.ILogger
+}
+
+func New(
+	dailyTaskService *dailytaskservice.Service,
+	logger logger.ILogger,
+) *DeactivateByID {
+	return &DeactivateByID{
+		dailyTaskService: dailyTaskService,
+		logger:           logger,
+	}
+}
+
+// Execute deactivates a daily task by ID (admin).
+// @Summary Deactivate daily task by ID (admin)
+// @Description Deactivates the daily task so it is no longer assigned to users. The last active daily task cannot be deactivated. Returns the updated record.
+// @Tags Daily task
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param dailyTaskID path int true "Daily task ID"
+// @Success 200 {object} dailytask.DailyTaskSwaggerResponse "Successful response"
+// @Failure 400 {object} dailytask.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} dailytask.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/daily_task/id/{dailyTaskID}/deactivate [patch]
+func (h *DeactivateByID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[deactivate daily task by id] execute handler")
+
+	dailyTaskIDStr := c.Params("dailyTaskID")
+	if dailyTaskIDStr == "" {
+		h.logger.Error("failed to get param dailyTaskID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param dailyTaskID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	dailyTaskID, err := strconv.ParseInt(dailyTaskIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if dailyTaskID <= 0 {
+		h.logger.Error("invalid dailyTaskID", "error", "daily task id must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid daily task id", "daily task id must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.dailyTaskService.DeactivateByID.Execute(ctxTimeout, dailyTaskID)
+	if err != nil {
+		h.logger.Error("failed to deactivate daily task by id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to deactivate daily task by id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[dailytask.DailyTask](true, "success", "", result))
+}
@@ -1,7 +1,10 @@
 package dailytask
 
 import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/daily_task/all"
 	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/daily_task/create"
+	deactivatebyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/daily_task/deactivate_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/daily_task/update"
 	"github.com/go-jedi/lingramm_backend/internal/middleware"
 	dailytaskservice "github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
@@ -10,7 +13,10 @@ import (
 )
 
 type Handler struct {
-	create *create.Create
+	all            *all.All
+	create         *create.Create
+	deactivateByID *deactivatebyid.DeactivateByID
+	update         *update.Update
 }
 
 func New(
@@ -21,7 +27,10 @@ func New(
 	middleware *middleware.Middleware,
 ) *Handler {
 	h := &Handler{
-		create: create.New(dailyTaskService, logger, validator),
+		all:            all.New(dailyTaskService, logger),
+		create:         create.New(dailyTaskService, logger, validator),
+		deactivateByID: deactivatebyid.New(dailyTaskService, logger),
+		update:         update.New(dailyTaskService, logger, validator),
 	}
 
 	h.initRoutes(app, middleware)
@@ -36,6 +45,9 @@ func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware)
 		middleware.AdminGuard.AdminGuardMiddleware,
 	)
 	{
+		api.Get("/all", h.all.Execute)
 		api.Post("", h.create.Execute)
+		api.Put("", h.update.Execute)
+		api.Patch("/id/:dailyTaskID/deactivate", h.deactivateByID.Execute)
 	}
 }
@@ -0,0 +1,75 @@
+package update
+
+import (
+	"context"
+	"time"
+
+	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
+	dailytaskservice "github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Update struct {
+	dailyTaskService *dailytaskservice.Service
+	logger           logger.ILogger
+	validator        validator.IValidator
+}
+
+func New(
+	dailyTaskService *dailytaskservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Update {
+	return &Update{
+		dailyTaskService: dailyTaskService,
+		logger:           logger,
+		validator:        validator,
+	}
+}
+
+// Execute updates a daily task (admin).
+// @Summary Update daily task (admin)
+// @Description Updates requirements, difficulty and activity of a daily task found by ID. **At least one** of the `*_need` fields must be provided and greater than 0.
+// @Description The last active daily task cannot be deactivated. Already assigned user daily tasks keep their progress.
+// @Tags Daily task
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body dailytask.UpdateDTO true "Daily task data"
+// @Success 200 {object} dailytask.DailyTaskSwaggerResponse "Successful response"
+// @Failure 400 {object} dailytask.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} dailytask.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/daily_task [put]
+func (h *Update) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[update daily task] execute handler")
+
+	var dto dailytask.UpdateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.dailyTaskService.Update.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to update daily task", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to update daily task", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[dailytask.DailyTask](true, "success", "", result))
+}
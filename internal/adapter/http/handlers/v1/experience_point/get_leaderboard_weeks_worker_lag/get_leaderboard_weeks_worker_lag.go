@@ -0,0 +1,65 @@
+package getleaderboardweeksworkerlag
+
+import (
+	"context"
+	"time"
+
+	experiencepoint "github.com/go-jedi/lingramm_backend/internal/domain/experience_point"
+	experiencepointservice "github.com/go-jedi/lingramm_backend/internal/service/v1/experience_point"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type GetLeaderboardWeeksWorkerLag struct {
+	experiencePointService *experiencepointservice.Service
+	logger                 logger.ILogger
+}
+
+func New(
+	experiencePointService *experiencepointservice.Service,
+	logger logger.ILogger,
+) *GetLeaderboardWeeksWorkerLag {
+	return &GetLeaderboardWeeksWorkerLag{
+		experiencePointService: experiencePointService,
+		logger:                 logger,
+	}
+}
+
+// Execute returns the lag of the weekly leaderboard batch worker.
+// @Summary Get leaderboard weeks worker lag
+// @Description Returns the last processed xp event ID of the worker, the current max xp event ID and the lag between them. Intended for monitoring and alerting.
+// @Tags Experience point
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param workerName path string true "Worker name"
+// @Success 200 {object} experiencepoint.GetLeaderboardWeeksWorkerLagSwaggerResponse "Successful response"
+// @Failure 400 {object} experiencepoint.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} experiencepoint.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/experience_point/leaderboard/worker_lag/{workerName} [get]
+func (h *GetLeaderboardWeeksWorkerLag) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get leaderboard weeks worker lag] execute handler")
+
+	workerName := c.Params("workerName")
+	if workerName == "" {
+		h.logger.Error("failed to get param workerName", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param workerName", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.experiencePointService.GetLeaderboardWeeksWorkerLag.Execute(ctxTimeout, workerName)
+	if err != nil {
+		h.logger.Error("failed to get leaderboard weeks worker lag", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get leaderboard weeks worker lag", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[experiencepoint.GetLeaderboardWeeksWorkerLagResponse](true, "success", "", result))
+}
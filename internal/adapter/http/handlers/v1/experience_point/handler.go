@@ -3,6 +3,7 @@ package experiencepoint
 import (
 	getleaderboardtopweek "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/experience_point/get_leaderboard_top_week"
 	getleaderboardtopweekforuser "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/experience_point/get_leaderboard_top_week_for_user"
+	getleaderboardweeksworkerlag "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/experience_point/get_leaderboard_weeks_worker_lag"
 	"github.com/go-jedi/lingramm_backend/internal/middleware"
 	experiencepointservice "github.com/go-jedi/lingramm_backend/internal/service/v1/experience_point"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
@@ -13,6 +14,7 @@ import (
 type Handler struct {
 	getLeaderboardTopWeek        *getleaderboardtopweek.GetLeaderboardTopWeek
 	getLeaderboardTopWeekForUser *getleaderboardtopweekforuser.GetLeaderboardTopWeekForUser
+	getLeaderboardWeeksWorkerLag *getleaderboardweeksworkerlag.GetLeaderboardWeeksWorkerLag
 }
 
 func New(
@@ -25,6 +27,7 @@ func New(
 	h := &Handler{
 		getLeaderboardTopWeek:        getleaderboardtopweek.New(experiencePointService, logger, validator),
 		getLeaderboardTopWeekForUser: getleaderboardtopweekforuser.New(experiencePointService, logger, validator),
+		getLeaderboardWeeksWorkerLag: getleaderboardweeksworkerlag.New(experiencePointService, logger),
 	}
 
 	h.initRoutes(app, middleware)
@@ -40,5 +43,10 @@ func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware)
 	{
 		api.Post("/leaderboard/week_top", h.getLeaderboardTopWeek.Execute)
 		api.Post("/leaderboard/week_top/user", h.getLeaderboardTopWeekForUser.Execute)
+		api.Get(
+			"/leaderboard/worker_lag/:workerName",
+			middleware.AdminGuard.AdminGuardMiddleware,
+			h.getLeaderboardWeeksWorkerLag.Execute,
+		)
 	}
 }
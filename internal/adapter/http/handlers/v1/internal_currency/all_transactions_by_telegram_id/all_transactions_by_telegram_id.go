@@ -0,0 +1,79 @@
+package alltransactionsbytelegramid
+
+import (
+	"context"
+	"time"
+
+	balancetransaction "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/balance_transaction"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/internal_currency"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllTransactionsByTelegramID struct {
+	internalCurrencyService *internalcurrency.Service
+	logger                  logger.ILogger
+	validator               validator.IValidator
+}
+
+func New(
+	internalCurrencyService *internalcurrency.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *AllTransactionsByTelegramID {
+	return &AllTransactionsByTelegramID{
+		internalCurrencyService: internalCurrencyService,
+		logger:                  logger,
+		validator:               validator,
+	}
+}
+
+// Execute returns balance transactions of any user (admin).
+// @Summary Get balance transactions by Telegram ID (admin)
+// @Description Returns a page of balance transactions of the user from newest to oldest. Filters:
+// @Description • `direction` — `credit` or `debit`
+// @Description • `event_type` — event type name
+// @Description • `date_from` (inclusive) and `date_to` (exclusive), `date_from` must be before `date_to`
+// @Description • `lang` — language of titles (default `ru`)
+// @Description Pass `next_cursor` of the previous page as `cursor` to get the next page; `limit` is between 1 and 100.
+// @Tags Internal currency
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body balancetransaction.AllByTelegramIDDTO true "Balance transactions filters"
+// @Success 200 {object} balancetransaction.AllByTelegramIDSwaggerResponse "Successful response"
+// @Failure 400 {object} balancetransaction.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} balancetransaction.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/internal_currency/admin/transactions [post]
+func (h *AllTransactionsByTelegramID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all balance transactions by telegram id] execute handler")
+
+	var dto balancetransaction.AllByTelegramIDDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.internalCurrencyService.AllTransactionsByTelegramID.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to get all balance transactions by telegram id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all balance transactions by telegram id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[balancetransaction.AllByTelegramIDResponse](true, "success", "", result))
+}
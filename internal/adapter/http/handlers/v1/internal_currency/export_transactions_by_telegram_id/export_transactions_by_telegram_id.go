@@ -0,0 +1,80 @@
+package exporttransactionsbytelegramid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	balancetransaction "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/balance_transaction"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/internal_currency"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type ExportTransactionsByTelegramID struct {
+	internalCurrencyService *internalcurrency.Service
+	logger                  logger.ILogger
+	validator               validator.IValidator
+}
+
+func New(
+	internalCurrencyService *internalcurrency.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *ExportTransactionsByTelegramID {
+	return &ExportTransactionsByTelegramID{
+		internalCurrencyService: internalCurrencyService,
+		logger:                  logger,
+		validator:               validator,
+	}
+}
+
+// Execute exports balance transactions of any user to CSV (admin).
+// @Summary Export balance transactions by Telegram ID to CSV (admin)
+// @Description Exports balance transactions of the user matching the filters to a CSV file, newest first.
+// @Description Filters are the same as for the history; at most 10000 transactions are exported at once, otherwise the filters must be narrowed.
+// @Description Columns: `id`, `created_at`, `direction`, `amount`, `balance_after`, `event_type`, `title`, `description`, `source_type`, `source_id`.
+// @Tags Internal currency
+// @Accept json
+// @Produce text/csv
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body balancetransaction.ExportByTelegramIDDTO true "Balance transactions filters"
+// @Success 200 {file} file "CSV file"
+// @Failure 400 {object} balancetransaction.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} balancetransaction.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/internal_currency/admin/transactions/export [post]
+func (h *ExportTransactionsByTelegramID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[export balance transactions by telegram id] execute handler")
+
+	var dto balancetransaction.ExportByTelegramIDDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.internalCurrencyService.ExportTransactionsByTelegramID.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to export balance transactions by telegram id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to export balance transactions by telegram id", err.Error(), nil))
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Attachment(fmt.Sprintf("balance_transactions_%s.csv", dto.TelegramID))
+
+	return c.Send(result)
+}
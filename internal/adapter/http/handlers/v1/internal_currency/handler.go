@@ -1,6 +1,9 @@
 package internalcurrency
 
 import (
+	alltransactionsbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/internal_currency/all_transactions_by_telegram_id"
+	allusertransactions "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/internal_currency/all_user_transactions"
+	exporttransactionsbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/internal_currency/export_transactions_by_telegram_id"
 	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/internal_currency/get_user_balance"
 	"github.com/go-jedi/lingramm_backend/internal/middleware"
 	"github.com/go-jedi/lingramm_backend/internal/service/v1/internal_currency"
@@ -10,18 +13,24 @@ import (
 )
 
 type Handler struct {
-	getUserBalance *getuserbalance.GetUserBalance
+	allTransactionsByTelegramID    *alltransactionsbytelegramid.AllTransactionsByTelegramID
+	allUserTransactions            *allusertransactions.AllUserTransactions
+	exportTransactionsByTelegramID *exporttransactionsbytelegramid.ExportTransactionsByTelegramID
+	getUserBalance                 *getuserbalance.GetUserBalance
 }
 
 func New(
 	internalCurrencyService *internalcurrency.Service,
 	app *fiber.App,
 	logger logger.ILogger,
-	_ validator.IValidator,
+	validator validator.IValidator,
 	middleware *middleware.Middleware,
 ) *Handler {
 	h := &Handler{
-		getUserBalance: getuserbalance.New(internalCurrencyService, logger),
+		allTransactionsByTelegramID:    alltransactionsbytelegramid.New(internalCurrencyService, logger, validator),
+		allUserTransactions:            allusertransactions.New(internalCurrencyService, logger, validator, middleware),
+		exportTransactionsByTelegramID: exporttransactionsbytelegramid.New(internalCurrencyService, logger, validator),
+		getUserBalance:                 getuserbalance.New(internalCurrencyService, logger),
 	}
 
 	h.initRoutes(app, middleware)
@@ -33,5 +42,8 @@ func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware)
 	api := app.Group("/v1/internal_currency", middleware.Auth.AuthMiddleware)
 	{
 		api.Get("/user/balance/telegram/:telegramID", h.getUserBalance.Execute)
+		api.Post("/user/transactions", h.allUserTransactions.Execute)
+		api.Post("/admin/transactions", middleware.AdminGuard.AdminGuardMiddleware, h.allTransactionsByTelegramID.Execute)
+		api.Post("/admin/transactions/export", middleware.AdminGuard.AdminGuardMiddleware, h.exportTransactionsByTelegramID.Execute)
 	}
 }
@@ -0,0 +1,65 @@
+package getcohortstandingsbytelegramid
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/league"
+	leagueservice "github.com/go-jedi/lingramm_backend/internal/service/v1/league"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type GetCohortStandingsByTelegramID struct {
+	leagueService *leagueservice.Service
+	logger        logger.ILogger
+}
+
+func New(
+	leagueService *leagueservice.Service,
+	logger logger.ILogger,
+) *GetCohortStandingsByTelegramID {
+	return &GetCohortStandingsByTelegramID{
+		leagueService: leagueService,
+		logger:        logger,
+	}
+}
+
+// Execute returns the current week cohort standings for a user by Telegram ID.
+// @Summary Get league cohort standings by Telegram ID
+// @Description Returns the standings of the current week cohort the user belongs to, ordered by weekly XP.
+// @Tags League
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param telegramID path string true "Telegram ID"
+// @Success 200 {object} league.GetCohortStandingsByTelegramIDSwaggerResponse "Successful response"
+// @Failure 400 {object} league.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} league.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/league/standings/telegram/{telegramID} [get]
+func (h *GetCohortStandingsByTelegramID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get league cohort standings by telegram id] execute handler")
+
+	telegramID := c.Params("telegramID")
+	if telegramID == "" {
+		h.logger.Error("failed to get param telegramID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param telegramID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.leagueService.GetCohortStandingsByTelegramID.Execute(ctxTimeout, telegramID)
+	if err != nil {
+		h.logger.Error("failed to get league cohort standings by telegram id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get league cohort standings by telegram id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]league.GetCohortStandingsByTelegramIDResponse](true, "success", "", result))
+}
@@ -0,0 +1,46 @@
+package league
+
+import (
+	allhistorybytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/league/all_history_by_telegram_id"
+	getcohortstandingsbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/league/get_cohort_standings_by_telegram_id"
+	getcurrentbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/league/get_current_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	leagueservice "github.com/go-jedi/lingramm_backend/internal/service/v1/league"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	allHistoryByTelegramID         *allhistorybytelegramid.AllHistoryByTelegramID
+	getCohortStandingsByTelegramID *getcohortstandingsbytelegramid.GetCohortStandingsByTelegramID
+	getCurrentByTelegramID         *getcurrentbytelegramid.GetCurrentByTelegramID
+}
+
+func New(
+	leagueService *leagueservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		allHistoryByTelegramID:         allhistorybytelegramid.New(leagueService, logger),
+		getCohortStandingsByTelegramID: getcohortstandingsbytelegramid.New(leagueService, logger),
+		getCurrentByTelegramID:         getcurrentbytelegramid.New(leagueService, logger),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/league",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Get("/telegram/:telegramID", h.getCurrentByTelegramID.Execute)
+		api.Get("/standings/telegram/:telegramID", h.getCohortStandingsByTelegramID.Execute)
+		api.Get("/history/telegram/:telegramID", h.allHistoryByTelegramID.Execute)
+	}
+}
@@ -0,0 +1,55 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	ledgerReconciliationService *ledgerreconciliationservice.Service
+	logger                      logger.ILogger
+}
+
+func New(
+	ledgerReconciliationService *ledgerreconciliationservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		ledgerReconciliationService: ledgerReconciliationService,
+		logger:                      logger,
+	}
+}
+
+// Execute returns the latest ledger reconciliation jobs.
+// @Summary Get all ledger reconciliation jobs (admin)
+// @Description Returns the latest ledger reconciliation jobs, newest first.
+// @Tags Ledger reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} ledgerreconciliation.AllSwaggerResponse "Successful response"
+// @Failure 500 {object} ledgerreconciliation.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/ledger_reconciliation/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all ledger reconciliation jobs] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.ledgerReconciliationService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all ledger reconciliation jobs", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all ledger reconciliation jobs", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]ledgerreconciliation.Job](true, "success", "", result))
+}
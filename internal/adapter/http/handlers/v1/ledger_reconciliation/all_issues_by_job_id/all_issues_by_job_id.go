@@ -0,0 +1,74 @@
+package allissuesbyjobid
+
+import (
+	"context"
+	"time"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllIssuesByJobID struct {
+	ledgerReconciliationService *ledgerreconciliationservice.Service
+	logger                      logger.ILogger
+	validator                   validator.IValidator
+}
+
+func New(
+	ledgerReconciliationService *ledgerreconciliationservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *AllIssuesByJobID {
+	return &AllIssuesByJobID{
+		ledgerReconciliationService: ledgerReconciliationService,
+		logger:                      logger,
+		validator:                   validator,
+	}
+}
+
+// Execute returns a page of issues found by a ledger reconciliation job.
+// @Summary Get all ledger reconciliation issues by job id (admin)
+// @Description Returns balance drift and broken balance_after chain issues of the job in order they were found. Pass next_cursor of the previous page as cursor to get the next page.
+// @Tags Ledger reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body ledgerreconciliation.AllIssuesByJobIDDTO true "Issues filter"
+// @Success 200 {object} ledgerreconciliation.AllIssuesByJobIDSwaggerResponse "Successful response"
+// @Failure 400 {object} ledgerreconciliation.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} ledgerreconciliation.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/ledger_reconciliation/issues [post]
+func (h *AllIssuesByJobID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all ledger reconciliation issues by job id] execute handler")
+
+	var dto ledgerreconciliation.AllIssuesByJobIDDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.ledgerReconciliationService.AllIssuesByJobID.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to get all ledger reconciliation issues by job id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all ledger reconciliation issues by job id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[ledgerreconciliation.AllIssuesByJobIDResponse](true, "success", "", result))
+}
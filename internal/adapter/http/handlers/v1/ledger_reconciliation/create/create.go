@@ -0,0 +1,74 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Create struct {
+	ledgerReconciliationService *ledgerreconciliationservice.Service
+	logger                      logger.ILogger
+	validator                   validator.IValidator
+}
+
+func New(
+	ledgerReconciliationService *ledgerreconciliationservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Create {
+	return &Create{
+		ledgerReconciliationService: ledgerReconciliationService,
+		logger:                      logger,
+		validator:                   validator,
+	}
+}
+
+// Execute creates a job that reconciles user balances against balance transactions.
+// @Summary Create ledger reconciliation job (admin)
+// @Description Creates a job that checks for one user or everyone that user_balances.balance equals the sum of balance transactions and that balance_after values form a consistent chain. With dry_run the job only records issues, otherwise balance drift is corrected by a ledger_correction transaction. The job is processed in resumable chunks by the background worker.
+// @Tags Ledger reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body ledgerreconciliation.CreateDTO true "Ledger reconciliation job data"
+// @Success 200 {object} ledgerreconciliation.JobSwaggerResponse "Successful response"
+// @Failure 400 {object} ledgerreconciliation.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} ledgerreconciliation.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/ledger_reconciliation [post]
+func (h *Create) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create ledger reconciliation job] execute handler")
+
+	var dto ledgerreconciliation.CreateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.ledgerReconciliationService.Create.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create ledger reconciliation job", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create ledger reconciliation job", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[ledgerreconciliation.Job](true, "success", "", result))
+}
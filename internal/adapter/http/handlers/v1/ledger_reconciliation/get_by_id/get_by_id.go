@@ -0,0 +1,79 @@
+package getbyid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type GetByID struct {
+	ledgerReconciliationService *ledgerreconciliationservice.Service
+	logger                      logger.ILogger
+}
+
+func New(
+	ledgerReconciliationService *ledgerreconciliationservice.Service,
+	logger logger.ILogger,
+) *GetByID {
+	return &GetByID{
+		ledgerReconciliationService: ledgerReconciliationService,
+		logger:                      logger,
+	}
+}
+
+// Execute returns a ledger reconciliation job with its progress and issue counters.
+// @Summary Get ledger reconciliation job by id (admin)
+// @Description Returns status, processed/total users, the number of users with balance drift or broken balance_after chain, corrected users and the total drift amount.
+// @Tags Ledger reconciliation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param jobID path integer true "Ledger reconciliation job ID"
+// @Success 200 {object} ledgerreconciliation.JobSwaggerResponse "Successful response"
+// @Failure 400 {object} ledgerreconciliation.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} ledgerreconciliation.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/ledger_reconciliation/id/{jobID} [get]
+func (h *GetByID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get ledger reconciliation job by id] execute handler")
+
+	jobIDStr := c.Params("jobID")
+	if jobIDStr == "" {
+		h.logger.Error("failed to get param jobID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param jobID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	jobID, err := strconv.ParseInt(jobIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if jobID <= 0 {
+		h.logger.Error("invalid jobID", "error", "job id must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid job id", "job id must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.ledgerReconciliationService.GetByID.Execute(ctxTimeout, jobID)
+	if err != nil {
+		h.logger.Error("failed to get ledger reconciliation job by id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get ledger reconciliation job by id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[ledgerreconciliation.Job](true, "success", "", result))
+}
@@ -0,0 +1,53 @@
+package ledgerreconciliation
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/ledger_reconciliation/all"
+	allissuesbyjobid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/ledger_reconciliation/all_issues_by_job_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/ledger_reconciliation/create"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/ledger_reconciliation/get_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	ledgerreconciliationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	all              *all.All
+	allIssuesByJobID *allissuesbyjobid.AllIssuesByJobID
+	create           *create.Create
+	getByID          *getbyid.GetByID
+}
+
+func New(
+	ledgerReconciliationService *ledgerreconciliationservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		all:              all.New(ledgerReconciliationService, logger),
+		allIssuesByJobID: allissuesbyjobid.New(ledgerReconciliationService, logger, validator),
+		create:           create.New(ledgerReconciliationService, logger, validator),
+		getByID:          getbyid.New(ledgerReconciliationService, logger),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/ledger_reconciliation",
+		middleware.Auth.AuthMiddleware,
+		middleware.AdminGuard.AdminGuardMiddleware,
+	)
+	{
+		api.Post("", h.create.Execute)
+		api.Get("/all", h.all.Execute)
+		api.Get("/id/:jobID", h.getByID.Execute)
+		api.Post("/issues", h.allIssuesByJobID.Execute)
+	}
+}
@@ -0,0 +1,55 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	levelservice "github.com/go-jedi/lingramm_backend/internal/service/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	levelService *levelservice.Service
+	logger       logger.ILogger
+}
+
+func New(
+	levelService *levelservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		levelService: levelService,
+		logger:       logger,
+	}
+}
+
+// Execute returns the level curve with rewards.
+// @Summary Get all levels
+// @Description Returns all levels ordered by level number with their required experience and level-up rewards.
+// @Tags Level
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} level.AllSwaggerResponse "Successful response"
+// @Failure 500 {object} level.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/level/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all levels] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.levelService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all levels", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all levels", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]level.Level](true, "success", "", result))
+}
@@ -0,0 +1,74 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	levelservice "github.com/go-jedi/lingramm_backend/internal/service/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Create struct {
+	levelService *levelservice.Service
+	logger       logger.ILogger
+	validator    validator.IValidator
+}
+
+func New(
+	levelService *levelservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Create {
+	return &Create{
+		levelService: levelService,
+		logger:       logger,
+		validator:    validator,
+	}
+}
+
+// Execute creates a new level (admin).
+// @Summary Create level (admin)
+// @Description Creates a level. Required experience must grow strictly with level number.
+// @Tags Level
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body level.CreateDTO true "Level data"
+// @Success 200 {object} level.LevelSwaggerResponse "Successful response"
+// @Failure 400 {object} level.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} level.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/level [post]
+func (h *Create) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create a new level] execute handler")
+
+	var dto level.CreateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.levelService.Create.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create a new level", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create a new level", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[level.Level](true, "success", "", result))
+}
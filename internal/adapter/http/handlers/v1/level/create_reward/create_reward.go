@@ -0,0 +1,74 @@
+package createreward
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	levelservice "github.com/go-jedi/lingramm_backend/internal/service/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type CreateReward struct {
+	levelService *levelservice.Service
+	logger       logger.ILogger
+	validator    validator.IValidator
+}
+
+func New(
+	levelService *levelservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *CreateReward {
+	return &CreateReward{
+		levelService: levelService,
+		logger:       logger,
+		validator:    validator,
+	}
+}
+
+// Execute adds a reward to a level (admin).
+// @Summary Create level reward (admin)
+// @Description Adds an internal currency, award asset or subscription days reward that is granted once to every user reaching the level after the reward was created.
+// @Tags Level
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body level.CreateRewardDTO true "Level reward data"
+// @Success 200 {object} level.LevelRewardSwaggerResponse "Successful response"
+// @Failure 400 {object} level.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} level.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/level/reward [post]
+func (h *CreateReward) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create a new level reward] execute handler")
+
+	var dto level.CreateRewardDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.levelService.CreateReward.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create a new level reward", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create a new level reward", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[level.LevelReward](true, "success", "", result))
+}
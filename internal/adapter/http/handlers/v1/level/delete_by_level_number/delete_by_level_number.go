@@ -0,0 +1,79 @@
+package deletebylevelnumber
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	levelservice "github.com/go-jedi/lingramm_backend/internal/service/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type DeleteByLevelNumber struct {
+	levelService *levelservice.Service
+	logger       logger.ILogger
+}
+
+func New(
+	levelService *levelservice.Service,
+	logger logger.ILogger,
+) *DeleteByLevelNumber {
+	return &DeleteByLevelNumber{
+		levelService: levelService,
+		logger:       logger,
+	}
+}
+
+// Execute deletes a level that no user has reached yet (admin).
+// @Summary Delete level by level number (admin)
+// @Description Deletes a level together with its rewards. Levels already reached by users cannot be deleted.
+// @Tags Level
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param levelNumber path integer true "Level number"
+// @Success 200 {object} level.LevelSwaggerResponse "Successful response"
+// @Failure 400 {object} level.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} level.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/level/number/{levelNumber} [delete]
+func (h *DeleteByLevelNumber) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[delete level by level number] execute handler")
+
+	levelNumberStr := c.Params("levelNumber")
+	if levelNumberStr == "" {
+		h.logger.Error("failed to get param levelNumber", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param levelNumber", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	levelNumber, err := strconv.ParseInt(levelNumberStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if levelNumber <= 0 {
+		h.logger.Error("invalid levelNumber", "error", "level number must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid level number", "level number must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.levelService.DeleteByLevelNumber.Execute(ctxTimeout, levelNumber)
+	if err != nil {
+		h.logger.Error("failed to delete level by level number", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to delete level by level number", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[level.Level](true, "success", "", result))
+}
@@ -0,0 +1,74 @@
+package generate
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	levelservice "github.com/go-jedi/lingramm_backend/internal/service/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Generate struct {
+	levelService *levelservice.Service
+	logger       logger.ILogger
+	validator    validator.IValidator
+}
+
+func New(
+	levelService *levelservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Generate {
+	return &Generate{
+		levelService: levelService,
+		logger:       logger,
+		validator:    validator,
+	}
+}
+
+// Execute extends the level curve using a formula (admin).
+// @Summary Generate levels (admin)
+// @Description Adds levels after the current top level up to to_level with required_experience = base_experience * (level_number - 1) ^ exponent. Existing levels are not changed.
+// @Tags Level
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body level.GenerateDTO true "Curve parameters"
+// @Success 200 {object} level.GenerateSwaggerResponse "Successful response"
+// @Failure 400 {object} level.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} level.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/level/generate [post]
+func (h *Generate) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[generate levels] execute handler")
+
+	var dto level.GenerateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.levelService.Generate.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to generate levels", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to generate levels", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]level.Level](true, "success", "", result))
+}
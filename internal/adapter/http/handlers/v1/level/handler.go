@@ -0,0 +1,64 @@
+package level
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level/all"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level/create"
+	createreward "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level/create_reward"
+	deletebylevelnumber "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level/delete_by_level_number"
+	deleterewardbyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level/delete_reward_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level/generate"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level/update"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	levelservice "github.com/go-jedi/lingramm_backend/internal/service/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	all                 *all.All
+	create              *create.Create
+	createReward        *createreward.CreateReward
+	deleteByLevelNumber *deletebylevelnumber.DeleteByLevelNumber
+	deleteRewardByID    *deleterewardbyid.DeleteRewardByID
+	generate            *generate.Generate
+	update              *update.Update
+}
+
+func New(
+	levelService *levelservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		all:                 all.New(levelService, logger),
+		create:              create.New(levelService, logger, validator),
+		createReward:        createreward.New(levelService, logger, validator),
+		deleteByLevelNumber: deletebylevelnumber.New(levelService, logger),
+		deleteRewardByID:    deleterewardbyid.New(levelService, logger),
+		generate:            generate.New(levelService, logger, validator),
+		update:              update.New(levelService, logger, validator),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/level",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Get("/all", h.all.Execute)
+		api.Post("", middleware.AdminGuard.AdminGuardMiddleware, h.create.Execute)
+		api.Put("", middleware.AdminGuard.AdminGuardMiddleware, h.update.Execute)
+		api.Delete("/number/:levelNumber", middleware.AdminGuard.AdminGuardMiddleware, h.deleteByLevelNumber.Execute)
+		api.Post("/generate", middleware.AdminGuard.AdminGuardMiddleware, h.generate.Execute)
+		api.Post("/reward", middleware.AdminGuard.AdminGuardMiddleware, h.createReward.Execute)
+		api.Delete("/reward/id/:rewardID", middleware.AdminGuard.AdminGuardMiddleware, h.deleteRewardByID.Execute)
+	}
+}
@@ -0,0 +1,78 @@
+package createinvoice
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	paymentservice "github.com/go-jedi/lingramm_backend/internal/service/v1/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type CreateInvoice struct {
+	paymentService *paymentservice.Service
+	logger         logger.ILogger
+	validator      validator.IValidator
+}
+
+func New(
+	paymentService *paymentservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *CreateInvoice {
+	return &CreateInvoice{
+		paymentService: paymentService,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+// Execute creates invoice in telegram stars.
+// @Summary Create payment invoice
+// @Description Creates a pending payment and a Telegram invoice link in Telegram Stars (XTR). Rules:
+// @Description • `product_type` is required: `subscription_plan` or `shop_item`
+// @Description • `plan_code` is required for `subscription_plan`, the trial plan is not sold
+// @Description • `shop_item_id` is required for `shop_item`, `quantity` is between 1 and 100 (default 1)
+// @Description The product is granted after Telegram sends `successful_payment` to the webhook.
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body payment.CreateInvoiceDTO true "Create invoice data"
+// @Success 200 {object} payment.CreateInvoiceSwaggerResponse "Successful response"
+// @Failure 400 {object} payment.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} payment.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/payment/invoice [post]
+func (h *CreateInvoice) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create payment invoice] execute handler")
+
+	var dto payment.CreateInvoiceDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.paymentService.CreateInvoice.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create payment invoice", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create payment invoice", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[payment.CreateInvoiceResponse](true, "success", "", result))
+}
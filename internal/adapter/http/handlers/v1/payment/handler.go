@@ -0,0 +1,48 @@
+package payment
+
+import (
+	"github.com/go-jedi/lingramm_backend/config"
+	createinvoice "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/payment/create_invoice"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/payment/refund"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/payment/webhook"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	paymentservice "github.com/go-jedi/lingramm_backend/internal/service/v1/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	createInvoice *createinvoice.CreateInvoice
+	refund        *refund.Refund
+	webhook       *webhook.Webhook
+}
+
+func New(
+	paymentService *paymentservice.Service,
+	app *fiber.App,
+	telegram config.TelegramConfig,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		createInvoice: createinvoice.New(paymentService, logger, validator),
+		refund:        refund.New(paymentService, logger, validator),
+		webhook:       webhook.New(paymentService, logger, telegram.WebhookSecret),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group("/v1/payment")
+	{
+		// telegram calls webhook, requests are verified by secret token.
+		api.Post("/webhook", h.webhook.Execute)
+		api.Post("/invoice", middleware.Auth.AuthMiddleware, h.createInvoice.Execute)
+		api.Post("/refund", middleware.Auth.AuthMiddleware, middleware.AdminGuard.AdminGuardMiddleware, h.refund.Execute)
+	}
+}
@@ -0,0 +1,74 @@
+package refund
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	paymentservice "github.com/go-jedi/lingramm_backend/internal/service/v1/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Refund struct {
+	paymentService *paymentservice.Service
+	logger         logger.ILogger
+	validator      validator.IValidator
+}
+
+func New(
+	paymentService *paymentservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Refund {
+	return &Refund{
+		paymentService: paymentService,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+// Execute refunds payment in telegram stars.
+// @Summary Refund payment
+// @Description Refunds Telegram Stars of a paid payment and revokes the granted product: the subscription is shortened by the plan duration, unused shop items are removed from the inventory. A failed payment (paid, but the product was not granted) is refunded as well.
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body payment.RefundDTO true "Refund data"
+// @Success 200 {object} payment.PaymentSwaggerResponse "Successful response"
+// @Failure 400 {object} payment.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} payment.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/payment/refund [post]
+func (h *Refund) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[refund payment] execute handler")
+
+	var dto payment.RefundDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.paymentService.Refund.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to refund payment", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to refund payment", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[payment.Payment](true, "success", "", result))
+}
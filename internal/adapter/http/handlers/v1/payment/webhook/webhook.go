@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	paymentservice "github.com/go-jedi/lingramm_backend/internal/service/v1/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/telegram"
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	timeout           = 5 * time.Second
+	secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+)
+
+type Webhook struct {
+	paymentService *paymentservice.Service
+	logger         logger.ILogger
+	secretToken    string
+}
+
+func New(
+	paymentService *paymentservice.Service,
+	logger logger.ILogger,
+	secretToken string,
+) *Webhook {
+	return &Webhook{
+		paymentService: paymentService,
+		logger:         logger,
+		secretToken:    secretToken,
+	}
+}
+
+// Execute receives payment updates from telegram.
+// @Summary Payment webhook
+// @Description Receives Telegram Bot API updates. `pre_checkout_query` is confirmed when the payment is pending and matches the invoice, `successful_payment` grants the product once (repeated updates are ignored). Other updates are ignored. On error the response status is 500, so Telegram delivers the update again.
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Param X-Telegram-Bot-Api-Secret-Token header string true "Webhook secret token"
+// @Param payload body telegram.Update true "Telegram update"
+// @Success 200 {object} payment.ErrorSwaggerResponse "Successful response"
+// @Failure 400 {object} payment.ErrorSwaggerResponse "Bad request error"
+// @Failure 401 {object} payment.ErrorSwaggerResponse "Unauthorized error"
+// @Failure 500 {object} payment.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/payment/webhook [post]
+func (h *Webhook) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[payment webhook] execute handler")
+
+	if !h.isSecretTokenValid(c.Get(secretTokenHeader)) {
+		h.logger.Error("invalid webhook secret token", "error", apperrors.ErrPaymentWebhookSecretIsInvalid)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "invalid webhook secret token", apperrors.ErrPaymentWebhookSecretIsInvalid.Error(), nil))
+	}
+
+	var update telegram.Update
+	if err := c.Bind().Body(&update); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	switch {
+	case update.PreCheckoutQuery != nil:
+		q := update.PreCheckoutQuery
+
+		if err := h.paymentService.PreCheckout.Execute(ctxTimeout, payment.PreCheckoutDTO{
+			QueryID:        q.ID,
+			TelegramID:     strconv.FormatInt(q.From.ID, 10),
+			Currency:       q.Currency,
+			TotalAmount:    q.TotalAmount,
+			InvoicePayload: q.InvoicePayload,
+		}); err != nil {
+			h.logger.Error("failed to pre checkout payment", "error", err)
+			c.Status(fiber.StatusInternalServerError)
+			return c.JSON(response.New[any](false, "failed to pre checkout payment", err.Error(), nil))
+		}
+	case update.Message != nil && update.Message.SuccessfulPayment != nil:
+		sp := update.Message.SuccessfulPayment
+
+		if _, err := h.paymentService.Fulfil.Execute(ctxTimeout, payment.FulfilDTO{
+			InvoicePayload:          sp.InvoicePayload,
+			TelegramPaymentChargeID: sp.TelegramPaymentChargeID,
+			ProviderPaymentChargeID: sp.ProviderPaymentChargeID,
+			Currency:                sp.Currency,
+			TotalAmount:             sp.TotalAmount,
+		}); err != nil {
+			if errors.Is(err, apperrors.ErrPaymentDoesNotExist) { // repeated delivery will not help.
+				h.logger.Error("successful payment of unknown invoice", "invoice_payload", sp.InvoicePayload)
+				return c.JSON(response.New[any](true, "success", "", nil))
+			}
+
+			h.logger.Error("failed to fulfil payment", "error", err)
+			c.Status(fiber.StatusInternalServerError)
+			return c.JSON(response.New[any](false, "failed to fulfil payment", err.Error(), nil))
+		}
+	default:
+		h.logger.Debug("[payment webhook] update is ignored", "update_id", update.UpdateID)
+	}
+
+	return c.JSON(response.New[any](true, "success", "", nil))
+}
+
+// isSecretTokenValid compares secret token of request with configured one in constant time.
+func (h *Webhook) isSecretTokenValid(secretToken string) bool {
+	if h.secretToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(secretToken), []byte(h.secretToken)) == 1
+}
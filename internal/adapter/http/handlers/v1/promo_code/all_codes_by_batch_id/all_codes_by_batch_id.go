@@ -0,0 +1,79 @@
+package allcodesbybatchid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	promocodeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllCodesByBatchID struct {
+	promoCodeService *promocodeservice.Service
+	logger           logger.ILogger
+}
+
+func New(
+	promoCodeService *promocodeservice.Service,
+	logger logger.ILogger,
+) *AllCodesByBatchID {
+	return &AllCodesByBatchID{
+		promoCodeService: promoCodeService,
+		logger:           logger,
+	}
+}
+
+// Execute returns all codes of the batch.
+// @Summary Get all promo codes by batch id
+// @Description Returns all codes of the batch with the number of their redemptions, e.g. to export generated codes.
+// @Tags PromoCode
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param batchID path integer true "Promo code batch ID"
+// @Success 200 {object} promocode.AllCodesSwaggerResponse "Successful response"
+// @Failure 400 {object} promocode.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} promocode.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/promo_code/batch/{batchID}/codes [get]
+func (h *AllCodesByBatchID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all promo codes by batch id] execute handler")
+
+	batchIDStr := c.Params("batchID")
+	if batchIDStr == "" {
+		h.logger.Error("failed to get param batchID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param batchID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	batchID, err := strconv.ParseInt(batchIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if batchID <= 0 {
+		h.logger.Error("invalid batchID", "error", "batch id must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid batch id", "batch id must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.promoCodeService.AllCodesByBatchID.Execute(ctxTimeout, batchID)
+	if err != nil {
+		h.logger.Error("failed to get all promo codes by batch id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all promo codes by batch id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]promocode.PromoCode](true, "success", "", result))
+}
@@ -0,0 +1,74 @@
+package createbatch
+
+import (
+	"context"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	promocodeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type CreateBatch struct {
+	promoCodeService *promocodeservice.Service
+	logger           logger.ILogger
+	validator        validator.IValidator
+}
+
+func New(
+	promoCodeService *promocodeservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *CreateBatch {
+	return &CreateBatch{
+		promoCodeService: promoCodeService,
+		logger:           logger,
+		validator:        validator,
+	}
+}
+
+// Execute creates a new promo code batch.
+// @Summary Create promo code batch
+// @Description Creates a batch with one custom code (e.g. WELCOME100, reusable by many users) or `count` generated codes with optional prefix. The reward is internal currency, subscription days or a shop item. Generated codes are single-use unless `max_redemptions` is set, `per_user_limit` (default 1) limits redemptions of all codes of the batch by one user.
+// @Tags PromoCode
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body promocode.CreateBatchDTO true "Batch data"
+// @Success 200 {object} promocode.CreateBatchSwaggerResponse "Successful response"
+// @Failure 400 {object} promocode.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} promocode.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/promo_code/batch [post]
+func (h *CreateBatch) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create a new promo code batch] execute handler")
+
+	var dto promocode.CreateBatchDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.promoCodeService.CreateBatch.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create a new promo code batch", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create a new promo code batch", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[promocode.CreateBatchResponse](true, "success", "", result))
+}
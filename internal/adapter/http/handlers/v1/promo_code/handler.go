@@ -0,0 +1,52 @@
+package promocode
+
+import (
+	allcodesbybatchid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/promo_code/all_codes_by_batch_id"
+	createbatch "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/promo_code/create_batch"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/promo_code/redeem"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/promo_code/report"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	promocodeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	allCodesByBatchID *allcodesbybatchid.AllCodesByBatchID
+	createBatch       *createbatch.CreateBatch
+	redeem            *redeem.Redeem
+	report            *report.Report
+}
+
+func New(
+	promoCodeService *promocodeservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		allCodesByBatchID: allcodesbybatchid.New(promoCodeService, logger),
+		createBatch:       createbatch.New(promoCodeService, logger, validator),
+		redeem:            redeem.New(promoCodeService, logger, validator),
+		report:            report.New(promoCodeService, logger),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/promo_code",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Post("/redeem", h.redeem.Execute)
+		api.Post("/batch", middleware.AdminGuard.AdminGuardMiddleware, h.createBatch.Execute)
+		api.Get("/batch/:batchID/codes", middleware.AdminGuard.AdminGuardMiddleware, h.allCodesByBatchID.Execute)
+		api.Get("/report", middleware.AdminGuard.AdminGuardMiddleware, h.report.Execute)
+	}
+}
@@ -0,0 +1,74 @@
+package redeem
+
+import (
+	"context"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	promocodeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Redeem struct {
+	promoCodeService *promocodeservice.Service
+	logger           logger.ILogger
+	validator        validator.IValidator
+}
+
+func New(
+	promoCodeService *promocodeservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Redeem {
+	return &Redeem{
+		promoCodeService: promoCodeService,
+		logger:           logger,
+		validator:        validator,
+	}
+}
+
+// Execute redeems promo code.
+// @Summary Redeem promo code
+// @Description Redeems promo code (case-insensitive) and grants its reward in one transaction: internal currency is added to the balance, subscription is extended, shop item is added to the inventory. Concurrent redemptions can not exceed limits of the code and the batch.
+// @Tags PromoCode
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body promocode.RedeemDTO true "Redeem data"
+// @Success 200 {object} promocode.RedemptionSwaggerResponse "Successful response"
+// @Failure 400 {object} promocode.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} promocode.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/promo_code/redeem [post]
+func (h *Redeem) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[redeem promo code] execute handler")
+
+	var dto promocode.RedeemDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.promoCodeService.Redeem.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to redeem promo code", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to redeem promo code", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[promocode.Redemption](true, "success", "", result))
+}
@@ -0,0 +1,55 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	promocodeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Report struct {
+	promoCodeService *promocodeservice.Service
+	logger           logger.ILogger
+}
+
+func New(
+	promoCodeService *promocodeservice.Service,
+	logger logger.ILogger,
+) *Report {
+	return &Report{
+		promoCodeService: promoCodeService,
+		logger:           logger,
+	}
+}
+
+// Execute returns redemption statistics of promo code batches.
+// @Summary Get promo code report
+// @Description Returns all batches, newest first, with the number of codes, redeemed codes, redemptions and users, the granted amount of internal currency and the time of the last redemption.
+// @Tags PromoCode
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} promocode.ReportSwaggerResponse "Successful response"
+// @Failure 500 {object} promocode.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/promo_code/report [get]
+func (h *Report) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get promo code report] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.promoCodeService.Report.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get promo code report", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get promo code report", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]promocode.BatchReport](true, "success", "", result))
+}
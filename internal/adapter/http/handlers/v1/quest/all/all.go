@@ -0,0 +1,55 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/quest"
+	questservice "github.com/go-jedi/lingramm_backend/internal/service/v1/quest"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	questService *questservice.Service
+	logger       logger.ILogger
+}
+
+func New(
+	questService *questservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		questService: questService,
+		logger:       logger,
+	}
+}
+
+// Execute returns all quests (admin).
+// @Summary Get all quests (admin)
+// @Description Returns all daily, weekly and one-off quests including inactive ones.
+// @Tags Quest
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} quest.AllSwaggerResponse "Successful response"
+// @Failure 500 {object} quest.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/quest/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all quests] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.questService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all quests", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all quests", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]quest.Quest](true, "success", "", result))
+}
@@ -0,0 +1,79 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/quest"
+	questservice "github.com/go-jedi/lingramm_backend/internal/service/v1/quest"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Create struct {
+	questService *questservice.Service
+	logger       logger.ILogger
+	validator    validator.IValidator
+}
+
+func New(
+	questService *questservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Create {
+	return &Create{
+		questService: questService,
+		logger:       logger,
+		validator:    validator,
+	}
+}
+
+// Execute creates a new quest (admin).
+// @Summary Create quest (admin)
+// @Description Creates a quest that is assigned to every user for each period of its cadence. Rules:
+// @Description • `name` is required
+// @Description • `cadence` is required: `daily` and `weekly` quests expire at the end of the user day/week, `one_off` quests are assigned once
+// @Description • **at least one** of the `*_need` fields must be provided and greater than 0
+// @Description • `reward_amount` (internal currency) must be positive if provided, `reward_experience_points` is granted on completion
+// @Description • `duration_days` is allowed only for `one_off` quests (without it the quest never expires)
+// @Tags Quest
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body quest.CreateDTO true "Quest data"
+// @Success 200 {object} quest.QuestSwaggerResponse "Successful response"
+// @Failure 400 {object} quest.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} quest.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/quest [post]
+func (h *Create) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create a new quest] execute handler")
+
+	var dto quest.CreateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.questService.Create.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create a new quest", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create a new quest", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[quest.Quest](true, "success", "", result))
+}
@@ -0,0 +1,45 @@
+package quest
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/quest/all"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/quest/create"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	questservice "github.com/go-jedi/lingramm_backend/internal/service/v1/quest"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	all    *all.All
+	create *create.Create
+}
+
+func New(
+	questService *questservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		all:    all.New(questService, logger),
+		create: create.New(questService, logger, validator),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/quest",
+		middleware.Auth.AuthMiddleware,
+		middleware.AdminGuard.AdminGuardMiddleware,
+	)
+	{
+		api.Post("", h.create.Execute)
+		api.Get("/all", h.all.Execute)
+	}
+}
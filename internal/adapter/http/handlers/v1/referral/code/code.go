@@ -0,0 +1,67 @@
+package code
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	referralservice "github.com/go-jedi/lingramm_backend/internal/service/v1/referral"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Code struct {
+	referralService *referralservice.Service
+	logger          logger.ILogger
+	middleware      *middleware.Middleware
+}
+
+func New(
+	referralService *referralservice.Service,
+	logger logger.ILogger,
+	middleware *middleware.Middleware,
+) *Code {
+	return &Code{
+		referralService: referralService,
+		logger:          logger,
+		middleware:      middleware,
+	}
+}
+
+// Execute returns referral code of the user making request.
+// @Summary Get referral code
+// @Description Returns referral code of the user making request, the code is generated on first request. `start_param` is passed to the Mini App link as `startapp`: a new user who signs in with it is attributed to the user, both get rewards once the new user reaches the milestone (level or streak days).
+// @Tags Referral
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} referral.CodeSwaggerResponse "Successful response"
+// @Failure 401 {object} referral.ErrorSwaggerResponse "Unauthorized error"
+// @Failure 500 {object} referral.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/referral/code [get]
+func (h *Code) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get referral code] execute handler")
+
+	telegramID, err := h.middleware.Auth.GetTelegramIDFromContext(c)
+	if err != nil {
+		h.logger.Error("failed to get telegram id from context", "error", err)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.referralService.GetCode.Execute(ctxTimeout, telegramID)
+	if err != nil {
+		h.logger.Error("failed to get referral code", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get referral code", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[referral.CodeResponse](true, "success", "", result))
+}
@@ -0,0 +1,42 @@
+package referral
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/referral/code"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/referral/stats"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	referralservice "github.com/go-jedi/lingramm_backend/internal/service/v1/referral"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	code  *code.Code
+	stats *stats.Stats
+}
+
+func New(
+	referralService *referralservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		code:  code.New(referralService, logger, middleware),
+		stats: stats.New(referralService, logger, middleware),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/referral",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Get("/code", h.code.Execute)
+		api.Get("/stats", h.stats.Execute)
+	}
+}
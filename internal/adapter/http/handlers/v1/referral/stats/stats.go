@@ -0,0 +1,67 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	referralservice "github.com/go-jedi/lingramm_backend/internal/service/v1/referral"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Stats struct {
+	referralService *referralservice.Service
+	logger          logger.ILogger
+	middleware      *middleware.Middleware
+}
+
+func New(
+	referralService *referralservice.Service,
+	logger logger.ILogger,
+	middleware *middleware.Middleware,
+) *Stats {
+	return &Stats{
+		referralService: referralService,
+		logger:          logger,
+		middleware:      middleware,
+	}
+}
+
+// Execute returns referral stats of the user making request.
+// @Summary Get referral stats
+// @Description Returns the number of users invited by the user making request by status (pending until the milestone is reached, rewarded, rejected for banned users or when the limit of rewarded referrals is reached) and internal currency earned for them.
+// @Tags Referral
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} referral.StatsSwaggerResponse "Successful response"
+// @Failure 401 {object} referral.ErrorSwaggerResponse "Unauthorized error"
+// @Failure 500 {object} referral.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/referral/stats [get]
+func (h *Stats) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get referral stats] execute handler")
+
+	telegramID, err := h.middleware.Auth.GetTelegramIDFromContext(c)
+	if err != nil {
+		h.logger.Error("failed to get telegram id from context", "error", err)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.referralService.GetStatsByTelegramID.Execute(ctxTimeout, telegramID)
+	if err != nil {
+		h.logger.Error("failed to get referral stats", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get referral stats", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[referral.Stats](true, "success", "", result))
+}
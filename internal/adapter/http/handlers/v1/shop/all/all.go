@@ -0,0 +1,56 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type All struct {
+	shopService *shopservice.Service
+	logger      logger.ILogger
+}
+
+func New(
+	shopService *shopservice.Service,
+	logger logger.ILogger,
+) *All {
+	return &All{
+		shopService: shopService,
+		logger:      logger,
+	}
+}
+
+// Execute returns all shop items (admin).
+// @Summary Get all shop items (admin)
+// @Description Returns all shop items including inactive and unavailable ones, ordered by ID.
+// @Tags Shop
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} shop.AllSwaggerResponse "Successful response"
+// @Failure 400 {object} shop.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} shop.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/shop/all [get]
+func (h *All) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all shop items] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.shopService.All.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all shop items", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all shop items", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]shop.ShopItem](true, "success", "", result))
+}
@@ -0,0 +1,56 @@
+package allavailable
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllAvailable struct {
+	shopService *shopservice.Service
+	logger      logger.ILogger
+}
+
+func New(
+	shopService *shopservice.Service,
+	logger logger.ILogger,
+) *AllAvailable {
+	return &AllAvailable{
+		shopService: shopService,
+		logger:      logger,
+	}
+}
+
+// Execute returns shop items available for purchase.
+// @Summary Get available shop items
+// @Description Returns active shop items whose availability window includes the current time and which are in stock, ordered by price.
+// @Tags Shop
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} shop.AllSwaggerResponse "Successful response"
+// @Failure 400 {object} shop.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} shop.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/shop/available [get]
+func (h *AllAvailable) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all available shop items] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.shopService.AllAvailable.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all available shop items", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all available shop items", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]shop.ShopItem](true, "success", "", result))
+}
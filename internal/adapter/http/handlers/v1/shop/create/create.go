@@ -0,0 +1,79 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Create struct {
+	shopService *shopservice.Service
+	logger      logger.ILogger
+	validator   validator.IValidator
+}
+
+func New(
+	shopService *shopservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Create {
+	return &Create{
+		shopService: shopService,
+		logger:      logger,
+		validator:   validator,
+	}
+}
+
+// Execute creates a new shop item (admin).
+// @Summary Create shop item (admin)
+// @Description Creates a shop item. Rules:
+// @Description • `type` is one of `boost`, `streak_freeze`, `cosmetic_frame`, `premium_days`
+// @Description • `value` is required for all types except `cosmetic_frame` (boost minutes, streak freezes or premium days per unit)
+// @Description • `price` must be positive
+// @Description • `stock` and `per_user_limit` are optional, empty means no limit
+// @Description • `available_from` must be before `available_until`
+// @Tags Shop
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body shop.CreateDTO true "Shop item data"
+// @Success 200 {object} shop.ShopItemSwaggerResponse "Successful response"
+// @Failure 400 {object} shop.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} shop.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/shop [post]
+func (h *Create) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[create shop item] execute handler")
+
+	var dto shop.CreateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.shopService.Create.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to create shop item", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to create shop item", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[shop.ShopItem](true, "success", "", result))
+}
@@ -0,0 +1,79 @@
+package deletebyid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type DeleteByID struct {
+	shopService *shopservice.Service
+	logger      logger.ILogger
+}
+
+func New(
+	shopService *shopservice.Service,
+	logger logger.ILogger,
+) *DeleteByID {
+	return &DeleteByID{
+		shopService: shopService,
+		logger:      logger,
+	}
+}
+
+// Execute deletes a shop item by ID (admin).
+// @Summary Delete shop item by ID (admin)
+// @Description Deletes a shop item that was never purchased. Purchased items can only be deactivated. Returns the deleted record.
+// @Tags Shop
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param shopItemID path int true "Shop item ID"
+// @Success 200 {object} shop.ShopItemSwaggerResponse "Successful response"
+// @Failure 400 {object} shop.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} shop.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/shop/id/{shopItemID} [delete]
+func (h *DeleteByID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[delete shop item by id] execute handler")
+
+	shopItemIDStr := c.Params("shopItemID")
+	if shopItemIDStr == "" {
+		h.logger.Error("failed to get param shopItemID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param shopItemID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	shopItemID, err := strconv.ParseInt(shopItemIDStr, 10, 64)
+	if err != nil {
+		h.logger.Error("failed parse string to int64", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed parse string to int64", err.Error(), nil))
+	}
+
+	if shopItemID <= 0 {
+		h.logger.Error("invalid shopItemID", "error", "shop item id must be a positive integer")
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "invalid shop item id", "shop item id must be a positive integer", nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.shopService.DeleteByID.Execute(ctxTimeout, shopItemID)
+	if err != nil {
+		h.logger.Error("failed to delete shop item by id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to delete shop item by id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[shop.ShopItem](true, "success", "", result))
+}
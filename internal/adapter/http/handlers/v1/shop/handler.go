@@ -0,0 +1,60 @@
+package shop
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/shop/all"
+	allavailable "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/shop/all_available"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/shop/create"
+	deletebyid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/shop/delete_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/shop/purchase"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/shop/update"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	all          *all.All
+	allAvailable *allavailable.AllAvailable
+	create       *create.Create
+	deleteByID   *deletebyid.DeleteByID
+	purchase     *purchase.Purchase
+	update       *update.Update
+}
+
+func New(
+	shopService *shopservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		all:          all.New(shopService, logger),
+		allAvailable: allavailable.New(shopService, logger),
+		create:       create.New(shopService, logger, validator),
+		deleteByID:   deletebyid.New(shopService, logger),
+		purchase:     purchase.New(shopService, logger, validator),
+		update:       update.New(shopService, logger, validator),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/shop",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Get("/all", middleware.AdminGuard.AdminGuardMiddleware, h.all.Execute)
+		api.Get("/available", h.allAvailable.Execute)
+		api.Post("", middleware.AdminGuard.AdminGuardMiddleware, h.create.Execute)
+		api.Post("/purchase", h.purchase.Execute)
+		api.Put("", middleware.AdminGuard.AdminGuardMiddleware, h.update.Execute)
+		api.Delete("/id/:shopItemID", middleware.AdminGuard.AdminGuardMiddleware, h.deleteByID.Execute)
+	}
+}
@@ -0,0 +1,78 @@
+package purchase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Purchase struct {
+	shopService *shopservice.Service
+	logger      logger.ILogger
+	validator   validator.IValidator
+}
+
+func New(
+	shopService *shopservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Purchase {
+	return &Purchase{
+		shopService: shopService,
+		logger:      logger,
+		validator:   validator,
+	}
+}
+
+// Execute purchases a shop item for internal currency.
+// @Summary Purchase shop item
+// @Description Purchases a shop item for internal currency and adds it to the user inventory. Rules:
+// @Description • `quantity` is required and must be between 1 and 100
+// @Description • `idempotency_key` is required; a repeated request with the same key returns the existing purchase with `is_duplicate` = true and does not debit currency again
+// @Description • reusing the key for another item or quantity is rejected
+// @Description The purchase is rejected if the item is not available, out of stock, the per user limit is reached or the balance is insufficient.
+// @Tags Shop
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body shop.PurchaseDTO true "Shop purchase data"
+// @Success 200 {object} shop.PurchaseSwaggerResponse "Successful response"
+// @Failure 400 {object} shop.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} shop.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/shop/purchase [post]
+func (h *Purchase) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[purchase shop item] execute handler")
+
+	var dto shop.PurchaseDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.shopService.Purchase.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to purchase shop item", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to purchase shop item", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[shop.PurchaseResponse](true, "success", "", result))
+}
@@ -0,0 +1,74 @@
+package update
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Update struct {
+	shopService *shopservice.Service
+	logger      logger.ILogger
+	validator   validator.IValidator
+}
+
+func New(
+	shopService *shopservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Update {
+	return &Update{
+		shopService: shopService,
+		logger:      logger,
+		validator:   validator,
+	}
+}
+
+// Execute updates a shop item (admin).
+// @Summary Update shop item (admin)
+// @Description Updates a shop item found by ID. The same rules as for creation apply. Already purchased inventory items are not changed.
+// @Tags Shop
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body shop.UpdateDTO true "Shop item data"
+// @Success 200 {object} shop.ShopItemSwaggerResponse "Successful response"
+// @Failure 400 {object} shop.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} shop.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/shop [put]
+func (h *Update) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[update shop item] execute handler")
+
+	var dto shop.UpdateDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.shopService.Update.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to update shop item", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to update shop item", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[shop.ShopItem](true, "success", "", result))
+}
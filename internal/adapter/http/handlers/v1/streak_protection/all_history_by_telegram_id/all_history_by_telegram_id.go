@@ -0,0 +1,66 @@
+package allhistorybytelegramid
+
+import (
+	"context"
+	"time"
+
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	streakprotectionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllHistoryByTelegramID struct {
+	streakProtectionService *streakprotectionservice.Service
+	logger                  logger.ILogger
+}
+
+func New(
+	streakProtectionService *streakprotectionservice.Service,
+	logger logger.ILogger,
+) *AllHistoryByTelegramID {
+	return &AllHistoryByTelegramID{
+		streakProtectionService: streakProtectionService,
+		logger:                  logger,
+	}
+}
+
+// Execute returns streak protection history of a user by Telegram ID.
+// @Summary Get streak protection history by Telegram ID
+// @Description Returns the latest purchased, rewarded and consumed streak freezes and streak repairs of a user (newest first).
+// @Description For consumed freezes and repairs `protected_date` is the missed day that was protected.
+// @Tags Streak protection
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param telegramID path string true "Telegram ID"
+// @Success 200 {object} streakprotection.AllHistorySwaggerResponse "Successful response"
+// @Failure 400 {object} streakprotection.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} streakprotection.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/streak_protection/history/telegram/{telegramID} [get]
+func (h *AllHistoryByTelegramID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all streak protection history by telegram id] execute handler")
+
+	telegramID := c.Params("telegramID")
+	if telegramID == "" {
+		h.logger.Error("failed to get param telegramID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param telegramID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.streakProtectionService.AllHistoryByTelegramID.Execute(ctxTimeout, telegramID)
+	if err != nil {
+		h.logger.Error("failed to get all streak protection history by telegram id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all streak protection history by telegram id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]streakprotection.History](true, "success", "", result))
+}
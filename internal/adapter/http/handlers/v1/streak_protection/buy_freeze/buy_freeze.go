@@ -0,0 +1,78 @@
+package buyfreeze
+
+import (
+	"context"
+	"time"
+
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	streakprotectionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type BuyFreeze struct {
+	streakProtectionService *streakprotectionservice.Service
+	logger                  logger.ILogger
+	validator               validator.IValidator
+}
+
+func New(
+	streakProtectionService *streakprotectionservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *BuyFreeze {
+	return &BuyFreeze{
+		streakProtectionService: streakProtectionService,
+		logger:                  logger,
+		validator:               validator,
+	}
+}
+
+// Execute buys streak freezes for internal currency.
+// @Summary Buy streak freeze
+// @Description Buys streak freezes for internal currency. Rules:
+// @Description • `telegram_id` is required
+// @Description • `quantity` is required and must be positive
+// @Description The price of one freeze is the amount of `streak_freeze_purchase` event type.
+// @Description A freeze is consumed automatically for a missed day; purchase exceeding the freeze limit is rejected.
+// @Tags Streak protection
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body streakprotection.BuyFreezeDTO true "Streak freeze purchase data"
+// @Success 200 {object} streakprotection.StreakProtectionSwaggerResponse "Successful response"
+// @Failure 400 {object} streakprotection.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} streakprotection.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/streak_protection/freeze [post]
+func (h *BuyFreeze) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[buy streak freeze] execute handler")
+
+	var dto streakprotection.BuyFreezeDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.streakProtectionService.BuyFreeze.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to buy streak freeze", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to buy streak freeze", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[streakprotection.StreakProtection](true, "success", "", result))
+}
@@ -0,0 +1,52 @@
+package streakprotection
+
+import (
+	allhistorybytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/streak_protection/all_history_by_telegram_id"
+	buyfreeze "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/streak_protection/buy_freeze"
+	getbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/streak_protection/get_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/streak_protection/repair"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	streakprotectionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	allHistoryByTelegramID *allhistorybytelegramid.AllHistoryByTelegramID
+	buyFreeze              *buyfreeze.BuyFreeze
+	getByTelegramID        *getbytelegramid.GetByTelegramID
+	repair                 *repair.Repair
+}
+
+func New(
+	streakProtectionService *streakprotectionservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		allHistoryByTelegramID: allhistorybytelegramid.New(streakProtectionService, logger),
+		buyFreeze:              buyfreeze.New(streakProtectionService, logger, validator),
+		getByTelegramID:        getbytelegramid.New(streakProtectionService, logger),
+		repair:                 repair.New(streakProtectionService, logger, validator),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/streak_protection",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Get("/telegram/:telegramID", h.getByTelegramID.Execute)
+		api.Get("/history/telegram/:telegramID", h.allHistoryByTelegramID.Execute)
+		api.Post("/freeze", h.buyFreeze.Execute)
+		api.Post("/repair", h.repair.Execute)
+	}
+}
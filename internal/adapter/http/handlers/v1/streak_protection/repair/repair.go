@@ -0,0 +1,78 @@
+package repair
+
+import (
+	"context"
+	"time"
+
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	streakprotectionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Repair struct {
+	streakProtectionService *streakprotectionservice.Service
+	logger                  logger.ILogger
+	validator               validator.IValidator
+}
+
+func New(
+	streakProtectionService *streakprotectionservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Repair {
+	return &Repair{
+		streakProtectionService: streakProtectionService,
+		logger:                  logger,
+		validator:               validator,
+	}
+}
+
+// Execute restores lost streak for internal currency.
+// @Summary Repair streak
+// @Description Restores the lost streak for internal currency. Rules:
+// @Description • `telegram_id` is required
+// @Description • repair is available on the day the streak was lost and on the next day
+// @Description • subscription with `streak_repair` entitlement makes repair free (its limit is count of free repairs per month)
+// @Description The price is the amount of `streak_repair_purchase` event type.
+// @Tags Streak protection
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body streakprotection.RepairDTO true "Streak repair data"
+// @Success 200 {object} streakprotection.StreakProtectionSwaggerResponse "Successful response"
+// @Failure 400 {object} streakprotection.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} streakprotection.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/streak_protection/repair [post]
+func (h *Repair) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[repair streak] execute handler")
+
+	var dto streakprotection.RepairDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.streakProtectionService.Repair.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to repair streak", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to repair streak", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[streakprotection.StreakProtection](true, "success", "", result))
+}
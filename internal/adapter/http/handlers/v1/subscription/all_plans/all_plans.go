@@ -0,0 +1,55 @@
+package allplans
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllPlans struct {
+	subscriptionService *subscriptionservice.Service
+	logger              logger.ILogger
+}
+
+func New(
+	subscriptionService *subscriptionservice.Service,
+	logger logger.ILogger,
+) *AllPlans {
+	return &AllPlans{
+		subscriptionService: subscriptionService,
+		logger:              logger,
+	}
+}
+
+// Execute returns subscription plans available for subscribing.
+// @Summary Get all subscription plans
+// @Description Returns active subscription plans (trial, monthly, yearly) with their duration and grace period in days.
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} subscription.AllPlansSwaggerResponse "Successful response"
+// @Failure 500 {object} subscription.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/subscription/plans [get]
+func (h *AllPlans) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all subscription plans] execute handler")
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.subscriptionService.AllPlans.Execute(ctxTimeout)
+	if err != nil {
+		h.logger.Error("failed to get all subscription plans", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all subscription plans", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]subscription.Plan](true, "success", "", result))
+}
@@ -1,28 +1,42 @@
 package subscription
 
 import (
+	allplans "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/subscription/all_plans"
 	existsbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/subscription/exists_by_telegram_id"
 	getbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/subscription/get_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/subscription/snapshot"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/subscription/subscribe"
+	subscribetrial "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/subscription/subscribe_trial"
 	"github.com/go-jedi/lingramm_backend/internal/middleware"
 	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
 	"github.com/gofiber/fiber/v3"
 )
 
 type Handler struct {
+	allPlans           *allplans.AllPlans
 	existsByTelegramID *existsbytelegramid.ExistsByTelegramID
 	getByTelegramID    *getbytelegramid.GetByTelegramID
+	snapshot           *snapshot.Snapshot
+	subscribe          *subscribe.Subscribe
+	subscribeTrial     *subscribetrial.SubscribeTrial
 }
 
 func New(
 	subscriptionService *subscriptionservice.Service,
 	app *fiber.App,
 	logger logger.ILogger,
+	validator validator.IValidator,
 	middleware *middleware.Middleware,
 ) *Handler {
 	h := &Handler{
+		allPlans:           allplans.New(subscriptionService, logger),
 		existsByTelegramID: existsbytelegramid.New(subscriptionService, logger),
 		getByTelegramID:    getbytelegramid.New(subscriptionService, logger),
+		snapshot:           snapshot.New(subscriptionService, logger, middleware),
+		subscribe:          subscribe.New(subscriptionService, logger, validator),
+		subscribeTrial:     subscribetrial.New(subscriptionService, logger, middleware),
 	}
 
 	h.initRoutes(app, middleware)
@@ -38,5 +52,9 @@ func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware)
 	{
 		api.Get("/telegram/:telegramID", h.getByTelegramID.Execute)
 		api.Get("/exists/telegram/:telegramID", h.existsByTelegramID.Execute)
+		api.Get("/plans", h.allPlans.Execute)
+		api.Get("/snapshot", h.snapshot.Execute)
+		api.Post("/trial", h.subscribeTrial.Execute)
+		api.Post("/subscribe", middleware.AdminGuard.AdminGuardMiddleware, h.subscribe.Execute)
 	}
 }
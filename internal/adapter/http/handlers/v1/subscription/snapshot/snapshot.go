@@ -0,0 +1,67 @@
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Snapshot struct {
+	subscriptionService *subscriptionservice.Service
+	logger              logger.ILogger
+	middleware          *middleware.Middleware
+}
+
+func New(
+	subscriptionService *subscriptionservice.Service,
+	logger logger.ILogger,
+	middleware *middleware.Middleware,
+) *Snapshot {
+	return &Snapshot{
+		subscriptionService: subscriptionService,
+		logger:              logger,
+		middleware:          middleware,
+	}
+}
+
+// Execute returns subscription state with entitlements of the user making request.
+// @Summary Get subscription snapshot
+// @Description Returns subscription state of the user making request with entitlements of the plan. Access is kept until `access_until` (end of grace period), entitlements are granted only while there is access.
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} subscription.SnapshotSwaggerResponse "Successful response"
+// @Failure 401 {object} subscription.ErrorSwaggerResponse "Unauthorized error"
+// @Failure 500 {object} subscription.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/subscription/snapshot [get]
+func (h *Snapshot) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get subscription snapshot] execute handler")
+
+	telegramID, err := h.middleware.Auth.GetTelegramIDFromContext(c)
+	if err != nil {
+		h.logger.Error("failed to get telegram id from context", "error", err)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.subscriptionService.GetSnapshotByTelegramID.Execute(ctxTimeout, telegramID)
+	if err != nil {
+		h.logger.Error("failed to get subscription snapshot", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get subscription snapshot", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[subscription.Snapshot](true, "success", "", result))
+}
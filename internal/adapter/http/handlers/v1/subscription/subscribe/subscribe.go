@@ -0,0 +1,74 @@
+package subscribe
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Subscribe struct {
+	subscriptionService *subscriptionservice.Service
+	logger              logger.ILogger
+	validator           validator.IValidator
+}
+
+func New(
+	subscriptionService *subscriptionservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Subscribe {
+	return &Subscribe{
+		subscriptionService: subscriptionService,
+		logger:              logger,
+		validator:           validator,
+	}
+}
+
+// Execute subscribes the user by plan.
+// @Summary Subscribe user by plan (admin)
+// @Description Subscribes the user by plan. Remaining time of an active subscription is kept and the plan duration is added to it, an expired subscription starts now. Trial is available once per user.
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body subscription.SubscribeDTO true "Subscribe data"
+// @Success 200 {object} subscription.GetByTelegramIDSwaggerResponse "Successful response"
+// @Failure 400 {object} subscription.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} subscription.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/subscription/subscribe [post]
+func (h *Subscribe) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[subscribe by plan] execute handler")
+
+	var dto subscription.SubscribeDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.subscriptionService.Subscribe.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to subscribe by plan", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to subscribe by plan", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[subscription.Subscription](true, "success", "", result))
+}
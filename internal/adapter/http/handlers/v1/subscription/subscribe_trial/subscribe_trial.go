@@ -0,0 +1,70 @@
+package subscribetrial
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type SubscribeTrial struct {
+	subscriptionService *subscriptionservice.Service
+	logger              logger.ILogger
+	middleware          *middleware.Middleware
+}
+
+func New(
+	subscriptionService *subscriptionservice.Service,
+	logger logger.ILogger,
+	middleware *middleware.Middleware,
+) *SubscribeTrial {
+	return &SubscribeTrial{
+		subscriptionService: subscriptionService,
+		logger:              logger,
+		middleware:          middleware,
+	}
+}
+
+// Execute activates trial subscription of the user making request.
+// @Summary Subscribe trial
+// @Description Activates trial subscription of the user making request. Trial is available once per user, remaining time of an active subscription is kept.
+// @Tags Subscription
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Success 200 {object} subscription.GetByTelegramIDSwaggerResponse "Successful response"
+// @Failure 401 {object} subscription.ErrorSwaggerResponse "Unauthorized error"
+// @Failure 500 {object} subscription.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/subscription/trial [post]
+func (h *SubscribeTrial) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[subscribe trial] execute handler")
+
+	telegramID, err := h.middleware.Auth.GetTelegramIDFromContext(c)
+	if err != nil {
+		h.logger.Error("failed to get telegram id from context", "error", err)
+		c.Status(fiber.StatusUnauthorized)
+		return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.subscriptionService.Subscribe.Execute(ctxTimeout, subscription.SubscribeDTO{
+		TelegramID: telegramID,
+		PlanCode:   subscription.PlanCodeTrial,
+	})
+	if err != nil {
+		h.logger.Error("failed to subscribe trial", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to subscribe trial", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[subscription.Subscription](true, "success", "", result))
+}
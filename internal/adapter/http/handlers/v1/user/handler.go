@@ -2,24 +2,29 @@ package user
 
 import (
 	getbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user/get_by_telegram_id"
+	updatetimezone "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user/update_timezone"
 	"github.com/go-jedi/lingramm_backend/internal/middleware"
 	userservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
 	"github.com/gofiber/fiber/v3"
 )
 
 type Handler struct {
 	getByTelegramID *getbytelegramid.GetByTelegramID
+	updateTimezone  *updatetimezone.UpdateTimezone
 }
 
 func New(
 	userService *userservice.Service,
 	app *fiber.App,
 	logger logger.ILogger,
+	validator validator.IValidator,
 	middleware *middleware.Middleware,
 ) *Handler {
 	h := &Handler{
 		getByTelegramID: getbytelegramid.New(userService, logger),
+		updateTimezone:  updatetimezone.New(userService, logger, validator),
 	}
 
 	h.initRoutes(app, middleware)
@@ -34,5 +39,6 @@ func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware)
 	)
 	{
 		api.Get("/telegram/:telegramID", h.getByTelegramID.Execute)
+		api.Put("/timezone", h.updateTimezone.Execute)
 	}
 }
@@ -0,0 +1,78 @@
+package updatetimezone
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/user"
+	userservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type UpdateTimezone struct {
+	userService *userservice.Service
+	logger      logger.ILogger
+	validator   validator.IValidator
+}
+
+func New(
+	userService *userservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *UpdateTimezone {
+	return &UpdateTimezone{
+		userService: userService,
+		logger:      logger,
+		validator:   validator,
+	}
+}
+
+// Execute updates the user timezone.
+// @Summary Update user timezone
+// @Description Sets the IANA timezone of a user (by Telegram ID). Rules:
+// @Description • `telegram_id` is required
+// @Description • `timezone` is required and must be a valid IANA timezone (e.g. `Europe/Berlin`)
+// @Description Streaks, daily task assignment and week summaries use this timezone; the weekly leaderboard keeps `Europe/Moscow`.
+// @Description Timezone set manually is not overwritten by the one detected at sign-in.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body user.UpdateTimezoneDTO true "Timezone data"
+// @Success 200 {object} user.CreateDailyTaskSwaggerResponse "Successful response"
+// @Failure 400 {object} user.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} user.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/user/timezone [put]
+func (h *UpdateTimezone) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[update user timezone] execute handler")
+
+	var dto user.UpdateTimezoneDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.userService.UpdateTimezone.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to update user timezone", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to update user timezone", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[user.User](true, "success", "", result))
+}
@@ -31,7 +31,7 @@ func New(
 
 // Execute returns all user achievements with details by Telegram ID (admin).
 // @Summary Get all user achievements detail by Telegram ID (admin)
-// @Description Returns a list of user's achievements with name, description, and asset paths for the specified Telegram ID.
+// @Description Returns a list of user's achievements with name, description, and asset paths for the specified Telegram ID. Tier chains are returned as a single entry with the highest unlocked tier.
 // @Tags User achievement
 // @Accept json
 // @Produce json
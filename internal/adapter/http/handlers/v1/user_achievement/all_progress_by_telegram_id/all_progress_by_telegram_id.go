@@ -0,0 +1,65 @@
+package allprogressbytelegramid
+
+import (
+	"context"
+	"time"
+
+	userachievement "github.com/go-jedi/lingramm_backend/internal/domain/user_achievement"
+	userachievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllProgressByTelegramID struct {
+	userAchievementService *userachievementservice.Service
+	logger                 logger.ILogger
+}
+
+func New(
+	userAchievementService *userachievementservice.Service,
+	logger logger.ILogger,
+) *AllProgressByTelegramID {
+	return &AllProgressByTelegramID{
+		userAchievementService: userAchievementService,
+		logger:                 logger,
+	}
+}
+
+// Execute returns user progress towards every active achievement.
+// @Summary Get achievement progress by Telegram ID
+// @Description Compares user stats with achievement type thresholds and returns progress per criterion and overall percentage for every active achievement. Achievements closest to completion come first, unlocked ones last. Secret achievements are shown as "???" until unlocked, event achievements whose window has ended are hidden unless unlocked, and tier chains are shown as a single badge with the next tier to unlock.
+// @Tags User achievement
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param telegramID path string true "Telegram ID"
+// @Success 200 {object} userachievement.AllProgressByTelegramIDSwaggerResponse "Successful response"
+// @Failure 400 {object} userachievement.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} userachievement.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/user_achievement/progress/telegram/{telegramID} [get]
+func (h *AllProgressByTelegramID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all achievement progress by telegram id] execute handler")
+
+	telegramID := c.Params("telegramID")
+	if telegramID == "" {
+		h.logger.Error("failed to get param telegramID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param telegramID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.userAchievementService.AllProgressByTelegramID.Execute(ctxTimeout, telegramID)
+	if err != nil {
+		h.logger.Error("failed to get all achievement progress by telegram id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all achievement progress by telegram id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]userachievement.Progress](true, "success", "", result))
+}
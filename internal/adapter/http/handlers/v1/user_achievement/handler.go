@@ -2,6 +2,7 @@ package userachievement
 
 import (
 	alldetailbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_achievement/all_detail_by_telegram_id"
+	allprogressbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_achievement/all_progress_by_telegram_id"
 	"github.com/go-jedi/lingramm_backend/internal/middleware"
 	userachievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_achievement"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
@@ -9,7 +10,8 @@ import (
 )
 
 type Handler struct {
-	allDetailByTelegramID *alldetailbytelegramid.AllDetailByTelegramID
+	allDetailByTelegramID   *alldetailbytelegramid.AllDetailByTelegramID
+	allProgressByTelegramID *allprogressbytelegramid.AllProgressByTelegramID
 }
 
 func New(
@@ -19,7 +21,8 @@ func New(
 	middleware *middleware.Middleware,
 ) *Handler {
 	h := &Handler{
-		allDetailByTelegramID: alldetailbytelegramid.New(userAchievementService, logger),
+		allDetailByTelegramID:   alldetailbytelegramid.New(userAchievementService, logger),
+		allProgressByTelegramID: allprogressbytelegramid.New(userAchievementService, logger),
 	}
 
 	h.initRoutes(app, middleware)
@@ -34,5 +37,6 @@ func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware)
 	)
 	{
 		api.Get("/all/telegram/:telegramID", h.allDetailByTelegramID.Execute)
+		api.Get("/progress/telegram/:telegramID", h.allProgressByTelegramID.Execute)
 	}
 }
@@ -0,0 +1,65 @@
+package allbytelegramid
+
+import (
+	"context"
+	"time"
+
+	userinventory "github.com/go-jedi/lingramm_backend/internal/domain/user_inventory"
+	userinventoryservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_inventory"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllByTelegramID struct {
+	userInventoryService *userinventoryservice.Service
+	logger               logger.ILogger
+}
+
+func New(
+	userInventoryService *userinventoryservice.Service,
+	logger logger.ILogger,
+) *AllByTelegramID {
+	return &AllByTelegramID{
+		userInventoryService: userInventoryService,
+		logger:               logger,
+	}
+}
+
+// Execute returns user inventory by Telegram ID.
+// @Summary Get user inventory by Telegram ID
+// @Description Returns inventory items purchased by the user with shop item data, remaining quantity and boost activity, newest first.
+// @Tags User inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param telegramID path string true "Telegram ID"
+// @Success 200 {object} userinventory.AllByTelegramIDSwaggerResponse "Successful response"
+// @Failure 400 {object} userinventory.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} userinventory.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/user_inventory/telegram/{telegramID} [get]
+func (h *AllByTelegramID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all user inventory items by telegram id] execute handler")
+
+	telegramID := c.Params("telegramID")
+	if telegramID == "" {
+		h.logger.Error("failed to get param telegramID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param telegramID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.userInventoryService.AllByTelegramID.Execute(ctxTimeout, telegramID)
+	if err != nil {
+		h.logger.Error("failed to get all user inventory items by telegram id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all user inventory items by telegram id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]userinventory.UserInventoryItem](true, "success", "", result))
+}
@@ -0,0 +1,78 @@
+package consume
+
+import (
+	"context"
+	"time"
+
+	userinventory "github.com/go-jedi/lingramm_backend/internal/domain/user_inventory"
+	userinventoryservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_inventory"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type Consume struct {
+	userInventoryService *userinventoryservice.Service
+	logger               logger.ILogger
+	validator            validator.IValidator
+}
+
+func New(
+	userInventoryService *userinventoryservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *Consume {
+	return &Consume{
+		userInventoryService: userInventoryService,
+		logger:               logger,
+		validator:            validator,
+	}
+}
+
+// Execute consumes a user inventory item.
+// @Summary Consume inventory item
+// @Description Consumes units of an inventory item and applies its effect:
+// @Description • `streak_freeze` adds freezes to streak protection (the freeze limit applies)
+// @Description • `premium_days` extends the subscription
+// @Description • `boost` activates the boost or extends the active one (experience points of events are doubled while active)
+// @Description Cosmetic frames are not consumable.
+// @Tags User inventory
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param payload body userinventory.ConsumeDTO true "Inventory item consume data"
+// @Success 200 {object} userinventory.InventoryItemSwaggerResponse "Successful response"
+// @Failure 400 {object} userinventory.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} userinventory.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/user_inventory/consume [post]
+func (h *Consume) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[consume user inventory item] execute handler")
+
+	var dto userinventory.ConsumeDTO
+	if err := c.Bind().Body(&dto); err != nil {
+		h.logger.Error("failed to bind body", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to bind body", err.Error(), nil))
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.userInventoryService.Consume.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to consume user inventory item", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to consume user inventory item", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[userinventory.InventoryItem](true, "success", "", result))
+}
@@ -0,0 +1,44 @@
+package userinventory
+
+import (
+	allbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_inventory/all_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_inventory/consume"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	userinventoryservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_inventory"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	allByTelegramID *allbytelegramid.AllByTelegramID
+	consume         *consume.Consume
+}
+
+func New(
+	userInventoryService *userinventoryservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		allByTelegramID: allbytelegramid.New(userInventoryService, logger),
+		consume:         consume.New(userInventoryService, logger, validator),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/user_inventory",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Get("/telegram/:telegramID", h.allByTelegramID.Execute)
+		api.Post("/consume", h.consume.Execute)
+	}
+}
@@ -0,0 +1,86 @@
+package allbytelegramid
+
+import (
+	"context"
+	"time"
+
+	userquest "github.com/go-jedi/lingramm_backend/internal/domain/user_quest"
+	userquestservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_quest"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+const timeout = 5 * time.Second
+
+type AllByTelegramID struct {
+	userQuestService *userquestservice.Service
+	logger           logger.ILogger
+	validator        validator.IValidator
+}
+
+func New(
+	userQuestService *userquestservice.Service,
+	logger logger.ILogger,
+	validator validator.IValidator,
+) *AllByTelegramID {
+	return &AllByTelegramID{
+		userQuestService: userQuestService,
+		logger:           logger,
+		validator:        validator,
+	}
+}
+
+// Execute returns quests of a user by Telegram ID.
+// @Summary Get user quests by Telegram ID
+// @Description Returns daily, weekly and one-off quests of a user with requirements, progress and rewards (newest first).
+// @Description Quests of the current day/week are assigned on request, so active quests are always up to date.
+// @Description Optional `status` filters quests: `active`, `completed` or `expired`.
+// @Tags User quest
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Authorization token" default(Bearer <token>)
+// @Param telegramID path string true "Telegram ID"
+// @Param status query string false "Quest status" Enums(active, completed, expired)
+// @Success 200 {object} userquest.AllByTelegramIDSwaggerResponse "Successful response"
+// @Failure 400 {object} userquest.ErrorSwaggerResponse "Bad request error"
+// @Failure 500 {object} userquest.ErrorSwaggerResponse "Internal server error"
+// @Router /v1/user_quest/telegram/{telegramID} [get]
+func (h *AllByTelegramID) Execute(c fiber.Ctx) error {
+	h.logger.Debug("[get all user quests by telegram id] execute handler")
+
+	telegramID := c.Params("telegramID")
+	if telegramID == "" {
+		h.logger.Error("failed to get param telegramID", "error", apperrors.ErrParamIsRequired)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to get param telegramID", apperrors.ErrParamIsRequired.Error(), nil))
+	}
+
+	dto := userquest.AllByTelegramIDDTO{
+		TelegramID: telegramID,
+	}
+
+	if status := c.Query("status"); status != "" {
+		dto.Status = &status
+	}
+
+	if err := h.validator.StructCtx(c.RequestCtx(), dto); err != nil {
+		h.logger.Error("failed to validate struct", "error", err)
+		c.Status(fiber.StatusBadRequest)
+		return c.JSON(response.New[any](false, "failed to validate struct", err.Error(), nil))
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(c.RequestCtx(), timeout)
+	defer cancel()
+
+	result, err := h.userQuestService.AllByTelegramID.Execute(ctxTimeout, dto)
+	if err != nil {
+		h.logger.Error("failed to get all user quests by telegram id", "error", err)
+		c.Status(fiber.StatusInternalServerError)
+		return c.JSON(response.New[any](false, "failed to get all user quests by telegram id", err.Error(), nil))
+	}
+
+	return c.JSON(response.New[[]userquest.UserQuest](true, "success", "", result))
+}
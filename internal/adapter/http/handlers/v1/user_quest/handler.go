@@ -0,0 +1,40 @@
+package userquest
+
+import (
+	allbytelegramid "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_quest/all_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/middleware"
+	userquestservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_quest"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/validator"
+	"github.com/gofiber/fiber/v3"
+)
+
+type Handler struct {
+	allByTelegramID *allbytelegramid.AllByTelegramID
+}
+
+func New(
+	userQuestService *userquestservice.Service,
+	app *fiber.App,
+	logger logger.ILogger,
+	validator validator.IValidator,
+	middleware *middleware.Middleware,
+) *Handler {
+	h := &Handler{
+		allByTelegramID: allbytelegramid.New(userQuestService, logger, validator),
+	}
+
+	h.initRoutes(app, middleware)
+
+	return h
+}
+
+func (h *Handler) initRoutes(app *fiber.App, middleware *middleware.Middleware) {
+	api := app.Group(
+		"/v1/user_quest",
+		middleware.Auth.AuthMiddleware,
+	)
+	{
+		api.Get("/telegram/:telegramID", h.allByTelegramID.Execute)
+	}
+}
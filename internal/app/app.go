@@ -21,6 +21,7 @@ import (
 	"github.com/go-jedi/lingramm_backend/pkg/rabbitmq"
 	"github.com/go-jedi/lingramm_backend/pkg/redis"
 	swaggerserver "github.com/go-jedi/lingramm_backend/pkg/swagger_server"
+	"github.com/go-jedi/lingramm_backend/pkg/telegram"
 	"github.com/go-jedi/lingramm_backend/pkg/uuid"
 	"github.com/go-jedi/lingramm_backend/pkg/validator"
 	wsmanager "github.com/go-jedi/lingramm_backend/pkg/ws_manager"
@@ -39,6 +40,7 @@ type App struct {
 	redis         *redis.Redis
 	bigCache      *bigcachepkg.BigCache
 	wsManager     *wsmanager.WSManager
+	telegram      *telegram.Telegram
 	hs            *httpserver.HTTPServer
 	swaggerServer *swaggerserver.SwaggerServer
 	fileServer    *fileserver.FileServer
@@ -109,6 +111,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initRedis,
 		a.initBigCache,
 		a.initWSManager,
+		a.initTelegram,
 		a.initHTTPServer,
 		a.initSwaggerServer,
 		a.initFileServer,
@@ -209,6 +212,16 @@ func (a *App) initWSManager(_ context.Context) error {
 	return nil
 }
 
+// initTelegram initialize telegram bot api client.
+func (a *App) initTelegram(_ context.Context) (err error) {
+	a.telegram, err = telegram.New(a.cfg.Telegram)
+	if err != nil {
+		return err
+	}
+
+	return
+}
+
 // initHTTPServer initialize http server.
 func (a *App) initHTTPServer(_ context.Context) (err error) {
 	a.hs, err = httpserver.New(a.cfg.HTTPServer)
@@ -287,6 +300,7 @@ func (a *App) initDependencies(ctx context.Context) error {
 		a.redis,
 		a.bigCache,
 		a.wsManager,
+		a.telegram,
 		a.fileServer,
 	)
 
@@ -0,0 +1,51 @@
+package dependencies
+
+import (
+	achievementevaluationhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_evaluation"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
+	achievementevaluationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation"
+)
+
+func (d *Dependencies) AchievementEvaluationRepository() *achievementevaluationrepository.Repository {
+	if d.achievementEvaluationRepository == nil {
+		d.achievementEvaluationRepository = achievementevaluationrepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.achievementEvaluationRepository
+}
+
+func (d *Dependencies) AchievementEvaluationService() *achievementevaluationservice.Service {
+	if d.achievementEvaluationService == nil {
+		d.achievementEvaluationService = achievementevaluationservice.New(
+			d.AchievementEvaluationRepository(),
+			d.AchievementRepository(),
+			d.EventTypeRepository(),
+			d.InternalCurrencyRepository(),
+			d.LevelRepository(),
+			d.NotificationRepository(),
+			d.logger,
+			d.rabbitMQ,
+			d.postgres,
+			d.redis,
+		)
+	}
+
+	return d.achievementEvaluationService
+}
+
+func (d *Dependencies) AchievementEvaluationHandler() *achievementevaluationhandler.Handler {
+	if d.achievementEvaluationHandler == nil {
+		d.achievementEvaluationHandler = achievementevaluationhandler.New(
+			d.AchievementEvaluationService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.achievementEvaluationHandler
+}
@@ -0,0 +1,20 @@
+package dependencies
+
+import (
+	"context"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/achievement_evaluation"
+)
+
+func (d *Dependencies) AchievementEvaluationCron(ctx context.Context) *achievementevaluation.AchievementEvaluation {
+	if d.achievementEvaluation == nil {
+		d.achievementEvaluation = achievementevaluation.New(
+			ctx,
+			d.AchievementEvaluationService(),
+			d.cfg.Cron,
+			d.logger,
+		)
+	}
+
+	return d.achievementEvaluation
+}
@@ -0,0 +1,20 @@
+package dependencies
+
+import (
+	"context"
+
+	achievementstatsrefresh "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/achievement_stats_refresh"
+)
+
+func (d *Dependencies) AchievementStatsRefreshCron(ctx context.Context) *achievementstatsrefresh.AchievementStatsRefresh {
+	if d.achievementStatsRefresh == nil {
+		d.achievementStatsRefresh = achievementstatsrefresh.New(
+			ctx,
+			d.AchievementService(),
+			d.cfg.Cron,
+			d.logger,
+		)
+	}
+
+	return d.achievementStatsRefresh
+}
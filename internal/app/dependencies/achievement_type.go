@@ -1,6 +1,10 @@
 package dependencies
 
-import achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+import (
+	achievementtypehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_type"
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	achievementtypeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type"
+)
 
 func (d *Dependencies) AchievementTypeRepository() *achievementtyperepository.Repository {
 	if d.achievementTypeRepository == nil {
@@ -12,3 +16,29 @@ func (d *Dependencies) AchievementTypeRepository() *achievementtyperepository.Re
 
 	return d.achievementTypeRepository
 }
+
+func (d *Dependencies) AchievementTypeService() *achievementtypeservice.Service {
+	if d.achievementTypeService == nil {
+		d.achievementTypeService = achievementtypeservice.New(
+			d.AchievementTypeRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.achievementTypeService
+}
+
+func (d *Dependencies) AchievementTypeHandler() *achievementtypehandler.Handler {
+	if d.achievementTypeHandler == nil {
+		d.achievementTypeHandler = achievementtypehandler.New(
+			d.AchievementTypeService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.achievementTypeHandler
+}
@@ -0,0 +1,45 @@
+package dependencies
+
+import (
+	aggregaterebuildhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/aggregate_rebuild"
+	aggregaterebuildrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild"
+	aggregaterebuildservice "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild"
+)
+
+func (d *Dependencies) AggregateRebuildRepository() *aggregaterebuildrepository.Repository {
+	if d.aggregateRebuildRepository == nil {
+		d.aggregateRebuildRepository = aggregaterebuildrepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.aggregateRebuildRepository
+}
+
+func (d *Dependencies) AggregateRebuildService() *aggregaterebuildservice.Service {
+	if d.aggregateRebuildService == nil {
+		d.aggregateRebuildService = aggregaterebuildservice.New(
+			d.AggregateRebuildRepository(),
+			d.UserRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.aggregateRebuildService
+}
+
+func (d *Dependencies) AggregateRebuildHandler() *aggregaterebuildhandler.Handler {
+	if d.aggregateRebuildHandler == nil {
+		d.aggregateRebuildHandler = aggregaterebuildhandler.New(
+			d.AggregateRebuildService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.aggregateRebuildHandler
+}
@@ -0,0 +1,20 @@
+package dependencies
+
+import (
+	"context"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/aggregate_rebuild"
+)
+
+func (d *Dependencies) AggregateRebuildCron(ctx context.Context) *aggregaterebuild.AggregateRebuild {
+	if d.aggregateRebuild == nil {
+		d.aggregateRebuild = aggregaterebuild.New(
+			ctx,
+			d.AggregateRebuildService(),
+			d.cfg.Cron,
+			d.logger,
+		)
+	}
+
+	return d.aggregateRebuild
+}
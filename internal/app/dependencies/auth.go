@@ -11,6 +11,7 @@ func (d *Dependencies) AuthService() *authservice.Service {
 			d.UserRepository(),
 			d.LevelRepository(),
 			d.UserDailyTaskRepository(),
+			d.ReferralRepository(),
 			d.logger,
 			d.postgres,
 			d.redis,
@@ -0,0 +1,46 @@
+package dependencies
+
+import (
+	currencyratehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate"
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+)
+
+func (d *Dependencies) CurrencyRateRepository() *currencyraterepository.Repository {
+	if d.currencyRateRepository == nil {
+		d.currencyRateRepository = currencyraterepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.currencyRateRepository
+}
+
+func (d *Dependencies) CurrencyRateService() *currencyrateservice.Service {
+	if d.currencyRateService == nil {
+		d.currencyRateService = currencyrateservice.New(
+			d.CurrencyRateRepository(),
+			d.ShopRepository(),
+			d.logger,
+			d.postgres,
+			d.bigCache,
+		)
+	}
+
+	return d.currencyRateService
+}
+
+func (d *Dependencies) CurrencyRateHandler() *currencyratehandler.Handler {
+	if d.currencyRateHandler == nil {
+		d.currencyRateHandler = currencyratehandler.New(
+			d.CurrencyRateService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.currencyRateHandler
+}
@@ -0,0 +1,51 @@
+package dependencies
+
+import (
+	currencytransferhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_transfer"
+	currencytransferrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_transfer"
+	currencytransferservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_transfer"
+)
+
+func (d *Dependencies) CurrencyTransferRepository() *currencytransferrepository.Repository {
+	if d.currencyTransferRepository == nil {
+		d.currencyTransferRepository = currencytransferrepository.New(
+			d.postgres.QueryTimeout,
+			d.cfg.CurrencyTransfer,
+			d.logger,
+		)
+	}
+
+	return d.currencyTransferRepository
+}
+
+func (d *Dependencies) CurrencyTransferService() *currencytransferservice.Service {
+	if d.currencyTransferService == nil {
+		d.currencyTransferService = currencytransferservice.New(
+			d.CurrencyTransferRepository(),
+			d.UserRepository(),
+			d.EventTypeRepository(),
+			d.InternalCurrencyRepository(),
+			d.NotificationRepository(),
+			d.logger,
+			d.rabbitMQ,
+			d.postgres,
+			d.redis,
+		)
+	}
+
+	return d.currencyTransferService
+}
+
+func (d *Dependencies) CurrencyTransferHandler() *currencytransferhandler.Handler {
+	if d.currencyTransferHandler == nil {
+		d.currencyTransferHandler = currencytransferhandler.New(
+			d.CurrencyTransferService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.currencyTransferHandler
+}
@@ -4,34 +4,60 @@ import (
 	"context"
 
 	"github.com/go-jedi/lingramm_backend/config"
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/achievement_evaluation"
+	achievementstatsrefresh "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/achievement_stats_refresh"
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/aggregate_rebuild"
 	leaderboardweeksprocessbatch "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/leaderboard_weeks_process_batch"
+	leagueweeksfinalize "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/league_weeks_finalize"
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/ledger_reconciliation"
+	subscriptionexpiry "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/subscription_expiry"
 	undeletefileachievementcleaner "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/un_delete_file_achievement_cleaner"
 	undeletefileawardcleaner "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/un_delete_file_award_cleaner"
 	undeletefileclientcleaner "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/un_delete_file_client_cleaner"
 	achievementhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement"
+	achievementevaluationhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_evaluation"
+	achievementtypehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/achievement_type"
 	adminhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/admin"
+	aggregaterebuildhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/aggregate_rebuild"
 	authhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/auth"
 	bigcachehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/bigcache"
+	currencyratehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_rate"
+	currencytransferhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/currency_transfer"
 	dailytaskhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/daily_task"
 	eventhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/event"
 	eventtypehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/event_type"
 	experiencepointhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/experience_point"
 	clientassetshandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/file_server/client_assets"
 	internalcurrencyhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/internal_currency"
+	leaguehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/league"
+	ledgerreconciliationhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/ledger_reconciliation"
+	levelhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level"
 	localizedtexthandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/localized_text"
 	notificationhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/notification"
+	paymenthandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/payment"
+	promocodehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/promo_code"
+	questhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/quest"
+	referralhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/referral"
+	shophandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/shop"
+	streakprotectionhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/streak_protection"
 	studiedlanguagehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/studied_language"
 	subscriptionhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/subscription"
 	userhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user"
 	userachievementhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_achievement"
 	userdailytaskhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_daily_task"
+	userinventoryhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_inventory"
+	userquesthandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_quest"
 	userstatshandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_stats"
 	userstudiedlanguagehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_studied_language"
 	notificationwebsockethandler "github.com/go-jedi/lingramm_backend/internal/adapter/websocket/handlers/v1/notification"
 	"github.com/go-jedi/lingramm_backend/internal/middleware"
 	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
 	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
 	adminrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/admin"
+	aggregaterebuildrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild"
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	currencytransferrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_transfer"
 	dailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task"
 	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
 	experiencepointrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point"
@@ -39,33 +65,59 @@ import (
 	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
 	clientassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/client_assets"
 	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	leaguerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/league"
+	ledgerreconciliationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation"
 	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
 	localizedtextepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/localized_text"
 	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	paymentrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment"
+	promocoderepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code"
+	questrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/quest"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	streakprotectionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection"
 	studiedlanguagerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/studied_language"
 	subscriptionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription"
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	userachievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement"
 	userdailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task"
+	userinventoryrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory"
+	userquestrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest"
 	userstatsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_stats"
 	userstudiedlanguagerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_studied_language"
 	achievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement"
+	achievementevaluationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation"
+	achievementtypeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type"
 	adminservice "github.com/go-jedi/lingramm_backend/internal/service/v1/admin"
+	aggregaterebuildservice "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild"
 	authservice "github.com/go-jedi/lingramm_backend/internal/service/v1/auth"
 	bigcacheservice "github.com/go-jedi/lingramm_backend/internal/service/v1/bigcache"
+	currencyrateservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate"
+	currencytransferservice "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_transfer"
 	dailytaskservice "github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task"
 	eventservice "github.com/go-jedi/lingramm_backend/internal/service/v1/event"
 	eventtypeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/event_type"
 	experiencepointservice "github.com/go-jedi/lingramm_backend/internal/service/v1/experience_point"
 	clientassetsservice "github.com/go-jedi/lingramm_backend/internal/service/v1/file_server/client_assets"
 	internalcurrencyservice "github.com/go-jedi/lingramm_backend/internal/service/v1/internal_currency"
+	leagueservice "github.com/go-jedi/lingramm_backend/internal/service/v1/league"
+	ledgerreconciliationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation"
+	levelservice "github.com/go-jedi/lingramm_backend/internal/service/v1/level"
 	localizedtextservice "github.com/go-jedi/lingramm_backend/internal/service/v1/localized_text"
 	notificationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/notification"
+	paymentservice "github.com/go-jedi/lingramm_backend/internal/service/v1/payment"
+	promocodeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code"
+	questservice "github.com/go-jedi/lingramm_backend/internal/service/v1/quest"
+	referralservice "github.com/go-jedi/lingramm_backend/internal/service/v1/referral"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+	streakprotectionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection"
 	studiedlanguageservice "github.com/go-jedi/lingramm_backend/internal/service/v1/studied_language"
 	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
 	userservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user"
 	userachievementservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_achievement"
 	userdailytaskservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_daily_task"
+	userinventoryservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_inventory"
+	userquestservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_quest"
 	userstatsservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_stats"
 	userstudiedlanguageservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_studied_language"
 	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
@@ -75,6 +127,7 @@ import (
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
 	"github.com/go-jedi/lingramm_backend/pkg/rabbitmq"
 	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/go-jedi/lingramm_backend/pkg/telegram"
 	"github.com/go-jedi/lingramm_backend/pkg/uuid"
 	"github.com/go-jedi/lingramm_backend/pkg/validator"
 	wsmanager "github.com/go-jedi/lingramm_backend/pkg/ws_manager"
@@ -94,6 +147,7 @@ type Dependencies struct {
 	redis      *redis.Redis
 	bigCache   *bigcachepkg.BigCache
 	wsManager  *wsmanager.WSManager
+	telegram   *telegram.Telegram
 	fileServer *fileserver.FileServer
 
 	// auth.
@@ -132,6 +186,8 @@ type Dependencies struct {
 
 	// achievement type.
 	achievementTypeRepository *achievementtyperepository.Repository
+	achievementTypeService    *achievementtypeservice.Service
+	achievementTypeHandler    *achievementtypehandler.Handler
 
 	// achievement.
 	achievementRepository *achievementrepository.Repository
@@ -173,12 +229,19 @@ type Dependencies struct {
 	experiencePointService    *experiencepointservice.Service
 	experiencePointHandler    *experiencepointhandler.Handler
 
+	// league.
+	leagueRepository *leaguerepository.Repository
+	leagueService    *leagueservice.Service
+	leagueHandler    *leaguehandler.Handler
+
 	// event.
 	eventService *eventservice.Service
 	eventHandler *eventhandler.Handler
 
 	// level.
 	levelRepository *levelrepository.Repository
+	levelService    *levelservice.Service
+	levelHandler    *levelhandler.Handler
 
 	// event type.
 	eventTypeRepository *eventtyperepository.Repository
@@ -195,6 +258,71 @@ type Dependencies struct {
 	userDailyTaskService    *userdailytaskservice.Service
 	userDailyTaskHandler    *userdailytaskhandler.Handler
 
+	// streak protection.
+	streakProtectionRepository *streakprotectionrepository.Repository
+	streakProtectionService    *streakprotectionservice.Service
+	streakProtectionHandler    *streakprotectionhandler.Handler
+
+	// quest.
+	questRepository *questrepository.Repository
+	questService    *questservice.Service
+	questHandler    *questhandler.Handler
+
+	// user quest.
+	userQuestRepository *userquestrepository.Repository
+	userQuestService    *userquestservice.Service
+	userQuestHandler    *userquesthandler.Handler
+
+	// shop.
+	shopRepository *shoprepository.Repository
+	shopService    *shopservice.Service
+	shopHandler    *shophandler.Handler
+
+	// user inventory.
+	userInventoryRepository *userinventoryrepository.Repository
+	userInventoryService    *userinventoryservice.Service
+	userInventoryHandler    *userinventoryhandler.Handler
+
+	// currency rate.
+	currencyRateRepository *currencyraterepository.Repository
+	currencyRateService    *currencyrateservice.Service
+	currencyRateHandler    *currencyratehandler.Handler
+
+	// achievement evaluation.
+	achievementEvaluationRepository *achievementevaluationrepository.Repository
+	achievementEvaluationService    *achievementevaluationservice.Service
+	achievementEvaluationHandler    *achievementevaluationhandler.Handler
+
+	// aggregate rebuild.
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository
+	aggregateRebuildService    *aggregaterebuildservice.Service
+	aggregateRebuildHandler    *aggregaterebuildhandler.Handler
+
+	// ledger reconciliation.
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository
+	ledgerReconciliationService    *ledgerreconciliationservice.Service
+	ledgerReconciliationHandler    *ledgerreconciliationhandler.Handler
+
+	// payment.
+	paymentRepository *paymentrepository.Repository
+	paymentService    *paymentservice.Service
+	paymentHandler    *paymenthandler.Handler
+
+	// promo code.
+	promoCodeRepository *promocoderepository.Repository
+	promoCodeService    *promocodeservice.Service
+	promoCodeHandler    *promocodehandler.Handler
+
+	// referral.
+	referralRepository *referralrepository.Repository
+	referralService    *referralservice.Service
+	referralHandler    *referralhandler.Handler
+
+	// currency transfer.
+	currencyTransferRepository *currencytransferrepository.Repository
+	currencyTransferService    *currencytransferservice.Service
+	currencyTransferHandler    *currencytransferhandler.Handler
+
 	// admin.
 	adminRepository *adminrepository.Repository
 	adminService    *adminservice.Service
@@ -208,6 +336,12 @@ type Dependencies struct {
 	unDeleteFileAwardCleaner       *undeletefileawardcleaner.UnDeleteFileAwardCleaner
 	unDeleteFileClientCleaner      *undeletefileclientcleaner.UnDeleteFileClientCleaner
 	leaderboardWeeksProcessBatch   *leaderboardweeksprocessbatch.LeaderboardWeeksProcessBatch
+	leagueWeeksFinalize            *leagueweeksfinalize.LeagueWeeksFinalize
+	aggregateRebuild               *aggregaterebuild.AggregateRebuild
+	ledgerReconciliation           *ledgerreconciliation.LedgerReconciliation
+	achievementEvaluation          *achievementevaluation.AchievementEvaluation
+	achievementStatsRefresh        *achievementstatsrefresh.AchievementStatsRefresh
+	subscriptionExpiry             *subscriptionexpiry.SubscriptionExpiry
 }
 
 func New(
@@ -223,6 +357,7 @@ func New(
 	redis *redis.Redis,
 	bigCache *bigcachepkg.BigCache,
 	wsManager *wsmanager.WSManager,
+	telegram *telegram.Telegram,
 	fileServer *fileserver.FileServer,
 ) *Dependencies {
 	d := &Dependencies{
@@ -237,6 +372,7 @@ func New(
 		redis:      redis,
 		bigCache:   bigCache,
 		wsManager:  wsManager,
+		telegram:   telegram,
 		fileServer: fileServer,
 	}
 
@@ -253,6 +389,7 @@ func (d *Dependencies) initMiddleware() {
 	d.middleware = middleware.New(
 		d.cfg.Middleware,
 		d.AdminService(),
+		d.SubscriptionService(),
 		d.jwt,
 		d.redis,
 	)
@@ -266,6 +403,7 @@ func (d *Dependencies) initHandler() {
 	_ = d.ClientAssetsHandler()
 	_ = d.BigCacheHandler()
 	_ = d.InternalCurrencyHandler()
+	_ = d.AchievementTypeHandler()
 	_ = d.AchievementHandler()
 	_ = d.UserAchievementHandler()
 	_ = d.StudiedLanguageHandler()
@@ -274,10 +412,25 @@ func (d *Dependencies) initHandler() {
 	_ = d.NotificationHandler()
 	_ = d.SubscriptionHandler()
 	_ = d.ExperiencePointHandler()
+	_ = d.LeagueHandler()
+	_ = d.LevelHandler()
 	_ = d.EventHandler()
 	_ = d.EventTypeHandler()
 	_ = d.DailyTaskHandler()
 	_ = d.UserDailyTaskHandler()
+	_ = d.StreakProtectionHandler()
+	_ = d.QuestHandler()
+	_ = d.UserQuestHandler()
+	_ = d.ShopHandler()
+	_ = d.UserInventoryHandler()
+	_ = d.CurrencyRateHandler()
+	_ = d.AggregateRebuildHandler()
+	_ = d.LedgerReconciliationHandler()
+	_ = d.AchievementEvaluationHandler()
+	_ = d.PaymentHandler()
+	_ = d.PromoCodeHandler()
+	_ = d.ReferralHandler()
+	_ = d.CurrencyTransferHandler()
 	_ = d.AdminHandler()
 }
 
@@ -292,4 +445,10 @@ func (d *Dependencies) initCron(ctx context.Context) {
 	_ = d.UnDeleteFileAwardCleanerCron(ctx)
 	_ = d.UnDeleteFileClientCleanerCron(ctx)
 	_ = d.LeaderboardWeeksProcessBatchCron(ctx)
+	_ = d.LeagueWeeksFinalizeCron(ctx)
+	_ = d.AggregateRebuildCron(ctx)
+	_ = d.LedgerReconciliationCron(ctx)
+	_ = d.AchievementEvaluationCron(ctx)
+	_ = d.AchievementStatsRefreshCron(ctx)
+	_ = d.SubscriptionExpiryCron(ctx)
 }
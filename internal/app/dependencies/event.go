@@ -16,7 +16,11 @@ func (d *Dependencies) EventService() *eventservice.Service {
 			d.InternalCurrencyRepository(),
 			d.UserAchievementRepository(),
 			d.UserDailyTaskRepository(),
+			d.UserQuestRepository(),
+			d.UserInventoryRepository(),
 			d.NotificationRepository(),
+			d.LeagueRepository(),
+			d.ReferralRepository(),
 			d.logger,
 			d.rabbitMQ,
 			d.postgres,
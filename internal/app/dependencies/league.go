@@ -0,0 +1,44 @@
+package dependencies
+
+import (
+	leaguehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/league"
+	leaguerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/league"
+	leagueservice "github.com/go-jedi/lingramm_backend/internal/service/v1/league"
+)
+
+func (d *Dependencies) LeagueRepository() *leaguerepository.Repository {
+	if d.leagueRepository == nil {
+		d.leagueRepository = leaguerepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.leagueRepository
+}
+
+func (d *Dependencies) LeagueService() *leagueservice.Service {
+	if d.leagueService == nil {
+		d.leagueService = leagueservice.New(
+			d.LeagueRepository(),
+			d.UserRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.leagueService
+}
+
+func (d *Dependencies) LeagueHandler() *leaguehandler.Handler {
+	if d.leagueHandler == nil {
+		d.leagueHandler = leaguehandler.New(
+			d.LeagueService(),
+			d.app,
+			d.logger,
+			d.middleware,
+		)
+	}
+
+	return d.leagueHandler
+}
@@ -0,0 +1,20 @@
+package dependencies
+
+import (
+	"context"
+
+	leagueweeksfinalize "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/league_weeks_finalize"
+)
+
+func (d *Dependencies) LeagueWeeksFinalizeCron(ctx context.Context) *leagueweeksfinalize.LeagueWeeksFinalize {
+	if d.leagueWeeksFinalize == nil {
+		d.leagueWeeksFinalize = leagueweeksfinalize.New(
+			ctx,
+			d.LeagueService(),
+			d.cfg.Cron,
+			d.logger,
+		)
+	}
+
+	return d.leagueWeeksFinalize
+}
@@ -0,0 +1,45 @@
+package dependencies
+
+import (
+	ledgerreconciliationhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/ledger_reconciliation"
+	ledgerreconciliationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation"
+	ledgerreconciliationservice "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation"
+)
+
+func (d *Dependencies) LedgerReconciliationRepository() *ledgerreconciliationrepository.Repository {
+	if d.ledgerReconciliationRepository == nil {
+		d.ledgerReconciliationRepository = ledgerreconciliationrepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.ledgerReconciliationRepository
+}
+
+func (d *Dependencies) LedgerReconciliationService() *ledgerreconciliationservice.Service {
+	if d.ledgerReconciliationService == nil {
+		d.ledgerReconciliationService = ledgerreconciliationservice.New(
+			d.LedgerReconciliationRepository(),
+			d.UserRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.ledgerReconciliationService
+}
+
+func (d *Dependencies) LedgerReconciliationHandler() *ledgerreconciliationhandler.Handler {
+	if d.ledgerReconciliationHandler == nil {
+		d.ledgerReconciliationHandler = ledgerreconciliationhandler.New(
+			d.LedgerReconciliationService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.ledgerReconciliationHandler
+}
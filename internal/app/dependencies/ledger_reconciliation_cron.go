@@ -0,0 +1,20 @@
+package dependencies
+
+import (
+	"context"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/ledger_reconciliation"
+)
+
+func (d *Dependencies) LedgerReconciliationCron(ctx context.Context) *ledgerreconciliation.LedgerReconciliation {
+	if d.ledgerReconciliation == nil {
+		d.ledgerReconciliation = ledgerreconciliation.New(
+			ctx,
+			d.LedgerReconciliationService(),
+			d.cfg.Cron,
+			d.logger,
+		)
+	}
+
+	return d.ledgerReconciliation
+}
@@ -1,6 +1,10 @@
 package dependencies
 
-import levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+import (
+	levelhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/level"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	levelservice "github.com/go-jedi/lingramm_backend/internal/service/v1/level"
+)
 
 func (d *Dependencies) LevelRepository() *levelrepository.Repository {
 	if d.levelRepository == nil {
@@ -12,3 +16,30 @@ func (d *Dependencies) LevelRepository() *levelrepository.Repository {
 
 	return d.levelRepository
 }
+
+func (d *Dependencies) LevelService() *levelservice.Service {
+	if d.levelService == nil {
+		d.levelService = levelservice.New(
+			d.LevelRepository(),
+			d.AwardAssetsRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.levelService
+}
+
+func (d *Dependencies) LevelHandler() *levelhandler.Handler {
+	if d.levelHandler == nil {
+		d.levelHandler = levelhandler.New(
+			d.LevelService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.levelHandler
+}
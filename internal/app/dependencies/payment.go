@@ -0,0 +1,49 @@
+package dependencies
+
+import (
+	paymenthandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/payment"
+	paymentrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment"
+	paymentservice "github.com/go-jedi/lingramm_backend/internal/service/v1/payment"
+)
+
+func (d *Dependencies) PaymentRepository() *paymentrepository.Repository {
+	if d.paymentRepository == nil {
+		d.paymentRepository = paymentrepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.paymentRepository
+}
+
+func (d *Dependencies) PaymentService() *paymentservice.Service {
+	if d.paymentService == nil {
+		d.paymentService = paymentservice.New(
+			d.PaymentRepository(),
+			d.UserRepository(),
+			d.logger,
+			d.postgres,
+			d.redis,
+			d.telegram,
+			d.uuid,
+		)
+	}
+
+	return d.paymentService
+}
+
+func (d *Dependencies) PaymentHandler() *paymenthandler.Handler {
+	if d.paymentHandler == nil {
+		d.paymentHandler = paymenthandler.New(
+			d.PaymentService(),
+			d.app,
+			d.cfg.Telegram,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.paymentHandler
+}
@@ -0,0 +1,49 @@
+package dependencies
+
+import (
+	promocodehandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/promo_code"
+	promocoderepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code"
+	promocodeservice "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code"
+)
+
+func (d *Dependencies) PromoCodeRepository() *promocoderepository.Repository {
+	if d.promoCodeRepository == nil {
+		d.promoCodeRepository = promocoderepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.promoCodeRepository
+}
+
+func (d *Dependencies) PromoCodeService() *promocodeservice.Service {
+	if d.promoCodeService == nil {
+		d.promoCodeService = promocodeservice.New(
+			d.PromoCodeRepository(),
+			d.UserRepository(),
+			d.ShopRepository(),
+			d.EventTypeRepository(),
+			d.InternalCurrencyRepository(),
+			d.logger,
+			d.postgres,
+			d.redis,
+		)
+	}
+
+	return d.promoCodeService
+}
+
+func (d *Dependencies) PromoCodeHandler() *promocodehandler.Handler {
+	if d.promoCodeHandler == nil {
+		d.promoCodeHandler = promocodehandler.New(
+			d.PromoCodeService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.promoCodeHandler
+}
@@ -0,0 +1,44 @@
+package dependencies
+
+import (
+	questhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/quest"
+	questrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/quest"
+	questservice "github.com/go-jedi/lingramm_backend/internal/service/v1/quest"
+)
+
+func (d *Dependencies) QuestRepository() *questrepository.Repository {
+	if d.questRepository == nil {
+		d.questRepository = questrepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.questRepository
+}
+
+func (d *Dependencies) QuestService() *questservice.Service {
+	if d.questService == nil {
+		d.questService = questservice.New(
+			d.QuestRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.questService
+}
+
+func (d *Dependencies) QuestHandler() *questhandler.Handler {
+	if d.questHandler == nil {
+		d.questHandler = questhandler.New(
+			d.QuestService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.questHandler
+}
@@ -0,0 +1,45 @@
+package dependencies
+
+import (
+	referralhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/referral"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
+	referralservice "github.com/go-jedi/lingramm_backend/internal/service/v1/referral"
+)
+
+func (d *Dependencies) ReferralRepository() *referralrepository.Repository {
+	if d.referralRepository == nil {
+		d.referralRepository = referralrepository.New(
+			d.postgres.QueryTimeout,
+			d.cfg.Referral,
+			d.logger,
+		)
+	}
+
+	return d.referralRepository
+}
+
+func (d *Dependencies) ReferralService() *referralservice.Service {
+	if d.referralService == nil {
+		d.referralService = referralservice.New(
+			d.ReferralRepository(),
+			d.UserRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.referralService
+}
+
+func (d *Dependencies) ReferralHandler() *referralhandler.Handler {
+	if d.referralHandler == nil {
+		d.referralHandler = referralhandler.New(
+			d.ReferralService(),
+			d.app,
+			d.logger,
+			d.middleware,
+		)
+	}
+
+	return d.referralHandler
+}
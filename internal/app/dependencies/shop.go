@@ -0,0 +1,47 @@
+package dependencies
+
+import (
+	shophandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/shop"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	shopservice "github.com/go-jedi/lingramm_backend/internal/service/v1/shop"
+)
+
+func (d *Dependencies) ShopRepository() *shoprepository.Repository {
+	if d.shopRepository == nil {
+		d.shopRepository = shoprepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.shopRepository
+}
+
+func (d *Dependencies) ShopService() *shopservice.Service {
+	if d.shopService == nil {
+		d.shopService = shopservice.New(
+			d.ShopRepository(),
+			d.UserRepository(),
+			d.EventTypeRepository(),
+			d.InternalCurrencyRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.shopService
+}
+
+func (d *Dependencies) ShopHandler() *shophandler.Handler {
+	if d.shopHandler == nil {
+		d.shopHandler = shophandler.New(
+			d.ShopService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.shopHandler
+}
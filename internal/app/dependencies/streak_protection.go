@@ -0,0 +1,49 @@
+package dependencies
+
+import (
+	streakprotectionhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/streak_protection"
+	streakprotectionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection"
+	streakprotectionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection"
+)
+
+func (d *Dependencies) StreakProtectionRepository() *streakprotectionrepository.Repository {
+	if d.streakProtectionRepository == nil {
+		d.streakProtectionRepository = streakprotectionrepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.streakProtectionRepository
+}
+
+func (d *Dependencies) StreakProtectionService() *streakprotectionservice.Service {
+	if d.streakProtectionService == nil {
+		d.streakProtectionService = streakprotectionservice.New(
+			d.StreakProtectionRepository(),
+			d.UserRepository(),
+			d.EventTypeRepository(),
+			d.InternalCurrencyRepository(),
+			d.SubscriptionRepository(),
+			d.logger,
+			d.postgres,
+			d.redis,
+		)
+	}
+
+	return d.streakProtectionService
+}
+
+func (d *Dependencies) StreakProtectionHandler() *streakprotectionhandler.Handler {
+	if d.streakProtectionHandler == nil {
+		d.streakProtectionHandler = streakprotectionhandler.New(
+			d.StreakProtectionService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.streakProtectionHandler
+}
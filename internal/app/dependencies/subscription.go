@@ -21,9 +21,12 @@ func (d *Dependencies) SubscriptionService() *subscriptionservice.Service {
 	if d.subscriptionService == nil {
 		d.subscriptionService = subscriptionservice.New(
 			d.SubscriptionRepository(),
+			d.NotificationRepository(),
 			d.UserRepository(),
 			d.logger,
+			d.rabbitMQ,
 			d.postgres,
+			d.redis,
 		)
 	}
 
@@ -36,6 +39,7 @@ func (d *Dependencies) SubscriptionHandler() *subscriptionhandler.Handler {
 			d.SubscriptionService(),
 			d.app,
 			d.logger,
+			d.validator,
 			d.middleware,
 		)
 	}
@@ -0,0 +1,20 @@
+package dependencies
+
+import (
+	"context"
+
+	subscriptionexpiry "github.com/go-jedi/lingramm_backend/internal/adapter/cron/jobs/v1/subscription_expiry"
+)
+
+func (d *Dependencies) SubscriptionExpiryCron(ctx context.Context) *subscriptionexpiry.SubscriptionExpiry {
+	if d.subscriptionExpiry == nil {
+		d.subscriptionExpiry = subscriptionexpiry.New(
+			ctx,
+			d.SubscriptionService(),
+			d.cfg.Cron,
+			d.logger,
+		)
+	}
+
+	return d.subscriptionExpiry
+}
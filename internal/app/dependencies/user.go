@@ -23,6 +23,7 @@ func (d *Dependencies) UserService() *userservice.Service {
 			d.UserRepository(),
 			d.logger,
 			d.postgres,
+			d.bigCache,
 		)
 	}
 
@@ -35,6 +36,7 @@ func (d *Dependencies) UserHandler() *userhandler.Handler {
 			d.UserService(),
 			d.app,
 			d.logger,
+			d.validator,
 			d.middleware,
 		)
 	}
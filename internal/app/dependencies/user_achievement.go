@@ -24,6 +24,7 @@ func (d *Dependencies) UserAchievementService() *userachievementservice.Service
 			d.UserRepository(),
 			d.logger,
 			d.postgres,
+			d.redis,
 		)
 	}
 
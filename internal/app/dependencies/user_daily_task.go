@@ -10,6 +10,7 @@ func (d *Dependencies) UserDailyTaskRepository() *userdailytaskrepository.Reposi
 	if d.userDailyTaskRepository == nil {
 		d.userDailyTaskRepository = userdailytaskrepository.New(
 			d.postgres.QueryTimeout,
+			d.cfg.DailyTask,
 			d.logger,
 		)
 	}
@@ -0,0 +1,46 @@
+package dependencies
+
+import (
+	userinventoryhandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_inventory"
+	userinventoryrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory"
+	userinventoryservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_inventory"
+)
+
+func (d *Dependencies) UserInventoryRepository() *userinventoryrepository.Repository {
+	if d.userInventoryRepository == nil {
+		d.userInventoryRepository = userinventoryrepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.userInventoryRepository
+}
+
+func (d *Dependencies) UserInventoryService() *userinventoryservice.Service {
+	if d.userInventoryService == nil {
+		d.userInventoryService = userinventoryservice.New(
+			d.UserInventoryRepository(),
+			d.UserRepository(),
+			d.logger,
+			d.postgres,
+			d.redis,
+		)
+	}
+
+	return d.userInventoryService
+}
+
+func (d *Dependencies) UserInventoryHandler() *userinventoryhandler.Handler {
+	if d.userInventoryHandler == nil {
+		d.userInventoryHandler = userinventoryhandler.New(
+			d.UserInventoryService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.userInventoryHandler
+}
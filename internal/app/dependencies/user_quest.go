@@ -0,0 +1,45 @@
+package dependencies
+
+import (
+	userquesthandler "github.com/go-jedi/lingramm_backend/internal/adapter/http/handlers/v1/user_quest"
+	userquestrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest"
+	userquestservice "github.com/go-jedi/lingramm_backend/internal/service/v1/user_quest"
+)
+
+func (d *Dependencies) UserQuestRepository() *userquestrepository.Repository {
+	if d.userQuestRepository == nil {
+		d.userQuestRepository = userquestrepository.New(
+			d.postgres.QueryTimeout,
+			d.logger,
+		)
+	}
+
+	return d.userQuestRepository
+}
+
+func (d *Dependencies) UserQuestService() *userquestservice.Service {
+	if d.userQuestService == nil {
+		d.userQuestService = userquestservice.New(
+			d.UserQuestRepository(),
+			d.UserRepository(),
+			d.logger,
+			d.postgres,
+		)
+	}
+
+	return d.userQuestService
+}
+
+func (d *Dependencies) UserQuestHandler() *userquesthandler.Handler {
+	if d.userQuestHandler == nil {
+		d.userQuestHandler = userquesthandler.New(
+			d.UserQuestService(),
+			d.app,
+			d.logger,
+			d.validator,
+			d.middleware,
+		)
+	}
+
+	return d.userQuestHandler
+}
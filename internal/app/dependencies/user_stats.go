@@ -24,6 +24,9 @@ func (d *Dependencies) UserStatsService() *userstatsservice.Service {
 			d.UserRepository(),
 			d.UserAchievementRepository(),
 			d.NotificationRepository(),
+			d.EventTypeRepository(),
+			d.InternalCurrencyRepository(),
+			d.ReferralRepository(),
 			d.logger,
 			d.rabbitMQ,
 			d.postgres,
@@ -6,18 +6,68 @@ import (
 
 	achievementassets "github.com/go-jedi/lingramm_backend/internal/domain/file_server/achievement_assets"
 	awardassets "github.com/go-jedi/lingramm_backend/internal/domain/file_server/award_assets"
+	"github.com/shopspring/decimal"
+)
+
+// RewardEventType is the event type of balance transactions and xp events
+// created for achievement rewards.
+const RewardEventType = "achievement_reward"
+
+const (
+	RewardTypeInternalCurrency = "internal_currency"
+	RewardTypeExperiencePoints = "experience_points"
+	RewardTypeSubscriptionDays = "subscription_days"
+	RewardTypeStreakFreezes    = "streak_freezes"
 )
 
 // Achievement represents achievement in the system.
 type Achievement struct {
-	ID                  int64     `json:"id"`
-	AchievementAssetsID int64     `json:"achievement_assets_id"`
-	AwardAssetsID       int64     `json:"award_assets_id"`
-	AchievementTypeID   int64     `json:"achievement_type_id"`
-	CreatedAt           time.Time `json:"created_at"`
-	UpdatedAt           time.Time `json:"updated_at"`
-	Description         *string   `json:"description,omitempty"`
-	Name                string    `json:"name"`
+	ID                  int64      `json:"id"`
+	AchievementAssetsID int64      `json:"achievement_assets_id"`
+	AwardAssetsID       int64      `json:"award_assets_id"`
+	AchievementTypeID   int64      `json:"achievement_type_id"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	AvailableFrom       *time.Time `json:"available_from,omitempty"`
+	AvailableTo         *time.Time `json:"available_to,omitempty"`
+	Description         *string    `json:"description,omitempty"`
+	TierGroup           *string    `json:"tier_group,omitempty"`
+	TierLevel           *int64     `json:"tier_level,omitempty"`
+	Name                string     `json:"name"`
+	IsSecret            bool       `json:"is_secret"`
+}
+
+// Reward represents a reward granted once when a user unlocks the achievement.
+type Reward struct {
+	ID               int64            `json:"id"`
+	AchievementID    int64            `json:"achievement_id"`
+	Type             string           `json:"type"`
+	Amount           *decimal.Decimal `json:"amount,omitempty"`
+	ExperiencePoints *int64           `json:"experience_points,omitempty"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty"`
+	StreakFreezes    *int64           `json:"streak_freezes,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+}
+
+// Stats represents rarity statistics of achievement.
+// Statistics are recalculated periodically, so they may be slightly outdated.
+type Stats struct {
+	AchievementID           int64           `json:"achievement_id"`
+	UnlockCount             int64           `json:"unlock_count"`
+	ActiveUsers             int64           `json:"active_users"`
+	ActiveUnlockCount       int64           `json:"active_unlock_count"`
+	UnlockPercent           decimal.Decimal `json:"unlock_percent"`
+	FirstUnlockerTelegramID *string         `json:"first_unlocker_telegram_id,omitempty"`
+	FirstUnlockedAt         *time.Time      `json:"first_unlocked_at,omitempty"`
+	MedianTimeToUnlock      *int64          `json:"median_time_to_unlock,omitempty"` // in seconds since registration.
+	CalculatedAt            time.Time       `json:"calculated_at"`
+}
+
+// StatsDetail represents achievement with its rarity statistics.
+type StatsDetail struct {
+	Achievement Achievement `json:"achievement"`
+	Stats       *Stats      `json:"stats,omitempty"`
 }
 
 // Detail represents achievement detail in the system.
@@ -25,6 +75,8 @@ type Detail struct {
 	Achievement       Achievement                         `json:"achievement"`
 	AchievementAssets achievementassets.AchievementAssets `json:"achievement_assets"`
 	AwardAssets       awardassets.AwardAssets             `json:"award_assets"`
+	Rewards           []Reward                            `json:"rewards,omitempty"`
+	Stats             *Stats                              `json:"stats,omitempty"`
 }
 
 //
@@ -34,9 +86,14 @@ type Detail struct {
 type CreateDTO struct {
 	FileAchievementHeader *multipart.FileHeader
 	FileAwardHeader       *multipart.FileHeader
-	Description           *string `json:"description" validate:"omitempty,min=1"`
-	Name                  string  `json:"name" validate:"required,min=1"`
-	AchievementType       string  `json:"achievement_type" validate:"required,min=1"`
+	AvailableFrom         *time.Time `json:"available_from" validate:"omitempty"`
+	AvailableTo           *time.Time `json:"available_to" validate:"omitempty"`
+	Description           *string    `json:"description" validate:"omitempty,min=1"`
+	TierGroup             *string    `json:"tier_group" validate:"required_with=TierLevel,omitempty,min=1"`
+	TierLevel             *int64     `json:"tier_level" validate:"required_with=TierGroup,omitempty,gt=0"`
+	Name                  string     `json:"name" validate:"required,min=1"`
+	AchievementType       string     `json:"achievement_type" validate:"required,min=1"`
+	IsSecret              bool       `json:"is_secret"`
 }
 
 //
@@ -44,11 +101,67 @@ type CreateDTO struct {
 //
 
 type CreateAchievementDTO struct {
-	AchievementAssetsID int64   `json:"achievement_assets_id"`
-	AwardAssetsID       int64   `json:"award_assets_id"`
-	AchievementTypeID   int64   `json:"achievement_type_id"`
-	Description         *string `json:"description,omitempty"`
-	Name                string  `json:"name"`
+	AchievementAssetsID int64      `json:"achievement_assets_id"`
+	AwardAssetsID       int64      `json:"award_assets_id"`
+	AchievementTypeID   int64      `json:"achievement_type_id"`
+	AvailableFrom       *time.Time `json:"available_from,omitempty"`
+	AvailableTo         *time.Time `json:"available_to,omitempty"`
+	Description         *string    `json:"description,omitempty"`
+	TierGroup           *string    `json:"tier_group,omitempty"`
+	TierLevel           *int64     `json:"tier_level,omitempty"`
+	Name                string     `json:"name"`
+	IsSecret            bool       `json:"is_secret"`
+}
+
+//
+// UPDATE
+//
+
+// UpdateDTO updates achievement metadata and optionally swaps images.
+// Images that are not passed stay unchanged.
+type UpdateDTO struct {
+	FileAchievementHeader *multipart.FileHeader
+	FileAwardHeader       *multipart.FileHeader
+	ID                    int64      `json:"id" validate:"required,gt=0"`
+	AvailableFrom         *time.Time `json:"available_from" validate:"omitempty"`
+	AvailableTo           *time.Time `json:"available_to" validate:"omitempty"`
+	Description           *string    `json:"description" validate:"omitempty,min=1"`
+	TierGroup             *string    `json:"tier_group" validate:"required_with=TierLevel,omitempty,min=1"`
+	TierLevel             *int64     `json:"tier_level" validate:"required_with=TierGroup,omitempty,gt=0"`
+	Name                  string     `json:"name" validate:"required,min=1"`
+	AchievementType       string     `json:"achievement_type" validate:"required,min=1"`
+	IsSecret              bool       `json:"is_secret"`
+}
+
+//
+// UPDATE ACHIEVEMENT
+//
+
+type UpdateAchievementDTO struct {
+	ID                  int64      `json:"id"`
+	AchievementAssetsID int64      `json:"achievement_assets_id"`
+	AwardAssetsID       int64      `json:"award_assets_id"`
+	AchievementTypeID   int64      `json:"achievement_type_id"`
+	AvailableFrom       *time.Time `json:"available_from,omitempty"`
+	AvailableTo         *time.Time `json:"available_to,omitempty"`
+	Description         *string    `json:"description,omitempty"`
+	TierGroup           *string    `json:"tier_group,omitempty"`
+	TierLevel           *int64     `json:"tier_level,omitempty"`
+	Name                string     `json:"name"`
+	IsSecret            bool       `json:"is_secret"`
+}
+
+//
+// CREATE REWARD
+//
+
+type CreateRewardDTO struct {
+	AchievementID    int64            `json:"achievement_id" validate:"required,gt=0"`
+	Type             string           `json:"type" validate:"required,oneof=internal_currency experience_points subscription_days streak_freezes"`
+	Amount           *decimal.Decimal `json:"amount,omitempty" validate:"required_if=Type internal_currency"`
+	ExperiencePoints *int64           `json:"experience_points,omitempty" validate:"required_if=Type experience_points,omitempty,gt=0"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty" validate:"required_if=Type subscription_days,omitempty,gt=0,lte=3650"`
+	StreakFreezes    *int64           `json:"streak_freezes,omitempty" validate:"required_if=Type streak_freezes,omitempty,gt=0"`
 }
 
 //
@@ -61,14 +174,19 @@ type AllDetailSwaggerResponse struct {
 	Error   string `json:"error" example:""`
 	Data    []struct {
 		Achievement struct {
-			ID                  int64     `json:"id" example:"1"`
-			AchievementAssetsID int64     `json:"achievement_assets_id"  example:"1"`
-			AwardAssetsID       int64     `json:"award_assets_id"  example:"1"`
-			AchievementTypeID   int64     `json:"achievement_type_id"  example:"3"`
-			CreatedAt           time.Time `json:"created_at"  example:"2025-09-02T12:48:06.37622+03:00"`
-			UpdatedAt           time.Time `json:"updated_at"  example:"2025-09-02T12:48:06.37622+03:00"`
-			Description         *string   `json:"description,omitempty"  example:"description"`
-			Name                string    `json:"name"  example:"two dialogs"`
+			ID                  int64      `json:"id" example:"1"`
+			AchievementAssetsID int64      `json:"achievement_assets_id"  example:"1"`
+			AwardAssetsID       int64      `json:"award_assets_id"  example:"1"`
+			AchievementTypeID   int64      `json:"achievement_type_id"  example:"3"`
+			CreatedAt           time.Time  `json:"created_at"  example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt           time.Time  `json:"updated_at"  example:"2025-09-02T12:48:06.37622+03:00"`
+			AvailableFrom       *time.Time `json:"available_from,omitempty"  example:"2025-12-20T00:00:00+03:00"`
+			AvailableTo         *time.Time `json:"available_to,omitempty"  example:"2026-01-10T00:00:00+03:00"`
+			Description         *string    `json:"description,omitempty"  example:"description"`
+			TierGroup           *string    `json:"tier_group,omitempty"  example:"dialog_completed"`
+			TierLevel           *int64     `json:"tier_level,omitempty"  example:"1"`
+			Name                string     `json:"name"  example:"two dialogs"`
+			IsSecret            bool       `json:"is_secret"  example:"false"`
 		} `json:"achievement"`
 		AchievementAssets struct {
 			ID                       int64     `json:"id" example:"1"`
@@ -96,6 +214,28 @@ type AllDetailSwaggerResponse struct {
 			CreatedAt                time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
 			UpdatedAt                time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
 		} `json:"award_assets"`
+		Rewards []struct {
+			ID               int64            `json:"id" example:"1"`
+			AchievementID    int64            `json:"achievement_id" example:"1"`
+			Type             string           `json:"type" example:"internal_currency"`
+			Amount           *decimal.Decimal `json:"amount,omitempty" example:"50.00"`
+			ExperiencePoints *int64           `json:"experience_points,omitempty" example:"100"`
+			SubscriptionDays *int64           `json:"subscription_days,omitempty" example:"7"`
+			StreakFreezes    *int64           `json:"streak_freezes,omitempty" example:"1"`
+			CreatedAt        time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt        time.Time        `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"rewards,omitempty"`
+		Stats *struct {
+			AchievementID           int64           `json:"achievement_id" example:"1"`
+			UnlockCount             int64           `json:"unlock_count" example:"120"`
+			ActiveUsers             int64           `json:"active_users" example:"3400"`
+			ActiveUnlockCount       int64           `json:"active_unlock_count" example:"102"`
+			UnlockPercent           decimal.Decimal `json:"unlock_percent" example:"3.00"`
+			FirstUnlockerTelegramID *string         `json:"first_unlocker_telegram_id,omitempty" example:"1"`
+			FirstUnlockedAt         *time.Time      `json:"first_unlocked_at,omitempty" example:"2025-09-02T12:48:06.37622+03:00"`
+			MedianTimeToUnlock      *int64          `json:"median_time_to_unlock,omitempty" example:"604800"`
+			CalculatedAt            time.Time       `json:"calculated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"stats,omitempty"`
 	} `json:"data"`
 }
 
@@ -105,14 +245,19 @@ type DetailSwaggerResponse struct {
 	Error   string `json:"error" example:""`
 	Data    struct {
 		Achievement struct {
-			ID                  int64     `json:"id" example:"1"`
-			AchievementAssetsID int64     `json:"achievement_assets_id"  example:"1"`
-			AwardAssetsID       int64     `json:"award_assets_id"  example:"1"`
-			AchievementTypeID   int64     `json:"achievement_type_id"  example:"3"`
-			CreatedAt           time.Time `json:"created_at"  example:"2025-09-02T12:48:06.37622+03:00"`
-			UpdatedAt           time.Time `json:"updated_at"  example:"2025-09-02T12:48:06.37622+03:00"`
-			Description         *string   `json:"description,omitempty"  example:"description"`
-			Name                string    `json:"name"  example:"two dialogs"`
+			ID                  int64      `json:"id" example:"1"`
+			AchievementAssetsID int64      `json:"achievement_assets_id"  example:"1"`
+			AwardAssetsID       int64      `json:"award_assets_id"  example:"1"`
+			AchievementTypeID   int64      `json:"achievement_type_id"  example:"3"`
+			CreatedAt           time.Time  `json:"created_at"  example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt           time.Time  `json:"updated_at"  example:"2025-09-02T12:48:06.37622+03:00"`
+			AvailableFrom       *time.Time `json:"available_from,omitempty"  example:"2025-12-20T00:00:00+03:00"`
+			AvailableTo         *time.Time `json:"available_to,omitempty"  example:"2026-01-10T00:00:00+03:00"`
+			Description         *string    `json:"description,omitempty"  example:"description"`
+			TierGroup           *string    `json:"tier_group,omitempty"  example:"dialog_completed"`
+			TierLevel           *int64     `json:"tier_level,omitempty"  example:"1"`
+			Name                string     `json:"name"  example:"two dialogs"`
+			IsSecret            bool       `json:"is_secret"  example:"false"`
 		} `json:"achievement"`
 		AchievementAssets struct {
 			ID                       int64     `json:"id" example:"1"`
@@ -140,6 +285,79 @@ type DetailSwaggerResponse struct {
 			CreatedAt                time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
 			UpdatedAt                time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
 		} `json:"award_assets"`
+		Rewards []struct {
+			ID               int64            `json:"id" example:"1"`
+			AchievementID    int64            `json:"achievement_id" example:"1"`
+			Type             string           `json:"type" example:"internal_currency"`
+			Amount           *decimal.Decimal `json:"amount,omitempty" example:"50.00"`
+			ExperiencePoints *int64           `json:"experience_points,omitempty" example:"100"`
+			SubscriptionDays *int64           `json:"subscription_days,omitempty" example:"7"`
+			StreakFreezes    *int64           `json:"streak_freezes,omitempty" example:"1"`
+			CreatedAt        time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt        time.Time        `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"rewards,omitempty"`
+		Stats *struct {
+			AchievementID           int64           `json:"achievement_id" example:"1"`
+			UnlockCount             int64           `json:"unlock_count" example:"120"`
+			ActiveUsers             int64           `json:"active_users" example:"3400"`
+			ActiveUnlockCount       int64           `json:"active_unlock_count" example:"102"`
+			UnlockPercent           decimal.Decimal `json:"unlock_percent" example:"3.00"`
+			FirstUnlockerTelegramID *string         `json:"first_unlocker_telegram_id,omitempty" example:"1"`
+			FirstUnlockedAt         *time.Time      `json:"first_unlocked_at,omitempty" example:"2025-09-02T12:48:06.37622+03:00"`
+			MedianTimeToUnlock      *int64          `json:"median_time_to_unlock,omitempty" example:"604800"`
+			CalculatedAt            time.Time       `json:"calculated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"stats,omitempty"`
+	} `json:"data"`
+}
+
+type RewardSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID               int64            `json:"id" example:"1"`
+		AchievementID    int64            `json:"achievement_id" example:"1"`
+		Type             string           `json:"type" example:"internal_currency"`
+		Amount           *decimal.Decimal `json:"amount,omitempty" example:"50.00"`
+		ExperiencePoints *int64           `json:"experience_points,omitempty" example:"100"`
+		SubscriptionDays *int64           `json:"subscription_days,omitempty" example:"7"`
+		StreakFreezes    *int64           `json:"streak_freezes,omitempty" example:"1"`
+		CreatedAt        time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt        time.Time        `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type AllStatsSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		Achievement struct {
+			ID                  int64      `json:"id" example:"1"`
+			AchievementAssetsID int64      `json:"achievement_assets_id" example:"1"`
+			AwardAssetsID       int64      `json:"award_assets_id" example:"1"`
+			AchievementTypeID   int64      `json:"achievement_type_id" example:"1"`
+			Name                string     `json:"name" example:"Первые шаги"`
+			Description         *string    `json:"description,omitempty" example:"Выучить 10 слов"`
+			IsSecret            bool       `json:"is_secret" example:"false"`
+			AvailableFrom       *time.Time `json:"available_from,omitempty" example:"2025-12-01T00:00:00Z"`
+			AvailableTo         *time.Time `json:"available_to,omitempty" example:"2025-12-31T23:59:59Z"`
+			TierGroup           *string    `json:"tier_group,omitempty" example:"words_learned"`
+			TierLevel           *int64     `json:"tier_level,omitempty" example:"1"`
+			CreatedAt           time.Time  `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt           time.Time  `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"achievement"`
+		Stats *struct {
+			AchievementID           int64           `json:"achievement_id" example:"1"`
+			UnlockCount             int64           `json:"unlock_count" example:"120"`
+			ActiveUsers             int64           `json:"active_users" example:"3400"`
+			ActiveUnlockCount       int64           `json:"active_unlock_count" example:"102"`
+			UnlockPercent           decimal.Decimal `json:"unlock_percent" example:"3.00"`
+			FirstUnlockerTelegramID *string         `json:"first_unlocker_telegram_id,omitempty" example:"1"`
+			FirstUnlockedAt         *time.Time      `json:"first_unlocked_at,omitempty" example:"2025-09-02T12:48:06.37622+03:00"`
+			MedianTimeToUnlock      *int64          `json:"median_time_to_unlock,omitempty" example:"604800"`
+			CalculatedAt            time.Time       `json:"calculated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"stats,omitempty"`
 	} `json:"data"`
 }
 
@@ -0,0 +1,124 @@
+package achievementevaluation
+
+import (
+	"time"
+
+	userachievement "github.com/go-jedi/lingramm_backend/internal/domain/user_achievement"
+)
+
+const (
+	ReasonAchievementCreate = "achievement_create"
+	ReasonAchievementUpdate = "achievement_update"
+	ReasonManual            = "manual"
+
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+// Job represents retroactive evaluation of an achievement across all users.
+type Job struct {
+	ID             int64      `json:"id"`
+	AchievementID  int64      `json:"achievement_id"`
+	Reason         string     `json:"reason"`
+	Status         string     `json:"status"`
+	CursorUserID   int64      `json:"cursor_user_id"`
+	ProcessedUsers int64      `json:"processed_users"`
+	TotalUsers     int64      `json:"total_users"`
+	UnlockedUsers  int64      `json:"unlocked_users"`
+	NotifiedUsers  int64      `json:"notified_users"`
+	Error          *string    `json:"error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+}
+
+// IsFinished reports whether the job will not be processed anymore.
+func (j Job) IsFinished() bool {
+	return j.Status == StatusCompleted || j.Status == StatusFailed
+}
+
+//
+// CREATE
+//
+
+type CreateDTO struct {
+	AchievementID int64  `json:"achievement_id" validate:"required,gt=0"`
+	Reason        string `json:"reason" swaggerignore:"true"` // set by the caller, not by the client.
+}
+
+//
+// PROCESS CHUNK
+//
+
+type ProcessChunkDTO struct {
+	JobID       *int64 // nil = the oldest unfinished job.
+	ChunkSize   int64
+	ActiveSince time.Time // users active after this time get notifications.
+}
+
+type ProcessChunkResponse struct {
+	Job      *Job           `json:"job"`
+	Unlocked []UnlockedUser `json:"unlocked"`
+}
+
+// UnlockedUser represents achievements unlocked for a user within a processed chunk.
+type UnlockedUser struct {
+	TelegramID   string                                                `json:"telegram_id"`
+	IsActive     bool                                                  `json:"is_active"`
+	Achievements []userachievement.UnlockAvailableAchievementsResponse `json:"achievements"`
+}
+
+//
+// SWAGGER
+//
+
+type JobSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID             int64      `json:"id" example:"1"`
+		AchievementID  int64      `json:"achievement_id" example:"1"`
+		Reason         string     `json:"reason" example:"achievement_create"`
+		Status         string     `json:"status" example:"running"`
+		CursorUserID   int64      `json:"cursor_user_id" example:"500"`
+		ProcessedUsers int64      `json:"processed_users" example:"500"`
+		TotalUsers     int64      `json:"total_users" example:"1200"`
+		UnlockedUsers  int64      `json:"unlocked_users" example:"42"`
+		NotifiedUsers  int64      `json:"notified_users" example:"17"`
+		Error          *string    `json:"error,omitempty" example:""`
+		CreatedAt      time.Time  `json:"created_at" example:"2025-09-10T12:00:00Z"`
+		UpdatedAt      time.Time  `json:"updated_at" example:"2025-09-10T12:05:00Z"`
+		FinishedAt     *time.Time `json:"finished_at,omitempty" example:"2025-09-10T12:10:00Z"`
+	} `json:"data"`
+}
+
+type AllSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID             int64      `json:"id" example:"1"`
+		AchievementID  int64      `json:"achievement_id" example:"1"`
+		Reason         string     `json:"reason" example:"achievement_update"`
+		Status         string     `json:"status" example:"completed"`
+		CursorUserID   int64      `json:"cursor_user_id" example:"1200"`
+		ProcessedUsers int64      `json:"processed_users" example:"1200"`
+		TotalUsers     int64      `json:"total_users" example:"1200"`
+		UnlockedUsers  int64      `json:"unlocked_users" example:"42"`
+		NotifiedUsers  int64      `json:"notified_users" example:"17"`
+		Error          *string    `json:"error,omitempty" example:""`
+		CreatedAt      time.Time  `json:"created_at" example:"2025-09-10T12:00:00Z"`
+		UpdatedAt      time.Time  `json:"updated_at" example:"2025-09-10T12:05:00Z"`
+		FinishedAt     *time.Time `json:"finished_at,omitempty" example:"2025-09-10T12:10:00Z"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
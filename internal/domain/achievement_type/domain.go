@@ -2,6 +2,7 @@ package achievementtype
 
 import "time"
 
+// AchievementType represents achievement type (criteria) in the system.
 type AchievementType struct {
 	ID                      int64     `json:"id"`
 	Name                    string    `json:"name"`
@@ -18,4 +19,176 @@ type AchievementType struct {
 	IsActive                bool      `json:"is_active"`
 	CreatedAt               time.Time `json:"created_at"`
 	UpdatedAt               time.Time `json:"updated_at"`
+	Version                 int64     `json:"version"`
+}
+
+// Version represents snapshot of achievement type criteria.
+// A new version is created every time criteria are changed.
+type Version struct {
+	ID                      int64     `json:"id"`
+	AchievementTypeID       int64     `json:"achievement_type_id"`
+	Version                 int64     `json:"version"`
+	StreakDaysNeed          *int64    `json:"streak_days_need,omitempty"`
+	DailyTaskStreakDaysNeed *int64    `json:"daily_task_streak_days_need,omitempty"`
+	WordsLearnedNeed        *int64    `json:"words_learned_need,omitempty"`
+	TasksCompletedNeed      *int64    `json:"tasks_completed_need,omitempty"`
+	LessonsFinishedNeed     *int64    `json:"lessons_finished_need,omitempty"`
+	WordsTranslateNeed      *int64    `json:"words_translate_need,omitempty"`
+	DialogCompletedNeed     *int64    `json:"dialog_completed_need,omitempty"`
+	ExperiencePointsNeed    *int64    `json:"experience_points_need,omitempty"`
+	LevelNeed               *int64    `json:"level_need,omitempty"`
+	CreatedAt               time.Time `json:"created_at"`
+}
+
+//
+// CREATE
+//
+
+type CreateDTO struct {
+	Name                    string  `json:"name" validate:"required,min=1"`
+	Description             *string `json:"description,omitempty" validate:"omitempty,min=1"`
+	StreakDaysNeed          *int64  `json:"streak_days_need,omitempty" validate:"omitempty,gt=0"`
+	DailyTaskStreakDaysNeed *int64  `json:"daily_task_streak_days_need,omitempty" validate:"omitempty,gt=0"`
+	WordsLearnedNeed        *int64  `json:"words_learned_need,omitempty" validate:"omitempty,gt=0"`
+	TasksCompletedNeed      *int64  `json:"tasks_completed_need,omitempty" validate:"omitempty,gt=0"`
+	LessonsFinishedNeed     *int64  `json:"lessons_finished_need,omitempty" validate:"omitempty,gt=0"`
+	WordsTranslateNeed      *int64  `json:"words_translate_need,omitempty" validate:"omitempty,gt=0"`
+	DialogCompletedNeed     *int64  `json:"dialog_completed_need,omitempty" validate:"omitempty,gt=0"`
+	ExperiencePointsNeed    *int64  `json:"experience_points_need,omitempty" validate:"omitempty,gt=0"`
+	LevelNeed               *int64  `json:"level_need,omitempty" validate:"omitempty,gt=0"`
+	IsActive                *bool   `json:"is_active,omitempty"`
+}
+
+// HasCriteria reports whether at least one *_need threshold is set.
+func (dto CreateDTO) HasCriteria() bool {
+	return hasCriteria(
+		dto.StreakDaysNeed, dto.DailyTaskStreakDaysNeed,
+		dto.WordsLearnedNeed, dto.TasksCompletedNeed,
+		dto.LessonsFinishedNeed, dto.WordsTranslateNeed,
+		dto.DialogCompletedNeed, dto.ExperiencePointsNeed,
+		dto.LevelNeed,
+	)
+}
+
+//
+// UPDATE
+//
+
+// UpdateDTO replaces name, description, activity and criteria of achievement type.
+// Criteria that are not passed are cleared.
+type UpdateDTO struct {
+	ID                      int64   `json:"id" validate:"required,gt=0"`
+	Name                    string  `json:"name" validate:"required,min=1"`
+	Description             *string `json:"description,omitempty" validate:"omitempty,min=1"`
+	StreakDaysNeed          *int64  `json:"streak_days_need,omitempty" validate:"omitempty,gt=0"`
+	DailyTaskStreakDaysNeed *int64  `json:"daily_task_streak_days_need,omitempty" validate:"omitempty,gt=0"`
+	WordsLearnedNeed        *int64  `json:"words_learned_need,omitempty" validate:"omitempty,gt=0"`
+	TasksCompletedNeed      *int64  `json:"tasks_completed_need,omitempty" validate:"omitempty,gt=0"`
+	LessonsFinishedNeed     *int64  `json:"lessons_finished_need,omitempty" validate:"omitempty,gt=0"`
+	WordsTranslateNeed      *int64  `json:"words_translate_need,omitempty" validate:"omitempty,gt=0"`
+	DialogCompletedNeed     *int64  `json:"dialog_completed_need,omitempty" validate:"omitempty,gt=0"`
+	ExperiencePointsNeed    *int64  `json:"experience_points_need,omitempty" validate:"omitempty,gt=0"`
+	LevelNeed               *int64  `json:"level_need,omitempty" validate:"omitempty,gt=0"`
+	IsActive                *bool   `json:"is_active,omitempty"`
+}
+
+// HasCriteria reports whether at least one *_need threshold is set.
+func (dto UpdateDTO) HasCriteria() bool {
+	return hasCriteria(
+		dto.StreakDaysNeed, dto.DailyTaskStreakDaysNeed,
+		dto.WordsLearnedNeed, dto.TasksCompletedNeed,
+		dto.LessonsFinishedNeed, dto.WordsTranslateNeed,
+		dto.DialogCompletedNeed, dto.ExperiencePointsNeed,
+		dto.LevelNeed,
+	)
+}
+
+func hasCriteria(needs ...*int64) bool {
+	for _, n := range needs {
+		if n != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+//
+// SWAGGER
+//
+
+type AchievementTypeSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID                      int64     `json:"id" example:"1"`
+		Name                    string    `json:"name" example:"words_learned_50"`
+		Description             *string   `json:"description,omitempty" example:"description"`
+		StreakDaysNeed          *int64    `json:"streak_days_need,omitempty" example:"30"`
+		DailyTaskStreakDaysNeed *int64    `json:"daily_task_streak_days_need,omitempty" example:"7"`
+		WordsLearnedNeed        *int64    `json:"words_learned_need,omitempty" example:"50"`
+		TasksCompletedNeed      *int64    `json:"tasks_completed_need,omitempty" example:"10"`
+		LessonsFinishedNeed     *int64    `json:"lessons_finished_need,omitempty" example:"5"`
+		WordsTranslateNeed      *int64    `json:"words_translate_need,omitempty" example:"100"`
+		DialogCompletedNeed     *int64    `json:"dialog_completed_need,omitempty" example:"2"`
+		ExperiencePointsNeed    *int64    `json:"experience_points_need,omitempty" example:"1000"`
+		LevelNeed               *int64    `json:"level_need,omitempty" example:"5"`
+		IsActive                bool      `json:"is_active" example:"true"`
+		CreatedAt               time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt               time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		Version                 int64     `json:"version" example:"2"`
+	} `json:"data"`
+}
+
+type AllSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                      int64     `json:"id" example:"1"`
+		Name                    string    `json:"name" example:"words_learned_50"`
+		Description             *string   `json:"description,omitempty" example:"description"`
+		StreakDaysNeed          *int64    `json:"streak_days_need,omitempty" example:"30"`
+		DailyTaskStreakDaysNeed *int64    `json:"daily_task_streak_days_need,omitempty" example:"7"`
+		WordsLearnedNeed        *int64    `json:"words_learned_need,omitempty" example:"50"`
+		TasksCompletedNeed      *int64    `json:"tasks_completed_need,omitempty" example:"10"`
+		LessonsFinishedNeed     *int64    `json:"lessons_finished_need,omitempty" example:"5"`
+		WordsTranslateNeed      *int64    `json:"words_translate_need,omitempty" example:"100"`
+		DialogCompletedNeed     *int64    `json:"dialog_completed_need,omitempty" example:"2"`
+		ExperiencePointsNeed    *int64    `json:"experience_points_need,omitempty" example:"1000"`
+		LevelNeed               *int64    `json:"level_need,omitempty" example:"5"`
+		IsActive                bool      `json:"is_active" example:"true"`
+		CreatedAt               time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt               time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		Version                 int64     `json:"version" example:"2"`
+	} `json:"data"`
+}
+
+type AllVersionsSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                      int64     `json:"id" example:"1"`
+		AchievementTypeID       int64     `json:"achievement_type_id" example:"1"`
+		Version                 int64     `json:"version" example:"1"`
+		StreakDaysNeed          *int64    `json:"streak_days_need,omitempty" example:"30"`
+		DailyTaskStreakDaysNeed *int64    `json:"daily_task_streak_days_need,omitempty" example:"7"`
+		WordsLearnedNeed        *int64    `json:"words_learned_need,omitempty" example:"50"`
+		TasksCompletedNeed      *int64    `json:"tasks_completed_need,omitempty" example:"10"`
+		LessonsFinishedNeed     *int64    `json:"lessons_finished_need,omitempty" example:"5"`
+		WordsTranslateNeed      *int64    `json:"words_translate_need,omitempty" example:"100"`
+		DialogCompletedNeed     *int64    `json:"dialog_completed_need,omitempty" example:"2"`
+		ExperiencePointsNeed    *int64    `json:"experience_points_need,omitempty" example:"1000"`
+		LevelNeed               *int64    `json:"level_need,omitempty" example:"5"`
+		CreatedAt               time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
 }
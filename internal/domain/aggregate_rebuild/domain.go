@@ -0,0 +1,121 @@
+package aggregaterebuild
+
+import "time"
+
+const (
+	ScopeUser      = "user"
+	ScopeWeekRange = "week_range"
+	ScopeAll       = "all"
+
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+type Job struct {
+	ID                   int64      `json:"id"`
+	Scope                string     `json:"scope"`
+	TelegramID           *string    `json:"telegram_id,omitempty"`
+	WeekFrom             *string    `json:"week_from,omitempty"`
+	WeekTo               *string    `json:"week_to,omitempty"`
+	DryRun               bool       `json:"dry_run"`
+	Status               string     `json:"status"`
+	CursorUserID         int64      `json:"cursor_user_id"`
+	ProcessedUsers       int64      `json:"processed_users"`
+	TotalUsers           int64      `json:"total_users"`
+	LeaderboardWeeksDiff int64      `json:"leaderboard_weeks_diff"`
+	UserStatsDiff        int64      `json:"user_stats_diff"`
+	UserLevelHistoryDiff int64      `json:"user_level_history_diff"`
+	Error                *string    `json:"error,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+	FinishedAt           *time.Time `json:"finished_at,omitempty"`
+}
+
+// IsFinished reports whether the job will not be processed anymore.
+func (j Job) IsFinished() bool {
+	return j.Status == StatusCompleted || j.Status == StatusFailed
+}
+
+//
+// CREATE
+//
+
+type CreateDTO struct {
+	Scope      string  `json:"scope" validate:"required,oneof=user week_range all"`
+	TelegramID *string `json:"telegram_id,omitempty" validate:"required_if=Scope user,omitempty,min=1"`
+	WeekFrom   *string `json:"week_from,omitempty" validate:"required_if=Scope week_range,omitempty,datetime=2006-01-02"`
+	WeekTo     *string `json:"week_to,omitempty" validate:"required_if=Scope week_range,omitempty,datetime=2006-01-02"`
+	DryRun     bool    `json:"dry_run"`
+}
+
+//
+// PROCESS CHUNK
+//
+
+type ProcessChunkDTO struct {
+	JobID     *int64 // nil = the oldest unfinished job.
+	ChunkSize int64
+}
+
+//
+// SWAGGER
+//
+
+type JobSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID                   int64      `json:"id" example:"1"`
+		Scope                string     `json:"scope" example:"week_range"`
+		TelegramID           *string    `json:"telegram_id,omitempty" example:"1"`
+		WeekFrom             *string    `json:"week_from,omitempty" example:"2025-09-01"`
+		WeekTo               *string    `json:"week_to,omitempty" example:"2025-09-15"`
+		DryRun               bool       `json:"dry_run" example:"true"`
+		Status               string     `json:"status" example:"running"`
+		CursorUserID         int64      `json:"cursor_user_id" example:"500"`
+		ProcessedUsers       int64      `json:"processed_users" example:"500"`
+		TotalUsers           int64      `json:"total_users" example:"1200"`
+		LeaderboardWeeksDiff int64      `json:"leaderboard_weeks_diff" example:"3"`
+		UserStatsDiff        int64      `json:"user_stats_diff" example:"0"`
+		UserLevelHistoryDiff int64      `json:"user_level_history_diff" example:"0"`
+		Error                *string    `json:"error,omitempty" example:""`
+		CreatedAt            time.Time  `json:"created_at" example:"2025-09-10T12:00:00Z"`
+		UpdatedAt            time.Time  `json:"updated_at" example:"2025-09-10T12:05:00Z"`
+		FinishedAt           *time.Time `json:"finished_at,omitempty" example:"2025-09-10T12:10:00Z"`
+	} `json:"data"`
+}
+
+type AllSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                   int64      `json:"id" example:"1"`
+		Scope                string     `json:"scope" example:"week_range"`
+		TelegramID           *string    `json:"telegram_id,omitempty" example:"1"`
+		WeekFrom             *string    `json:"week_from,omitempty" example:"2025-09-01"`
+		WeekTo               *string    `json:"week_to,omitempty" example:"2025-09-15"`
+		DryRun               bool       `json:"dry_run" example:"true"`
+		Status               string     `json:"status" example:"completed"`
+		CursorUserID         int64      `json:"cursor_user_id" example:"1200"`
+		ProcessedUsers       int64      `json:"processed_users" example:"1200"`
+		TotalUsers           int64      `json:"total_users" example:"1200"`
+		LeaderboardWeeksDiff int64      `json:"leaderboard_weeks_diff" example:"3"`
+		UserStatsDiff        int64      `json:"user_stats_diff" example:"0"`
+		UserLevelHistoryDiff int64      `json:"user_level_history_diff" example:"0"`
+		Error                *string    `json:"error,omitempty" example:""`
+		CreatedAt            time.Time  `json:"created_at" example:"2025-09-10T12:00:00Z"`
+		UpdatedAt            time.Time  `json:"updated_at" example:"2025-09-10T12:05:00Z"`
+		FinishedAt           *time.Time `json:"finished_at,omitempty" example:"2025-09-10T12:10:00Z"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
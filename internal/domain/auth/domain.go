@@ -11,11 +11,15 @@ import "time"
 // @param username string true "Username of the user".
 // @param first_name string true "First name of the user".
 // @param last_name string true "Last name of the user".
+// @param timezone string false "IANA timezone detected by the Mini App".
+// @param start_param string false "Start parameter of the Mini App (ref_<code> attributes a new user to the referrer)".
 type SignInDTO struct {
 	TelegramID string `json:"telegram_id" validate:"required,min=1"`
 	Username   string `json:"username" validate:"omitempty,min=1"`
 	FirstName  string `json:"first_name" validate:"omitempty,min=1"`
 	LastName   string `json:"last_name" validate:"omitempty,min=1"`
+	Timezone   string `json:"timezone" validate:"omitempty,timezone"`
+	StartParam string `json:"start_param" validate:"omitempty,max=512"`
 }
 
 // SignInResp represents the response body for a successful sign-in.
@@ -0,0 +1,222 @@
+package currencyrate
+
+import (
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/shopspring/decimal"
+)
+
+// Actions of currency rate history.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Directions of conversion.
+const (
+	DirectionToFiat     = "to_fiat"
+	DirectionToInternal = "to_internal"
+)
+
+// Scales of converted amounts.
+// Fiat amounts are rounded half away from zero,
+// internal amounts are rounded up so that they always cover the fiat amount.
+const (
+	FiatScale     = 2
+	InternalScale = 2
+)
+
+// CurrencyRate represents a currency rate: how many units of the currency one internal unit costs.
+type CurrencyRate struct {
+	ID           int64           `json:"id"`
+	CurrencyCode string          `json:"currency_code"`
+	Rate         decimal.Decimal `json:"rate"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// ToFiat converts internal amount to the currency.
+func (cr CurrencyRate) ToFiat(amount decimal.Decimal) (decimal.Decimal, error) {
+	if !cr.Rate.IsPositive() {
+		return decimal.Zero, apperrors.ErrCurrencyRateMustBePositive
+	}
+
+	return amount.Mul(cr.Rate).Round(FiatScale), nil
+}
+
+// ToInternal converts amount in the currency to internal amount.
+func (cr CurrencyRate) ToInternal(amount decimal.Decimal) (decimal.Decimal, error) {
+	if !cr.Rate.IsPositive() {
+		return decimal.Zero, apperrors.ErrCurrencyRateMustBePositive
+	}
+
+	return amount.Div(cr.Rate).RoundCeil(InternalScale), nil
+}
+
+// History represents a currency rate change.
+// Changed by is telegram id of the admin who changed the rate.
+type History struct {
+	ID           int64            `json:"id"`
+	CurrencyCode string           `json:"currency_code"`
+	Action       string           `json:"action"`
+	OldRate      *decimal.Decimal `json:"old_rate,omitempty"`
+	NewRate      *decimal.Decimal `json:"new_rate,omitempty"`
+	ChangedBy    *string          `json:"changed_by,omitempty"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
+
+//
+// CREATE
+//
+
+type CreateDTO struct {
+	CurrencyCode string          `json:"currency_code" validate:"required,len=3,uppercase"`
+	Rate         decimal.Decimal `json:"rate" validate:"required"`
+	ChangedBy    string          `json:"-"`
+}
+
+//
+// UPDATE
+//
+
+type UpdateDTO struct {
+	CurrencyCode string          `json:"currency_code" validate:"required,len=3,uppercase"`
+	Rate         decimal.Decimal `json:"rate" validate:"required"`
+	ChangedBy    string          `json:"-"`
+}
+
+//
+// DELETE BY CURRENCY CODE
+//
+
+type DeleteByCurrencyCodeDTO struct {
+	CurrencyCode string `json:"currency_code" validate:"required,len=3,uppercase"`
+	ChangedBy    string `json:"-"`
+}
+
+//
+// CREATE HISTORY
+//
+
+type CreateHistoryDTO struct {
+	CurrencyCode string
+	Action       string
+	OldRate      *decimal.Decimal
+	NewRate      *decimal.Decimal
+	ChangedBy    string
+}
+
+//
+// CONVERT
+//
+
+type ConvertDTO struct {
+	CurrencyCode string          `json:"currency_code" validate:"required,len=3,uppercase"`
+	Amount       decimal.Decimal `json:"amount" validate:"required"`
+	Direction    string          `json:"direction" validate:"required,oneof=to_fiat to_internal"`
+}
+
+type ConvertResponse struct {
+	CurrencyCode string          `json:"currency_code"`
+	Rate         decimal.Decimal `json:"rate"`
+	Direction    string          `json:"direction"`
+	Amount       decimal.Decimal `json:"amount"`
+	Result       decimal.Decimal `json:"result"`
+}
+
+//
+// PRICES BY CURRENCY CODE
+//
+
+// OfferPrice represents an offer priced in internal currency and in the requested currency.
+type OfferPrice struct {
+	ShopItemID   int64           `json:"shop_item_id"`
+	Name         string          `json:"name"`
+	Type         string          `json:"type"`
+	Value        *int64          `json:"value,omitempty"`
+	Price        decimal.Decimal `json:"price"`
+	FiatPrice    decimal.Decimal `json:"fiat_price"`
+	CurrencyCode string          `json:"currency_code"`
+}
+
+//
+// SWAGGER
+//
+
+type CurrencyRateSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID           int64           `json:"id" example:"1"`
+		CurrencyCode string          `json:"currency_code" example:"USD"`
+		Rate         decimal.Decimal `json:"rate" example:"0.012500"`
+		CreatedAt    time.Time       `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt    time.Time       `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type AllSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID           int64           `json:"id" example:"1"`
+		CurrencyCode string          `json:"currency_code" example:"USD"`
+		Rate         decimal.Decimal `json:"rate" example:"0.012500"`
+		CreatedAt    time.Time       `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt    time.Time       `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type AllHistorySwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID           int64            `json:"id" example:"2"`
+		CurrencyCode string           `json:"currency_code" example:"USD"`
+		Action       string           `json:"action" example:"update"`
+		OldRate      *decimal.Decimal `json:"old_rate,omitempty" example:"0.010000"`
+		NewRate      *decimal.Decimal `json:"new_rate,omitempty" example:"0.012500"`
+		ChangedBy    *string          `json:"changed_by,omitempty" example:"1"`
+		CreatedAt    time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ConvertSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		CurrencyCode string          `json:"currency_code" example:"USD"`
+		Rate         decimal.Decimal `json:"rate" example:"0.012500"`
+		Direction    string          `json:"direction" example:"to_fiat"`
+		Amount       decimal.Decimal `json:"amount" example:"100.00"`
+		Result       decimal.Decimal `json:"result" example:"1.25"`
+	} `json:"data"`
+}
+
+type PricesSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ShopItemID   int64           `json:"shop_item_id" example:"1"`
+		Name         string          `json:"name" example:"Премиум на 7 дней"`
+		Type         string          `json:"type" example:"premium_days"`
+		Value        *int64          `json:"value,omitempty" example:"7"`
+		Price        decimal.Decimal `json:"price" example:"300.00"`
+		FiatPrice    decimal.Decimal `json:"fiat_price" example:"3.75"`
+		CurrencyCode string          `json:"currency_code" example:"USD"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
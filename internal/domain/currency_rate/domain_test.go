@@ -0,0 +1,164 @@
+package currencyrate
+
+import (
+	"testing"
+
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToFiat(t *testing.T) {
+	type in struct {
+		rate   string
+		amount string
+	}
+
+	type want struct {
+		result string
+		err    error
+	}
+
+	tests := []struct {
+		name string
+		in   in
+		want want
+	}{
+		{
+			name: "exact",
+			in:   in{rate: "0.0125", amount: "80"},
+			want: want{result: "1"},
+		},
+		{
+			name: "half is rounded up",
+			in:   in{rate: "0.015", amount: "1"},
+			want: want{result: "0.02"},
+		},
+		{
+			name: "half is rounded away from zero, not to even",
+			in:   in{rate: "0.025", amount: "1"},
+			want: want{result: "0.03"},
+		},
+		{
+			name: "below half is rounded down",
+			in:   in{rate: "0.0149", amount: "1"},
+			want: want{result: "0.01"},
+		},
+		{
+			name: "above half is rounded up",
+			in:   in{rate: "0.0125", amount: "3"},
+			want: want{result: "0.04"},
+		},
+		{
+			name: "smallest half is rounded up",
+			in:   in{rate: "0.01", amount: "0.5"},
+			want: want{result: "0.01"},
+		},
+		{
+			name: "negative half is rounded away from zero",
+			in:   in{rate: "0.015", amount: "-1"},
+			want: want{result: "-0.02"},
+		},
+		{
+			name: "very large amount",
+			in:   in{rate: "0.0125", amount: "123456789012345678.9"},
+			want: want{result: "1543209862654320.99"},
+		},
+		{
+			name: "zero rate",
+			in:   in{rate: "0", amount: "1"},
+			want: want{result: "0", err: apperrors.ErrCurrencyRateMustBePositive},
+		},
+		{
+			name: "negative rate",
+			in:   in{rate: "-0.0125", amount: "1"},
+			want: want{result: "0", err: apperrors.ErrCurrencyRateMustBePositive},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cr := CurrencyRate{Rate: decimal.RequireFromString(test.in.rate)}
+
+			result, err := cr.ToFiat(decimal.RequireFromString(test.in.amount))
+
+			assert.ErrorIs(t, err, test.want.err)
+			assert.Equal(t, test.want.result, result.String())
+		})
+	}
+}
+
+func TestToInternal(t *testing.T) {
+	type in struct {
+		rate   string
+		amount string
+	}
+
+	type want struct {
+		result string
+		err    error
+	}
+
+	tests := []struct {
+		name string
+		in   in
+		want want
+	}{
+		{
+			name: "exact",
+			in:   in{rate: "0.0125", amount: "1"},
+			want: want{result: "80"},
+		},
+		{
+			name: "exact with scale is not rounded up",
+			in:   in{rate: "1", amount: "0.01"},
+			want: want{result: "0.01"},
+		},
+		{
+			name: "half is rounded up",
+			in:   in{rate: "1", amount: "0.015"},
+			want: want{result: "0.02"},
+		},
+		{
+			name: "below half is rounded up",
+			in:   in{rate: "1", amount: "0.011"},
+			want: want{result: "0.02"},
+		},
+		{
+			name: "repeating fraction is rounded up",
+			in:   in{rate: "0.03", amount: "1"},
+			want: want{result: "33.34"},
+		},
+		{
+			name: "small repeating fraction is rounded up",
+			in:   in{rate: "0.03", amount: "0.01"},
+			want: want{result: "0.34"},
+		},
+		{
+			name: "very large amount",
+			in:   in{rate: "0.0125", amount: "1000000000000000"},
+			want: want{result: "80000000000000000"},
+		},
+		{
+			name: "zero rate",
+			in:   in{rate: "0", amount: "1"},
+			want: want{result: "0", err: apperrors.ErrCurrencyRateMustBePositive},
+		},
+		{
+			name: "negative rate",
+			in:   in{rate: "-0.0125", amount: "1"},
+			want: want{result: "0", err: apperrors.ErrCurrencyRateMustBePositive},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cr := CurrencyRate{Rate: decimal.RequireFromString(test.in.rate)}
+
+			result, err := cr.ToInternal(decimal.RequireFromString(test.in.amount))
+
+			assert.ErrorIs(t, err, test.want.err)
+			assert.Equal(t, test.want.result, result.String())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package currencytransfer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Event types of balance transactions created for the sender and the recipient of the transfer.
+const (
+	SentEventType     = "currency_transfer_sent"
+	ReceivedEventType = "currency_transfer_received"
+)
+
+// AmountPrecision number of decimal places allowed in transfer amount.
+const AmountPrecision = 2
+
+// Statuses of transfer creation returned by the database.
+const (
+	CreateStatusCreated                 = "created"
+	CreateStatusSelfTransfer            = "self_transfer"
+	CreateStatusRecipientNotFound       = "recipient_not_found"
+	CreateStatusSenderBanned            = "sender_banned"
+	CreateStatusRecipientBanned         = "recipient_banned"
+	CreateStatusAccountTooYoung         = "account_too_young"
+	CreateStatusDailyAmountLimitReached = "daily_amount_limit_reached"
+	CreateStatusDailyCountLimitReached  = "daily_count_limit_reached"
+	CreateStatusInsufficientBalance     = "insufficient_balance"
+)
+
+// CurrencyTransfer represents internal currency transfer between users.
+// Recipient receives amount, sender pays amount plus fee.
+type CurrencyTransfer struct {
+	ID                  int64           `json:"id"`
+	SenderTelegramID    string          `json:"sender_telegram_id"`
+	RecipientTelegramID string          `json:"recipient_telegram_id"`
+	Amount              decimal.Decimal `json:"amount"`
+	Fee                 decimal.Decimal `json:"fee"`
+	Comment             *string         `json:"comment,omitempty"`
+	CreatedAt           time.Time       `json:"created_at"`
+}
+
+// Total returns amount debited from the sender.
+func (t CurrencyTransfer) Total() decimal.Decimal {
+	return t.Amount.Add(t.Fee)
+}
+
+// SentDescription returns description of the sender balance transaction.
+func (t CurrencyTransfer) SentDescription() string {
+	if t.Fee.IsPositive() {
+		return fmt.Sprintf("Перевод другу: %s (комиссия %s)", t.Amount, t.Fee)
+	}
+
+	return fmt.Sprintf("Перевод другу: %s", t.Amount)
+}
+
+// ReceivedDescription returns description of the recipient balance transaction.
+func (t CurrencyTransfer) ReceivedDescription() string {
+	return fmt.Sprintf("Перевод от друга: %s", t.Amount)
+}
+
+// SentNotificationText returns text of the notification sent to the sender.
+func (t CurrencyTransfer) SentNotificationText() string {
+	return fmt.Sprintf("Перевод выполнен! Списано %s", t.Total())
+}
+
+// ReceivedNotificationText returns text of the notification sent to the recipient.
+func (t CurrencyTransfer) ReceivedNotificationText() string {
+	if t.Comment != nil {
+		return fmt.Sprintf("Друг перевёл вам %s: «%s»", t.Amount, *t.Comment)
+	}
+
+	return fmt.Sprintf("Друг перевёл вам %s", t.Amount)
+}
+
+//
+// SEND
+//
+
+type SendDTO struct {
+	RecipientTelegramID string          `json:"recipient_telegram_id" validate:"required,min=1"`
+	Amount              decimal.Decimal `json:"amount" validate:"required"`
+	Comment             *string         `json:"comment,omitempty" validate:"omitempty,max=255"`
+	SenderTelegramID    string          `json:"-"`
+}
+
+// IsAmountValid reports whether amount is positive and has no more than allowed decimal places.
+func (dto SendDTO) IsAmountValid() bool {
+	return dto.Amount.IsPositive() && dto.Amount.Equal(dto.Amount.Truncate(AmountPrecision))
+}
+
+// CreateResult represents transfer creation result returned by the database.
+type CreateResult struct {
+	Status           string            `json:"status"`
+	CurrencyTransfer *CurrencyTransfer `json:"transfer,omitempty"`
+}
+
+//
+// SWAGGER
+//
+
+type CurrencyTransferSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID                  int64           `json:"id" example:"1"`
+		SenderTelegramID    string          `json:"sender_telegram_id" example:"1"`
+		RecipientTelegramID string          `json:"recipient_telegram_id" example:"2"`
+		Amount              decimal.Decimal `json:"amount" example:"100.00"`
+		Fee                 decimal.Decimal `json:"fee" example:"5.00"`
+		Comment             *string         `json:"comment,omitempty" example:"С днём рождения!"`
+		CreatedAt           time.Time       `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
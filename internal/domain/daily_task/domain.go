@@ -2,6 +2,14 @@ package dailytask
 
 import "time"
 
+// Difficulty tiers of daily tasks.
+// Assignment picks a tier by user level and recent completion rate.
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+)
+
 type DailyTask struct {
 	ID                   int64     `json:"id"`
 	WordsLearnedNeed     *int64    `json:"words_learned_need,omitempty"`
@@ -10,6 +18,7 @@ type DailyTask struct {
 	WordsTranslateNeed   *int64    `json:"words_translate_need,omitempty"`
 	DialogCompletedNeed  *int64    `json:"dialog_completed_need,omitempty"`
 	ExperiencePointsNeed *int64    `json:"experience_points_need,omitempty"`
+	Difficulty           string    `json:"difficulty"`
 	IsActive             bool      `json:"is_active"`
 	CreatedAt            time.Time `json:"created_at"`
 	UpdatedAt            time.Time `json:"updated_at"`
@@ -20,19 +29,100 @@ type DailyTask struct {
 //
 
 type CreateDTO struct {
+	WordsLearnedNeed     *int64  `json:"words_learned_need,omitempty" validate:"omitempty,gt=0"`
+	TasksCompletedNeed   *int64  `json:"tasks_completed_need,omitempty" validate:"omitempty,gt=0"`
+	LessonsFinishedNeed  *int64  `json:"lessons_finished_need,omitempty" validate:"omitempty,gt=0"`
+	WordsTranslateNeed   *int64  `json:"words_translate_need,omitempty" validate:"omitempty,gt=0"`
+	DialogCompletedNeed  *int64  `json:"dialog_completed_need,omitempty" validate:"omitempty,gt=0"`
+	ExperiencePointsNeed *int64  `json:"experience_points_need,omitempty" validate:"omitempty,gt=0"`
+	Difficulty           *string `json:"difficulty,omitempty" validate:"omitempty,oneof=easy medium hard"`
+	IsActive             bool    `json:"is_active"`
+}
+
+// HasRequirements reports whether at least one requirement is set.
+func (dto CreateDTO) HasRequirements() bool {
+	return hasRequirements(
+		dto.WordsLearnedNeed, dto.TasksCompletedNeed, dto.LessonsFinishedNeed,
+		dto.WordsTranslateNeed, dto.DialogCompletedNeed, dto.ExperiencePointsNeed,
+	)
+}
+
+//
+// UPDATE
+//
+
+type UpdateDTO struct {
+	ID                   int64  `json:"id" validate:"required,gt=0"`
 	WordsLearnedNeed     *int64 `json:"words_learned_need,omitempty" validate:"omitempty,gt=0"`
 	TasksCompletedNeed   *int64 `json:"tasks_completed_need,omitempty" validate:"omitempty,gt=0"`
 	LessonsFinishedNeed  *int64 `json:"lessons_finished_need,omitempty" validate:"omitempty,gt=0"`
 	WordsTranslateNeed   *int64 `json:"words_translate_need,omitempty" validate:"omitempty,gt=0"`
 	DialogCompletedNeed  *int64 `json:"dialog_completed_need,omitempty" validate:"omitempty,gt=0"`
 	ExperiencePointsNeed *int64 `json:"experience_points_need,omitempty" validate:"omitempty,gt=0"`
+	Difficulty           string `json:"difficulty" validate:"required,oneof=easy medium hard"`
 	IsActive             bool   `json:"is_active"`
 }
 
+// HasRequirements reports whether at least one requirement is set.
+func (dto UpdateDTO) HasRequirements() bool {
+	return hasRequirements(
+		dto.WordsLearnedNeed, dto.TasksCompletedNeed, dto.LessonsFinishedNeed,
+		dto.WordsTranslateNeed, dto.DialogCompletedNeed, dto.ExperiencePointsNeed,
+	)
+}
+
+func hasRequirements(needs ...*int64) bool {
+	for _, need := range needs {
+		if need != nil && *need > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 //
 // SWAGGER
 //
 
+type DailyTaskSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID                   int64     `json:"id" example:"1"`
+		WordsLearnedNeed     *int64    `json:"words_learned_need,omitempty" example:"1"`
+		TasksCompletedNeed   *int64    `json:"tasks_completed_need,omitempty" example:"1"`
+		LessonsFinishedNeed  *int64    `json:"lessons_finished_need,omitempty" example:"1"`
+		WordsTranslateNeed   *int64    `json:"words_translate_need,omitempty" example:"1"`
+		DialogCompletedNeed  *int64    `json:"dialog_completed_need,omitempty" example:"1"`
+		ExperiencePointsNeed *int64    `json:"experience_points_need,omitempty" example:"1"`
+		Difficulty           string    `json:"difficulty" example:"medium"`
+		IsActive             bool      `json:"is_active" example:"true"`
+		CreatedAt            time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt            time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type AllDailyTasksSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                   int64     `json:"id" example:"1"`
+		WordsLearnedNeed     *int64    `json:"words_learned_need,omitempty" example:"1"`
+		TasksCompletedNeed   *int64    `json:"tasks_completed_need,omitempty" example:"1"`
+		LessonsFinishedNeed  *int64    `json:"lessons_finished_need,omitempty" example:"1"`
+		WordsTranslateNeed   *int64    `json:"words_translate_need,omitempty" example:"1"`
+		DialogCompletedNeed  *int64    `json:"dialog_completed_need,omitempty" example:"1"`
+		ExperiencePointsNeed *int64    `json:"experience_points_need,omitempty" example:"1"`
+		Difficulty           string    `json:"difficulty" example:"medium"`
+		IsActive             bool      `json:"is_active" example:"true"`
+		CreatedAt            time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt            time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
 type CreateDailyTaskSwaggerResponse struct {
 	Status  bool   `json:"status" example:"true"`
 	Message string `json:"message" example:"success"`
@@ -45,6 +135,7 @@ type CreateDailyTaskSwaggerResponse struct {
 		WordsTranslateNeed   *int64    `json:"words_translate_need,omitempty" example:"1"`
 		DialogCompletedNeed  *int64    `json:"dialog_completed_need,omitempty" example:"1"`
 		ExperiencePointsNeed *int64    `json:"experience_points_need,omitempty" example:"1"`
+		Difficulty           string    `json:"difficulty" example:"medium"`
 		IsActive             bool      `json:"is_active" example:"true"`
 		CreatedAt            time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
 		UpdatedAt            time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
@@ -44,6 +44,20 @@ type LeaderboardWeeksProcessBatchResponse struct {
 	AppliedXP      int64 `json:"applied_xp"`
 	NewLastEventID int64 `json:"new_last_event_id"`
 	Processed      bool  `json:"processed"`
+	IsLeader       bool  `json:"is_leader"`
+}
+
+//
+// GET LEADERBOARD WEEKS WORKER LAG
+//
+
+type GetLeaderboardWeeksWorkerLagResponse struct {
+	WorkerName  string     `json:"worker_name"`
+	LastEventID int64      `json:"last_event_id"`
+	MaxEventID  int64      `json:"max_event_id"`
+	LagEvents   int64      `json:"lag_events"`
+	LagSeconds  *int64     `json:"lag_seconds,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
 //
@@ -111,6 +125,20 @@ type GetLeaderboardTopWeekForUserSwaggerResponse struct {
 	} `json:"data"`
 }
 
+type GetLeaderboardWeeksWorkerLagSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		WorkerName  string     `json:"worker_name" example:"worker_1"`
+		LastEventID int64      `json:"last_event_id" example:"1000"`
+		MaxEventID  int64      `json:"max_event_id" example:"1200"`
+		LagEvents   int64      `json:"lag_events" example:"200"`
+		LagSeconds  *int64     `json:"lag_seconds,omitempty" example:"4"`
+		UpdatedAt   *time.Time `json:"updated_at,omitempty" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
 type ErrorSwaggerResponse struct {
 	Status  bool        `json:"status" example:"false"`
 	Message string      `json:"message" example:"some error"`
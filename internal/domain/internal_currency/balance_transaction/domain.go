@@ -0,0 +1,139 @@
+package balancetransaction
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Directions of balance transactions.
+// Amount is always positive, direction shows whether it was credited or debited.
+const (
+	DirectionCredit = "credit"
+	DirectionDebit  = "debit"
+)
+
+// DefaultLang language of transaction titles if language is not set.
+const DefaultLang = "ru"
+
+// ExportMaxRows maximum number of transactions exported to CSV at once.
+const ExportMaxRows = 10000
+
+// Transaction represents a balance transaction.
+// Title is localized by event type, description keeps details of the operation.
+type Transaction struct {
+	ID            int64            `json:"id"`
+	TelegramID    string           `json:"telegram_id"`
+	EventTypeID   int64            `json:"event_type_id"`
+	EventTypeName string           `json:"event_type_name"`
+	Direction     string           `json:"direction"`
+	Amount        decimal.Decimal  `json:"amount"`
+	BalanceAfter  *decimal.Decimal `json:"balance_after,omitempty"`
+	Title         *string          `json:"title,omitempty"`
+	Description   *string          `json:"description,omitempty"`
+	SourceType    *string          `json:"source_type,omitempty"`
+	SourceID      *int64           `json:"source_id,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+//
+// ALL
+//
+
+// AllDTO represents history request of the user making request.
+type AllDTO struct {
+	Direction *string    `json:"direction,omitempty" validate:"omitempty,oneof=credit debit"`
+	EventType *string    `json:"event_type,omitempty" validate:"omitempty,min=1"`
+	DateFrom  *time.Time `json:"date_from,omitempty"`
+	DateTo    *time.Time `json:"date_to,omitempty"`
+	Lang      string     `json:"lang,omitempty" validate:"omitempty,len=2"`
+	Cursor    *int64     `json:"cursor,omitempty" validate:"omitempty,gt=0"`
+	Limit     int64      `json:"limit" validate:"required,gt=0,lte=100"`
+}
+
+//
+// ALL BY TELEGRAM ID
+//
+
+// AllByTelegramIDDTO represents history request.
+// Cursor is the id of the last transaction of the previous page.
+type AllByTelegramIDDTO struct {
+	TelegramID string     `json:"telegram_id" validate:"required,min=1"`
+	Direction  *string    `json:"direction,omitempty" validate:"omitempty,oneof=credit debit"`
+	EventType  *string    `json:"event_type,omitempty" validate:"omitempty,min=1"`
+	DateFrom   *time.Time `json:"date_from,omitempty"`
+	DateTo     *time.Time `json:"date_to,omitempty"`
+	Lang       string     `json:"lang,omitempty" validate:"omitempty,len=2"`
+	Cursor     *int64     `json:"cursor,omitempty" validate:"omitempty,gt=0"`
+	Limit      int64      `json:"limit" validate:"required,gt=0,lte=100"`
+}
+
+// IsDateRangeValid reports whether date range bounds are ordered.
+func (dto AllByTelegramIDDTO) IsDateRangeValid() bool {
+	return isDateRangeValid(dto.DateFrom, dto.DateTo)
+}
+
+// AllByTelegramIDResponse represents a page of balance transactions.
+// Next cursor is set if there are more transactions.
+type AllByTelegramIDResponse struct {
+	Transactions []Transaction `json:"transactions"`
+	NextCursor   *int64        `json:"next_cursor,omitempty"`
+	HasMore      bool          `json:"has_more"`
+}
+
+//
+// EXPORT BY TELEGRAM ID
+//
+
+type ExportByTelegramIDDTO struct {
+	TelegramID string     `json:"telegram_id" validate:"required,min=1"`
+	Direction  *string    `json:"direction,omitempty" validate:"omitempty,oneof=credit debit"`
+	EventType  *string    `json:"event_type,omitempty" validate:"omitempty,min=1"`
+	DateFrom   *time.Time `json:"date_from,omitempty"`
+	DateTo     *time.Time `json:"date_to,omitempty"`
+	Lang       string     `json:"lang,omitempty" validate:"omitempty,len=2"`
+}
+
+// IsDateRangeValid reports whether date range bounds are ordered.
+func (dto ExportByTelegramIDDTO) IsDateRangeValid() bool {
+	return isDateRangeValid(dto.DateFrom, dto.DateTo)
+}
+
+func isDateRangeValid(from *time.Time, to *time.Time) bool {
+	return from == nil || to == nil || from.Before(*to)
+}
+
+//
+// SWAGGER
+//
+
+type AllByTelegramIDSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		Transactions []struct {
+			ID            int64            `json:"id" example:"120"`
+			TelegramID    string           `json:"telegram_id" example:"1"`
+			EventTypeID   int64            `json:"event_type_id" example:"7"`
+			EventTypeName string           `json:"event_type_name" example:"shop_purchase"`
+			Direction     string           `json:"direction" example:"debit"`
+			Amount        decimal.Decimal  `json:"amount" example:"50.00"`
+			BalanceAfter  *decimal.Decimal `json:"balance_after,omitempty" example:"150.00"`
+			Title         *string          `json:"title,omitempty" example:"Покупка в магазине"`
+			Description   *string          `json:"description,omitempty" example:"Покупка в магазине: товар #1 (1 шт.)"`
+			SourceType    *string          `json:"source_type,omitempty" example:"shop_purchase"`
+			SourceID      *int64           `json:"source_id,omitempty" example:"1"`
+			CreatedAt     time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"transactions"`
+		NextCursor *int64 `json:"next_cursor,omitempty" example:"101"`
+		HasMore    bool   `json:"has_more" example:"true"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
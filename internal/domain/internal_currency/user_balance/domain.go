@@ -6,6 +6,22 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// Source types of balance transactions.
+// Source id references the record that caused the transaction.
+const (
+	SourceTypeAchievementReward = "achievement_reward"
+	SourceTypeCurrencyTransfer  = "currency_transfer" // written for both sides of the transfer, source id is the transfer id.
+	SourceTypeDailyTask         = "daily_task"
+	SourceTypeLedgerCorrection  = "ledger_correction" // written by ledger reconciliation, source id is the job id.
+	SourceTypeLevelReward       = "level_reward"
+	SourceTypePromoCode         = "promo_code"
+	SourceTypeQuest             = "quest"
+	SourceTypeReferral          = "referral"
+	SourceTypeShopPurchase      = "shop_purchase"
+	SourceTypeStreakFreeze      = "streak_freeze"
+	SourceTypeStreakRepair      = "streak_repair"
+)
+
 // UserBalance represents a user balance in the system.
 type UserBalance struct {
 	ID         int64           `json:"id"`
@@ -24,6 +40,8 @@ type AddUserBalanceDTO struct {
 	Amount      decimal.Decimal `json:"amount"`
 	TelegramID  string          `json:"telegram_id"`
 	Description *string         `json:"description,omitempty"`
+	SourceType  *string         `json:"source_type,omitempty"`
+	SourceID    *int64          `json:"source_id,omitempty"`
 }
 
 //
@@ -35,6 +53,8 @@ type ReduceUserBalanceDTO struct {
 	Amount      decimal.Decimal `json:"amount"`
 	TelegramID  string          `json:"telegram_id"`
 	Description string          `json:"description"`
+	SourceType  *string         `json:"source_type,omitempty"`
+	SourceID    *int64          `json:"source_id,omitempty"`
 }
 
 //
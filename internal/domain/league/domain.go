@@ -0,0 +1,129 @@
+package league
+
+import "time"
+
+type Division struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Tier         int64     `json:"tier"`
+	CohortSize   int64     `json:"cohort_size"`
+	PromoteCount int64     `json:"promote_count"`
+	DemoteCount  int64     `json:"demote_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+//
+// GET CURRENT BY TELEGRAM ID
+//
+
+type GetCurrentByTelegramIDResponse struct {
+	CohortID     int64     `json:"cohort_id"`
+	DivisionID   int64     `json:"division_id"`
+	DivisionName string    `json:"division_name"`
+	Tier         int64     `json:"tier"`
+	WeekStart    time.Time `json:"week_start"`
+	WeekEnd      time.Time `json:"week_end"`
+	MembersCount int64     `json:"members_count"`
+	PromoteCount int64     `json:"promote_count"`
+	DemoteCount  int64     `json:"demote_count"`
+	Position     int64     `json:"position"`
+	XP           int64     `json:"xp"`
+	Zone         string    `json:"zone"`
+}
+
+//
+// GET COHORT STANDINGS BY TELEGRAM ID
+//
+
+type GetCohortStandingsByTelegramIDResponse struct {
+	Position    int64  `json:"position"`
+	TelegramID  string `json:"telegram_id"`
+	DisplayName string `json:"display_name"`
+	XP          int64  `json:"xp"`
+	Zone        string `json:"zone"`
+	IsMe        bool   `json:"is_me"`
+}
+
+//
+// ALL HISTORY BY TELEGRAM ID
+//
+
+type AllHistoryByTelegramIDResponse struct {
+	WeekStart     time.Time `json:"week_start"`
+	DivisionName  string    `json:"division_name"`
+	Tier          int64     `json:"tier"`
+	IsFinalized   bool      `json:"is_finalized"`
+	FinalPosition *int64    `json:"final_position,omitempty"`
+	FinalXP       *int64    `json:"final_xp,omitempty"`
+	Result        *string   `json:"result,omitempty"`
+}
+
+//
+// WEEKS FINALIZE
+//
+
+type WeeksFinalizeResponse struct {
+	CohortsCount int64 `json:"cohorts_count"`
+	MembersCount int64 `json:"members_count"`
+}
+
+//
+// SWAGGER
+//
+
+type GetCurrentByTelegramIDSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		CohortID     int64     `json:"cohort_id" example:"1"`
+		DivisionID   int64     `json:"division_id" example:"1"`
+		DivisionName string    `json:"division_name" example:"bronze"`
+		Tier         int64     `json:"tier" example:"1"`
+		WeekStart    time.Time `json:"week_start" example:"2025-09-01T00:00:00Z"`
+		WeekEnd      time.Time `json:"week_end" example:"2025-09-08T00:00:00Z"`
+		MembersCount int64     `json:"members_count" example:"30"`
+		PromoteCount int64     `json:"promote_count" example:"10"`
+		DemoteCount  int64     `json:"demote_count" example:"0"`
+		Position     int64     `json:"position" example:"3"`
+		XP           int64     `json:"xp" example:"120"`
+		Zone         string    `json:"zone" example:"promotion"`
+	} `json:"data"`
+}
+
+type GetCohortStandingsByTelegramIDSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		Position    int64  `json:"position" example:"1"`
+		TelegramID  string `json:"telegram_id" example:"1"`
+		DisplayName string `json:"display_name" example:"some name"`
+		XP          int64  `json:"xp" example:"120"`
+		Zone        string `json:"zone" example:"promotion"`
+		IsMe        bool   `json:"is_me" example:"true"`
+	} `json:"data"`
+}
+
+type AllHistoryByTelegramIDSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		WeekStart     time.Time `json:"week_start" example:"2025-09-01T00:00:00Z"`
+		DivisionName  string    `json:"division_name" example:"bronze"`
+		Tier          int64     `json:"tier" example:"1"`
+		IsFinalized   bool      `json:"is_finalized" example:"true"`
+		FinalPosition *int64    `json:"final_position,omitempty" example:"3"`
+		FinalXP       *int64    `json:"final_xp,omitempty" example:"120"`
+		Result        *string   `json:"result,omitempty" example:"promoted"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
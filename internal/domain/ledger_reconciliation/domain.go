@@ -0,0 +1,185 @@
+package ledgerreconciliation
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+
+	IssueTypeBalanceDrift = "balance_drift"
+	IssueTypeBrokenChain  = "broken_chain"
+)
+
+// Job represents a reconciliation of user_balances against balance_transactions.
+// Counters are accumulated chunk by chunk and serve as metrics of the ledger state.
+type Job struct {
+	ID               int64           `json:"id"`
+	TelegramID       *string         `json:"telegram_id,omitempty"`
+	DryRun           bool            `json:"dry_run"`
+	Status           string          `json:"status"`
+	CursorUserID     int64           `json:"cursor_user_id"`
+	ProcessedUsers   int64           `json:"processed_users"`
+	TotalUsers       int64           `json:"total_users"`
+	DriftUsers       int64           `json:"drift_users"`
+	BrokenChainUsers int64           `json:"broken_chain_users"`
+	CorrectedUsers   int64           `json:"corrected_users"`
+	DriftAmount      decimal.Decimal `json:"drift_amount"`
+	Error            *string         `json:"error,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	FinishedAt       *time.Time      `json:"finished_at,omitempty"`
+}
+
+// IsFinished reports whether the job will not be processed anymore.
+func (j Job) IsFinished() bool {
+	return j.Status == StatusCompleted || j.Status == StatusFailed
+}
+
+// HasIssues reports whether the job found drift or broken chains.
+func (j Job) HasIssues() bool {
+	return j.DriftUsers > 0 || j.BrokenChainUsers > 0
+}
+
+// Issue represents a mismatch found by a reconciliation job.
+// For balance drift expected balance is the sum of transactions and actual balance is user_balances.balance,
+// for broken chain they are balance_after values of the first transaction breaking the chain.
+type Issue struct {
+	ID                      int64           `json:"id"`
+	JobID                   int64           `json:"job_id"`
+	TelegramID              string          `json:"telegram_id"`
+	Type                    string          `json:"type"`
+	ExpectedBalance         decimal.Decimal `json:"expected_balance"`
+	ActualBalance           decimal.Decimal `json:"actual_balance"`
+	Difference              decimal.Decimal `json:"difference"`
+	TransactionID           *int64          `json:"transaction_id,omitempty"`
+	BrokenLinks             int64           `json:"broken_links"`
+	CorrectionTransactionID *int64          `json:"correction_transaction_id,omitempty"`
+	CreatedAt               time.Time       `json:"created_at"`
+}
+
+//
+// CREATE
+//
+
+// CreateDTO represents a reconciliation request.
+// Telegram id limits the job to one user, without dry run drift is corrected by a ledger_correction transaction.
+type CreateDTO struct {
+	TelegramID *string `json:"telegram_id,omitempty" validate:"omitempty,min=1"`
+	DryRun     bool    `json:"dry_run"`
+}
+
+//
+// PROCESS CHUNK
+//
+
+type ProcessChunkDTO struct {
+	JobID     *int64 // nil = the oldest unfinished job.
+	ChunkSize int64
+}
+
+//
+// ALL ISSUES BY JOB ID
+//
+
+// AllIssuesByJobIDDTO represents issues request.
+// Cursor is the id of the last issue of the previous page.
+type AllIssuesByJobIDDTO struct {
+	JobID  int64   `json:"job_id" validate:"required,gt=0"`
+	Type   *string `json:"type,omitempty" validate:"omitempty,oneof=balance_drift broken_chain"`
+	Cursor *int64  `json:"cursor,omitempty" validate:"omitempty,gt=0"`
+	Limit  int64   `json:"limit" validate:"required,gt=0,lte=100"`
+}
+
+// AllIssuesByJobIDResponse represents a page of issues.
+// Next cursor is set if there are more issues.
+type AllIssuesByJobIDResponse struct {
+	Issues     []Issue `json:"issues"`
+	NextCursor *int64  `json:"next_cursor,omitempty"`
+	HasMore    bool    `json:"has_more"`
+}
+
+//
+// SWAGGER
+//
+
+type JobSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID               int64           `json:"id" example:"1"`
+		TelegramID       *string         `json:"telegram_id,omitempty" example:"1"`
+		DryRun           bool            `json:"dry_run" example:"true"`
+		Status           string          `json:"status" example:"running"`
+		CursorUserID     int64           `json:"cursor_user_id" example:"500"`
+		ProcessedUsers   int64           `json:"processed_users" example:"500"`
+		TotalUsers       int64           `json:"total_users" example:"1200"`
+		DriftUsers       int64           `json:"drift_users" example:"2"`
+		BrokenChainUsers int64           `json:"broken_chain_users" example:"1"`
+		CorrectedUsers   int64           `json:"corrected_users" example:"0"`
+		DriftAmount      decimal.Decimal `json:"drift_amount" example:"150.00"`
+		Error            *string         `json:"error,omitempty" example:""`
+		CreatedAt        time.Time       `json:"created_at" example:"2025-09-10T12:00:00Z"`
+		UpdatedAt        time.Time       `json:"updated_at" example:"2025-09-10T12:05:00Z"`
+		FinishedAt       *time.Time      `json:"finished_at,omitempty" example:"2025-09-10T12:10:00Z"`
+	} `json:"data"`
+}
+
+type AllSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID               int64           `json:"id" example:"1"`
+		TelegramID       *string         `json:"telegram_id,omitempty" example:"1"`
+		DryRun           bool            `json:"dry_run" example:"true"`
+		Status           string          `json:"status" example:"completed"`
+		CursorUserID     int64           `json:"cursor_user_id" example:"1200"`
+		ProcessedUsers   int64           `json:"processed_users" example:"1200"`
+		TotalUsers       int64           `json:"total_users" example:"1200"`
+		DriftUsers       int64           `json:"drift_users" example:"2"`
+		BrokenChainUsers int64           `json:"broken_chain_users" example:"1"`
+		CorrectedUsers   int64           `json:"corrected_users" example:"0"`
+		DriftAmount      decimal.Decimal `json:"drift_amount" example:"150.00"`
+		Error            *string         `json:"error,omitempty" example:""`
+		CreatedAt        time.Time       `json:"created_at" example:"2025-09-10T12:00:00Z"`
+		UpdatedAt        time.Time       `json:"updated_at" example:"2025-09-10T12:05:00Z"`
+		FinishedAt       *time.Time      `json:"finished_at,omitempty" example:"2025-09-10T12:10:00Z"`
+	} `json:"data"`
+}
+
+type AllIssuesByJobIDSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		Issues []struct {
+			ID                      int64           `json:"id" example:"10"`
+			JobID                   int64           `json:"job_id" example:"1"`
+			TelegramID              string          `json:"telegram_id" example:"1"`
+			Type                    string          `json:"type" example:"balance_drift"`
+			ExpectedBalance         decimal.Decimal `json:"expected_balance" example:"100.00"`
+			ActualBalance           decimal.Decimal `json:"actual_balance" example:"250.00"`
+			Difference              decimal.Decimal `json:"difference" example:"150.00"`
+			TransactionID           *int64          `json:"transaction_id,omitempty" example:"120"`
+			BrokenLinks             int64           `json:"broken_links" example:"0"`
+			CorrectionTransactionID *int64          `json:"correction_transaction_id,omitempty" example:"130"`
+			CreatedAt               time.Time       `json:"created_at" example:"2025-09-10T12:05:00Z"`
+		} `json:"issues"`
+		NextCursor *int64 `json:"next_cursor,omitempty" example:"10"`
+		HasMore    bool   `json:"has_more" example:"true"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
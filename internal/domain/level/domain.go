@@ -1,6 +1,80 @@
 package level
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LevelUpRewardEventType is the event type of balance transactions
+// created for internal currency level rewards.
+const LevelUpRewardEventType = "level_up_reward"
+
+const (
+	RewardTypeInternalCurrency = "internal_currency"
+	RewardTypeAwardAsset       = "award_asset"
+	RewardTypeSubscriptionDays = "subscription_days"
+)
+
+// Level represents a level of the progression curve.
+type Level struct {
+	ID                 int64         `json:"id"`
+	LevelName          string        `json:"level_name"`
+	LevelNumber        int64         `json:"level_number"`
+	RequiredExperience int64         `json:"required_experience"`
+	Rewards            []LevelReward `json:"rewards,omitempty"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+}
+
+// LevelReward represents a reward granted once when a user reaches the level.
+type LevelReward struct {
+	ID               int64            `json:"id"`
+	LevelNumber      int64            `json:"level_number"`
+	Type             string           `json:"type"`
+	Amount           *decimal.Decimal `json:"amount,omitempty"`
+	AwardAssetID     *int64           `json:"award_asset_id,omitempty"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+}
+
+// UserLevelReward represents a level reward granted to a user.
+type UserLevelReward struct {
+	ID               int64            `json:"id"`
+	TelegramID       string           `json:"telegram_id"`
+	LevelRewardID    *int64           `json:"level_reward_id,omitempty"`
+	LevelNumber      int64            `json:"level_number"`
+	Type             string           `json:"type"`
+	Amount           *decimal.Decimal `json:"amount,omitempty"`
+	AwardAssetID     *int64           `json:"award_asset_id,omitempty"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty"`
+	GrantedAt        time.Time        `json:"granted_at"`
+}
+
+// NotificationText returns notification text for the granted level reward.
+func (r UserLevelReward) NotificationText() string {
+	switch r.Type {
+	case RewardTypeInternalCurrency:
+		return fmt.Sprintf("Награда за %d уровень: %s на баланс!", r.LevelNumber, r.Amount.StringFixed(2))
+	case RewardTypeSubscriptionDays:
+		return fmt.Sprintf("Награда за %d уровень: %d дн. подписки!", r.LevelNumber, *r.SubscriptionDays)
+	default:
+		return fmt.Sprintf("Награда за %d уровень: новая награда в коллекции!", r.LevelNumber)
+	}
+}
+
+// AnyHasSubscriptionDays reports whether any granted level reward extends subscription.
+func AnyHasSubscriptionDays(rewards []UserLevelReward) bool {
+	for i := range rewards {
+		if rewards[i].Type == RewardTypeSubscriptionDays {
+			return true
+		}
+	}
+
+	return false
+}
 
 // UserLevelHistory represents user level history in the system.
 type UserLevelHistory struct {
@@ -32,3 +106,124 @@ type BackFillMissingLevelHistoryByTelegramIDResponse struct {
 	OldLevel  int64 `json:"old_level"`
 	NewLevel  int64 `json:"new_level"`
 }
+
+//
+// CREATE
+//
+
+type CreateDTO struct {
+	LevelName          string `json:"level_name" validate:"required,min=1,max=50"`
+	LevelNumber        int64  `json:"level_number" validate:"required,gt=0"`
+	RequiredExperience int64  `json:"required_experience" validate:"gte=0"`
+}
+
+//
+// UPDATE
+//
+
+type UpdateDTO struct {
+	LevelNumber        int64  `json:"level_number" validate:"required,gt=0"`
+	LevelName          string `json:"level_name" validate:"required,min=1,max=50"`
+	RequiredExperience int64  `json:"required_experience" validate:"gte=0"`
+}
+
+//
+// GENERATE
+//
+
+type GenerateDTO struct {
+	ToLevel        int64           `json:"to_level" validate:"required,gt=0,lte=1000"`
+	BaseExperience int64           `json:"base_experience" validate:"required,gt=0"`
+	Exponent       decimal.Decimal `json:"exponent" validate:"required"`
+}
+
+//
+// CREATE REWARD
+//
+
+type CreateRewardDTO struct {
+	LevelNumber      int64            `json:"level_number" validate:"required,gt=0"`
+	Type             string           `json:"type" validate:"required,oneof=internal_currency award_asset subscription_days"`
+	Amount           *decimal.Decimal `json:"amount,omitempty" validate:"required_if=Type internal_currency"`
+	AwardAssetID     *int64           `json:"award_asset_id,omitempty" validate:"required_if=Type award_asset,omitempty,gt=0"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty" validate:"required_if=Type subscription_days,omitempty,gt=0,lte=3650"`
+}
+
+//
+// SWAGGER
+//
+
+type LevelSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID                 int64     `json:"id" example:"8"`
+		LevelName          string    `json:"level_name" example:"level 8"`
+		LevelNumber        int64     `json:"level_number" example:"8"`
+		RequiredExperience int64     `json:"required_experience" example:"2600"`
+		CreatedAt          time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt          time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type AllSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                 int64  `json:"id" example:"2"`
+		LevelName          string `json:"level_name" example:"level 2"`
+		LevelNumber        int64  `json:"level_number" example:"2"`
+		RequiredExperience int64  `json:"required_experience" example:"100"`
+		Rewards            []struct {
+			ID               int64            `json:"id" example:"1"`
+			LevelNumber      int64            `json:"level_number" example:"2"`
+			Type             string           `json:"type" example:"internal_currency"`
+			Amount           *decimal.Decimal `json:"amount,omitempty" example:"50.00"`
+			AwardAssetID     *int64           `json:"award_asset_id,omitempty" example:"1"`
+			SubscriptionDays *int64           `json:"subscription_days,omitempty" example:"7"`
+			CreatedAt        time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt        time.Time        `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"rewards,omitempty"`
+		CreatedAt time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type GenerateSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                 int64     `json:"id" example:"8"`
+		LevelName          string    `json:"level_name" example:"level 8"`
+		LevelNumber        int64     `json:"level_number" example:"8"`
+		RequiredExperience int64     `json:"required_experience" example:"2600"`
+		CreatedAt          time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt          time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type LevelRewardSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID               int64            `json:"id" example:"1"`
+		LevelNumber      int64            `json:"level_number" example:"2"`
+		Type             string           `json:"type" example:"internal_currency"`
+		Amount           *decimal.Decimal `json:"amount,omitempty" example:"50.00"`
+		AwardAssetID     *int64           `json:"award_asset_id,omitempty" example:"1"`
+		SubscriptionDays *int64           `json:"subscription_days,omitempty" example:"7"`
+		CreatedAt        time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt        time.Time        `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
@@ -2,6 +2,8 @@ package notification
 
 import (
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -12,9 +14,13 @@ const (
 
 const (
 	AchievementType      = "achievement"
+	CurrencyTransferType = "currency_transfer"
+	DailyTaskType        = "daily_task"
 	InternalCurrencyType = "internal_currency"
 	LevelType            = "level"
 	MiniGameType         = "mini_game"
+	QuestType            = "quest"
+	SubscriptionType     = "subscription"
 )
 
 // Notification represents notification in the system.
@@ -29,8 +35,18 @@ type Notification struct {
 }
 
 type Message struct {
-	Title string `json:"title"`
-	Text  string `json:"text"`
+	Title   string   `json:"title"`
+	Text    string   `json:"text"`
+	Rewards []Reward `json:"rewards,omitempty"`
+}
+
+// Reward represents a reward shown in the notification (e.g. for unlocked achievement).
+type Reward struct {
+	Type             string           `json:"type"`
+	Amount           *decimal.Decimal `json:"amount,omitempty"`
+	ExperiencePoints *int64           `json:"experience_points,omitempty"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty"`
+	StreakFreezes    *int64           `json:"streak_freezes,omitempty"`
 }
 
 //
@@ -0,0 +1,195 @@
+package payment
+
+import (
+	"time"
+)
+
+// Types of products sold for Telegram Stars.
+const (
+	ProductTypeSubscriptionPlan = "subscription_plan"
+	ProductTypeShopItem         = "shop_item"
+)
+
+// Statuses of payment.
+// Failed payment was paid, but the product could not be granted, so it has to be refunded.
+const (
+	StatusPending  = "pending"
+	StatusPaid     = "paid"
+	StatusFailed   = "failed"
+	StatusRefunded = "refunded"
+)
+
+// Statuses of payment creation returned by the database.
+const (
+	CreateStatusCreated      = "created"
+	CreateStatusNotAvailable = "not_available"
+	CreateStatusOutOfStock   = "out_of_stock"
+	CreateStatusLimitReached = "limit_reached"
+)
+
+// Statuses of payment fulfilment returned by the database, besides paid and failed.
+const (
+	FulfilStatusDuplicate = "duplicate"
+	FulfilStatusNotFound  = "not_found"
+)
+
+// Payment represents a payment in Telegram Stars.
+// Amount is in Telegram Stars, invoice payload identifies the payment in Telegram updates.
+type Payment struct {
+	ID                      int64      `json:"id"`
+	TelegramID              string     `json:"telegram_id"`
+	ProductType             string     `json:"product_type"`
+	SubscriptionPlanID      *int64     `json:"subscription_plan_id,omitempty"`
+	ShopItemID              *int64     `json:"shop_item_id,omitempty"`
+	Quantity                int64      `json:"quantity"`
+	Title                   string     `json:"title"`
+	Description             string     `json:"description"`
+	Amount                  int64      `json:"amount"`
+	Currency                string     `json:"currency"`
+	Status                  string     `json:"status"`
+	InvoicePayload          string     `json:"invoice_payload"`
+	TelegramPaymentChargeID *string    `json:"telegram_payment_charge_id,omitempty"`
+	ProviderPaymentChargeID *string    `json:"provider_payment_charge_id,omitempty"`
+	ShopPurchaseID          *int64     `json:"shop_purchase_id,omitempty"`
+	Error                   *string    `json:"error,omitempty"`
+	PaidAt                  *time.Time `json:"paid_at,omitempty"`
+	RefundedAt              *time.Time `json:"refunded_at,omitempty"`
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+}
+
+// IsRefundable reports whether the payment can be refunded.
+func (p Payment) IsRefundable() bool {
+	return (p.Status == StatusPaid || p.Status == StatusFailed) && p.TelegramPaymentChargeID != nil
+}
+
+//
+// CREATE INVOICE
+//
+
+type CreateInvoiceDTO struct {
+	TelegramID  string  `json:"telegram_id" validate:"required,min=1"`
+	ProductType string  `json:"product_type" validate:"required,oneof=subscription_plan shop_item"`
+	PlanCode    *string `json:"plan_code,omitempty" validate:"required_if=ProductType subscription_plan,excluded_unless=ProductType subscription_plan,omitempty,min=1"`
+	ShopItemID  *int64  `json:"shop_item_id,omitempty" validate:"required_if=ProductType shop_item,excluded_unless=ProductType shop_item,omitempty,gt=0"`
+	Quantity    int64   `json:"quantity" validate:"omitempty,gt=0,lte=100"`
+}
+
+// CreateDTO data of a new payment, invoice payload is generated by service.
+type CreateDTO struct {
+	TelegramID     string
+	ProductType    string
+	PlanCode       *string
+	ShopItemID     *int64
+	Quantity       int64
+	InvoicePayload string
+}
+
+// CreateResult represents payment creation result returned by the database.
+type CreateResult struct {
+	Status  string   `json:"status"`
+	Payment *Payment `json:"payment,omitempty"`
+}
+
+// CreateInvoiceResponse represents created payment and link of Telegram invoice to pay it.
+type CreateInvoiceResponse struct {
+	Payment     Payment `json:"payment"`
+	InvoiceLink string  `json:"invoice_link"`
+}
+
+//
+// FULFIL
+//
+
+type FulfilDTO struct {
+	InvoicePayload          string
+	TelegramPaymentChargeID string
+	ProviderPaymentChargeID string
+	Currency                string
+	TotalAmount             int64
+}
+
+// FulfilResult represents payment fulfilment result returned by the database.
+type FulfilResult struct {
+	Status  string   `json:"status"`
+	Payment *Payment `json:"payment,omitempty"`
+}
+
+//
+// PRE CHECKOUT
+//
+
+type PreCheckoutDTO struct {
+	QueryID        string
+	TelegramID     string
+	Currency       string
+	TotalAmount    int64
+	InvoicePayload string
+}
+
+//
+// REFUND
+//
+
+type RefundDTO struct {
+	PaymentID int64 `json:"payment_id" validate:"required,gt=0"`
+}
+
+//
+// SWAGGER
+//
+
+type CreateInvoiceSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		Payment struct {
+			ID                 int64     `json:"id" example:"1"`
+			TelegramID         string    `json:"telegram_id" example:"1"`
+			ProductType        string    `json:"product_type" example:"subscription_plan"`
+			SubscriptionPlanID *int64    `json:"subscription_plan_id,omitempty" example:"2"`
+			Quantity           int64     `json:"quantity" example:"1"`
+			Title              string    `json:"title" example:"Подписка на месяц"`
+			Description        string    `json:"description" example:"Премиум-подписка на 30 дн."`
+			Amount             int64     `json:"amount" example:"250"`
+			Currency           string    `json:"currency" example:"XTR"`
+			Status             string    `json:"status" example:"pending"`
+			InvoicePayload     string    `json:"invoice_payload" example:"01K4ZQ2W5V3X8N6M7B9C1D2E3F"`
+			CreatedAt          time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt          time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"payment"`
+		InvoiceLink string `json:"invoice_link" example:"https://t.me/$EXAMPLE_INVOICE"`
+	} `json:"data"`
+}
+
+type PaymentSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID                      int64      `json:"id" example:"1"`
+		TelegramID              string     `json:"telegram_id" example:"1"`
+		ProductType             string     `json:"product_type" example:"subscription_plan"`
+		SubscriptionPlanID      *int64     `json:"subscription_plan_id,omitempty" example:"2"`
+		Quantity                int64      `json:"quantity" example:"1"`
+		Title                   string     `json:"title" example:"Подписка на месяц"`
+		Description             string     `json:"description" example:"Премиум-подписка на 30 дн."`
+		Amount                  int64      `json:"amount" example:"250"`
+		Currency                string     `json:"currency" example:"XTR"`
+		Status                  string     `json:"status" example:"refunded"`
+		InvoicePayload          string     `json:"invoice_payload" example:"01K4ZQ2W5V3X8N6M7B9C1D2E3F"`
+		TelegramPaymentChargeID *string    `json:"telegram_payment_charge_id,omitempty" example:"stxAbCdEf"`
+		PaidAt                  *time.Time `json:"paid_at,omitempty" example:"2025-09-02T12:50:06.37622+03:00"`
+		RefundedAt              *time.Time `json:"refunded_at,omitempty" example:"2025-09-03T10:00:00.37622+03:00"`
+		CreatedAt               time.Time  `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt               time.Time  `json:"updated_at" example:"2025-09-03T10:00:00.37622+03:00"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
@@ -0,0 +1,217 @@
+package promocode
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RewardEventType event type of balance transactions created for promo code rewards.
+const RewardEventType = "promo_code_reward"
+
+// Types of promo code rewards.
+const (
+	RewardTypeInternalCurrency = "internal_currency"
+	RewardTypeSubscriptionDays = "subscription_days"
+	RewardTypeShopItem         = "shop_item"
+)
+
+// Statuses of promo code redemption returned by the database.
+// Reward unavailable means the shop item of the reward can not be granted (not available, out of stock or limit reached).
+const (
+	RedeemStatusRedeemed          = "redeemed"
+	RedeemStatusNotFound          = "not_found"
+	RedeemStatusInactive          = "inactive"
+	RedeemStatusExpired           = "expired"
+	RedeemStatusExhausted         = "exhausted"
+	RedeemStatusLimitReached      = "limit_reached"
+	RedeemStatusRewardUnavailable = "reward_unavailable"
+)
+
+// GeneratedCodeLength length of generated codes without prefix.
+const GeneratedCodeLength = 10
+
+// Batch represents a batch of promo codes, reward and redemption rules are common for all codes of the batch.
+// Max redemptions limits redemptions of every code (nil means no limit),
+// per user limit limits redemptions of all codes of the batch by one user.
+type Batch struct {
+	ID               int64            `json:"id"`
+	Name             string           `json:"name"`
+	RewardType       string           `json:"reward_type"`
+	Amount           *decimal.Decimal `json:"amount,omitempty"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty"`
+	ShopItemID       *int64           `json:"shop_item_id,omitempty"`
+	Quantity         *int64           `json:"quantity,omitempty"`
+	MaxRedemptions   *int64           `json:"max_redemptions,omitempty"`
+	PerUserLimit     int64            `json:"per_user_limit"`
+	ExpiresAt        *time.Time       `json:"expires_at,omitempty"`
+	IsActive         bool             `json:"is_active"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+}
+
+// PromoCode represents a promo code.
+type PromoCode struct {
+	ID               int64     `json:"id"`
+	BatchID          int64     `json:"batch_id"`
+	Code             string    `json:"code"`
+	RedemptionsCount int64     `json:"redemptions_count"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// Redemption represents a promo code redemption with the granted reward.
+type Redemption struct {
+	ID               int64            `json:"id"`
+	PromoCodeID      int64            `json:"promo_code_id"`
+	BatchID          int64            `json:"batch_id"`
+	Code             string           `json:"code"`
+	TelegramID       string           `json:"telegram_id"`
+	RewardType       string           `json:"reward_type"`
+	Amount           *decimal.Decimal `json:"amount,omitempty"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty"`
+	ShopItemID       *int64           `json:"shop_item_id,omitempty"`
+	Quantity         *int64           `json:"quantity,omitempty"`
+	ShopPurchaseID   *int64           `json:"shop_purchase_id,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
+}
+
+// BatchReport represents redemption statistics of a batch.
+type BatchReport struct {
+	Batch
+	CodesCount         int64            `json:"codes_count"`
+	RedeemedCodesCount int64            `json:"redeemed_codes_count"`
+	RedemptionsCount   int64            `json:"redemptions_count"`
+	UsersCount         int64            `json:"users_count"`
+	AmountGranted      *decimal.Decimal `json:"amount_granted,omitempty"`
+	LastRedeemedAt     *time.Time       `json:"last_redeemed_at,omitempty"`
+}
+
+//
+// CREATE BATCH
+//
+
+// CreateBatchDTO data of a new batch.
+// Batch has either one custom code (e.g. WELCOME100) or count generated codes,
+// generated codes are single-use unless max redemptions is set.
+type CreateBatchDTO struct {
+	Name             string           `json:"name" validate:"required,min=1,max=255"`
+	Code             *string          `json:"code,omitempty" validate:"required_without=Count,excluded_with=Count,omitempty,min=4,max=32,alphanum"`
+	Count            int64            `json:"count,omitempty" validate:"required_without=Code,omitempty,gt=0,lte=10000"`
+	Prefix           *string          `json:"prefix,omitempty" validate:"excluded_with=Code,omitempty,min=1,max=10,alphanum"`
+	RewardType       string           `json:"reward_type" validate:"required,oneof=internal_currency subscription_days shop_item"`
+	Amount           *decimal.Decimal `json:"amount,omitempty" validate:"required_if=RewardType internal_currency,excluded_unless=RewardType internal_currency"`
+	SubscriptionDays *int64           `json:"subscription_days,omitempty" validate:"required_if=RewardType subscription_days,excluded_unless=RewardType subscription_days,omitempty,gt=0,lte=3650"`
+	ShopItemID       *int64           `json:"shop_item_id,omitempty" validate:"required_if=RewardType shop_item,excluded_unless=RewardType shop_item,omitempty,gt=0"`
+	Quantity         *int64           `json:"quantity,omitempty" validate:"required_if=RewardType shop_item,excluded_unless=RewardType shop_item,omitempty,gt=0,lte=100"`
+	MaxRedemptions   *int64           `json:"max_redemptions,omitempty" validate:"omitempty,gt=0"`
+	PerUserLimit     int64            `json:"per_user_limit,omitempty" validate:"omitempty,gt=0"`
+	ExpiresAt        *time.Time       `json:"expires_at,omitempty"`
+}
+
+// CreateBatchResponse represents created batch with its codes.
+type CreateBatchResponse struct {
+	Batch Batch    `json:"batch"`
+	Codes []string `json:"codes"`
+}
+
+//
+// REDEEM
+//
+
+type RedeemDTO struct {
+	TelegramID string `json:"telegram_id" validate:"required,min=1"`
+	Code       string `json:"code" validate:"required,min=4,max=64"`
+}
+
+// RedeemResult represents promo code redemption result returned by the database.
+type RedeemResult struct {
+	Status     string      `json:"status"`
+	Redemption *Redemption `json:"redemption,omitempty"`
+}
+
+//
+// SWAGGER
+//
+
+type CreateBatchSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		Batch struct {
+			ID             int64      `json:"id" example:"1"`
+			Name           string     `json:"name" example:"Осенняя рассылка"`
+			RewardType     string     `json:"reward_type" example:"internal_currency"`
+			Amount         *string    `json:"amount,omitempty" example:"100"`
+			MaxRedemptions *int64     `json:"max_redemptions,omitempty" example:"1"`
+			PerUserLimit   int64      `json:"per_user_limit" example:"1"`
+			ExpiresAt      *time.Time `json:"expires_at,omitempty" example:"2025-12-31T23:59:59Z"`
+			IsActive       bool       `json:"is_active" example:"true"`
+			CreatedAt      time.Time  `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt      time.Time  `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"batch"`
+		Codes []string `json:"codes" example:"AUTUMN7K2M9QXR,AUTUMNP4T8WZ3H"`
+	} `json:"data"`
+}
+
+type RedemptionSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID               int64     `json:"id" example:"1"`
+		PromoCodeID      int64     `json:"promo_code_id" example:"1"`
+		BatchID          int64     `json:"batch_id" example:"1"`
+		Code             string    `json:"code" example:"PREMIUM7"`
+		TelegramID       string    `json:"telegram_id" example:"1"`
+		RewardType       string    `json:"reward_type" example:"subscription_days"`
+		SubscriptionDays *int64    `json:"subscription_days,omitempty" example:"7"`
+		CreatedAt        time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ReportSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                 int64      `json:"id" example:"1"`
+		Name               string     `json:"name" example:"Приветственный бонус"`
+		RewardType         string     `json:"reward_type" example:"internal_currency"`
+		Amount             *string    `json:"amount,omitempty" example:"100"`
+		MaxRedemptions     *int64     `json:"max_redemptions,omitempty" example:"1000"`
+		PerUserLimit       int64      `json:"per_user_limit" example:"1"`
+		ExpiresAt          *time.Time `json:"expires_at,omitempty" example:"2025-12-31T23:59:59Z"`
+		IsActive           bool       `json:"is_active" example:"true"`
+		CreatedAt          time.Time  `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt          time.Time  `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		CodesCount         int64      `json:"codes_count" example:"1"`
+		RedeemedCodesCount int64      `json:"redeemed_codes_count" example:"1"`
+		RedemptionsCount   int64      `json:"redemptions_count" example:"420"`
+		UsersCount         int64      `json:"users_count" example:"420"`
+		AmountGranted      *string    `json:"amount_granted,omitempty" example:"42000"`
+		LastRedeemedAt     *time.Time `json:"last_redeemed_at,omitempty" example:"2025-09-10T18:00:00Z"`
+	} `json:"data"`
+}
+
+type AllCodesSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID               int64     `json:"id" example:"1"`
+		BatchID          int64     `json:"batch_id" example:"1"`
+		Code             string    `json:"code" example:"AUTUMN7K2M9QXR"`
+		RedemptionsCount int64     `json:"redemptions_count" example:"0"`
+		CreatedAt        time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt        time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
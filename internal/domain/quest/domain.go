@@ -0,0 +1,125 @@
+package quest
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CompletedEventType is the event type of balance transactions and xp events
+// created for completed quest rewards.
+const CompletedEventType = "quest_completed"
+
+const (
+	CadenceDaily  = "daily"
+	CadenceWeekly = "weekly"
+	CadenceOneOff = "one_off"
+)
+
+// Quest represents a quest that is assigned to users with its cadence.
+// Daily and weekly quests expire at the end of the user day/week,
+// one-off quests expire after duration days (never if duration days is not set).
+type Quest struct {
+	ID                     int64            `json:"id"`
+	Name                   string           `json:"name"`
+	Description            *string          `json:"description,omitempty"`
+	Cadence                string           `json:"cadence"`
+	WordsLearnedNeed       int64            `json:"words_learned_need"`
+	TasksCompletedNeed     int64            `json:"tasks_completed_need"`
+	LessonsFinishedNeed    int64            `json:"lessons_finished_need"`
+	WordsTranslateNeed     int64            `json:"words_translate_need"`
+	DialogCompletedNeed    int64            `json:"dialog_completed_need"`
+	ExperiencePointsNeed   int64            `json:"experience_points_need"`
+	RewardAmount           *decimal.Decimal `json:"reward_amount,omitempty"`
+	RewardExperiencePoints int64            `json:"reward_experience_points"`
+	DurationDays           *int64           `json:"duration_days,omitempty"`
+	IsActive               bool             `json:"is_active"`
+	CreatedAt              time.Time        `json:"created_at"`
+	UpdatedAt              time.Time        `json:"updated_at"`
+}
+
+//
+// CREATE
+//
+
+type CreateDTO struct {
+	Name                   string           `json:"name" validate:"required,min=1"`
+	Description            *string          `json:"description,omitempty" validate:"omitempty,min=1"`
+	Cadence                string           `json:"cadence" validate:"required,oneof=daily weekly one_off"`
+	WordsLearnedNeed       *int64           `json:"words_learned_need,omitempty" validate:"omitempty,gt=0"`
+	TasksCompletedNeed     *int64           `json:"tasks_completed_need,omitempty" validate:"omitempty,gt=0"`
+	LessonsFinishedNeed    *int64           `json:"lessons_finished_need,omitempty" validate:"omitempty,gt=0"`
+	WordsTranslateNeed     *int64           `json:"words_translate_need,omitempty" validate:"omitempty,gt=0"`
+	DialogCompletedNeed    *int64           `json:"dialog_completed_need,omitempty" validate:"omitempty,gt=0"`
+	ExperiencePointsNeed   *int64           `json:"experience_points_need,omitempty" validate:"omitempty,gt=0"`
+	RewardAmount           *decimal.Decimal `json:"reward_amount,omitempty"`
+	RewardExperiencePoints *int64           `json:"reward_experience_points,omitempty" validate:"omitempty,gt=0"`
+	DurationDays           *int64           `json:"duration_days,omitempty" validate:"excluded_unless=Cadence one_off,omitempty,gt=0,lte=365"`
+	IsActive               bool             `json:"is_active"`
+}
+
+// HasRequirements reports whether at least one requirement is set.
+func (dto CreateDTO) HasRequirements() bool {
+	return dto.WordsLearnedNeed != nil || dto.TasksCompletedNeed != nil ||
+		dto.LessonsFinishedNeed != nil || dto.WordsTranslateNeed != nil ||
+		dto.DialogCompletedNeed != nil || dto.ExperiencePointsNeed != nil
+}
+
+//
+// SWAGGER
+//
+
+type QuestSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID                     int64            `json:"id" example:"1"`
+		Name                   string           `json:"name" example:"Неделя практики"`
+		Description            *string          `json:"description,omitempty" example:"Пройдите 15 диалогов за неделю"`
+		Cadence                string           `json:"cadence" example:"weekly"`
+		WordsLearnedNeed       int64            `json:"words_learned_need" example:"0"`
+		TasksCompletedNeed     int64            `json:"tasks_completed_need" example:"0"`
+		LessonsFinishedNeed    int64            `json:"lessons_finished_need" example:"0"`
+		WordsTranslateNeed     int64            `json:"words_translate_need" example:"0"`
+		DialogCompletedNeed    int64            `json:"dialog_completed_need" example:"15"`
+		ExperiencePointsNeed   int64            `json:"experience_points_need" example:"0"`
+		RewardAmount           *decimal.Decimal `json:"reward_amount,omitempty" example:"50.00"`
+		RewardExperiencePoints int64            `json:"reward_experience_points" example:"50"`
+		DurationDays           *int64           `json:"duration_days,omitempty" example:"7"`
+		IsActive               bool             `json:"is_active" example:"true"`
+		CreatedAt              time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt              time.Time        `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type AllSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                     int64            `json:"id" example:"1"`
+		Name                   string           `json:"name" example:"Неделя практики"`
+		Description            *string          `json:"description,omitempty" example:"Пройдите 15 диалогов за неделю"`
+		Cadence                string           `json:"cadence" example:"weekly"`
+		WordsLearnedNeed       int64            `json:"words_learned_need" example:"0"`
+		TasksCompletedNeed     int64            `json:"tasks_completed_need" example:"0"`
+		LessonsFinishedNeed    int64            `json:"lessons_finished_need" example:"0"`
+		WordsTranslateNeed     int64            `json:"words_translate_need" example:"0"`
+		DialogCompletedNeed    int64            `json:"dialog_completed_need" example:"15"`
+		ExperiencePointsNeed   int64            `json:"experience_points_need" example:"0"`
+		RewardAmount           *decimal.Decimal `json:"reward_amount,omitempty" example:"50.00"`
+		RewardExperiencePoints int64            `json:"reward_experience_points" example:"50"`
+		DurationDays           *int64           `json:"duration_days,omitempty" example:"7"`
+		IsActive               bool             `json:"is_active" example:"true"`
+		CreatedAt              time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt              time.Time        `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
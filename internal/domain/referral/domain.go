@@ -0,0 +1,205 @@
+package referral
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RewardEventType event type of balance transactions created for referral rewards.
+const RewardEventType = "referral_reward"
+
+// StartParamPrefix prefix of Mini App start parameter with referral code (startapp=ref_<code>).
+const StartParamPrefix = "ref_"
+
+// CodeLength length of generated referral codes.
+const CodeLength = 8
+
+// Default rules of referral program, used when they are not set in config.
+// Invitee qualifies on reaching the level or the streak days, whichever comes first.
+const (
+	DefaultMilestoneLevel       = 2
+	DefaultMilestoneStreakDays  = 3
+	DefaultReferrerReward       = 100
+	DefaultInviteeReward        = 50
+	DefaultMaxRewardedReferrals = 50
+)
+
+// Statuses of referral.
+// Rejected referral will never be rewarded (banned user or referrer reached the limit of rewarded referrals).
+const (
+	StatusPending  = "pending"
+	StatusRewarded = "rewarded"
+	StatusRejected = "rejected"
+)
+
+// Statuses of getting referral code returned by the database.
+// Code taken means the generated code belongs to another user, so a new one has to be generated.
+const (
+	CodeStatusOK    = "ok"
+	CodeStatusTaken = "code_taken"
+)
+
+// Statuses of referral attribution returned by the database.
+const (
+	AttributeStatusAttributed        = "attributed"
+	AttributeStatusNotFound          = "not_found"
+	AttributeStatusSelfReferral      = "self_referral"
+	AttributeStatusReferrerBanned    = "referrer_banned"
+	AttributeStatusAlreadyAttributed = "already_attributed"
+)
+
+// Statuses of referral qualification returned by the database.
+const (
+	QualifyStatusRewarded     = "rewarded"
+	QualifyStatusRejected     = "rejected"
+	QualifyStatusNotQualified = "not_qualified"
+	QualifyStatusNotFound     = "not_found"
+)
+
+// Code represents referral code of a user.
+type Code struct {
+	ID         int64     `json:"id"`
+	TelegramID string    `json:"telegram_id"`
+	Code       string    `json:"code"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Referral represents a user invited by another user.
+type Referral struct {
+	ID                 int64            `json:"id"`
+	ReferrerTelegramID string           `json:"referrer_telegram_id"`
+	InviteeTelegramID  string           `json:"invitee_telegram_id"`
+	Code               string           `json:"code"`
+	Status             string           `json:"status"`
+	RejectReason       *string          `json:"reject_reason,omitempty"`
+	ReferrerReward     *decimal.Decimal `json:"referrer_reward,omitempty"`
+	InviteeReward      *decimal.Decimal `json:"invitee_reward,omitempty"`
+	RewardedAt         *time.Time       `json:"rewarded_at,omitempty"`
+	CreatedAt          time.Time        `json:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
+}
+
+// Reward represents internal currency reward of one side of a rewarded referral.
+type Reward struct {
+	TelegramID  string
+	Amount      decimal.Decimal
+	Description string
+}
+
+// Rewards returns positive rewards of the referrer and the invitee.
+func (r Referral) Rewards() []Reward {
+	rewards := make([]Reward, 0, 2)
+
+	if r.ReferrerReward != nil && r.ReferrerReward.IsPositive() {
+		rewards = append(rewards, Reward{
+			TelegramID:  r.ReferrerTelegramID,
+			Amount:      *r.ReferrerReward,
+			Description: "Награда за приглашение друга",
+		})
+	}
+
+	if r.InviteeReward != nil && r.InviteeReward.IsPositive() {
+		rewards = append(rewards, Reward{
+			TelegramID:  r.InviteeTelegramID,
+			Amount:      *r.InviteeReward,
+			Description: "Награда за регистрацию по приглашению",
+		})
+	}
+
+	return rewards
+}
+
+// CodeFromStartParam returns referral code of Mini App start parameter.
+func CodeFromStartParam(startParam string) (string, bool) {
+	code, ok := strings.CutPrefix(startParam, StartParamPrefix)
+	if !ok || code == "" {
+		return "", false
+	}
+
+	return code, true
+}
+
+//
+// GET CODE
+//
+
+// GetOrCreateCodeResult represents result of getting referral code returned by the database.
+type GetOrCreateCodeResult struct {
+	Status       string `json:"status"`
+	ReferralCode *Code  `json:"referral_code,omitempty"`
+}
+
+// CodeResponse represents referral code of the user and start parameter to share it.
+type CodeResponse struct {
+	Code       string `json:"code"`
+	StartParam string `json:"start_param"`
+}
+
+//
+// ATTRIBUTE
+//
+
+// AttributeResult represents referral attribution result returned by the database.
+type AttributeResult struct {
+	Status   string    `json:"status"`
+	Referral *Referral `json:"referral,omitempty"`
+}
+
+//
+// QUALIFY
+//
+
+// QualifyResult represents referral qualification result returned by the database.
+type QualifyResult struct {
+	Status   string    `json:"status"`
+	Referral *Referral `json:"referral,omitempty"`
+}
+
+//
+// STATS
+//
+
+// Stats represents referrals of the user, earned amount counts rewarded referrals only.
+type Stats struct {
+	InvitedCount  int64           `json:"invited_count"`
+	PendingCount  int64           `json:"pending_count"`
+	RewardedCount int64           `json:"rewarded_count"`
+	RejectedCount int64           `json:"rejected_count"`
+	EarnedAmount  decimal.Decimal `json:"earned_amount"`
+}
+
+//
+// SWAGGER
+//
+
+type CodeSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		Code       string `json:"code" example:"K7M2QX9P"`
+		StartParam string `json:"start_param" example:"ref_K7M2QX9P"`
+	} `json:"data"`
+}
+
+type StatsSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		InvitedCount  int64  `json:"invited_count" example:"5"`
+		PendingCount  int64  `json:"pending_count" example:"2"`
+		RewardedCount int64  `json:"rewarded_count" example:"3"`
+		RejectedCount int64  `json:"rejected_count" example:"0"`
+		EarnedAmount  string `json:"earned_amount" example:"300"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
@@ -0,0 +1,224 @@
+package shop
+
+import (
+	"time"
+
+	userinventory "github.com/go-jedi/lingramm_backend/internal/domain/user_inventory"
+	"github.com/shopspring/decimal"
+)
+
+// PurchaseEventType event type of balance transactions created for shop purchases.
+const PurchaseEventType = "shop_purchase"
+
+// Types of shop items.
+// Value of an item unit means boost minutes, streak freezes or premium days, frames have no value.
+const (
+	TypeBoost         = "boost"
+	TypeStreakFreeze  = "streak_freeze"
+	TypeCosmeticFrame = "cosmetic_frame"
+	TypePremiumDays   = "premium_days"
+)
+
+// Statuses of shop purchase returned by the database.
+const (
+	PurchaseStatusCreated             = "created"
+	PurchaseStatusDuplicate           = "duplicate"
+	PurchaseStatusIdempotencyConflict = "idempotency_conflict"
+	PurchaseStatusNotAvailable        = "not_available"
+	PurchaseStatusOutOfStock          = "out_of_stock"
+	PurchaseStatusLimitReached        = "limit_reached"
+)
+
+// ShopItem represents a shop catalog item.
+// Nil stock, per user limit and availability bounds mean no limit.
+// Nil price in Telegram Stars means the item is sold only for internal currency.
+type ShopItem struct {
+	ID             int64           `json:"id"`
+	Name           string          `json:"name"`
+	Description    *string         `json:"description,omitempty"`
+	Type           string          `json:"type"`
+	Value          *int64          `json:"value,omitempty"`
+	Price          decimal.Decimal `json:"price"`
+	PriceStars     *int64          `json:"price_stars,omitempty"`
+	Stock          *int64          `json:"stock,omitempty"`
+	PerUserLimit   *int64          `json:"per_user_limit,omitempty"`
+	AvailableFrom  *time.Time      `json:"available_from,omitempty"`
+	AvailableUntil *time.Time      `json:"available_until,omitempty"`
+	IsActive       bool            `json:"is_active"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// Purchase represents a shop purchase.
+type Purchase struct {
+	ID             int64           `json:"id"`
+	TelegramID     string          `json:"telegram_id"`
+	ShopItemID     int64           `json:"shop_item_id"`
+	Quantity       int64           `json:"quantity"`
+	UnitPrice      decimal.Decimal `json:"unit_price"`
+	TotalPrice     decimal.Decimal `json:"total_price"`
+	IdempotencyKey string          `json:"idempotency_key"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+//
+// CREATE
+//
+
+type CreateDTO struct {
+	Name           string          `json:"name" validate:"required,min=1,max=255"`
+	Description    *string         `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Type           string          `json:"type" validate:"required,oneof=boost streak_freeze cosmetic_frame premium_days"`
+	Value          *int64          `json:"value,omitempty" validate:"required_unless=Type cosmetic_frame,excluded_if=Type cosmetic_frame,omitempty,gt=0"`
+	Price          decimal.Decimal `json:"price" validate:"required"`
+	PriceStars     *int64          `json:"price_stars,omitempty" validate:"omitempty,gt=0"`
+	Stock          *int64          `json:"stock,omitempty" validate:"omitempty,gte=0"`
+	PerUserLimit   *int64          `json:"per_user_limit,omitempty" validate:"omitempty,gt=0"`
+	AvailableFrom  *time.Time      `json:"available_from,omitempty"`
+	AvailableUntil *time.Time      `json:"available_until,omitempty"`
+	IsActive       bool            `json:"is_active"`
+}
+
+// IsAvailabilityWindowValid reports whether availability window bounds are ordered.
+func (dto CreateDTO) IsAvailabilityWindowValid() bool {
+	return isAvailabilityWindowValid(dto.AvailableFrom, dto.AvailableUntil)
+}
+
+//
+// UPDATE
+//
+
+type UpdateDTO struct {
+	ID             int64           `json:"id" validate:"required,gt=0"`
+	Name           string          `json:"name" validate:"required,min=1,max=255"`
+	Description    *string         `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Type           string          `json:"type" validate:"required,oneof=boost streak_freeze cosmetic_frame premium_days"`
+	Value          *int64          `json:"value,omitempty" validate:"required_unless=Type cosmetic_frame,excluded_if=Type cosmetic_frame,omitempty,gt=0"`
+	Price          decimal.Decimal `json:"price" validate:"required"`
+	PriceStars     *int64          `json:"price_stars,omitempty" validate:"omitempty,gt=0"`
+	Stock          *int64          `json:"stock,omitempty" validate:"omitempty,gte=0"`
+	PerUserLimit   *int64          `json:"per_user_limit,omitempty" validate:"omitempty,gt=0"`
+	AvailableFrom  *time.Time      `json:"available_from,omitempty"`
+	AvailableUntil *time.Time      `json:"available_until,omitempty"`
+	IsActive       bool            `json:"is_active"`
+}
+
+// IsAvailabilityWindowValid reports whether availability window bounds are ordered.
+func (dto UpdateDTO) IsAvailabilityWindowValid() bool {
+	return isAvailabilityWindowValid(dto.AvailableFrom, dto.AvailableUntil)
+}
+
+func isAvailabilityWindowValid(from *time.Time, until *time.Time) bool {
+	return from == nil || until == nil || from.Before(*until)
+}
+
+//
+// PURCHASE
+//
+
+type PurchaseDTO struct {
+	TelegramID     string `json:"telegram_id" validate:"required,min=1"`
+	ShopItemID     int64  `json:"shop_item_id" validate:"required,gt=0"`
+	Quantity       int64  `json:"quantity" validate:"required,gt=0,lte=100"`
+	IdempotencyKey string `json:"idempotency_key" validate:"required,min=1,max=128"`
+}
+
+// PurchaseResult represents shop purchase result returned by the database.
+type PurchaseResult struct {
+	Status        string                       `json:"status"`
+	Purchase      *Purchase                    `json:"purchase,omitempty"`
+	InventoryItem *userinventory.InventoryItem `json:"inventory_item,omitempty"`
+}
+
+// PurchaseResponse represents shop purchase.
+// Is duplicate is true when the purchase was already made with the same idempotency key,
+// currency is debited only once.
+type PurchaseResponse struct {
+	Purchase      Purchase                    `json:"purchase"`
+	InventoryItem userinventory.InventoryItem `json:"inventory_item"`
+	IsDuplicate   bool                        `json:"is_duplicate"`
+}
+
+//
+// SWAGGER
+//
+
+type ShopItemSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID             int64           `json:"id" example:"1"`
+		Name           string          `json:"name" example:"Заморозка streak"`
+		Description    *string         `json:"description,omitempty" example:"Сохраняет streak, если вы пропустите день"`
+		Type           string          `json:"type" example:"streak_freeze"`
+		Value          *int64          `json:"value,omitempty" example:"1"`
+		Price          decimal.Decimal `json:"price" example:"50.00"`
+		PriceStars     *int64          `json:"price_stars,omitempty" example:"50"`
+		Stock          *int64          `json:"stock,omitempty" example:"100"`
+		PerUserLimit   *int64          `json:"per_user_limit,omitempty" example:"5"`
+		AvailableFrom  *time.Time      `json:"available_from,omitempty" example:"2025-09-01T00:00:00+03:00"`
+		AvailableUntil *time.Time      `json:"available_until,omitempty" example:"2025-10-01T00:00:00+03:00"`
+		IsActive       bool            `json:"is_active" example:"true"`
+		CreatedAt      time.Time       `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt      time.Time       `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type AllSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID             int64           `json:"id" example:"1"`
+		Name           string          `json:"name" example:"Заморозка streak"`
+		Description    *string         `json:"description,omitempty" example:"Сохраняет streak, если вы пропустите день"`
+		Type           string          `json:"type" example:"streak_freeze"`
+		Value          *int64          `json:"value,omitempty" example:"1"`
+		Price          decimal.Decimal `json:"price" example:"50.00"`
+		PriceStars     *int64          `json:"price_stars,omitempty" example:"50"`
+		Stock          *int64          `json:"stock,omitempty" example:"100"`
+		PerUserLimit   *int64          `json:"per_user_limit,omitempty" example:"5"`
+		AvailableFrom  *time.Time      `json:"available_from,omitempty" example:"2025-09-01T00:00:00+03:00"`
+		AvailableUntil *time.Time      `json:"available_until,omitempty" example:"2025-10-01T00:00:00+03:00"`
+		IsActive       bool            `json:"is_active" example:"true"`
+		CreatedAt      time.Time       `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt      time.Time       `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type PurchaseSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		Purchase struct {
+			ID             int64           `json:"id" example:"1"`
+			TelegramID     string          `json:"telegram_id" example:"1"`
+			ShopItemID     int64           `json:"shop_item_id" example:"1"`
+			Quantity       int64           `json:"quantity" example:"1"`
+			UnitPrice      decimal.Decimal `json:"unit_price" example:"50.00"`
+			TotalPrice     decimal.Decimal `json:"total_price" example:"50.00"`
+			IdempotencyKey string          `json:"idempotency_key" example:"3f1c2a9e-6b1d-4c1e-9a5e-2f6e1b7c8d90"`
+			CreatedAt      time.Time       `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"purchase"`
+		InventoryItem struct {
+			ID               int64     `json:"id" example:"1"`
+			TelegramID       string    `json:"telegram_id" example:"1"`
+			ShopItemID       int64     `json:"shop_item_id" example:"1"`
+			ShopPurchaseID   int64     `json:"shop_purchase_id" example:"1"`
+			Quantity         int64     `json:"quantity" example:"1"`
+			ConsumedQuantity int64     `json:"consumed_quantity" example:"0"`
+			CreatedAt        time.Time `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+			UpdatedAt        time.Time `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		} `json:"inventory_item"`
+		IsDuplicate bool `json:"is_duplicate" example:"false"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
@@ -0,0 +1,115 @@
+package streakprotection
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Event types of balance transactions created for streak protection purchases.
+// Prices are configured by amount of these event types.
+const (
+	FreezePurchaseEventType = "streak_freeze_purchase"
+	RepairPurchaseEventType = "streak_repair_purchase"
+)
+
+// Actions of streak protection history.
+const (
+	ActionFreezePurchase = "freeze_purchase"
+	ActionFreezeReward   = "freeze_reward"
+	ActionFreezeConsume  = "freeze_consume"
+	ActionRepair         = "repair"
+)
+
+// StreakProtection represents streak protection state of a user.
+// Freezes are consumed automatically for missed days, lost streak
+// can be repaired only until repair available until.
+type StreakProtection struct {
+	StreakDays           int64      `json:"streak_days"`
+	StreakFreezes        int64      `json:"streak_freezes"`
+	MaxStreakFreezes     int64      `json:"max_streak_freezes"`
+	LostStreakDays       int64      `json:"lost_streak_days"`
+	IsRepairAvailable    bool       `json:"is_repair_available"`
+	RepairAvailableUntil *time.Time `json:"repair_available_until,omitempty"`
+}
+
+// History represents streak protection history record.
+type History struct {
+	ID            int64            `json:"id"`
+	TelegramID    string           `json:"telegram_id"`
+	Action        string           `json:"action"`
+	Quantity      int64            `json:"quantity"`
+	ProtectedDate *time.Time       `json:"protected_date,omitempty"`
+	StreakDays    int64            `json:"streak_days"`
+	Price         *decimal.Decimal `json:"price,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+//
+// ADD FREEZES
+//
+
+type AddFreezesDTO struct {
+	TelegramID string           `json:"telegram_id"`
+	Quantity   int64            `json:"quantity"`
+	Action     string           `json:"action"`
+	Price      *decimal.Decimal `json:"price,omitempty"`
+}
+
+//
+// BUY FREEZE
+//
+
+type BuyFreezeDTO struct {
+	TelegramID string `json:"telegram_id" validate:"required,min=1"`
+	Quantity   int64  `json:"quantity" validate:"required,gt=0"`
+}
+
+//
+// REPAIR
+//
+
+type RepairDTO struct {
+	TelegramID string `json:"telegram_id" validate:"required,min=1"`
+}
+
+//
+// SWAGGER
+//
+
+type StreakProtectionSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		StreakDays           int64      `json:"streak_days" example:"12"`
+		StreakFreezes        int64      `json:"streak_freezes" example:"1"`
+		MaxStreakFreezes     int64      `json:"max_streak_freezes" example:"2"`
+		LostStreakDays       int64      `json:"lost_streak_days" example:"0"`
+		IsRepairAvailable    bool       `json:"is_repair_available" example:"false"`
+		RepairAvailableUntil *time.Time `json:"repair_available_until,omitempty" example:"2025-09-03T00:00:00Z"`
+	} `json:"data"`
+}
+
+type AllHistorySwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID            int64            `json:"id" example:"1"`
+		TelegramID    string           `json:"telegram_id" example:"1"`
+		Action        string           `json:"action" example:"freeze_consume"`
+		Quantity      int64            `json:"quantity" example:"1"`
+		ProtectedDate *time.Time       `json:"protected_date,omitempty" example:"2025-09-01T00:00:00Z"`
+		StreakDays    int64            `json:"streak_days" example:"12"`
+		Price         *decimal.Decimal `json:"price,omitempty" example:"50.00"`
+		CreatedAt     time.Time        `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
@@ -1,16 +1,54 @@
 package subscription
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// Codes of subscription plans.
+const (
+	PlanCodeTrial   = "trial"
+	PlanCodeMonthly = "monthly"
+	PlanCodeYearly  = "yearly"
+)
+
+// Codes of entitlements granted by subscription plans.
+const (
+	EntitlementPremiumContent = "premium_content"
+	EntitlementStreakRepair   = "streak_repair"
+)
+
+// PremiumRequiredCode is the code of error returned when the user has no entitlement,
+// client shows subscription offer by it.
+const PremiumRequiredCode = "premium_required"
+
+// Actions of subscription history.
+const (
+	ActionSubscribe = "subscribe"
+	ActionExtend    = "extend"
+	ActionExpire    = "expire"
+	ActionRefund    = "refund"
+)
 
 // Subscription represents a subscription in the system.
+// Access is kept until grace expires at, plan is the plan of the last subscribe.
 type Subscription struct {
-	ID           int64      `json:"id"`
-	TelegramID   string     `json:"telegram_id"`
-	SubscribedAt *time.Time `json:"subscribed_at"`
-	ExpiresAt    *time.Time `json:"expires_at"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
-	IsActive     bool       `json:"is_active"`
+	ID             int64      `json:"id"`
+	TelegramID     string     `json:"telegram_id"`
+	SubscribedAt   *time.Time `json:"subscribed_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	IsActive       bool       `json:"is_active"`
+	PlanID         *int64     `json:"plan_id,omitempty"`
+	GraceExpiresAt *time.Time `json:"grace_expires_at,omitempty"`
+	ReminderSentAt *time.Time `json:"reminder_sent_at,omitempty"`
+	TrialUsedAt    *time.Time `json:"trial_used_at,omitempty"`
+}
+
+// IsTrialUsed reports whether the user has already had a trial.
+func (s Subscription) IsTrialUsed() bool {
+	return s.TrialUsedAt != nil
 }
 
 // History represents a subscription history in the system.
@@ -21,6 +59,97 @@ type History struct {
 	ExpiresAt  time.Time `json:"expires_at"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+	Action     string    `json:"action"`
+	PlanID     *int64    `json:"plan_id,omitempty"`
+}
+
+// Plan represents a subscription plan.
+// Grace period days is how long access is kept after the subscription expires.
+type Plan struct {
+	ID              int64         `json:"id"`
+	Code            string        `json:"code"`
+	Name            string        `json:"name"`
+	DurationDays    int64         `json:"duration_days"`
+	GracePeriodDays int64         `json:"grace_period_days"`
+	PriceStars      *int64        `json:"price_stars,omitempty"`
+	IsTrial         bool          `json:"is_trial"`
+	IsActive        bool          `json:"is_active"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+	Entitlements    []Entitlement `json:"entitlements"`
+}
+
+// Entitlement represents a feature granted by a subscription plan.
+// Limit is the maximum usage of the feature, nil means unlimited.
+type Entitlement struct {
+	Code  string `json:"code"`
+	Limit *int64 `json:"limit,omitempty"`
+}
+
+// Snapshot represents subscription state of a user with entitlements of the plan.
+// Snapshot is cached, so access is checked by access until instead of is active.
+type Snapshot struct {
+	TelegramID   string        `json:"telegram_id"`
+	IsActive     bool          `json:"is_active"`
+	PlanCode     *string       `json:"plan_code,omitempty"`
+	ExpiresAt    *time.Time    `json:"expires_at,omitempty"`
+	AccessUntil  *time.Time    `json:"access_until,omitempty"`
+	Entitlements []Entitlement `json:"entitlements"`
+}
+
+// HasAccess reports whether the subscription gives access at the moment.
+func (s Snapshot) HasAccess(now time.Time) bool {
+	return s.IsActive && s.AccessUntil != nil && now.Before(*s.AccessUntil)
+}
+
+// Entitlement returns entitlement of the plan by code if the subscription gives access.
+func (s Snapshot) Entitlement(code string, now time.Time) (Entitlement, bool) {
+	if !s.HasAccess(now) {
+		return Entitlement{}, false
+	}
+
+	for i := range s.Entitlements {
+		if s.Entitlements[i].Code == code {
+			return s.Entitlements[i], true
+		}
+	}
+
+	return Entitlement{}, false
+}
+
+// PremiumRequired represents data of error returned when the user has no entitlement.
+type PremiumRequired struct {
+	Code        string     `json:"code"`
+	Entitlement string     `json:"entitlement"`
+	PlanCode    *string    `json:"plan_code,omitempty"`
+	AccessUntil *time.Time `json:"access_until,omitempty"`
+}
+
+// PremiumRequired returns data of error returned when the subscription does not grant the entitlement.
+func (s Snapshot) PremiumRequired(entitlement string) PremiumRequired {
+	return PremiumRequired{
+		Code:        PremiumRequiredCode,
+		Entitlement: entitlement,
+		PlanCode:    s.PlanCode,
+		AccessUntil: s.AccessUntil,
+	}
+}
+
+// Expiring represents a subscription that is about to expire or has expired.
+type Expiring struct {
+	TelegramID string    `json:"telegram_id"`
+	PlanID     *int64    `json:"plan_id,omitempty"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// ReminderText returns text of the notification sent before the subscription expires.
+func (e Expiring) ReminderText() string {
+	return fmt.Sprintf("Ваша подписка заканчивается %s. Продлите её, чтобы сохранить доступ", e.ExpiresAt.Format("02.01.2006"))
+}
+
+// ExpiredText returns text of the notification sent when the subscription has expired.
+func (e Expiring) ExpiredText() string {
+	return "Ваша подписка закончилась. Оформите её снова, чтобы вернуть доступ"
 }
 
 //
@@ -33,6 +162,32 @@ type CreateSubscriptionHistoryDTO struct {
 	ExpiresAt  *time.Time `json:"expires_at"`
 }
 
+//
+// SUBSCRIBE
+//
+
+type SubscribeDTO struct {
+	TelegramID string `json:"telegram_id" validate:"required,min=1"`
+	PlanCode   string `json:"plan_code" validate:"required,oneof=trial monthly yearly"`
+}
+
+//
+// EXPIRE
+//
+
+type ExpireDTO struct {
+	Limit int64
+}
+
+//
+// REMIND
+//
+
+type RemindDTO struct {
+	RemindBeforeHours int64
+	Limit             int64
+}
+
 //
 // SWAGGER
 //
@@ -42,13 +197,68 @@ type GetByTelegramIDSwaggerResponse struct {
 	Message string `json:"message" example:"success"`
 	Error   string `json:"error" example:""`
 	Data    struct {
-		ID           int64      `json:"id" example:"1"`
+		ID             int64      `json:"id" example:"1"`
+		TelegramID     string     `json:"telegram_id" example:"1"`
+		SubscribedAt   *time.Time `json:"subscribed_at" example:"2025-09-02T15:30:20.095307198+03:00"`
+		ExpiresAt      *time.Time `json:"expires_at" example:"2025-09-02T15:30:20.095307198+03:00"`
+		CreatedAt      time.Time  `json:"created_at" example:"2025-09-02T15:30:20.095307198+03:00"`
+		UpdatedAt      time.Time  `json:"updated_at" example:"2025-09-02T15:30:20.095307198+03:00"`
+		IsActive       bool       `json:"is_active" example:"true"`
+		PlanID         *int64     `json:"plan_id,omitempty" example:"2"`
+		GraceExpiresAt *time.Time `json:"grace_expires_at,omitempty" example:"2025-09-05T15:30:20.095307198+03:00"`
+		ReminderSentAt *time.Time `json:"reminder_sent_at,omitempty" example:"2025-09-01T15:30:20.095307198+03:00"`
+		TrialUsedAt    *time.Time `json:"trial_used_at,omitempty" example:"2025-08-01T15:30:20.095307198+03:00"`
+	} `json:"data"`
+}
+
+type AllPlansSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID              int64     `json:"id" example:"2"`
+		Code            string    `json:"code" example:"monthly"`
+		Name            string    `json:"name" example:"Подписка на месяц"`
+		DurationDays    int64     `json:"duration_days" example:"30"`
+		GracePeriodDays int64     `json:"grace_period_days" example:"3"`
+		PriceStars      *int64    `json:"price_stars,omitempty" example:"250"`
+		IsTrial         bool      `json:"is_trial" example:"false"`
+		IsActive        bool      `json:"is_active" example:"true"`
+		CreatedAt       time.Time `json:"created_at" example:"2025-09-02T15:30:20.095307198+03:00"`
+		UpdatedAt       time.Time `json:"updated_at" example:"2025-09-02T15:30:20.095307198+03:00"`
+		Entitlements    []struct {
+			Code  string `json:"code" example:"streak_repair"`
+			Limit *int64 `json:"limit,omitempty" example:"3"`
+		} `json:"entitlements"`
+	} `json:"data"`
+}
+
+type SnapshotSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
 		TelegramID   string     `json:"telegram_id" example:"1"`
-		SubscribedAt *time.Time `json:"subscribed_at" example:"2025-09-02T15:30:20.095307198+03:00"`
-		ExpiresAt    *time.Time `json:"expires_at" example:"2025-09-02T15:30:20.095307198+03:00"`
-		CreatedAt    time.Time  `json:"created_at" example:"2025-09-02T15:30:20.095307198+03:00"`
-		UpdatedAt    time.Time  `json:"updated_at" example:"2025-09-02T15:30:20.095307198+03:00"`
 		IsActive     bool       `json:"is_active" example:"true"`
+		PlanCode     *string    `json:"plan_code,omitempty" example:"monthly"`
+		ExpiresAt    *time.Time `json:"expires_at,omitempty" example:"2025-10-02T15:30:20.095307198+03:00"`
+		AccessUntil  *time.Time `json:"access_until,omitempty" example:"2025-10-05T15:30:20.095307198+03:00"`
+		Entitlements []struct {
+			Code  string `json:"code" example:"streak_repair"`
+			Limit *int64 `json:"limit,omitempty" example:"3"`
+		} `json:"entitlements"`
+	} `json:"data"`
+}
+
+type PremiumRequiredSwaggerResponse struct {
+	Status  bool   `json:"status" example:"false"`
+	Message string `json:"message" example:"premium required"`
+	Error   string `json:"error" example:"premium subscription required: streak_repair"`
+	Data    struct {
+		Code        string     `json:"code" example:"premium_required"`
+		Entitlement string     `json:"entitlement" example:"streak_repair"`
+		PlanCode    *string    `json:"plan_code,omitempty" example:"trial"`
+		AccessUntil *time.Time `json:"access_until,omitempty" example:"2025-09-02T15:30:20.095307198+03:00"`
 	} `json:"data"`
 }
 
@@ -3,14 +3,17 @@ package user
 import "time"
 
 // User represents a user in the system.
+// Timezone is an IANA timezone, day and week boundaries of streaks and daily tasks are calculated in it.
 type User struct {
-	ID         int64     `json:"id"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
-	TelegramID string    `json:"telegram_id"`
-	Username   string    `json:"username"`
-	FirstName  string    `json:"first_name"`
-	LastName   string    `json:"last_name"`
+	ID               int64     `json:"id"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	TelegramID       string    `json:"telegram_id"`
+	Username         string    `json:"username"`
+	FirstName        string    `json:"first_name"`
+	LastName         string    `json:"last_name"`
+	Timezone         string    `json:"timezone"`
+	IsTimezoneManual bool      `json:"is_timezone_manual"`
 }
 
 //
@@ -23,6 +26,19 @@ type CreateDTO struct {
 	Username   string `json:"username"`
 	FirstName  string `json:"first_name"`
 	LastName   string `json:"last_name"`
+	Timezone   string `json:"timezone,omitempty"`
+}
+
+//
+// UPDATE TIMEZONE
+//
+
+// UpdateTimezoneDTO represents the data required to update a user timezone.
+// IsManual is set by the service: timezone chosen by the user is not overwritten at sign-in.
+type UpdateTimezoneDTO struct {
+	TelegramID string `json:"telegram_id" validate:"required,min=1"`
+	Timezone   string `json:"timezone" validate:"required,timezone"`
+	IsManual   bool   `json:"-"`
 }
 
 //
@@ -34,13 +50,15 @@ type CreateDailyTaskSwaggerResponse struct {
 	Message string `json:"message" example:"success"`
 	Error   string `json:"error" example:""`
 	Data    struct {
-		ID         int64     `json:"id" example:"1"`
-		CreatedAt  time.Time `json:"created_at" example:"2025-09-02T15:30:20.095307198+03:00"`
-		UpdatedAt  time.Time `json:"updated_at" example:"2025-09-02T15:30:20.095307198+03:00"`
-		TelegramID string    `json:"telegram_id" example:"1"`
-		Username   string    `json:"username" example:"some username"`
-		FirstName  string    `json:"first_name" example:"some first name"`
-		LastName   string    `json:"last_name" example:"some last name"`
+		ID               int64     `json:"id" example:"1"`
+		CreatedAt        time.Time `json:"created_at" example:"2025-09-02T15:30:20.095307198+03:00"`
+		UpdatedAt        time.Time `json:"updated_at" example:"2025-09-02T15:30:20.095307198+03:00"`
+		TelegramID       string    `json:"telegram_id" example:"1"`
+		Username         string    `json:"username" example:"some username"`
+		FirstName        string    `json:"first_name" example:"some first name"`
+		LastName         string    `json:"last_name" example:"some last name"`
+		Timezone         string    `json:"timezone" example:"Europe/Moscow"`
+		IsTimezoneManual bool      `json:"is_timezone_manual" example:"false"`
 	} `json:"data"`
 }
 
@@ -1,21 +1,172 @@
 package userachievement
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	"github.com/go-jedi/lingramm_backend/internal/domain/notification"
+	"github.com/shopspring/decimal"
+)
 
 // Detail represents user achievement detail in the system.
+// Tier chains are collapsed to the highest unlocked tier.
 type Detail struct {
-	ID                  int64  `json:"id"`
-	TelegramID          string `json:"telegram_id"`
-	Name                string `json:"name"`
-	Description         string `json:"description"`
-	AchievementPathFile string `json:"achievement_path_file"`
-	AwardPathFile       string `json:"award_path_file"`
+	ID                  int64   `json:"id"`
+	TelegramID          string  `json:"telegram_id"`
+	Name                string  `json:"name"`
+	Description         string  `json:"description"`
+	AchievementPathFile string  `json:"achievement_path_file"`
+	AwardPathFile       string  `json:"award_path_file"`
+	TierGroup           *string `json:"tier_group,omitempty"`
+	TierLevel           *int64  `json:"tier_level,omitempty"`
+	TierCount           *int64  `json:"tier_count,omitempty"`
 }
 
 type UnlockAvailableAchievementsResponse struct {
-	AchievementID   int64     `json:"achievement_id"`
-	AchievementName string    `json:"achievement_name"`
-	UnlockedAt      time.Time `json:"unlocked_at"`
+	AchievementID   int64        `json:"achievement_id"`
+	AchievementName string       `json:"achievement_name"`
+	UnlockedAt      time.Time    `json:"unlocked_at"`
+	Rewards         []UserReward `json:"rewards"`
+}
+
+// NotificationText returns notification text for the unlocked achievement.
+func (r UnlockAvailableAchievementsResponse) NotificationText() string {
+	if len(r.Rewards) == 0 {
+		return fmt.Sprintf("Поздравляем! Вы получили достижение «%s»! Так держать!", r.AchievementName)
+	}
+
+	parts := make([]string, 0, len(r.Rewards))
+	for i := range r.Rewards {
+		switch r.Rewards[i].Type {
+		case achievement.RewardTypeInternalCurrency:
+			parts = append(parts, fmt.Sprintf("%s на баланс", r.Rewards[i].Amount.StringFixed(2)))
+		case achievement.RewardTypeExperiencePoints:
+			parts = append(parts, fmt.Sprintf("%d опыта", *r.Rewards[i].ExperiencePoints))
+		case achievement.RewardTypeSubscriptionDays:
+			parts = append(parts, fmt.Sprintf("%d дн. подписки", *r.Rewards[i].SubscriptionDays))
+		case achievement.RewardTypeStreakFreezes:
+			parts = append(parts, fmt.Sprintf("%d шт. заморозки streak", *r.Rewards[i].StreakFreezes))
+		}
+	}
+
+	return fmt.Sprintf("Поздравляем! Вы получили достижение «%s»! Награда: %s!", r.AchievementName, strings.Join(parts, ", "))
+}
+
+// HasSubscriptionDays reports whether rewards of the unlocked achievement extend subscription.
+func (r UnlockAvailableAchievementsResponse) HasSubscriptionDays() bool {
+	for i := range r.Rewards {
+		if r.Rewards[i].Type == achievement.RewardTypeSubscriptionDays {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AnyHasSubscriptionDays reports whether rewards of any unlocked achievement extend subscription.
+func AnyHasSubscriptionDays(unlocked []UnlockAvailableAchievementsResponse) bool {
+	for i := range unlocked {
+		if unlocked[i].HasSubscriptionDays() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasExperiencePoints reports whether rewards of the unlocked achievement grant experience points.
+func (r UnlockAvailableAchievementsResponse) HasExperiencePoints() bool {
+	for i := range r.Rewards {
+		if r.Rewards[i].Type == achievement.RewardTypeExperiencePoints {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AnyHasExperiencePoints reports whether rewards of any unlocked achievement grant experience points.
+func AnyHasExperiencePoints(unlocked []UnlockAvailableAchievementsResponse) bool {
+	for i := range unlocked {
+		if unlocked[i].HasExperiencePoints() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotificationRewards returns granted rewards for the notification payload.
+func (r UnlockAvailableAchievementsResponse) NotificationRewards() []notification.Reward {
+	if len(r.Rewards) == 0 {
+		return nil
+	}
+
+	result := make([]notification.Reward, 0, len(r.Rewards))
+	for i := range r.Rewards {
+		result = append(result, notification.Reward{
+			Type:             r.Rewards[i].Type,
+			Amount:           r.Rewards[i].Amount,
+			ExperiencePoints: r.Rewards[i].ExperiencePoints,
+			SubscriptionDays: r.Rewards[i].SubscriptionDays,
+			StreakFreezes:    r.Rewards[i].StreakFreezes,
+		})
+	}
+
+	return result
+}
+
+// UserReward represents an achievement reward granted to a user.
+// Experience points, subscription days and streak freezes are applied by the database,
+// internal currency is accrued by the service.
+type UserReward struct {
+	ID                  int64            `json:"id"`
+	TelegramID          string           `json:"telegram_id"`
+	UserAchievementID   int64            `json:"user_achievement_id"`
+	AchievementID       int64            `json:"achievement_id"`
+	AchievementRewardID *int64           `json:"achievement_reward_id,omitempty"`
+	Type                string           `json:"type"`
+	Amount              *decimal.Decimal `json:"amount,omitempty"`
+	ExperiencePoints    *int64           `json:"experience_points,omitempty"`
+	SubscriptionDays    *int64           `json:"subscription_days,omitempty"`
+	StreakFreezes       *int64           `json:"streak_freezes,omitempty"`
+	GrantedAt           time.Time        `json:"granted_at"`
+}
+
+//
+// ALL PROGRESS BY TELEGRAM ID
+//
+
+// Progress represents how close a user is to an achievement.
+// Secret achievements are shown as "???" until unlocked and
+// tier chains are shown as a single badge (the next tier to unlock).
+type Progress struct {
+	AchievementID     int64               `json:"achievement_id"`
+	Name              string              `json:"name"`
+	Description       *string             `json:"description,omitempty"`
+	AchievementType   string              `json:"achievement_type"`
+	IsSecret          bool                `json:"is_secret"`
+	IsUnlocked        bool                `json:"is_unlocked"`
+	UnlockedAt        *time.Time          `json:"unlocked_at,omitempty"`
+	IsAvailable       bool                `json:"is_available"`
+	AvailableFrom     *time.Time          `json:"available_from,omitempty"`
+	AvailableTo       *time.Time          `json:"available_to,omitempty"`
+	TierGroup         *string             `json:"tier_group,omitempty"`
+	TierLevel         *int64              `json:"tier_level,omitempty"`
+	TierCount         *int64              `json:"tier_count,omitempty"`
+	TierUnlockedCount *int64              `json:"tier_unlocked_count,omitempty"`
+	OverallPercent    float64             `json:"overall_percent"`
+	Criteria          []ProgressCriterion `json:"criteria"`
+}
+
+// ProgressCriterion represents progress of one achievement type threshold (*_need).
+type ProgressCriterion struct {
+	Metric  string  `json:"metric"`
+	Current int64   `json:"current"`
+	Need    int64   `json:"need"`
+	Percent float64 `json:"percent"`
 }
 
 //
@@ -27,12 +178,44 @@ type AllDetailByTelegramIDSwaggerResponse struct {
 	Message string `json:"message" example:"success"`
 	Error   string `json:"error" example:""`
 	Data    []struct {
-		ID                  int64  `json:"id" example:"1"`
-		TelegramID          string `json:"telegram_id" example:"1"`
-		Name                string `json:"name" example:"some name"`
-		Description         string `json:"description" example:"some description"`
-		AchievementPathFile string `json:"achievement_path_file" example:"/images/achievement/01K44X76FBXJYK4D153WHZFXH7.webp"`
-		AwardPathFile       string `json:"award_path_file" example:"/images/award/01K44X76GAFBZBJ1W1WX4NSJT4.webp"`
+		ID                  int64   `json:"id" example:"1"`
+		TelegramID          string  `json:"telegram_id" example:"1"`
+		Name                string  `json:"name" example:"some name"`
+		Description         string  `json:"description" example:"some description"`
+		AchievementPathFile string  `json:"achievement_path_file" example:"/images/achievement/01K44X76FBXJYK4D153WHZFXH7.webp"`
+		AwardPathFile       string  `json:"award_path_file" example:"/images/award/01K44X76GAFBZBJ1W1WX4NSJT4.webp"`
+		TierGroup           *string `json:"tier_group,omitempty" example:"words_learned"`
+		TierLevel           *int64  `json:"tier_level,omitempty" example:"2"`
+		TierCount           *int64  `json:"tier_count,omitempty" example:"3"`
+	} `json:"data"`
+}
+
+type AllProgressByTelegramIDSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		AchievementID     int64      `json:"achievement_id" example:"2"`
+		Name              string     `json:"name" example:"50 слов"`
+		Description       *string    `json:"description,omitempty" example:"some description"`
+		AchievementType   string     `json:"achievement_type" example:"words_learned_50"`
+		IsSecret          bool       `json:"is_secret" example:"false"`
+		IsUnlocked        bool       `json:"is_unlocked" example:"false"`
+		UnlockedAt        *time.Time `json:"unlocked_at,omitempty" example:"2025-09-02T12:48:06.37622+03:00"`
+		IsAvailable       bool       `json:"is_available" example:"true"`
+		AvailableFrom     *time.Time `json:"available_from,omitempty" example:"2025-12-20T00:00:00+03:00"`
+		AvailableTo       *time.Time `json:"available_to,omitempty" example:"2026-01-10T00:00:00+03:00"`
+		TierGroup         *string    `json:"tier_group,omitempty" example:"words_learned"`
+		TierLevel         *int64     `json:"tier_level,omitempty" example:"2"`
+		TierCount         *int64     `json:"tier_count,omitempty" example:"3"`
+		TierUnlockedCount *int64     `json:"tier_unlocked_count,omitempty" example:"1"`
+		OverallPercent    float64    `json:"overall_percent" example:"74"`
+		Criteria          []struct {
+			Metric  string  `json:"metric" example:"words_learned"`
+			Current int64   `json:"current" example:"37"`
+			Need    int64   `json:"need" example:"50"`
+			Percent float64 `json:"percent" example:"74"`
+		} `json:"criteria"`
 	} `json:"data"`
 }
 
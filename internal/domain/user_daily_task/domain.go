@@ -1,7 +1,27 @@
 package userdailytask
 
 import (
+	"fmt"
+	"strings"
 	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	"github.com/go-jedi/lingramm_backend/internal/domain/notification"
+	"github.com/shopspring/decimal"
+)
+
+// CompletedEventType event type with experience points and amount rewarded for a completed daily task.
+const CompletedEventType = "daily_task_completed"
+
+// Default tuning of adaptive daily task assignment, used when it is not set in config.
+// Difficulty score is a weighted average of user level and recent completion rate.
+const (
+	DefaultLevelWeight          = 0.6
+	DefaultCompletionRateWeight = 0.4
+	DefaultMaxLevel             = 20
+	DefaultCompletionRateDays   = 14
+	DefaultMediumThreshold      = 0.35
+	DefaultHardThreshold        = 0.7
 )
 
 type Requirements struct {
@@ -87,6 +107,60 @@ type SyncUserDailyTaskProgressDTO struct {
 	Actions    Actions `json:"actions"`
 }
 
+//
+// COMPLETE DAILY TASK BY TELEGRAM ID
+//
+
+// CompleteDailyTaskByTelegramIDResponse represents a daily task counted as completed today.
+// Experience points are applied by the database, amount is accrued by the service.
+type CompleteDailyTaskByTelegramIDResponse struct {
+	UserDailyTaskID     int64            `json:"user_daily_task_id"`
+	Date                time.Time        `json:"date"`
+	DailyTaskStreakDays int64            `json:"daily_task_streak_days"`
+	ExperiencePoints    int64            `json:"experience_points"`
+	Amount              *decimal.Decimal `json:"amount,omitempty"`
+}
+
+// NotificationText returns notification text for the completed daily task.
+func (r CompleteDailyTaskByTelegramIDResponse) NotificationText() string {
+	text := fmt.Sprintf("Ежедневное задание выполнено! Серия: %d дн.", r.DailyTaskStreakDays)
+
+	var parts []string
+	if r.Amount != nil {
+		parts = append(parts, fmt.Sprintf("%s на баланс", r.Amount.StringFixed(2)))
+	}
+	if r.ExperiencePoints > 0 {
+		parts = append(parts, fmt.Sprintf("%d опыта", r.ExperiencePoints))
+	}
+
+	if len(parts) == 0 {
+		return text
+	}
+
+	return fmt.Sprintf("%s Награда: %s!", text, strings.Join(parts, ", "))
+}
+
+// NotificationRewards returns granted rewards for the notification payload.
+func (r CompleteDailyTaskByTelegramIDResponse) NotificationRewards() []notification.Reward {
+	var result []notification.Reward
+
+	if r.Amount != nil {
+		result = append(result, notification.Reward{
+			Type:   achievement.RewardTypeInternalCurrency,
+			Amount: r.Amount,
+		})
+	}
+
+	if r.ExperiencePoints > 0 {
+		result = append(result, notification.Reward{
+			Type:             achievement.RewardTypeExperiencePoints,
+			ExperiencePoints: &r.ExperiencePoints,
+		})
+	}
+
+	return result
+}
+
 //
 // GET DAILY TASK WEEK SUMMARY BY TELEGRAM ID
 //
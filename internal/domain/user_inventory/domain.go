@@ -0,0 +1,118 @@
+package userinventory
+
+import "time"
+
+// Statuses of inventory item consumption returned by the database.
+const (
+	ConsumeStatusConsumed      = "consumed"
+	ConsumeStatusNotFound      = "not_found"
+	ConsumeStatusNotConsumable = "not_consumable"
+	ConsumeStatusNotEnough     = "not_enough"
+	ConsumeStatusLimitReached  = "limit_reached"
+)
+
+// BoostXPMultiplier multiplies experience points of events while a boost is active.
+const BoostXPMultiplier = 2
+
+// InventoryItem represents shop item units owned by a user.
+// Active until is set for activated boosts.
+type InventoryItem struct {
+	ID               int64      `json:"id"`
+	TelegramID       string     `json:"telegram_id"`
+	ShopItemID       int64      `json:"shop_item_id"`
+	ShopPurchaseID   int64      `json:"shop_purchase_id"`
+	Quantity         int64      `json:"quantity"`
+	ConsumedQuantity int64      `json:"consumed_quantity"`
+	ActiveUntil      *time.Time `json:"active_until,omitempty"`
+	ConsumedAt       *time.Time `json:"consumed_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// Item represents shop item data shown in the inventory.
+type Item struct {
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Type        string  `json:"type"`
+	Value       *int64  `json:"value,omitempty"`
+}
+
+// UserInventoryItem represents an inventory item with shop item data.
+type UserInventoryItem struct {
+	InventoryItem
+	RemainingQuantity int64 `json:"remaining_quantity"`
+	IsBoostActive     bool  `json:"is_boost_active"`
+	Item              Item  `json:"item"`
+}
+
+//
+// CONSUME
+//
+
+type ConsumeDTO struct {
+	TelegramID          string `json:"telegram_id" validate:"required,min=1"`
+	UserInventoryItemID int64  `json:"user_inventory_item_id" validate:"required,gt=0"`
+	Quantity            int64  `json:"quantity" validate:"required,gt=0,lte=100"`
+}
+
+type ConsumeResult struct {
+	Status        string         `json:"status"`
+	InventoryItem *InventoryItem `json:"inventory_item,omitempty"`
+}
+
+//
+// SWAGGER
+//
+
+type InventoryItemSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    struct {
+		ID               int64      `json:"id" example:"1"`
+		TelegramID       string     `json:"telegram_id" example:"1"`
+		ShopItemID       int64      `json:"shop_item_id" example:"1"`
+		ShopPurchaseID   int64      `json:"shop_purchase_id" example:"1"`
+		Quantity         int64      `json:"quantity" example:"2"`
+		ConsumedQuantity int64      `json:"consumed_quantity" example:"1"`
+		ActiveUntil      *time.Time `json:"active_until,omitempty" example:"2025-09-02T13:18:06.37622+03:00"`
+		ConsumedAt       *time.Time `json:"consumed_at,omitempty" example:"2025-09-02T12:48:06.37622+03:00"`
+		CreatedAt        time.Time  `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt        time.Time  `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type AllByTelegramIDSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                int64      `json:"id" example:"1"`
+		TelegramID        string     `json:"telegram_id" example:"1"`
+		ShopItemID        int64      `json:"shop_item_id" example:"1"`
+		ShopPurchaseID    int64      `json:"shop_purchase_id" example:"1"`
+		Quantity          int64      `json:"quantity" example:"2"`
+		ConsumedQuantity  int64      `json:"consumed_quantity" example:"1"`
+		ActiveUntil       *time.Time `json:"active_until,omitempty" example:"2025-09-02T13:18:06.37622+03:00"`
+		ConsumedAt        *time.Time `json:"consumed_at,omitempty" example:"2025-09-02T12:48:06.37622+03:00"`
+		CreatedAt         time.Time  `json:"created_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		UpdatedAt         time.Time  `json:"updated_at" example:"2025-09-02T12:48:06.37622+03:00"`
+		RemainingQuantity int64      `json:"remaining_quantity" example:"1"`
+		IsBoostActive     bool       `json:"is_boost_active" example:"true"`
+		Item              struct {
+			ID          int64   `json:"id" example:"2"`
+			Name        string  `json:"name" example:"Ускоритель на 30 минут"`
+			Description *string `json:"description,omitempty" example:"Ускоритель опыта, действует 30 минут после активации"`
+			Type        string  `json:"type" example:"boost"`
+			Value       *int64  `json:"value,omitempty" example:"30"`
+		} `json:"item"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
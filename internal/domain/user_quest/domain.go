@@ -0,0 +1,195 @@
+package userquest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	"github.com/go-jedi/lingramm_backend/internal/domain/notification"
+	"github.com/shopspring/decimal"
+)
+
+// Statuses of user quest.
+const (
+	StatusActive    = "active"
+	StatusCompleted = "completed"
+	StatusExpired   = "expired"
+)
+
+// UserQuest represents a quest assigned to a user with its progress.
+type UserQuest struct {
+	ID                     int64            `json:"id"`
+	QuestID                int64            `json:"quest_id"`
+	Name                   string           `json:"name"`
+	Description            *string          `json:"description,omitempty"`
+	Cadence                string           `json:"cadence"`
+	Status                 string           `json:"status"`
+	RewardAmount           *decimal.Decimal `json:"reward_amount,omitempty"`
+	RewardExperiencePoints int64            `json:"reward_experience_points"`
+	Requirements           Requirements     `json:"requirements"`
+	Progress               Progress         `json:"progress"`
+	ProgressPercent        ProgressPercent  `json:"progress_percent"`
+	AssignedAt             time.Time        `json:"assigned_at"`
+	ExpiresAt              *time.Time       `json:"expires_at,omitempty"`
+	CompletedAt            *time.Time       `json:"completed_at,omitempty"`
+}
+
+type Requirements struct {
+	WordsLearnedNeed     *int64 `json:"words_learned_need,omitempty"`
+	TasksCompletedNeed   *int64 `json:"tasks_completed_need,omitempty"`
+	LessonsFinishedNeed  *int64 `json:"lessons_finished_need,omitempty"`
+	WordsTranslateNeed   *int64 `json:"words_translate_need,omitempty"`
+	DialogCompletedNeed  *int64 `json:"dialog_completed_need,omitempty"`
+	ExperiencePointsNeed *int64 `json:"experience_points_need,omitempty"`
+}
+
+type Progress struct {
+	WordsLearned     *int64 `json:"words_learned,omitempty"`
+	TasksCompleted   *int64 `json:"tasks_completed,omitempty"`
+	LessonsFinished  *int64 `json:"lessons_finished,omitempty"`
+	WordsTranslate   *int64 `json:"words_translate,omitempty"`
+	DialogCompleted  *int64 `json:"dialog_completed,omitempty"`
+	ExperiencePoints *int64 `json:"experience_points,omitempty"`
+}
+
+type ProgressPercent struct {
+	WordsLearned     *int64 `json:"words_learned,omitempty"`
+	TasksCompleted   *int64 `json:"tasks_completed,omitempty"`
+	LessonsFinished  *int64 `json:"lessons_finished,omitempty"`
+	WordsTranslate   *int64 `json:"words_translate,omitempty"`
+	DialogCompleted  *int64 `json:"dialog_completed,omitempty"`
+	ExperiencePoints *int64 `json:"experience_points,omitempty"`
+}
+
+type Actions struct {
+	WordsLearned     *int64 `json:"words_learned,omitempty"`
+	TasksCompleted   *int64 `json:"tasks_completed,omitempty"`
+	LessonsFinished  *int64 `json:"lessons_finished,omitempty"`
+	WordsTranslate   *int64 `json:"words_translate,omitempty"`
+	DialogCompleted  *int64 `json:"dialog_completed,omitempty"`
+	ExperiencePoints *int64 `json:"experience_points,omitempty"`
+}
+
+//
+// ALL BY TELEGRAM ID
+//
+
+type AllByTelegramIDDTO struct {
+	TelegramID string  `json:"telegram_id" validate:"required,min=1"`
+	Status     *string `json:"status,omitempty" validate:"omitempty,oneof=active completed expired"`
+}
+
+//
+// SYNC USER QUEST PROGRESS
+//
+
+type SyncUserQuestProgressDTO struct {
+	TelegramID string  `json:"telegram_id"`
+	Actions    Actions `json:"actions"`
+}
+
+// CompletedQuest represents a user quest counted as completed.
+// Experience points are applied by the database, amount is accrued by the service.
+type CompletedQuest struct {
+	UserQuestID      int64            `json:"user_quest_id"`
+	QuestID          int64            `json:"quest_id"`
+	Name             string           `json:"name"`
+	Cadence          string           `json:"cadence"`
+	Amount           *decimal.Decimal `json:"amount,omitempty"`
+	ExperiencePoints int64            `json:"experience_points"`
+}
+
+// NotificationText returns notification text for the completed quest.
+func (r CompletedQuest) NotificationText() string {
+	text := fmt.Sprintf("Задание «%s» выполнено!", r.Name)
+
+	var parts []string
+	if r.Amount != nil {
+		parts = append(parts, fmt.Sprintf("%s на баланс", r.Amount.StringFixed(2)))
+	}
+	if r.ExperiencePoints > 0 {
+		parts = append(parts, fmt.Sprintf("%d опыта", r.ExperiencePoints))
+	}
+
+	if len(parts) == 0 {
+		return text
+	}
+
+	return fmt.Sprintf("%s Награда: %s!", text, strings.Join(parts, ", "))
+}
+
+// NotificationRewards returns granted rewards for the notification payload.
+func (r CompletedQuest) NotificationRewards() []notification.Reward {
+	var result []notification.Reward
+
+	if r.Amount != nil {
+		result = append(result, notification.Reward{
+			Type:   achievement.RewardTypeInternalCurrency,
+			Amount: r.Amount,
+		})
+	}
+
+	if r.ExperiencePoints > 0 {
+		result = append(result, notification.Reward{
+			Type:             achievement.RewardTypeExperiencePoints,
+			ExperiencePoints: &r.ExperiencePoints,
+		})
+	}
+
+	return result
+}
+
+//
+// SWAGGER
+//
+
+type AllByTelegramIDSwaggerResponse struct {
+	Status  bool   `json:"status" example:"true"`
+	Message string `json:"message" example:"success"`
+	Error   string `json:"error" example:""`
+	Data    []struct {
+		ID                     int64            `json:"id" example:"1"`
+		QuestID                int64            `json:"quest_id" example:"4"`
+		Name                   string           `json:"name" example:"Неделя практики"`
+		Description            *string          `json:"description,omitempty" example:"Пройдите 15 диалогов за неделю"`
+		Cadence                string           `json:"cadence" example:"weekly"`
+		Status                 string           `json:"status" example:"active"`
+		RewardAmount           *decimal.Decimal `json:"reward_amount,omitempty" example:"50.00"`
+		RewardExperiencePoints int64            `json:"reward_experience_points" example:"50"`
+		Requirements           struct {
+			WordsLearnedNeed     *int64 `json:"words_learned_need,omitempty" example:"1"`
+			TasksCompletedNeed   *int64 `json:"tasks_completed_need,omitempty" example:"1"`
+			LessonsFinishedNeed  *int64 `json:"lessons_finished_need,omitempty" example:"1"`
+			WordsTranslateNeed   *int64 `json:"words_translate_need,omitempty" example:"1"`
+			DialogCompletedNeed  *int64 `json:"dialog_completed_need,omitempty" example:"15"`
+			ExperiencePointsNeed *int64 `json:"experience_points_need,omitempty" example:"10"`
+		} `json:"requirements"`
+		Progress struct {
+			WordsLearned     *int64 `json:"words_learned,omitempty" example:"1"`
+			TasksCompleted   *int64 `json:"tasks_completed,omitempty" example:"1"`
+			LessonsFinished  *int64 `json:"lessons_finished,omitempty" example:"1"`
+			WordsTranslate   *int64 `json:"words_translate,omitempty" example:"1"`
+			DialogCompleted  *int64 `json:"dialog_completed,omitempty" example:"6"`
+			ExperiencePoints *int64 `json:"experience_points,omitempty" example:"10"`
+		} `json:"progress"`
+		ProgressPercent struct {
+			WordsLearned     *int64 `json:"words_learned,omitempty" example:"100"`
+			TasksCompleted   *int64 `json:"tasks_completed,omitempty" example:"100"`
+			LessonsFinished  *int64 `json:"lessons_finished,omitempty" example:"100"`
+			WordsTranslate   *int64 `json:"words_translate,omitempty" example:"100"`
+			DialogCompleted  *int64 `json:"dialog_completed,omitempty" example:"40"`
+			ExperiencePoints *int64 `json:"experience_points,omitempty" example:"100"`
+		} `json:"progress_percent"`
+		AssignedAt  time.Time  `json:"assigned_at" example:"2025-09-01T00:00:10.37622+03:00"`
+		ExpiresAt   *time.Time `json:"expires_at,omitempty" example:"2025-09-08T00:00:00+03:00"`
+		CompletedAt *time.Time `json:"completed_at,omitempty" example:"2025-09-02T12:48:06.37622+03:00"`
+	} `json:"data"`
+}
+
+type ErrorSwaggerResponse struct {
+	Status  bool        `json:"status" example:"false"`
+	Message string      `json:"message" example:"some error"`
+	Error   string      `json:"error" example:"some error"`
+	Data    interface{} `json:"data"`
+}
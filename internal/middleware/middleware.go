@@ -8,7 +8,9 @@ import (
 	"github.com/go-jedi/lingramm_backend/internal/middleware/auth"
 	authwebsocket "github.com/go-jedi/lingramm_backend/internal/middleware/auth_websocket"
 	contentlengthlimiter "github.com/go-jedi/lingramm_backend/internal/middleware/content_length_limiter"
+	requiresubscription "github.com/go-jedi/lingramm_backend/internal/middleware/require_subscription"
 	adminservice "github.com/go-jedi/lingramm_backend/internal/service/v1/admin"
+	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
 	"github.com/go-jedi/lingramm_backend/pkg/jwt"
 	"github.com/go-jedi/lingramm_backend/pkg/redis"
 )
@@ -18,11 +20,13 @@ type Middleware struct {
 	Auth                 *auth.Middleware
 	AuthWebSocket        *authwebsocket.Middleware
 	ContentLengthLimiter *contentlengthlimiter.Middleware
+	RequireSubscription  *requiresubscription.Middleware
 }
 
 func New(
 	cfg config.MiddlewareConfig,
 	adminService *adminservice.Service,
+	subscriptionService *subscriptionservice.Service,
 	jwt *jwt.JWT,
 	redis *redis.Redis,
 ) *Middleware {
@@ -33,10 +37,13 @@ func New(
 		log.Fatal("redis instance cannot be nil")
 	}
 
+	authMiddleware := auth.New(jwt, redis)
+
 	return &Middleware{
 		AdminGuard:           adminguard.New(adminService, jwt),
-		Auth:                 auth.New(jwt, redis),
+		Auth:                 authMiddleware,
 		AuthWebSocket:        authwebsocket.New(jwt, redis),
 		ContentLengthLimiter: contentlengthlimiter.New(cfg.ContentLengthLimiter.MaxBodySize),
+		RequireSubscription:  requiresubscription.New(subscriptionService, authMiddleware),
 	}
 }
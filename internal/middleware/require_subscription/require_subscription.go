@@ -0,0 +1,83 @@
+package requiresubscription
+
+import (
+	"errors"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/internal/middleware/auth"
+	subscriptionservice "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/response"
+	"github.com/gofiber/fiber/v3"
+)
+
+const entitlementCtx = "entitlement"
+
+var (
+	ErrEntitlementNotFound       = errors.New("entitlement of request not found")
+	ErrEntitlementHasInvalidType = errors.New("entitlement of request has invalid type")
+)
+
+//go:generate mockery --name=IMiddleware --output=mocks --case=underscore
+type IMiddleware interface {
+	RequireSubscription(entitlement string) fiber.Handler
+	GetEntitlementFromContext(c fiber.Ctx) (subscription.Entitlement, error)
+}
+
+type Middleware struct {
+	subscriptionService *subscriptionservice.Service
+	auth                auth.IMiddleware
+}
+
+func New(
+	subscriptionService *subscriptionservice.Service,
+	auth auth.IMiddleware,
+) *Middleware {
+	return &Middleware{
+		subscriptionService: subscriptionService,
+		auth:                auth,
+	}
+}
+
+// RequireSubscription allows request only if subscription of the user grants the entitlement,
+// otherwise responds with premium required data the client can show subscription offer by.
+// It has to be used after auth middleware.
+func (m *Middleware) RequireSubscription(entitlement string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		telegramID, err := m.auth.GetTelegramIDFromContext(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.JSON(response.New[any](false, "failed to get telegram id from context", err.Error(), nil))
+		}
+
+		result, snapshot, err := m.subscriptionService.CheckEntitlement.Execute(c, telegramID, entitlement)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrPremiumRequired) {
+				c.Status(fiber.StatusForbidden)
+				return c.JSON(response.New[subscription.PremiumRequired](false, "premium required", err.Error(), snapshot.PremiumRequired(entitlement)))
+			}
+
+			c.Status(fiber.StatusInternalServerError)
+			return c.JSON(response.New[any](false, "internal server error", err.Error(), nil))
+		}
+
+		c.Locals(entitlementCtx, result)
+
+		return c.Next()
+	}
+}
+
+// GetEntitlementFromContext get entitlement checked by middleware from context (limit of the plan is in it).
+func (m *Middleware) GetEntitlementFromContext(c fiber.Ctx) (subscription.Entitlement, error) {
+	val := c.Locals(entitlementCtx)
+	if val == nil {
+		return subscription.Entitlement{}, ErrEntitlementNotFound
+	}
+
+	entitlement, ok := val.(subscription.Entitlement)
+	if !ok {
+		return subscription.Entitlement{}, ErrEntitlementHasInvalidType
+	}
+
+	return entitlement, nil
+}
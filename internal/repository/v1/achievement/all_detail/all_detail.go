@@ -59,6 +59,11 @@ func (r *AllDetail) Execute(ctx context.Context, tx pgx.Tx) ([]achievement.Detai
 						'achievement_type_id', a.achievement_type_id,
 						'name', a.name,
 						'description', a.description,
+						'is_secret', a.is_secret,
+						'available_from', a.available_from,
+						'available_to', a.available_to,
+						'tier_group', a.tier_group,
+						'tier_level', a.tier_level,
 						'created_at', a.created_at,
 						'updated_at', a.updated_at
 					),
@@ -87,12 +92,19 @@ func (r *AllDetail) Execute(ctx context.Context, tx pgx.Tx) ([]achievement.Detai
 						'old_extension', awa.old_extension,
 						'created_at', awa.created_at,
 						'updated_at', awa.updated_at
-					)
+					),
+					'rewards', (
+						SELECT COALESCE(JSONB_AGG(TO_JSONB(ar) ORDER BY ar.id), '[]'::JSONB)
+						FROM achievement_rewards ar
+						WHERE ar.achievement_id = a.id
+					),
+					'stats', TO_JSONB(s)
 				)
 			)
 		FROM achievements a
 		INNER JOIN achievement_assets aa ON a.achievement_assets_id = aa.id
-		INNER JOIN award_assets awa ON a.award_assets_id = awa.id;
+		INNER JOIN award_assets awa ON a.award_assets_id = awa.id
+		LEFT JOIN achievement_stats s ON a.id = s.achievement_id;
 	`
 
 	var d []achievement.Detail
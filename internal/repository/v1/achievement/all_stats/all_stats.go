@@ -0,0 +1,80 @@
+package allstats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllStats --output=mocks --case=underscore
+type IAllStats interface {
+	Execute(ctx context.Context, tx pgx.Tx) ([]achievement.StatsDetail, error)
+}
+
+type AllStats struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllStats {
+	r := &AllStats{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllStats) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AllStats) Execute(ctx context.Context, tx pgx.Tx) ([]achievement.StatsDetail, error) {
+	r.logger.Debug("[get all achievement stats] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT COALESCE(
+			JSONB_AGG(
+				JSONB_BUILD_OBJECT(
+					'achievement', TO_JSONB(a),
+					'stats', TO_JSONB(s)
+				)
+				ORDER BY s.unlock_percent NULLS LAST, s.unlock_count NULLS LAST, a.id
+			),
+			'[]'::JSONB
+		)
+		FROM achievements a
+		LEFT JOIN achievement_stats s ON a.id = s.achievement_id;
+	`
+
+	var result []achievement.StatsDetail
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all achievement stats", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all achievement stats", "err", err)
+		return nil, fmt.Errorf("could not get all achievement stats: %w", err)
+	}
+
+	return result, nil
+}
@@ -54,8 +54,13 @@ func (r *CreateAchievement) Execute(ctx context.Context, tx pgx.Tx, dto achievem
 		    award_assets_id,
 		    achievement_type_id,
 		    name,
-		    description
-		) VALUES($1, $2, $3, $4, $5)
+		    description,
+		    is_secret,
+		    available_from,
+		    available_to,
+		    tier_group,
+		    tier_level
+		) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		RETURNING *;
 	`
 
@@ -65,11 +70,16 @@ func (r *CreateAchievement) Execute(ctx context.Context, tx pgx.Tx, dto achievem
 		ctxTimeout, q,
 		dto.AchievementAssetsID, dto.AwardAssetsID,
 		dto.AchievementTypeID, dto.Name, dto.Description,
+		dto.IsSecret, dto.AvailableFrom, dto.AvailableTo,
+		dto.TierGroup, dto.TierLevel,
 	).Scan(
 		&na.ID, &na.AchievementAssetsID,
 		&na.AwardAssetsID, &na.AchievementTypeID,
 		&na.Name, &na.Description,
 		&na.CreatedAt, &na.UpdatedAt,
+		&na.IsSecret, &na.AvailableFrom,
+		&na.AvailableTo, &na.TierGroup,
+		&na.TierLevel,
 	); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while create achievement", "err", err)
@@ -0,0 +1,84 @@
+package createreward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreateReward --output=mocks --case=underscore
+type ICreateReward interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto achievement.CreateRewardDTO) (achievement.Reward, error)
+}
+
+type CreateReward struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *CreateReward {
+	r := &CreateReward{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *CreateReward) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *CreateReward) Execute(ctx context.Context, tx pgx.Tx, dto achievement.CreateRewardDTO) (achievement.Reward, error) {
+	r.logger.Debug("[create a new achievement reward] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO achievement_rewards(
+		    achievement_id,
+		    type,
+		    amount,
+		    experience_points,
+		    subscription_days,
+		    streak_freezes
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING *;
+	`
+
+	var nr achievement.Reward
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.AchievementID, dto.Type, dto.Amount,
+		dto.ExperiencePoints, dto.SubscriptionDays,
+		dto.StreakFreezes,
+	).Scan(
+		&nr.ID, &nr.AchievementID, &nr.Type,
+		&nr.Amount, &nr.ExperiencePoints, &nr.SubscriptionDays,
+		&nr.StreakFreezes, &nr.CreatedAt, &nr.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create a new achievement reward", "err", err)
+			return achievement.Reward{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create a new achievement reward", "err", err)
+		return achievement.Reward{}, fmt.Errorf("could not create a new achievement reward: %w", err)
+	}
+
+	return nr, nil
+}
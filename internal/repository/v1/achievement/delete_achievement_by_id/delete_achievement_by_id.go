@@ -64,6 +64,9 @@ func (r *DeleteAchievementByID) Execute(ctx context.Context, tx pgx.Tx, id int64
 		&da.AwardAssetsID, &da.AchievementTypeID,
 		&da.Name, &da.Description,
 		&da.CreatedAt, &da.UpdatedAt,
+		&da.IsSecret, &da.AvailableFrom,
+		&da.AvailableTo, &da.TierGroup,
+		&da.TierLevel,
 	); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while delete achievement by id", "err", err)
@@ -0,0 +1,76 @@
+package deleterewardbyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteRewardByID --output=mocks --case=underscore
+type IDeleteRewardByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (achievement.Reward, error)
+}
+
+type DeleteRewardByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *DeleteRewardByID {
+	r := &DeleteRewardByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *DeleteRewardByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *DeleteRewardByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (achievement.Reward, error) {
+	r.logger.Debug("[delete achievement reward by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		DELETE FROM achievement_rewards
+		WHERE id = $1
+		RETURNING *;
+	`
+
+	var nr achievement.Reward
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(
+		&nr.ID, &nr.AchievementID, &nr.Type,
+		&nr.Amount, &nr.ExperiencePoints, &nr.SubscriptionDays,
+		&nr.StreakFreezes, &nr.CreatedAt, &nr.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while delete achievement reward by id", "err", err)
+			return achievement.Reward{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to delete achievement reward by id", "err", err)
+		return achievement.Reward{}, fmt.Errorf("could not delete achievement reward by id: %w", err)
+	}
+
+	return nr, nil
+}
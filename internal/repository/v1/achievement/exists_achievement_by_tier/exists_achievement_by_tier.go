@@ -0,0 +1,75 @@
+package existsachievementbytier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsAchievementByTier --output=mocks --case=underscore
+type IExistsAchievementByTier interface {
+	Execute(ctx context.Context, tx pgx.Tx, tierGroup string, tierLevel int64, excludeID int64) (bool, error)
+}
+
+type ExistsAchievementByTier struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsAchievementByTier {
+	r := &ExistsAchievementByTier{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsAchievementByTier) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsAchievementByTier) Execute(ctx context.Context, tx pgx.Tx, tierGroup string, tierLevel int64, excludeID int64) (bool, error) {
+	r.logger.Debug("[check achievement exists by tier] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM achievements
+			WHERE tier_group = $1
+			AND tier_level = $2
+			AND id <> $3
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		tierGroup, tierLevel, excludeID,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check achievement exists by tier", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check achievement exists by tier", "err", err)
+		return false, fmt.Errorf("could not check achievement exists by tier: %w", err)
+	}
+
+	return ie, nil
+}
@@ -0,0 +1,73 @@
+package existsrewardbyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsRewardByID --output=mocks --case=underscore
+type IExistsRewardByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (bool, error)
+}
+
+type ExistsRewardByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsRewardByID {
+	r := &ExistsRewardByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsRewardByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsRewardByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (bool, error) {
+	r.logger.Debug("[check achievement reward exists by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM achievement_rewards
+			WHERE id = $1
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check achievement reward exists by id", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check achievement reward exists by id", "err", err)
+		return false, fmt.Errorf("could not check achievement reward exists by id: %w", err)
+	}
+
+	return ie, nil
+}
@@ -0,0 +1,67 @@
+package refreshstats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IRefreshStats --output=mocks --case=underscore
+type IRefreshStats interface {
+	Execute(ctx context.Context, tx pgx.Tx, activeDays int) (int64, error)
+}
+
+type RefreshStats struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *RefreshStats {
+	r := &RefreshStats{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *RefreshStats) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *RefreshStats) Execute(ctx context.Context, tx pgx.Tx, activeDays int) (int64, error) {
+	r.logger.Debug("[refresh achievement stats] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.achievement_stats_refresh($1);`
+
+	var result int64
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		activeDays,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while refresh achievement stats", "err", err)
+			return 0, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to refresh achievement stats", "err", err)
+		return 0, fmt.Errorf("could not refresh achievement stats: %w", err)
+	}
+
+	return result, nil
+}
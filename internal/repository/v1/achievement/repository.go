@@ -2,21 +2,35 @@ package achievement
 
 import (
 	alldetail "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/all_detail"
+	allstats "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/all_stats"
 	createachievement "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/create_achievement"
+	createreward "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/create_reward"
 	deleteachievementbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/delete_achievement_by_id"
+	deleterewardbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/delete_reward_by_id"
 	existsachievementbyachievementtype "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/exists_achievement_by_achievement_type"
 	existsachievementbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/exists_achievement_by_id"
+	existsachievementbytier "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/exists_achievement_by_tier"
+	existsrewardbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/exists_reward_by_id"
 	getdetailbyachievementid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/get_detail_by_achievement_id"
+	refreshstats "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/refresh_stats"
+	updateachievement "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement/update_achievement"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
 
 type Repository struct {
 	AllDetail                          alldetail.IAllDetail
+	AllStats                           allstats.IAllStats
 	CreateAchievement                  createachievement.ICreateAchievement
+	CreateReward                       createreward.ICreateReward
 	DeleteAchievementByID              deleteachievementbyid.IDeleteAchievementByID
+	DeleteRewardByID                   deleterewardbyid.IDeleteRewardByID
 	ExistsAchievementByAchievementType existsachievementbyachievementtype.IExistsAchievementByAchievementType
 	ExistsAchievementByID              existsachievementbyid.IExistsAchievementByID
+	ExistsAchievementByTier            existsachievementbytier.IExistsAchievementByTier
+	ExistsRewardByID                   existsrewardbyid.IExistsRewardByID
 	GetDetailByAchievementID           getdetailbyachievementid.IGetDetailByAchievementID
+	RefreshStats                       refreshstats.IRefreshStats
+	UpdateAchievement                  updateachievement.IUpdateAchievement
 }
 
 func New(
@@ -25,10 +39,17 @@ func New(
 ) *Repository {
 	return &Repository{
 		AllDetail:                          alldetail.New(queryTimeout, logger),
+		AllStats:                           allstats.New(queryTimeout, logger),
 		CreateAchievement:                  createachievement.New(queryTimeout, logger),
+		CreateReward:                       createreward.New(queryTimeout, logger),
 		DeleteAchievementByID:              deleteachievementbyid.New(queryTimeout, logger),
+		DeleteRewardByID:                   deleterewardbyid.New(queryTimeout, logger),
 		ExistsAchievementByAchievementType: existsachievementbyachievementtype.New(queryTimeout, logger),
 		ExistsAchievementByID:              existsachievementbyid.New(queryTimeout, logger),
+		ExistsAchievementByTier:            existsachievementbytier.New(queryTimeout, logger),
+		ExistsRewardByID:                   existsrewardbyid.New(queryTimeout, logger),
 		GetDetailByAchievementID:           getdetailbyachievementid.New(queryTimeout, logger),
+		RefreshStats:                       refreshstats.New(queryTimeout, logger),
+		UpdateAchievement:                  updateachievement.New(queryTimeout, logger),
 	}
 }
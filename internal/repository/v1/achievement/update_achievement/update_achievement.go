@@ -0,0 +1,94 @@
+package updateachievement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdateAchievement --output=mocks --case=underscore
+type IUpdateAchievement interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto achievement.UpdateAchievementDTO) (achievement.Achievement, error)
+}
+
+type UpdateAchievement struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *UpdateAchievement {
+	r := &UpdateAchievement{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *UpdateAchievement) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *UpdateAchievement) Execute(ctx context.Context, tx pgx.Tx, dto achievement.UpdateAchievementDTO) (achievement.Achievement, error) {
+	r.logger.Debug("[update achievement] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		UPDATE achievements SET
+		    achievement_assets_id = $2,
+		    award_assets_id = $3,
+		    achievement_type_id = $4,
+		    name = $5,
+		    description = $6,
+		    is_secret = $7,
+		    available_from = $8,
+		    available_to = $9,
+		    tier_group = $10,
+		    tier_level = $11,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING *;
+	`
+
+	var ua achievement.Achievement
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.ID, dto.AchievementAssetsID, dto.AwardAssetsID,
+		dto.AchievementTypeID, dto.Name, dto.Description,
+		dto.IsSecret, dto.AvailableFrom, dto.AvailableTo,
+		dto.TierGroup, dto.TierLevel,
+	).Scan(
+		&ua.ID, &ua.AchievementAssetsID,
+		&ua.AwardAssetsID, &ua.AchievementTypeID,
+		&ua.Name, &ua.Description,
+		&ua.CreatedAt, &ua.UpdatedAt,
+		&ua.IsSecret, &ua.AvailableFrom,
+		&ua.AvailableTo, &ua.TierGroup,
+		&ua.TierLevel,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while update achievement", "err", err)
+			return achievement.Achievement{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to update achievement", "err", err)
+		return achievement.Achievement{}, fmt.Errorf("could not update achievement: %w", err)
+	}
+
+	return ua, nil
+}
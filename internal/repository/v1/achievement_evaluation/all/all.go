@@ -0,0 +1,68 @@
+package all
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context, tx pgx.Tx, limit int64) ([]achievementevaluation.Job, error)
+}
+
+type All struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *All {
+	r := &All{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *All) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *All) Execute(ctx context.Context, tx pgx.Tx, limit int64) ([]achievementevaluation.Job, error) {
+	r.logger.Debug("[get all achievement evaluation jobs] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.achievement_evaluation_jobs_all($1);`
+
+	var result []achievementevaluation.Job
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		limit,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all achievement evaluation jobs", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all achievement evaluation jobs", "err", err)
+		return nil, fmt.Errorf("could not get all achievement evaluation jobs: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto achievementevaluation.CreateDTO) (achievementevaluation.Job, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto achievementevaluation.CreateDTO) (achievementevaluation.Job, error) {
+	r.logger.Debug("[create achievement evaluation job] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.achievement_evaluation_job_create($1);`
+
+	var result achievementevaluation.Job
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create achievement evaluation job", "err", err)
+			return achievementevaluation.Job{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create achievement evaluation job", "err", err)
+		return achievementevaluation.Job{}, fmt.Errorf("could not create achievement evaluation job: %w", err)
+	}
+
+	return result, nil
+}
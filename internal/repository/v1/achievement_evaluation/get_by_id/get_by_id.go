@@ -0,0 +1,68 @@
+package getbyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByID --output=mocks --case=underscore
+type IGetByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (achievementevaluation.Job, error)
+}
+
+type GetByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetByID {
+	r := &GetByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (achievementevaluation.Job, error) {
+	r.logger.Debug("[get achievement evaluation job by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.achievement_evaluation_job_get($1);`
+
+	var result achievementevaluation.Job
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get achievement evaluation job by id", "err", err)
+			return achievementevaluation.Job{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get achievement evaluation job by id", "err", err)
+		return achievementevaluation.Job{}, fmt.Errorf("could not get achievement evaluation job by id: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package achievementevaluation
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation/all"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation/create"
+	existsbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation/exists_by_id"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation/get_by_id"
+	processchunk "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation/process_chunk"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	All          all.IAll
+	Create       create.ICreate
+	ExistsByID   existsbyid.IExistsByID
+	GetByID      getbyid.IGetByID
+	ProcessChunk processchunk.IProcessChunk
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		All:          all.New(queryTimeout, logger),
+		Create:       create.New(queryTimeout, logger),
+		ExistsByID:   existsbyid.New(queryTimeout, logger),
+		GetByID:      getbyid.New(queryTimeout, logger),
+		ProcessChunk: processchunk.New(queryTimeout, logger),
+	}
+}
@@ -0,0 +1,75 @@
+package allversionsbyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllVersionsByID --output=mocks --case=underscore
+type IAllVersionsByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) ([]achievementtype.Version, error)
+}
+
+type AllVersionsByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllVersionsByID {
+	r := &AllVersionsByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllVersionsByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AllVersionsByID) Execute(ctx context.Context, tx pgx.Tx, id int64) ([]achievementtype.Version, error) {
+	r.logger.Debug("[get all achievement type versions by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT COALESCE(
+			JSONB_AGG(TO_JSONB(atv) ORDER BY atv.version DESC),
+			'[]'::JSONB
+		)
+		FROM achievement_type_versions atv
+		WHERE atv.achievement_type_id = $1;
+	`
+
+	var result []achievementtype.Version
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all achievement type versions by id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all achievement type versions by id", "err", err)
+		return nil, fmt.Errorf("could not get all achievement type versions by id: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto achievementtype.CreateDTO) (achievementtype.AchievementType, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto achievementtype.CreateDTO) (achievementtype.AchievementType, error) {
+	r.logger.Debug("[create achievement type] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.achievement_type_create($1);`
+
+	var result achievementtype.AchievementType
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create achievement type", "err", err)
+			return achievementtype.AchievementType{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create achievement type", "err", err)
+		return achievementtype.AchievementType{}, fmt.Errorf("could not create achievement type: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package deletebyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteByID --output=mocks --case=underscore
+type IDeleteByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (achievementtype.AchievementType, error)
+}
+
+type DeleteByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *DeleteByID {
+	r := &DeleteByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *DeleteByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *DeleteByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (achievementtype.AchievementType, error) {
+	r.logger.Debug("[delete achievement type by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		DELETE FROM achievement_types
+		WHERE id = $1
+		RETURNING *;
+	`
+
+	var result achievementtype.AchievementType
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(
+		&result.ID, &result.Name, &result.Description,
+		&result.StreakDaysNeed, &result.DailyTaskStreakDaysNeed,
+		&result.WordsLearnedNeed, &result.TasksCompletedNeed,
+		&result.LessonsFinishedNeed, &result.WordsTranslateNeed,
+		&result.DialogCompletedNeed, &result.ExperiencePointsNeed,
+		&result.LevelNeed, &result.IsActive, &result.CreatedAt, &result.UpdatedAt,
+		&result.Version,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while delete achievement type by id", "err", err)
+			return achievementtype.AchievementType{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to delete achievement type by id", "err", err)
+		return achievementtype.AchievementType{}, fmt.Errorf("could not delete achievement type by id: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package existsinusebyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsInUseByID --output=mocks --case=underscore
+type IExistsInUseByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (bool, error)
+}
+
+type ExistsInUseByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsInUseByID {
+	r := &ExistsInUseByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsInUseByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsInUseByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (bool, error) {
+	r.logger.Debug("[check achievement type in use by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM achievements
+			WHERE achievement_type_id = $1
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check achievement type in use by id", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check achievement type in use by id", "err", err)
+		return false, fmt.Errorf("could not check achievement type in use by id: %w", err)
+	}
+
+	return ie, nil
+}
@@ -0,0 +1,80 @@
+package getbyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByID --output=mocks --case=underscore
+type IGetByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (achievementtype.AchievementType, error)
+}
+
+type GetByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetByID {
+	r := &GetByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (achievementtype.AchievementType, error) {
+	r.logger.Debug("[get achievement type by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT *
+		FROM achievement_types
+		WHERE id = $1;
+	`
+
+	var result achievementtype.AchievementType
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(
+		&result.ID, &result.Name, &result.Description,
+		&result.StreakDaysNeed, &result.DailyTaskStreakDaysNeed,
+		&result.WordsLearnedNeed, &result.TasksCompletedNeed,
+		&result.LessonsFinishedNeed, &result.WordsTranslateNeed,
+		&result.DialogCompletedNeed, &result.ExperiencePointsNeed,
+		&result.LevelNeed, &result.IsActive, &result.CreatedAt, &result.UpdatedAt,
+		&result.Version,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get achievement type by id", "err", err)
+			return achievementtype.AchievementType{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get achievement type by id", "err", err)
+		return achievementtype.AchievementType{}, fmt.Errorf("could not get achievement type by id: %w", err)
+	}
+
+	return result, nil
+}
@@ -66,6 +66,7 @@ func (r *GetByName) Execute(ctx context.Context, tx pgx.Tx, name string) (achiev
 		&at.LessonsFinishedNeed, &at.WordsTranslateNeed,
 		&at.DialogCompletedNeed, &at.ExperiencePointsNeed,
 		&at.LevelNeed, &at.IsActive, &at.CreatedAt, &at.UpdatedAt,
+		&at.Version,
 	); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while get achievement type by name", "err", err)
@@ -1,14 +1,30 @@
 package achievementtype
 
 import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/all"
+	allversionsbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/all_versions_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/create"
+	deletebyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/delete_by_id"
+	existsbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/exists_by_id"
 	existsbyname "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/exists_by_name"
+	existsinusebyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/exists_in_use_by_id"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/get_by_id"
 	getbyname "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/get_by_name"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type/update"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
 
 type Repository struct {
-	ExistsByName existsbyname.IExistsByName
-	GetByName    getbyname.IGetByName
+	All             all.IAll
+	AllVersionsByID allversionsbyid.IAllVersionsByID
+	Create          create.ICreate
+	DeleteByID      deletebyid.IDeleteByID
+	ExistsByID      existsbyid.IExistsByID
+	ExistsByName    existsbyname.IExistsByName
+	ExistsInUseByID existsinusebyid.IExistsInUseByID
+	GetByID         getbyid.IGetByID
+	GetByName       getbyname.IGetByName
+	Update          update.IUpdate
 }
 
 func New(
@@ -16,7 +32,15 @@ func New(
 	logger logger.ILogger,
 ) *Repository {
 	return &Repository{
-		ExistsByName: existsbyname.New(queryTimeout, logger),
-		GetByName:    getbyname.New(queryTimeout, logger),
+		All:             all.New(queryTimeout, logger),
+		AllVersionsByID: allversionsbyid.New(queryTimeout, logger),
+		Create:          create.New(queryTimeout, logger),
+		DeleteByID:      deletebyid.New(queryTimeout, logger),
+		ExistsByID:      existsbyid.New(queryTimeout, logger),
+		ExistsByName:    existsbyname.New(queryTimeout, logger),
+		ExistsInUseByID: existsinusebyid.New(queryTimeout, logger),
+		GetByID:         getbyid.New(queryTimeout, logger),
+		GetByName:       getbyname.New(queryTimeout, logger),
+		Update:          update.New(queryTimeout, logger),
 	}
 }
@@ -0,0 +1,68 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto achievementtype.UpdateDTO) (achievementtype.AchievementType, error)
+}
+
+type Update struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Update {
+	r := &Update{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Update) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Update) Execute(ctx context.Context, tx pgx.Tx, dto achievementtype.UpdateDTO) (achievementtype.AchievementType, error) {
+	r.logger.Debug("[update achievement type] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.achievement_type_update($1);`
+
+	var result achievementtype.AchievementType
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while update achievement type", "err", err)
+			return achievementtype.AchievementType{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to update achievement type", "err", err)
+		return achievementtype.AchievementType{}, fmt.Errorf("could not update achievement type: %w", err)
+	}
+
+	return result, nil
+}
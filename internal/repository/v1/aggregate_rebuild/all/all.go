@@ -0,0 +1,68 @@
+package all
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context, tx pgx.Tx, limit int64) ([]aggregaterebuild.Job, error)
+}
+
+type All struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *All {
+	r := &All{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *All) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *All) Execute(ctx context.Context, tx pgx.Tx, limit int64) ([]aggregaterebuild.Job, error) {
+	r.logger.Debug("[get all aggregate rebuild jobs] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.aggregate_rebuild_jobs_all($1);`
+
+	var result []aggregaterebuild.Job
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		limit,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all aggregate rebuild jobs", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all aggregate rebuild jobs", "err", err)
+		return nil, fmt.Errorf("could not get all aggregate rebuild jobs: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package aggregaterebuild
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild/all"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild/create"
+	existsbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild/exists_by_id"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild/get_by_id"
+	processchunk "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild/process_chunk"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	All          all.IAll
+	Create       create.ICreate
+	ExistsByID   existsbyid.IExistsByID
+	GetByID      getbyid.IGetByID
+	ProcessChunk processchunk.IProcessChunk
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		All:          all.New(queryTimeout, logger),
+		Create:       create.New(queryTimeout, logger),
+		ExistsByID:   existsbyid.New(queryTimeout, logger),
+		GetByID:      getbyid.New(queryTimeout, logger),
+		ProcessChunk: processchunk.New(queryTimeout, logger),
+	}
+}
@@ -0,0 +1,73 @@
+package all
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context, tx pgx.Tx) ([]currencyrate.CurrencyRate, error)
+}
+
+type All struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *All {
+	r := &All{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *All) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *All) Execute(ctx context.Context, tx pgx.Tx) ([]currencyrate.CurrencyRate, error) {
+	r.logger.Debug("[get all currency rates] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT COALESCE(
+			JSONB_AGG(TO_JSONB(cr) ORDER BY cr.currency_code),
+			'[]'::JSONB
+		)
+		FROM currency_rates cr;
+	`
+
+	var result []currencyrate.CurrencyRate
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all currency rates", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all currency rates", "err", err)
+		return nil, fmt.Errorf("could not get all currency rates: %w", err)
+	}
+
+	return result, nil
+}
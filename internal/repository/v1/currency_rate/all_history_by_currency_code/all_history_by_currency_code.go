@@ -0,0 +1,75 @@
+package allhistorybycurrencycode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllHistoryByCurrencyCode --output=mocks --case=underscore
+type IAllHistoryByCurrencyCode interface {
+	Execute(ctx context.Context, tx pgx.Tx, currencyCode string) ([]currencyrate.History, error)
+}
+
+type AllHistoryByCurrencyCode struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllHistoryByCurrencyCode {
+	r := &AllHistoryByCurrencyCode{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllHistoryByCurrencyCode) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AllHistoryByCurrencyCode) Execute(ctx context.Context, tx pgx.Tx, currencyCode string) ([]currencyrate.History, error) {
+	r.logger.Debug("[get all currency rate history by currency code] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT COALESCE(
+			JSONB_AGG(TO_JSONB(crh) ORDER BY crh.id DESC),
+			'[]'::JSONB
+		)
+		FROM currency_rate_history crh
+		WHERE crh.currency_code = $1;
+	`
+
+	var result []currencyrate.History
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		currencyCode,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all currency rate history by currency code", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all currency rate history by currency code", "err", err)
+		return nil, fmt.Errorf("could not get all currency rate history by currency code: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto currencyrate.CreateDTO) (currencyrate.CurrencyRate, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto currencyrate.CreateDTO) (currencyrate.CurrencyRate, error) {
+	r.logger.Debug("[create currency rate] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO currency_rates(
+			currency_code,
+			rate
+		) VALUES ($1, $2)
+		RETURNING *;
+	`
+
+	var result currencyrate.CurrencyRate
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.CurrencyCode, dto.Rate,
+	).Scan(
+		&result.ID, &result.CurrencyCode, &result.Rate,
+		&result.CreatedAt, &result.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create currency rate", "err", err)
+			return currencyrate.CurrencyRate{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create currency rate", "err", err)
+		return currencyrate.CurrencyRate{}, fmt.Errorf("could not create currency rate: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package createhistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/utils/nullify"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreateHistory --output=mocks --case=underscore
+type ICreateHistory interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto currencyrate.CreateHistoryDTO) error
+}
+
+type CreateHistory struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *CreateHistory {
+	r := &CreateHistory{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *CreateHistory) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *CreateHistory) Execute(ctx context.Context, tx pgx.Tx, dto currencyrate.CreateHistoryDTO) error {
+	r.logger.Debug("[create currency rate history] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO currency_rate_history(
+			currency_code,
+			action,
+			old_rate,
+			new_rate,
+			changed_by
+		) VALUES ($1, $2, $3, $4, $5);
+	`
+
+	commandTag, err := tx.Exec(
+		ctxTimeout, q,
+		dto.CurrencyCode, dto.Action, dto.OldRate, dto.NewRate, nullify.EmptyString(&dto.ChangedBy),
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create currency rate history", "err", err)
+			return fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create currency rate history", "err", err)
+		return fmt.Errorf("could not create currency rate history: %w", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return apperrors.ErrNoRowsWereAffected
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package deletebycurrencycode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteByCurrencyCode --output=mocks --case=underscore
+type IDeleteByCurrencyCode interface {
+	Execute(ctx context.Context, tx pgx.Tx, currencyCode string) (currencyrate.CurrencyRate, error)
+}
+
+type DeleteByCurrencyCode struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *DeleteByCurrencyCode {
+	r := &DeleteByCurrencyCode{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *DeleteByCurrencyCode) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *DeleteByCurrencyCode) Execute(ctx context.Context, tx pgx.Tx, currencyCode string) (currencyrate.CurrencyRate, error) {
+	r.logger.Debug("[delete currency rate by currency code] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		DELETE FROM currency_rates
+		WHERE currency_code = $1
+		RETURNING *;
+	`
+
+	var result currencyrate.CurrencyRate
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		currencyCode,
+	).Scan(
+		&result.ID, &result.CurrencyCode, &result.Rate,
+		&result.CreatedAt, &result.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while delete currency rate by currency code", "err", err)
+			return currencyrate.CurrencyRate{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to delete currency rate by currency code", "err", err)
+		return currencyrate.CurrencyRate{}, fmt.Errorf("could not delete currency rate by currency code: %w", err)
+	}
+
+	return result, nil
+}
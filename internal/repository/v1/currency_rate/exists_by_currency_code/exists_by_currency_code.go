@@ -0,0 +1,73 @@
+package existsbycurrencycode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsByCurrencyCode --output=mocks --case=underscore
+type IExistsByCurrencyCode interface {
+	Execute(ctx context.Context, tx pgx.Tx, currencyCode string) (bool, error)
+}
+
+type ExistsByCurrencyCode struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsByCurrencyCode {
+	r := &ExistsByCurrencyCode{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsByCurrencyCode) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsByCurrencyCode) Execute(ctx context.Context, tx pgx.Tx, currencyCode string) (bool, error) {
+	r.logger.Debug("[check currency rate exists by currency code] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM currency_rates
+			WHERE currency_code = $1
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		currencyCode,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check currency rate exists by currency code", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check currency rate exists by currency code", "err", err)
+		return false, fmt.Errorf("could not check currency rate exists by currency code: %w", err)
+	}
+
+	return ie, nil
+}
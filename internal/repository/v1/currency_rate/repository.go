@@ -0,0 +1,40 @@
+package currencyrate
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate/all"
+	allhistorybycurrencycode "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate/all_history_by_currency_code"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate/create"
+	createhistory "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate/create_history"
+	deletebycurrencycode "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate/delete_by_currency_code"
+	existsbycurrencycode "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate/exists_by_currency_code"
+	getbycurrencycode "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate/get_by_currency_code"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate/update"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	All                      all.IAll
+	AllHistoryByCurrencyCode allhistorybycurrencycode.IAllHistoryByCurrencyCode
+	Create                   create.ICreate
+	CreateHistory            createhistory.ICreateHistory
+	DeleteByCurrencyCode     deletebycurrencycode.IDeleteByCurrencyCode
+	ExistsByCurrencyCode     existsbycurrencycode.IExistsByCurrencyCode
+	GetByCurrencyCode        getbycurrencycode.IGetByCurrencyCode
+	Update                   update.IUpdate
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		All:                      all.New(queryTimeout, logger),
+		AllHistoryByCurrencyCode: allhistorybycurrencycode.New(queryTimeout, logger),
+		Create:                   create.New(queryTimeout, logger),
+		CreateHistory:            createhistory.New(queryTimeout, logger),
+		DeleteByCurrencyCode:     deletebycurrencycode.New(queryTimeout, logger),
+		ExistsByCurrencyCode:     existsbycurrencycode.New(queryTimeout, logger),
+		GetByCurrencyCode:        getbycurrencycode.New(queryTimeout, logger),
+		Update:                   update.New(queryTimeout, logger),
+	}
+}
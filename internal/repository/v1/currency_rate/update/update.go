@@ -0,0 +1,77 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto currencyrate.UpdateDTO) (currencyrate.CurrencyRate, error)
+}
+
+type Update struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Update {
+	r := &Update{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Update) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Update) Execute(ctx context.Context, tx pgx.Tx, dto currencyrate.UpdateDTO) (currencyrate.CurrencyRate, error) {
+	r.logger.Debug("[update currency rate] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		UPDATE currency_rates SET
+			rate = $2,
+			updated_at = NOW()
+		WHERE currency_code = $1
+		RETURNING *;
+	`
+
+	var result currencyrate.CurrencyRate
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.CurrencyCode, dto.Rate,
+	).Scan(
+		&result.ID, &result.CurrencyCode, &result.Rate,
+		&result.CreatedAt, &result.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while update currency rate", "err", err)
+			return currencyrate.CurrencyRate{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to update currency rate", "err", err)
+		return currencyrate.CurrencyRate{}, fmt.Errorf("could not update currency rate: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	currencytransfer "github.com/go-jedi/lingramm_backend/internal/domain/currency_transfer"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto currencytransfer.SendDTO) (currencytransfer.CreateResult, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	cfg          config.CurrencyTransferConfig
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	cfg config.CurrencyTransferConfig,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		cfg:          cfg,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute creates currency transfer after locking balances of both users
+// and checking account age, bans, daily limits and sender balance.
+// Account age and daily limits equal to 0 in config disable the check.
+// Balances are not changed, debit and credit are done by the caller in the same transaction.
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto currencytransfer.SendDTO) (currencytransfer.CreateResult, error) {
+	r.logger.Debug("[create currency transfer] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.currency_transfer_create($1, $2, $3, $4, $5, $6, $7, $8);`
+
+	var result currencytransfer.CreateResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.SenderTelegramID,
+		dto.RecipientTelegramID,
+		dto.Amount,
+		dto.Comment,
+		r.cfg.FeePercent,
+		r.cfg.MinAccountAgeDays,
+		r.cfg.DailyLimit.Amount,
+		r.cfg.DailyLimit.Count,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create currency transfer", "err", err)
+			return currencytransfer.CreateResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create currency transfer", "err", err)
+		return currencytransfer.CreateResult{}, fmt.Errorf("could not create currency transfer: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,21 @@
+package currencytransfer
+
+import (
+	"github.com/go-jedi/lingramm_backend/config"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_transfer/create"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	Create create.ICreate
+}
+
+func New(
+	queryTimeout int64,
+	cfg config.CurrencyTransferConfig,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		Create: create.New(queryTimeout, cfg, logger),
+	}
+}
@@ -57,8 +57,9 @@ func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto dailytask.CreateDTO
 		    words_translate_need,
 		    dialog_completed_need,
 		    experience_points_need,
+		    difficulty,
 		    is_active
-		) VALUES($1, $2, $3, $4, $5, $6, $7)
+		) VALUES($1, $2, $3, $4, $5, $6, COALESCE($7::daily_task_difficulty, 'medium'), $8)
 		RETURNING *;
 	`
 
@@ -73,6 +74,7 @@ func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto dailytask.CreateDTO
 		&ndt.WordsTranslateNeed, &ndt.DialogCompletedNeed,
 		&ndt.ExperiencePointsNeed, &ndt.IsActive,
 		&ndt.CreatedAt, &ndt.UpdatedAt,
+		&ndt.Difficulty,
 	); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while create a new daily task", "err", err)
@@ -94,6 +96,7 @@ func (r *Create) getArgs(dto dailytask.CreateDTO) []interface{} {
 		nullify.EmptyInt64WithDefault(dto.WordsTranslateNeed),
 		nullify.EmptyInt64WithDefault(dto.DialogCompletedNeed),
 		nullify.EmptyInt64WithDefault(dto.ExperiencePointsNeed),
+		dto.Difficulty,
 		dto.IsActive,
 	}
 }
@@ -0,0 +1,81 @@
+package deactivatebyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeactivateByID --output=mocks --case=underscore
+type IDeactivateByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (dailytask.DailyTask, error)
+}
+
+type DeactivateByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *DeactivateByID {
+	r := &DeactivateByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *DeactivateByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *DeactivateByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (dailytask.DailyTask, error) {
+	r.logger.Debug("[deactivate daily task by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		UPDATE daily_tasks SET
+		    is_active = FALSE,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING *;
+	`
+
+	var result dailytask.DailyTask
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(
+		&result.ID, &result.WordsLearnedNeed,
+		&result.TasksCompletedNeed, &result.LessonsFinishedNeed,
+		&result.WordsTranslateNeed, &result.DialogCompletedNeed,
+		&result.ExperiencePointsNeed, &result.IsActive,
+		&result.CreatedAt, &result.UpdatedAt,
+		&result.Difficulty,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while deactivate daily task by id", "err", err)
+			return dailytask.DailyTask{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to deactivate daily task by id", "err", err)
+		return dailytask.DailyTask{}, fmt.Errorf("could not deactivate daily task by id: %w", err)
+	}
+
+	return result, nil
+}
@@ -1,12 +1,22 @@
 package dailytask
 
 import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task/all"
 	"github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task/create"
+	deactivatebyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task/deactivate_by_id"
+	existsbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task/exists_by_id"
+	existsotheractivebyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task/exists_other_active_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task/update"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
 
 type Repository struct {
-	Create create.ICreate
+	All                   all.IAll
+	Create                create.ICreate
+	DeactivateByID        deactivatebyid.IDeactivateByID
+	ExistsByID            existsbyid.IExistsByID
+	ExistsOtherActiveByID existsotheractivebyid.IExistsOtherActiveByID
+	Update                update.IUpdate
 }
 
 func New(
@@ -14,6 +24,11 @@ func New(
 	logger logger.ILogger,
 ) *Repository {
 	return &Repository{
-		Create: create.New(queryTimeout, logger),
+		All:                   all.New(queryTimeout, logger),
+		Create:                create.New(queryTimeout, logger),
+		DeactivateByID:        deactivatebyid.New(queryTimeout, logger),
+		ExistsByID:            existsbyid.New(queryTimeout, logger),
+		ExistsOtherActiveByID: existsotheractivebyid.New(queryTimeout, logger),
+		Update:                update.New(queryTimeout, logger),
 	}
 }
@@ -0,0 +1,104 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/utils/nullify"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto dailytask.UpdateDTO) (dailytask.DailyTask, error)
+}
+
+type Update struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Update {
+	r := &Update{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Update) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Update) Execute(ctx context.Context, tx pgx.Tx, dto dailytask.UpdateDTO) (dailytask.DailyTask, error) {
+	r.logger.Debug("[update daily task] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		UPDATE daily_tasks SET
+		    words_learned_need = $1,
+		    tasks_completed_need = $2,
+		    lessons_finished_need = $3,
+		    words_translate_need = $4,
+		    dialog_completed_need = $5,
+		    experience_points_need = $6,
+		    difficulty = $7,
+		    is_active = $8,
+		    updated_at = NOW()
+		WHERE id = $9
+		RETURNING *;
+	`
+
+	var result dailytask.DailyTask
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		r.getArgs(dto)...,
+	).Scan(
+		&result.ID, &result.WordsLearnedNeed,
+		&result.TasksCompletedNeed, &result.LessonsFinishedNeed,
+		&result.WordsTranslateNeed, &result.DialogCompletedNeed,
+		&result.ExperiencePointsNeed, &result.IsActive,
+		&result.CreatedAt, &result.UpdatedAt,
+		&result.Difficulty,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while update daily task", "err", err)
+			return dailytask.DailyTask{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to update daily task", "err", err)
+		return dailytask.DailyTask{}, fmt.Errorf("could not update daily task: %w", err)
+	}
+
+	return result, nil
+}
+
+// getArgs get args.
+func (r *Update) getArgs(dto dailytask.UpdateDTO) []interface{} {
+	return []interface{}{
+		nullify.EmptyInt64WithDefault(dto.WordsLearnedNeed),
+		nullify.EmptyInt64WithDefault(dto.TasksCompletedNeed),
+		nullify.EmptyInt64WithDefault(dto.LessonsFinishedNeed),
+		nullify.EmptyInt64WithDefault(dto.WordsTranslateNeed),
+		nullify.EmptyInt64WithDefault(dto.DialogCompletedNeed),
+		nullify.EmptyInt64WithDefault(dto.ExperiencePointsNeed),
+		dto.Difficulty,
+		dto.IsActive,
+		dto.ID,
+	}
+}
@@ -0,0 +1,68 @@
+package getleaderboardweeksworkerlag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	experiencepoint "github.com/go-jedi/lingramm_backend/internal/domain/experience_point"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetLeaderboardWeeksWorkerLag --output=mocks --case=underscore
+type IGetLeaderboardWeeksWorkerLag interface {
+	Execute(ctx context.Context, tx pgx.Tx, workerName string) (experiencepoint.GetLeaderboardWeeksWorkerLagResponse, error)
+}
+
+type GetLeaderboardWeeksWorkerLag struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetLeaderboardWeeksWorkerLag {
+	r := &GetLeaderboardWeeksWorkerLag{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetLeaderboardWeeksWorkerLag) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetLeaderboardWeeksWorkerLag) Execute(ctx context.Context, tx pgx.Tx, workerName string) (experiencepoint.GetLeaderboardWeeksWorkerLagResponse, error) {
+	r.logger.Debug("[get leaderboard weeks worker lag] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.leaderboard_weeks_worker_lag_get($1);`
+
+	var result experiencepoint.GetLeaderboardWeeksWorkerLagResponse
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		workerName,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get leaderboard weeks worker lag", "err", err)
+			return experiencepoint.GetLeaderboardWeeksWorkerLagResponse{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get leaderboard weeks worker lag", "err", err)
+		return experiencepoint.GetLeaderboardWeeksWorkerLagResponse{}, fmt.Errorf("could not get leaderboard weeks worker lag: %w", err)
+	}
+
+	return result, nil
+}
@@ -4,6 +4,7 @@ import (
 	createxpevents "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point/create_xp_events"
 	getleaderboardtopweek "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point/get_leaderboard_top_week"
 	getleaderboardtopweekforuser "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point/get_leaderboard_top_week_for_user"
+	getleaderboardweeksworkerlag "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point/get_leaderboard_weeks_worker_lag"
 	leaderboardweeksprocessbatch "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point/leaderboard_weeks_process_batch"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
@@ -12,6 +13,7 @@ type Repository struct {
 	CreateXPEvents               createxpevents.ICreateXPEvents
 	GetLeaderboardTopWeek        getleaderboardtopweek.IGetLeaderboardTopWeek
 	GetLeaderboardTopWeekForUser getleaderboardtopweekforuser.IGetLeaderboardTopWeekForUser
+	GetLeaderboardWeeksWorkerLag getleaderboardweeksworkerlag.IGetLeaderboardWeeksWorkerLag
 	LeaderboardWeeksProcessBatch leaderboardweeksprocessbatch.ILeaderboardWeeksProcessBatch
 }
 
@@ -23,6 +25,7 @@ func New(
 		CreateXPEvents:               createxpevents.New(queryTimeout, logger),
 		GetLeaderboardTopWeek:        getleaderboardtopweek.New(queryTimeout, logger),
 		GetLeaderboardTopWeekForUser: getleaderboardtopweekforuser.New(queryTimeout, logger),
+		GetLeaderboardWeeksWorkerLag: getleaderboardweeksworkerlag.New(queryTimeout, logger),
 		LeaderboardWeeksProcessBatch: leaderboardweeksprocessbatch.New(queryTimeout, logger),
 	}
 }
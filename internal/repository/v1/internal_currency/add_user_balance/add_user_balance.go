@@ -138,14 +138,18 @@ func (r *AddUserBalance) createBalanceTransaction(ctx context.Context, tx pgx.Tx
 		    telegram_id,
 		    amount,
 		    description,
-		    balance_after
-		) VALUES ($1, $2, $3, $4, $5);
+		    balance_after,
+		    source_type,
+		    source_id,
+		    direction
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, 'credit');
 	`
 
 	commandTag, err := tx.Exec(
 		ctxTimeout, q,
 		dto.EventTypeID, dto.TelegramID,
 		dto.Amount, nullify.EmptyString(dto.Description), newBalance,
+		dto.SourceType, dto.SourceID,
 	)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
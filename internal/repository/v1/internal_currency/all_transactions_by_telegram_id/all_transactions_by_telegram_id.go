@@ -0,0 +1,122 @@
+package alltransactionsbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	balancetransaction "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/balance_transaction"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllTransactionsByTelegramID --output=mocks --case=underscore
+type IAllTransactionsByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto balancetransaction.AllByTelegramIDDTO) ([]balancetransaction.Transaction, error)
+}
+
+type AllTransactionsByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllTransactionsByTelegramID {
+	r := &AllTransactionsByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllTransactionsByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns balance transactions of the user from newest to oldest.
+// Transactions with id less than cursor are returned, title is translated to dto language
+// and falls back to event type description.
+func (r *AllTransactionsByTelegramID) Execute(ctx context.Context, tx pgx.Tx, dto balancetransaction.AllByTelegramIDDTO) ([]balancetransaction.Transaction, error) {
+	r.logger.Debug("[get all balance transactions by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT
+			bt.id,
+			bt.telegram_id,
+			bt.event_type_id,
+			et.name,
+			bt.direction::TEXT,
+			bt.amount,
+			bt.balance_after,
+			COALESCE(tt.value, et.description),
+			bt.description,
+			bt.source_type,
+			bt.source_id,
+			bt.created_at
+		FROM balance_transactions bt
+		INNER JOIN event_types et ON et.id = bt.event_type_id
+		LEFT JOIN text_contents tc ON tc.code = 'balance_transaction_' || et.name
+		LEFT JOIN text_translations tt ON tt.content_id = tc.id AND tt.lang = $2
+		WHERE bt.telegram_id = $1
+		AND ($3::BIGINT IS NULL OR bt.id < $3)
+		AND ($4::TEXT IS NULL OR bt.direction::TEXT = $4)
+		AND ($5::TEXT IS NULL OR et.name = $5)
+		AND ($6::TIMESTAMPTZ IS NULL OR bt.created_at >= $6)
+		AND ($7::TIMESTAMPTZ IS NULL OR bt.created_at < $7)
+		ORDER BY bt.id DESC
+		LIMIT $8;
+	`
+
+	rows, err := tx.Query(
+		ctxTimeout, q,
+		dto.TelegramID, dto.Lang, dto.Cursor,
+		dto.Direction, dto.EventType,
+		dto.DateFrom, dto.DateTo, dto.Limit,
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all balance transactions by telegram id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all balance transactions by telegram id", "err", err)
+		return nil, fmt.Errorf("could not get all balance transactions by telegram id: %w", err)
+	}
+	defer rows.Close()
+
+	transactions := make([]balancetransaction.Transaction, 0)
+
+	for rows.Next() {
+		var t balancetransaction.Transaction
+
+		if err := rows.Scan(
+			&t.ID, &t.TelegramID, &t.EventTypeID, &t.EventTypeName,
+			&t.Direction, &t.Amount, &t.BalanceAfter,
+			&t.Title, &t.Description,
+			&t.SourceType, &t.SourceID, &t.CreatedAt,
+		); err != nil {
+			r.logger.Error("failed to scan row to get all balance transactions by telegram id", "err", err)
+			return nil, fmt.Errorf("failed to scan row to get all balance transactions by telegram id: %w", err)
+		}
+
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to get all balance transactions by telegram id", "err", rows.Err())
+		return nil, fmt.Errorf("failed to get all balance transactions by telegram id: %w", err)
+	}
+
+	return transactions, nil
+}
@@ -137,14 +137,18 @@ func (r *ReduceUserBalance) createBalanceTransaction(ctx context.Context, tx pgx
 		    telegram_id,
 		    amount,
 		    description,
-		    balance_after
-		) VALUES ($1, $2, $3, $4, $5);
+		    balance_after,
+		    source_type,
+		    source_id,
+		    direction
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, 'debit');
 	`
 
 	commandTag, err := tx.Exec(
 		ctxTimeout, q,
 		dto.EventTypeID, dto.TelegramID,
 		dto.Amount, dto.Description, newBalance,
+		dto.SourceType, dto.SourceID,
 	)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -2,15 +2,17 @@ package internalcurrency
 
 import (
 	adduserbalance "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency/add_user_balance"
+	alltransactionsbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency/all_transactions_by_telegram_id"
 	"github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency/get_user_balance"
 	reduceuserbalance "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency/reduce_user_balance"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
 
 type Repository struct {
-	AddUserBalance    adduserbalance.IAddUserBalance
-	GetUserBalance    getuserbalance.IGetUserBalance
-	ReduceUserBalance reduceuserbalance.IReduceUserBalance
+	AddUserBalance              adduserbalance.IAddUserBalance
+	AllTransactionsByTelegramID alltransactionsbytelegramid.IAllTransactionsByTelegramID
+	GetUserBalance              getuserbalance.IGetUserBalance
+	ReduceUserBalance           reduceuserbalance.IReduceUserBalance
 }
 
 func New(
@@ -18,8 +20,9 @@ func New(
 	logger logger.ILogger,
 ) *Repository {
 	return &Repository{
-		AddUserBalance:    adduserbalance.New(queryTimeout, logger),
-		GetUserBalance:    getuserbalance.New(queryTimeout, logger),
-		ReduceUserBalance: reduceuserbalance.New(queryTimeout, logger),
+		AddUserBalance:              adduserbalance.New(queryTimeout, logger),
+		AllTransactionsByTelegramID: alltransactionsbytelegramid.New(queryTimeout, logger),
+		GetUserBalance:              getuserbalance.New(queryTimeout, logger),
+		ReduceUserBalance:           reduceuserbalance.New(queryTimeout, logger),
 	}
 }
@@ -0,0 +1,74 @@
+package existsmemberbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsMemberByTelegramID --output=mocks --case=underscore
+type IExistsMemberByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (bool, error)
+}
+
+type ExistsMemberByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsMemberByTelegramID {
+	r := &ExistsMemberByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsMemberByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsMemberByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (bool, error) {
+	r.logger.Debug("[check league member exists by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM league_members
+			WHERE telegram_id = $1
+			AND week_start = DATE_TRUNC('week', (NOW() AT TIME ZONE 'Europe/Moscow'))::DATE
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check league member exists by telegram id", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check league member exists by telegram id", "err", err)
+		return false, fmt.Errorf("could not check league member exists by telegram id: %w", err)
+	}
+
+	return ie, nil
+}
@@ -0,0 +1,68 @@
+package getcohortstandingsbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	league "github.com/go-jedi/lingramm_backend/internal/domain/league"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetCohortStandingsByTelegramID --output=mocks --case=underscore
+type IGetCohortStandingsByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]league.GetCohortStandingsByTelegramIDResponse, error)
+}
+
+type GetCohortStandingsByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetCohortStandingsByTelegramID {
+	r := &GetCohortStandingsByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetCohortStandingsByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetCohortStandingsByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]league.GetCohortStandingsByTelegramIDResponse, error) {
+	r.logger.Debug("[get league cohort standings by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.league_cohort_standings_get($1);`
+
+	var result []league.GetCohortStandingsByTelegramIDResponse
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get league cohort standings by telegram id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get league cohort standings by telegram id", "err", err)
+		return nil, fmt.Errorf("could not get league cohort standings by telegram id: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package getcurrentbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	league "github.com/go-jedi/lingramm_backend/internal/domain/league"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetCurrentByTelegramID --output=mocks --case=underscore
+type IGetCurrentByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (league.GetCurrentByTelegramIDResponse, error)
+}
+
+type GetCurrentByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetCurrentByTelegramID {
+	r := &GetCurrentByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetCurrentByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetCurrentByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (league.GetCurrentByTelegramIDResponse, error) {
+	r.logger.Debug("[get current league by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.league_current_get($1);`
+
+	var result league.GetCurrentByTelegramIDResponse
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get current league by telegram id", "err", err)
+			return league.GetCurrentByTelegramIDResponse{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get current league by telegram id", "err", err)
+		return league.GetCurrentByTelegramIDResponse{}, fmt.Errorf("could not get current league by telegram id: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package joinbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IJoinByTelegramID --output=mocks --case=underscore
+type IJoinByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (bool, error)
+}
+
+type JoinByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *JoinByTelegramID {
+	r := &JoinByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *JoinByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *JoinByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (bool, error) {
+	r.logger.Debug("[join league by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.league_join($1);`
+
+	var joined bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&joined); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while join league by telegram id", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to join league by telegram id", "err", err)
+		return false, fmt.Errorf("could not join league by telegram id: %w", err)
+	}
+
+	return joined, nil
+}
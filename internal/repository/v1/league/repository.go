@@ -0,0 +1,34 @@
+package league
+
+import (
+	allhistorybytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/league/all_history_by_telegram_id"
+	existsmemberbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/league/exists_member_by_telegram_id"
+	getcohortstandingsbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/league/get_cohort_standings_by_telegram_id"
+	getcurrentbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/league/get_current_by_telegram_id"
+	joinbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/league/join_by_telegram_id"
+	weeksfinalize "github.com/go-jedi/lingramm_backend/internal/repository/v1/league/weeks_finalize"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	AllHistoryByTelegramID         allhistorybytelegramid.IAllHistoryByTelegramID
+	ExistsMemberByTelegramID       existsmemberbytelegramid.IExistsMemberByTelegramID
+	GetCohortStandingsByTelegramID getcohortstandingsbytelegramid.IGetCohortStandingsByTelegramID
+	GetCurrentByTelegramID         getcurrentbytelegramid.IGetCurrentByTelegramID
+	JoinByTelegramID               joinbytelegramid.IJoinByTelegramID
+	WeeksFinalize                  weeksfinalize.IWeeksFinalize
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		AllHistoryByTelegramID:         allhistorybytelegramid.New(queryTimeout, logger),
+		ExistsMemberByTelegramID:       existsmemberbytelegramid.New(queryTimeout, logger),
+		GetCohortStandingsByTelegramID: getcohortstandingsbytelegramid.New(queryTimeout, logger),
+		GetCurrentByTelegramID:         getcurrentbytelegramid.New(queryTimeout, logger),
+		JoinByTelegramID:               joinbytelegramid.New(queryTimeout, logger),
+		WeeksFinalize:                  weeksfinalize.New(queryTimeout, logger),
+	}
+}
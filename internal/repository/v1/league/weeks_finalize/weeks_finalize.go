@@ -0,0 +1,68 @@
+package weeksfinalize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	league "github.com/go-jedi/lingramm_backend/internal/domain/league"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IWeeksFinalize --output=mocks --case=underscore
+type IWeeksFinalize interface {
+	Execute(ctx context.Context, tx pgx.Tx, batchSize int64) (league.WeeksFinalizeResponse, error)
+}
+
+type WeeksFinalize struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *WeeksFinalize {
+	r := &WeeksFinalize{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *WeeksFinalize) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *WeeksFinalize) Execute(ctx context.Context, tx pgx.Tx, batchSize int64) (league.WeeksFinalizeResponse, error) {
+	r.logger.Debug("[league weeks finalize] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.league_weeks_finalize($1);`
+
+	var result league.WeeksFinalizeResponse
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		batchSize,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while league weeks finalize", "err", err)
+			return league.WeeksFinalizeResponse{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to league weeks finalize", "err", err)
+		return league.WeeksFinalizeResponse{}, fmt.Errorf("could not league weeks finalize: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,113 @@
+package allissuesbyjobid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllIssuesByJobID --output=mocks --case=underscore
+type IAllIssuesByJobID interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto ledgerreconciliation.AllIssuesByJobIDDTO) ([]ledgerreconciliation.Issue, error)
+}
+
+type AllIssuesByJobID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllIssuesByJobID {
+	r := &AllIssuesByJobID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllIssuesByJobID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns issues of the ledger reconciliation job in order they were found.
+// Issues with id greater than cursor are returned.
+func (r *AllIssuesByJobID) Execute(ctx context.Context, tx pgx.Tx, dto ledgerreconciliation.AllIssuesByJobIDDTO) ([]ledgerreconciliation.Issue, error) {
+	r.logger.Debug("[get all ledger reconciliation issues by job id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT
+			id,
+			job_id,
+			telegram_id,
+			type::TEXT,
+			expected_balance,
+			actual_balance,
+			difference,
+			transaction_id,
+			broken_links,
+			correction_transaction_id,
+			created_at
+		FROM ledger_reconciliation_issues
+		WHERE job_id = $1
+		AND ($2::TEXT IS NULL OR type::TEXT = $2)
+		AND ($3::BIGINT IS NULL OR id > $3)
+		ORDER BY id
+		LIMIT $4;
+	`
+
+	rows, err := tx.Query(
+		ctxTimeout, q,
+		dto.JobID, dto.Type,
+		dto.Cursor, dto.Limit,
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all ledger reconciliation issues by job id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all ledger reconciliation issues by job id", "err", err)
+		return nil, fmt.Errorf("could not get all ledger reconciliation issues by job id: %w", err)
+	}
+	defer rows.Close()
+
+	issues := make([]ledgerreconciliation.Issue, 0)
+
+	for rows.Next() {
+		var i ledgerreconciliation.Issue
+
+		if err := rows.Scan(
+			&i.ID, &i.JobID, &i.TelegramID, &i.Type,
+			&i.ExpectedBalance, &i.ActualBalance, &i.Difference,
+			&i.TransactionID, &i.BrokenLinks, &i.CorrectionTransactionID,
+			&i.CreatedAt,
+		); err != nil {
+			r.logger.Error("failed to scan row to get all ledger reconciliation issues by job id", "err", err)
+			return nil, fmt.Errorf("failed to scan row to get all ledger reconciliation issues by job id: %w", err)
+		}
+
+		issues = append(issues, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to get all ledger reconciliation issues by job id", "err", err)
+		return nil, fmt.Errorf("failed to get all ledger reconciliation issues by job id: %w", err)
+	}
+
+	return issues, nil
+}
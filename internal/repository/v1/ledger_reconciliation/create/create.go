@@ -0,0 +1,68 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto ledgerreconciliation.CreateDTO) (ledgerreconciliation.Job, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto ledgerreconciliation.CreateDTO) (ledgerreconciliation.Job, error) {
+	r.logger.Debug("[create ledger reconciliation job] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.ledger_reconciliation_job_create($1);`
+
+	var result ledgerreconciliation.Job
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create ledger reconciliation job", "err", err)
+			return ledgerreconciliation.Job{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create ledger reconciliation job", "err", err)
+		return ledgerreconciliation.Job{}, fmt.Errorf("could not create ledger reconciliation job: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package processchunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IProcessChunk --output=mocks --case=underscore
+type IProcessChunk interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto ledgerreconciliation.ProcessChunkDTO) (*ledgerreconciliation.Job, error)
+}
+
+type ProcessChunk struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ProcessChunk {
+	r := &ProcessChunk{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ProcessChunk) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ProcessChunk) Execute(ctx context.Context, tx pgx.Tx, dto ledgerreconciliation.ProcessChunkDTO) (*ledgerreconciliation.Job, error) {
+	r.logger.Debug("[process ledger reconciliation job chunk] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.ledger_reconciliation_job_process_chunk($1, $2);`
+
+	var result *ledgerreconciliation.Job
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.JobID,
+		dto.ChunkSize,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while process ledger reconciliation job chunk", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to process ledger reconciliation job chunk", "err", err)
+		return nil, fmt.Errorf("could not process ledger reconciliation job chunk: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package ledgerreconciliation
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation/all"
+	allissuesbyjobid "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation/all_issues_by_job_id"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation/create"
+	existsbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation/exists_by_id"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation/get_by_id"
+	processchunk "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation/process_chunk"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation/schedule"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	All              all.IAll
+	AllIssuesByJobID allissuesbyjobid.IAllIssuesByJobID
+	Create           create.ICreate
+	ExistsByID       existsbyid.IExistsByID
+	GetByID          getbyid.IGetByID
+	ProcessChunk     processchunk.IProcessChunk
+	Schedule         schedule.ISchedule
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		All:              all.New(queryTimeout, logger),
+		AllIssuesByJobID: allissuesbyjobid.New(queryTimeout, logger),
+		Create:           create.New(queryTimeout, logger),
+		ExistsByID:       existsbyid.New(queryTimeout, logger),
+		GetByID:          getbyid.New(queryTimeout, logger),
+		ProcessChunk:     processchunk.New(queryTimeout, logger),
+		Schedule:         schedule.New(queryTimeout, logger),
+	}
+}
@@ -0,0 +1,70 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ISchedule --output=mocks --case=underscore
+type ISchedule interface {
+	Execute(ctx context.Context, tx pgx.Tx, intervalMinutes int64) (*ledgerreconciliation.Job, error)
+}
+
+type Schedule struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Schedule {
+	r := &Schedule{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Schedule) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute creates a dry run reconciliation of all users if there was none within the interval,
+// otherwise it returns nil.
+func (r *Schedule) Execute(ctx context.Context, tx pgx.Tx, intervalMinutes int64) (*ledgerreconciliation.Job, error) {
+	r.logger.Debug("[schedule ledger reconciliation job] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.ledger_reconciliation_job_schedule($1);`
+
+	var result *ledgerreconciliation.Job
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		intervalMinutes,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while schedule ledger reconciliation job", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to schedule ledger reconciliation job", "err", err)
+		return nil, fmt.Errorf("could not schedule ledger reconciliation job: %w", err)
+	}
+
+	return result, nil
+}
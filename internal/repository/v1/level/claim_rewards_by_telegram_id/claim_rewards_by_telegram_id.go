@@ -0,0 +1,68 @@
+package claimrewardsbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IClaimRewardsByTelegramID --output=mocks --case=underscore
+type IClaimRewardsByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]level.UserLevelReward, error)
+}
+
+type ClaimRewardsByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ClaimRewardsByTelegramID {
+	r := &ClaimRewardsByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ClaimRewardsByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ClaimRewardsByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]level.UserLevelReward, error) {
+	r.logger.Debug("[claim level rewards by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.level_rewards_claim($1);`
+
+	var result []level.UserLevelReward
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while claim level rewards by telegram id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to claim level rewards by telegram id", "err", err)
+		return nil, fmt.Errorf("could not claim level rewards by telegram id: %w", err)
+	}
+
+	return result, nil
+}
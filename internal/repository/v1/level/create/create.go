@@ -0,0 +1,78 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto level.CreateDTO) (level.Level, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto level.CreateDTO) (level.Level, error) {
+	r.logger.Debug("[create a new level] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO levels(
+		    level_name,
+		    level_number,
+		    required_experience
+		) VALUES ($1, $2, $3)
+		RETURNING *;
+	`
+
+	var nl level.Level
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.LevelName, dto.LevelNumber, dto.RequiredExperience,
+	).Scan(
+		&nl.ID, &nl.LevelName, &nl.LevelNumber,
+		&nl.RequiredExperience, &nl.CreatedAt, &nl.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create a new level", "err", err)
+			return level.Level{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create a new level", "err", err)
+		return level.Level{}, fmt.Errorf("could not create a new level: %w", err)
+	}
+
+	return nl, nil
+}
@@ -0,0 +1,82 @@
+package createreward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreateReward --output=mocks --case=underscore
+type ICreateReward interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto level.CreateRewardDTO) (level.LevelReward, error)
+}
+
+type CreateReward struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *CreateReward {
+	r := &CreateReward{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *CreateReward) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *CreateReward) Execute(ctx context.Context, tx pgx.Tx, dto level.CreateRewardDTO) (level.LevelReward, error) {
+	r.logger.Debug("[create a new level reward] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO level_rewards(
+		    level_number,
+		    type,
+		    amount,
+		    award_asset_id,
+		    subscription_days
+		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING *;
+	`
+
+	var nr level.LevelReward
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.LevelNumber, dto.Type, dto.Amount,
+		dto.AwardAssetID, dto.SubscriptionDays,
+	).Scan(
+		&nr.ID, &nr.LevelNumber, &nr.Type,
+		&nr.Amount, &nr.AwardAssetID, &nr.SubscriptionDays,
+		&nr.CreatedAt, &nr.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create a new level reward", "err", err)
+			return level.LevelReward{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create a new level reward", "err", err)
+		return level.LevelReward{}, fmt.Errorf("could not create a new level reward: %w", err)
+	}
+
+	return nr, nil
+}
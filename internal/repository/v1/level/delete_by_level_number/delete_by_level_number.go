@@ -0,0 +1,75 @@
+package deletebylevelnumber
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteByLevelNumber --output=mocks --case=underscore
+type IDeleteByLevelNumber interface {
+	Execute(ctx context.Context, tx pgx.Tx, levelNumber int64) (level.Level, error)
+}
+
+type DeleteByLevelNumber struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *DeleteByLevelNumber {
+	r := &DeleteByLevelNumber{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *DeleteByLevelNumber) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *DeleteByLevelNumber) Execute(ctx context.Context, tx pgx.Tx, levelNumber int64) (level.Level, error) {
+	r.logger.Debug("[delete level by level number] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		DELETE FROM levels
+		WHERE level_number = $1
+		RETURNING *;
+	`
+
+	var nl level.Level
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		levelNumber,
+	).Scan(
+		&nl.ID, &nl.LevelName, &nl.LevelNumber,
+		&nl.RequiredExperience, &nl.CreatedAt, &nl.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while delete level by level number", "err", err)
+			return level.Level{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to delete level by level number", "err", err)
+		return level.Level{}, fmt.Errorf("could not delete level by level number: %w", err)
+	}
+
+	return nl, nil
+}
@@ -0,0 +1,74 @@
+package existsbylevelname
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsByLevelName --output=mocks --case=underscore
+type IExistsByLevelName interface {
+	Execute(ctx context.Context, tx pgx.Tx, levelName string, excludeLevelNumber int64) (bool, error)
+}
+
+type ExistsByLevelName struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsByLevelName {
+	r := &ExistsByLevelName{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsByLevelName) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsByLevelName) Execute(ctx context.Context, tx pgx.Tx, levelName string, excludeLevelNumber int64) (bool, error) {
+	r.logger.Debug("[check level exists by level name] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM levels
+			WHERE level_name = $1
+			AND level_number <> $2
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		levelName, excludeLevelNumber,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check level exists by level name", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check level exists by level name", "err", err)
+		return false, fmt.Errorf("could not check level exists by level name: %w", err)
+	}
+
+	return ie, nil
+}
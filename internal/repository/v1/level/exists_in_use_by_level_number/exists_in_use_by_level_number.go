@@ -0,0 +1,77 @@
+package existsinusebylevelnumber
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsInUseByLevelNumber --output=mocks --case=underscore
+type IExistsInUseByLevelNumber interface {
+	Execute(ctx context.Context, tx pgx.Tx, levelNumber int64) (bool, error)
+}
+
+type ExistsInUseByLevelNumber struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsInUseByLevelNumber {
+	r := &ExistsInUseByLevelNumber{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsInUseByLevelNumber) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsInUseByLevelNumber) Execute(ctx context.Context, tx pgx.Tx, levelNumber int64) (bool, error) {
+	r.logger.Debug("[check level in use by level number] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM user_stats
+			WHERE level = $1
+		) OR EXISTS(
+			SELECT 1
+			FROM user_level_history
+			WHERE level_number = $1
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		levelNumber,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check level in use by level number", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check level in use by level number", "err", err)
+		return false, fmt.Errorf("could not check level in use by level number: %w", err)
+	}
+
+	return ie, nil
+}
@@ -0,0 +1,74 @@
+package existsthresholdconflict
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsThresholdConflict --output=mocks --case=underscore
+type IExistsThresholdConflict interface {
+	Execute(ctx context.Context, tx pgx.Tx, levelNumber int64, requiredExperience int64) (bool, error)
+}
+
+type ExistsThresholdConflict struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsThresholdConflict {
+	r := &ExistsThresholdConflict{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsThresholdConflict) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsThresholdConflict) Execute(ctx context.Context, tx pgx.Tx, levelNumber int64, requiredExperience int64) (bool, error) {
+	r.logger.Debug("[check level threshold conflict] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM levels
+			WHERE (level_number < $1 AND required_experience >= $2)
+			OR (level_number > $1 AND required_experience <= $2)
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		levelNumber, requiredExperience,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check level threshold conflict", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check level threshold conflict", "err", err)
+		return false, fmt.Errorf("could not check level threshold conflict: %w", err)
+	}
+
+	return ie, nil
+}
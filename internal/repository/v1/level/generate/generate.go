@@ -0,0 +1,70 @@
+package generate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGenerate --output=mocks --case=underscore
+type IGenerate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto level.GenerateDTO) ([]level.Level, error)
+}
+
+type Generate struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Generate {
+	r := &Generate{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Generate) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Generate) Execute(ctx context.Context, tx pgx.Tx, dto level.GenerateDTO) ([]level.Level, error) {
+	r.logger.Debug("[generate levels] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.levels_generate($1, $2, $3);`
+
+	var result []level.Level
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.ToLevel,
+		dto.BaseExperience,
+		dto.Exponent,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while generate levels", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to generate levels", "err", err)
+		return nil, fmt.Errorf("could not generate levels: %w", err)
+	}
+
+	return result, nil
+}
@@ -1,14 +1,40 @@
 package level
 
 import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/level/all"
 	backfillmissinglevelhistorybytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/back_fill_missing_level_history_by_telegram_id"
+	claimrewardsbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/claim_rewards_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/level/create"
+	createreward "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/create_reward"
 	createuserlevelhistory "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/create_user_level_history"
+	deletebylevelnumber "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/delete_by_level_number"
+	deleterewardbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/delete_reward_by_id"
+	existsbylevelname "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/exists_by_level_name"
+	existsbylevelnumber "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/exists_by_level_number"
+	existsinusebylevelnumber "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/exists_in_use_by_level_number"
+	existsrewardbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/exists_reward_by_id"
+	existsthresholdconflict "github.com/go-jedi/lingramm_backend/internal/repository/v1/level/exists_threshold_conflict"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/level/generate"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/level/update"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
 
 type Repository struct {
+	All                                     all.IAll
 	BackFillMissingLevelHistoryByTelegramID backfillmissinglevelhistorybytelegramid.IBackFillMissingLevelHistoryByTelegramID
+	ClaimRewardsByTelegramID                claimrewardsbytelegramid.IClaimRewardsByTelegramID
+	Create                                  create.ICreate
+	CreateReward                            createreward.ICreateReward
 	CreateUserLevelHistory                  createuserlevelhistory.ICreateUserLevelHistory
+	DeleteByLevelNumber                     deletebylevelnumber.IDeleteByLevelNumber
+	DeleteRewardByID                        deleterewardbyid.IDeleteRewardByID
+	ExistsByLevelName                       existsbylevelname.IExistsByLevelName
+	ExistsByLevelNumber                     existsbylevelnumber.IExistsByLevelNumber
+	ExistsInUseByLevelNumber                existsinusebylevelnumber.IExistsInUseByLevelNumber
+	ExistsRewardByID                        existsrewardbyid.IExistsRewardByID
+	ExistsThresholdConflict                 existsthresholdconflict.IExistsThresholdConflict
+	Generate                                generate.IGenerate
+	Update                                  update.IUpdate
 }
 
 func New(
@@ -16,7 +42,20 @@ func New(
 	logger logger.ILogger,
 ) *Repository {
 	return &Repository{
+		All:                                     all.New(queryTimeout, logger),
 		BackFillMissingLevelHistoryByTelegramID: backfillmissinglevelhistorybytelegramid.New(queryTimeout, logger),
+		ClaimRewardsByTelegramID:                claimrewardsbytelegramid.New(queryTimeout, logger),
+		Create:                                  create.New(queryTimeout, logger),
+		CreateReward:                            createreward.New(queryTimeout, logger),
 		CreateUserLevelHistory:                  createuserlevelhistory.New(queryTimeout, logger),
+		DeleteByLevelNumber:                     deletebylevelnumber.New(queryTimeout, logger),
+		DeleteRewardByID:                        deleterewardbyid.New(queryTimeout, logger),
+		ExistsByLevelName:                       existsbylevelname.New(queryTimeout, logger),
+		ExistsByLevelNumber:                     existsbylevelnumber.New(queryTimeout, logger),
+		ExistsInUseByLevelNumber:                existsinusebylevelnumber.New(queryTimeout, logger),
+		ExistsRewardByID:                        existsrewardbyid.New(queryTimeout, logger),
+		ExistsThresholdConflict:                 existsthresholdconflict.New(queryTimeout, logger),
+		Generate:                                generate.New(queryTimeout, logger),
+		Update:                                  update.New(queryTimeout, logger),
 	}
 }
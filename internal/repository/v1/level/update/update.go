@@ -0,0 +1,78 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto level.UpdateDTO) (level.Level, error)
+}
+
+type Update struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Update {
+	r := &Update{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Update) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Update) Execute(ctx context.Context, tx pgx.Tx, dto level.UpdateDTO) (level.Level, error) {
+	r.logger.Debug("[update level] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		UPDATE levels SET
+		    level_name = $1,
+		    required_experience = $2,
+		    updated_at = NOW()
+		WHERE level_number = $3
+		RETURNING *;
+	`
+
+	var nl level.Level
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.LevelName, dto.RequiredExperience, dto.LevelNumber,
+	).Scan(
+		&nl.ID, &nl.LevelName, &nl.LevelNumber,
+		&nl.RequiredExperience, &nl.CreatedAt, &nl.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while update level", "err", err)
+			return level.Level{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to update level", "err", err)
+		return level.Level{}, fmt.Errorf("could not update level: %w", err)
+	}
+
+	return nl, nil
+}
@@ -0,0 +1,73 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto payment.CreateDTO) (payment.CreateResult, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto payment.CreateDTO) (payment.CreateResult, error) {
+	r.logger.Debug("[create a new payment] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.payment_create($1, $2, $3, $4, $5, $6);`
+
+	var result payment.CreateResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.TelegramID,
+		dto.ProductType,
+		dto.PlanCode,
+		dto.ShopItemID,
+		dto.Quantity,
+		dto.InvoicePayload,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create a new payment", "err", err)
+			return payment.CreateResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create a new payment", "err", err)
+		return payment.CreateResult{}, fmt.Errorf("could not create a new payment: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package existsbyinvoicepayload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsByInvoicePayload --output=mocks --case=underscore
+type IExistsByInvoicePayload interface {
+	Execute(ctx context.Context, tx pgx.Tx, invoicePayload string) (bool, error)
+}
+
+type ExistsByInvoicePayload struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsByInvoicePayload {
+	r := &ExistsByInvoicePayload{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsByInvoicePayload) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsByInvoicePayload) Execute(ctx context.Context, tx pgx.Tx, invoicePayload string) (bool, error) {
+	r.logger.Debug("[check payment exists by invoice payload] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM payments
+			WHERE invoice_payload = $1
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		invoicePayload,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check payment exists by invoice payload", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check payment exists by invoice payload", "err", err)
+		return false, fmt.Errorf("could not check payment exists by invoice payload: %w", err)
+	}
+
+	return ie, nil
+}
@@ -0,0 +1,72 @@
+package fulfil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IFulfil --output=mocks --case=underscore
+type IFulfil interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto payment.FulfilDTO) (payment.FulfilResult, error)
+}
+
+type Fulfil struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Fulfil {
+	r := &Fulfil{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Fulfil) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Fulfil) Execute(ctx context.Context, tx pgx.Tx, dto payment.FulfilDTO) (payment.FulfilResult, error) {
+	r.logger.Debug("[fulfil payment] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.payment_fulfil($1, $2, $3, $4, $5);`
+
+	var result payment.FulfilResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.InvoicePayload,
+		dto.TelegramPaymentChargeID,
+		dto.ProviderPaymentChargeID,
+		dto.Currency,
+		dto.TotalAmount,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while fulfil payment", "err", err)
+			return payment.FulfilResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to fulfil payment", "err", err)
+		return payment.FulfilResult{}, fmt.Errorf("could not fulfil payment: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package getbyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByID --output=mocks --case=underscore
+type IGetByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (payment.Payment, error)
+}
+
+type GetByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetByID {
+	r := &GetByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (payment.Payment, error) {
+	r.logger.Debug("[get payment by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT TO_JSONB(p)
+		FROM payments p
+		WHERE p.id = $1;
+	`
+
+	var result payment.Payment
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get payment by id", "err", err)
+			return payment.Payment{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get payment by id", "err", err)
+		return payment.Payment{}, fmt.Errorf("could not get payment by id: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package getbyinvoicepayload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByInvoicePayload --output=mocks --case=underscore
+type IGetByInvoicePayload interface {
+	Execute(ctx context.Context, tx pgx.Tx, invoicePayload string) (payment.Payment, error)
+}
+
+type GetByInvoicePayload struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetByInvoicePayload {
+	r := &GetByInvoicePayload{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetByInvoicePayload) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetByInvoicePayload) Execute(ctx context.Context, tx pgx.Tx, invoicePayload string) (payment.Payment, error) {
+	r.logger.Debug("[get payment by invoice payload] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT TO_JSONB(p)
+		FROM payments p
+		WHERE p.invoice_payload = $1;
+	`
+
+	var result payment.Payment
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		invoicePayload,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get payment by invoice payload", "err", err)
+			return payment.Payment{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get payment by invoice payload", "err", err)
+		return payment.Payment{}, fmt.Errorf("could not get payment by invoice payload: %w", err)
+	}
+
+	return result, nil
+}
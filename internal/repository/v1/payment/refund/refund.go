@@ -0,0 +1,68 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IRefund --output=mocks --case=underscore
+type IRefund interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (payment.Payment, error)
+}
+
+type Refund struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Refund {
+	r := &Refund{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Refund) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Refund) Execute(ctx context.Context, tx pgx.Tx, id int64) (payment.Payment, error) {
+	r.logger.Debug("[refund payment] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT TO_JSONB(public.payment_refund($1));`
+
+	var result payment.Payment
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while refund payment", "err", err)
+			return payment.Payment{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to refund payment", "err", err)
+		return payment.Payment{}, fmt.Errorf("could not refund payment: %w", err)
+	}
+
+	return result, nil
+}
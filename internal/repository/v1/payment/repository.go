@@ -0,0 +1,37 @@
+package payment
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/payment/create"
+	existsbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment/exists_by_id"
+	existsbyinvoicepayload "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment/exists_by_invoice_payload"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/payment/fulfil"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment/get_by_id"
+	getbyinvoicepayload "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment/get_by_invoice_payload"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/payment/refund"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	Create                 create.ICreate
+	ExistsByID             existsbyid.IExistsByID
+	ExistsByInvoicePayload existsbyinvoicepayload.IExistsByInvoicePayload
+	Fulfil                 fulfil.IFulfil
+	GetByID                getbyid.IGetByID
+	GetByInvoicePayload    getbyinvoicepayload.IGetByInvoicePayload
+	Refund                 refund.IRefund
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		Create:                 create.New(queryTimeout, logger),
+		ExistsByID:             existsbyid.New(queryTimeout, logger),
+		ExistsByInvoicePayload: existsbyinvoicepayload.New(queryTimeout, logger),
+		Fulfil:                 fulfil.New(queryTimeout, logger),
+		GetByID:                getbyid.New(queryTimeout, logger),
+		GetByInvoicePayload:    getbyinvoicepayload.New(queryTimeout, logger),
+		Refund:                 refund.New(queryTimeout, logger),
+	}
+}
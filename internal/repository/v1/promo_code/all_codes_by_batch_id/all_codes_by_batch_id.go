@@ -0,0 +1,95 @@
+package allcodesbybatchid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllCodesByBatchID --output=mocks --case=underscore
+type IAllCodesByBatchID interface {
+	Execute(ctx context.Context, tx pgx.Tx, batchID int64) ([]promocode.PromoCode, error)
+}
+
+type AllCodesByBatchID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllCodesByBatchID {
+	r := &AllCodesByBatchID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllCodesByBatchID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns all codes of the batch.
+func (r *AllCodesByBatchID) Execute(ctx context.Context, tx pgx.Tx, batchID int64) ([]promocode.PromoCode, error) {
+	r.logger.Debug("[get all promo codes by batch id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT *
+		FROM promo_codes
+		WHERE batch_id = $1
+		ORDER BY id;
+	`
+
+	rows, err := tx.Query(
+		ctxTimeout, q,
+		batchID,
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all promo codes by batch id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all promo codes by batch id", "err", err)
+		return nil, fmt.Errorf("could not get all promo codes by batch id: %w", err)
+	}
+	defer rows.Close()
+
+	codes := make([]promocode.PromoCode, 0)
+
+	for rows.Next() {
+		var pc promocode.PromoCode
+
+		if err := rows.Scan(
+			&pc.ID, &pc.BatchID, &pc.Code,
+			&pc.RedemptionsCount, &pc.CreatedAt, &pc.UpdatedAt,
+		); err != nil {
+			r.logger.Error("failed to scan row to get all promo codes by batch id", "err", err)
+			return nil, fmt.Errorf("failed to scan row to get all promo codes by batch id: %w", err)
+		}
+
+		codes = append(codes, pc)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to get all promo codes by batch id", "err", err)
+		return nil, fmt.Errorf("failed to get all promo codes by batch id: %w", err)
+	}
+
+	return codes, nil
+}
@@ -0,0 +1,105 @@
+package createbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/utils/nullify"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreateBatch --output=mocks --case=underscore
+type ICreateBatch interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto promocode.CreateBatchDTO) (promocode.Batch, error)
+}
+
+type CreateBatch struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *CreateBatch {
+	r := &CreateBatch{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *CreateBatch) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute creates a new promo code batch without codes.
+func (r *CreateBatch) Execute(ctx context.Context, tx pgx.Tx, dto promocode.CreateBatchDTO) (promocode.Batch, error) {
+	r.logger.Debug("[create a new promo code batch] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO promo_code_batches(
+		    name,
+		    reward_type,
+		    amount,
+		    subscription_days,
+		    shop_item_id,
+		    quantity,
+		    max_redemptions,
+		    per_user_limit,
+		    expires_at
+		) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING *;
+	`
+
+	var result promocode.Batch
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		r.getArgs(dto)...,
+	).Scan(
+		&result.ID, &result.Name, &result.RewardType,
+		&result.Amount, &result.SubscriptionDays,
+		&result.ShopItemID, &result.Quantity,
+		&result.MaxRedemptions, &result.PerUserLimit,
+		&result.ExpiresAt, &result.IsActive,
+		&result.CreatedAt, &result.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create a new promo code batch", "err", err)
+			return promocode.Batch{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create a new promo code batch", "err", err)
+		return promocode.Batch{}, fmt.Errorf("could not create a new promo code batch: %w", err)
+	}
+
+	return result, nil
+}
+
+// getArgs get args.
+func (r *CreateBatch) getArgs(dto promocode.CreateBatchDTO) []interface{} {
+	return []interface{}{
+		dto.Name,
+		dto.RewardType,
+		nullify.EmptyDecimal(dto.Amount),
+		nullify.EmptyInt64(dto.SubscriptionDays),
+		nullify.EmptyInt64(dto.ShopItemID),
+		nullify.EmptyInt64(dto.Quantity),
+		nullify.EmptyInt64(dto.MaxRedemptions),
+		dto.PerUserLimit,
+		dto.ExpiresAt,
+	}
+}
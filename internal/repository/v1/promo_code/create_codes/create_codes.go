@@ -0,0 +1,96 @@
+package createcodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreateCodes --output=mocks --case=underscore
+type ICreateCodes interface {
+	Execute(ctx context.Context, tx pgx.Tx, batchID int64, codes []string) ([]string, error)
+}
+
+type CreateCodes struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *CreateCodes {
+	r := &CreateCodes{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *CreateCodes) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute creates codes of the batch and returns created ones.
+// Codes that already exist are skipped, so the caller can generate new codes instead of them.
+func (r *CreateCodes) Execute(ctx context.Context, tx pgx.Tx, batchID int64, codes []string) ([]string, error) {
+	r.logger.Debug("[create promo codes] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO promo_codes(
+		    batch_id,
+		    code
+		)
+		SELECT $1, UNNEST($2::TEXT[])
+		ON CONFLICT (code) DO NOTHING
+		RETURNING code;
+	`
+
+	rows, err := tx.Query(
+		ctxTimeout, q,
+		batchID,
+		codes,
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create promo codes", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create promo codes", "err", err)
+		return nil, fmt.Errorf("could not create promo codes: %w", err)
+	}
+	defer rows.Close()
+
+	created := make([]string, 0)
+
+	for rows.Next() {
+		var code string
+
+		if err := rows.Scan(&code); err != nil {
+			r.logger.Error("failed to scan row to create promo codes", "err", err)
+			return nil, fmt.Errorf("failed to scan row to create promo codes: %w", err)
+		}
+
+		created = append(created, code)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to create promo codes", "err", err)
+		return nil, fmt.Errorf("failed to create promo codes: %w", err)
+	}
+
+	return created, nil
+}
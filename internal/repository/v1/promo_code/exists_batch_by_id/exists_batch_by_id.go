@@ -0,0 +1,74 @@
+package existsbatchbyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsBatchByID --output=mocks --case=underscore
+type IExistsBatchByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, batchID int64) (bool, error)
+}
+
+type ExistsBatchByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsBatchByID {
+	r := &ExistsBatchByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsBatchByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute checks promo code batch exists by id.
+func (r *ExistsBatchByID) Execute(ctx context.Context, tx pgx.Tx, batchID int64) (bool, error) {
+	r.logger.Debug("[check promo code batch exists by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM promo_code_batches
+			WHERE id = $1
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		batchID,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check promo code batch exists by id", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check promo code batch exists by id", "err", err)
+		return false, fmt.Errorf("could not check promo code batch exists by id: %w", err)
+	}
+
+	return ie, nil
+}
@@ -0,0 +1,74 @@
+package existsbycode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsByCode --output=mocks --case=underscore
+type IExistsByCode interface {
+	Execute(ctx context.Context, tx pgx.Tx, code string) (bool, error)
+}
+
+type ExistsByCode struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsByCode {
+	r := &ExistsByCode{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsByCode) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute checks promo code exists by code.
+func (r *ExistsByCode) Execute(ctx context.Context, tx pgx.Tx, code string) (bool, error) {
+	r.logger.Debug("[check promo code exists by code] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM promo_codes
+			WHERE code = $1
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		code,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check promo code exists by code", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check promo code exists by code", "err", err)
+		return false, fmt.Errorf("could not check promo code exists by code: %w", err)
+	}
+
+	return ie, nil
+}
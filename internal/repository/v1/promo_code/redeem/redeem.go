@@ -0,0 +1,71 @@
+package redeem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IRedeem --output=mocks --case=underscore
+type IRedeem interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string, code string) (promocode.RedeemResult, error)
+}
+
+type Redeem struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Redeem {
+	r := &Redeem{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Redeem) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute redeems promo code by user and returns the status of redemption.
+// Reward of internal currency is not granted by the database, other rewards are granted in the same transaction.
+func (r *Redeem) Execute(ctx context.Context, tx pgx.Tx, telegramID string, code string) (promocode.RedeemResult, error) {
+	r.logger.Debug("[redeem promo code] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.promo_code_redeem($1, $2);`
+
+	var result promocode.RedeemResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+		code,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while redeem promo code", "err", err)
+			return promocode.RedeemResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to redeem promo code", "err", err)
+		return promocode.RedeemResult{}, fmt.Errorf("could not redeem promo code: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IReport --output=mocks --case=underscore
+type IReport interface {
+	Execute(ctx context.Context, tx pgx.Tx) ([]promocode.BatchReport, error)
+}
+
+type Report struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Report {
+	r := &Report{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Report) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns redemption statistics of all batches, newest batches first.
+func (r *Report) Execute(ctx context.Context, tx pgx.Tx) ([]promocode.BatchReport, error) {
+	r.logger.Debug("[get promo code report] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT
+			b.id,
+			b.name,
+			b.reward_type,
+			b.amount,
+			b.subscription_days,
+			b.shop_item_id,
+			b.quantity,
+			b.max_redemptions,
+			b.per_user_limit,
+			b.expires_at,
+			b.is_active,
+			b.created_at,
+			b.updated_at,
+			(SELECT COUNT(*) FROM promo_codes c WHERE c.batch_id = b.id),
+			(SELECT COUNT(*) FROM promo_codes c WHERE c.batch_id = b.id AND c.redemptions_count > 0),
+			COUNT(r.id),
+			COUNT(DISTINCT r.telegram_id),
+			SUM(r.amount),
+			MAX(r.created_at)
+		FROM promo_code_batches b
+		LEFT JOIN promo_code_redemptions r ON r.batch_id = b.id
+		GROUP BY b.id
+		ORDER BY b.id DESC;
+	`
+
+	rows, err := tx.Query(ctxTimeout, q)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get promo code report", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get promo code report", "err", err)
+		return nil, fmt.Errorf("could not get promo code report: %w", err)
+	}
+	defer rows.Close()
+
+	report := make([]promocode.BatchReport, 0)
+
+	for rows.Next() {
+		var br promocode.BatchReport
+
+		if err := rows.Scan(
+			&br.ID, &br.Name, &br.RewardType,
+			&br.Amount, &br.SubscriptionDays,
+			&br.ShopItemID, &br.Quantity,
+			&br.MaxRedemptions, &br.PerUserLimit,
+			&br.ExpiresAt, &br.IsActive,
+			&br.CreatedAt, &br.UpdatedAt,
+			&br.CodesCount, &br.RedeemedCodesCount,
+			&br.RedemptionsCount, &br.UsersCount,
+			&br.AmountGranted, &br.LastRedeemedAt,
+		); err != nil {
+			r.logger.Error("failed to scan row to get promo code report", "err", err)
+			return nil, fmt.Errorf("failed to scan row to get promo code report: %w", err)
+		}
+
+		report = append(report, br)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to get promo code report", "err", err)
+		return nil, fmt.Errorf("failed to get promo code report: %w", err)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,37 @@
+package promocode
+
+import (
+	allcodesbybatchid "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code/all_codes_by_batch_id"
+	createbatch "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code/create_batch"
+	createcodes "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code/create_codes"
+	existsbatchbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code/exists_batch_by_id"
+	existsbycode "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code/exists_by_code"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code/redeem"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code/report"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	AllCodesByBatchID allcodesbybatchid.IAllCodesByBatchID
+	CreateBatch       createbatch.ICreateBatch
+	CreateCodes       createcodes.ICreateCodes
+	ExistsBatchByID   existsbatchbyid.IExistsBatchByID
+	ExistsByCode      existsbycode.IExistsByCode
+	Redeem            redeem.IRedeem
+	Report            report.IReport
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		AllCodesByBatchID: allcodesbybatchid.New(queryTimeout, logger),
+		CreateBatch:       createbatch.New(queryTimeout, logger),
+		CreateCodes:       createcodes.New(queryTimeout, logger),
+		ExistsBatchByID:   existsbatchbyid.New(queryTimeout, logger),
+		ExistsByCode:      existsbycode.New(queryTimeout, logger),
+		Redeem:            redeem.New(queryTimeout, logger),
+		Report:            report.New(queryTimeout, logger),
+	}
+}
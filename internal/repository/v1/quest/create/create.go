@@ -0,0 +1,113 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/quest"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/utils/nullify"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto quest.CreateDTO) (quest.Quest, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto quest.CreateDTO) (quest.Quest, error) {
+	r.logger.Debug("[create a new quest] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO quests(
+		    name,
+		    description,
+		    cadence,
+		    words_learned_need,
+		    tasks_completed_need,
+		    lessons_finished_need,
+		    words_translate_need,
+		    dialog_completed_need,
+		    experience_points_need,
+		    reward_amount,
+		    reward_experience_points,
+		    duration_days,
+		    is_active
+		) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING *;
+	`
+
+	var nq quest.Quest
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		r.getArgs(dto)...,
+	).Scan(
+		&nq.ID, &nq.Name, &nq.Description, &nq.Cadence,
+		&nq.WordsLearnedNeed, &nq.TasksCompletedNeed,
+		&nq.LessonsFinishedNeed, &nq.WordsTranslateNeed,
+		&nq.DialogCompletedNeed, &nq.ExperiencePointsNeed,
+		&nq.RewardAmount, &nq.RewardExperiencePoints,
+		&nq.DurationDays, &nq.IsActive,
+		&nq.CreatedAt, &nq.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create a new quest", "err", err)
+			return quest.Quest{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create a new quest", "err", err)
+		return quest.Quest{}, fmt.Errorf("could not create a new quest: %w", err)
+	}
+
+	return nq, nil
+}
+
+// getArgs get args.
+func (r *Create) getArgs(dto quest.CreateDTO) []interface{} {
+	return []interface{}{
+		dto.Name,
+		nullify.EmptyString(dto.Description),
+		dto.Cadence,
+		nullify.EmptyInt64WithDefault(dto.WordsLearnedNeed),
+		nullify.EmptyInt64WithDefault(dto.TasksCompletedNeed),
+		nullify.EmptyInt64WithDefault(dto.LessonsFinishedNeed),
+		nullify.EmptyInt64WithDefault(dto.WordsTranslateNeed),
+		nullify.EmptyInt64WithDefault(dto.DialogCompletedNeed),
+		nullify.EmptyInt64WithDefault(dto.ExperiencePointsNeed),
+		nullify.EmptyDecimal(dto.RewardAmount),
+		nullify.EmptyInt64WithDefault(dto.RewardExperiencePoints),
+		nullify.EmptyInt64(dto.DurationDays),
+		dto.IsActive,
+	}
+}
@@ -0,0 +1,22 @@
+package quest
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/quest/all"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/quest/create"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	All    all.IAll
+	Create create.ICreate
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		All:    all.New(queryTimeout, logger),
+		Create: create.New(queryTimeout, logger),
+	}
+}
@@ -0,0 +1,70 @@
+package attribute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAttribute --output=mocks --case=underscore
+type IAttribute interface {
+	Execute(ctx context.Context, tx pgx.Tx, inviteeTelegramID string, code string) (referral.AttributeResult, error)
+}
+
+type Attribute struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Attribute {
+	r := &Attribute{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Attribute) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute attributes invitee to the owner of referral code.
+func (r *Attribute) Execute(ctx context.Context, tx pgx.Tx, inviteeTelegramID string, code string) (referral.AttributeResult, error) {
+	r.logger.Debug("[attribute referral] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.referral_attribute($1, $2);`
+
+	var result referral.AttributeResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		inviteeTelegramID,
+		code,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while attribute referral", "err", err)
+			return referral.AttributeResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to attribute referral", "err", err)
+		return referral.AttributeResult{}, fmt.Errorf("could not attribute referral: %w", err)
+	}
+
+	return result, nil
+}
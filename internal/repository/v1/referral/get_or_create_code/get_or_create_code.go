@@ -0,0 +1,70 @@
+package getorcreatecode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetOrCreateCode --output=mocks --case=underscore
+type IGetOrCreateCode interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string, code string) (referral.GetOrCreateCodeResult, error)
+}
+
+type GetOrCreateCode struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetOrCreateCode {
+	r := &GetOrCreateCode{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetOrCreateCode) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns referral code of the user or saves the given one if the user has no code yet.
+func (r *GetOrCreateCode) Execute(ctx context.Context, tx pgx.Tx, telegramID string, code string) (referral.GetOrCreateCodeResult, error) {
+	r.logger.Debug("[get or create referral code] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.referral_code_get_or_create($1, $2);`
+
+	var result referral.GetOrCreateCodeResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+		code,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get or create referral code", "err", err)
+			return referral.GetOrCreateCodeResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get or create referral code", "err", err)
+		return referral.GetOrCreateCodeResult{}, fmt.Errorf("could not get or create referral code: %w", err)
+	}
+
+	return result, nil
+}
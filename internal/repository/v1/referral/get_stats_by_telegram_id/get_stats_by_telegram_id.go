@@ -0,0 +1,82 @@
+package getstatsbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetStatsByTelegramID --output=mocks --case=underscore
+type IGetStatsByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (referral.Stats, error)
+}
+
+type GetStatsByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetStatsByTelegramID {
+	r := &GetStatsByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetStatsByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns stats of users invited by the user.
+func (r *GetStatsByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (referral.Stats, error) {
+	r.logger.Debug("[get referral stats by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE status = 'pending'),
+			COUNT(*) FILTER (WHERE status = 'rewarded'),
+			COUNT(*) FILTER (WHERE status = 'rejected'),
+			COALESCE(SUM(referrer_reward) FILTER (WHERE status = 'rewarded'), 0)
+		FROM referrals
+		WHERE referrer_telegram_id = $1;
+	`
+
+	var result referral.Stats
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(
+		&result.InvitedCount, &result.PendingCount,
+		&result.RewardedCount, &result.RejectedCount,
+		&result.EarnedAmount,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get referral stats by telegram id", "err", err)
+			return referral.Stats{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get referral stats by telegram id", "err", err)
+		return referral.Stats{}, fmt.Errorf("could not get referral stats by telegram id: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,93 @@
+package qualify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IQualify --output=mocks --case=underscore
+type IQualify interface {
+	Execute(ctx context.Context, tx pgx.Tx, inviteeTelegramID string) (referral.QualifyResult, error)
+}
+
+type Qualify struct {
+	queryTimeout int64
+	cfg          config.ReferralConfig
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	cfg config.ReferralConfig,
+	logger logger.ILogger,
+) *Qualify {
+	r := &Qualify{
+		queryTimeout: queryTimeout,
+		cfg:          cfg,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Qualify) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+
+	if r.cfg.Milestone.Level == 0 && r.cfg.Milestone.StreakDays == 0 {
+		r.cfg.Milestone.Level = referral.DefaultMilestoneLevel
+		r.cfg.Milestone.StreakDays = referral.DefaultMilestoneStreakDays
+	}
+
+	if r.cfg.Reward.Referrer == 0 && r.cfg.Reward.Invitee == 0 {
+		r.cfg.Reward.Referrer = referral.DefaultReferrerReward
+		r.cfg.Reward.Invitee = referral.DefaultInviteeReward
+	}
+
+	if r.cfg.MaxRewardedReferrals == 0 {
+		r.cfg.MaxRewardedReferrals = referral.DefaultMaxRewardedReferrals
+	}
+}
+
+// Execute checks whether pending referral of the invitee reached the milestone
+// and marks it rewarded (rewards are stored in the referral) or rejected.
+func (r *Qualify) Execute(ctx context.Context, tx pgx.Tx, inviteeTelegramID string) (referral.QualifyResult, error) {
+	r.logger.Debug("[qualify referral] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.referral_qualify($1, $2, $3, $4, $5, $6);`
+
+	var result referral.QualifyResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		inviteeTelegramID,
+		r.cfg.Milestone.Level,
+		r.cfg.Milestone.StreakDays,
+		r.cfg.MaxRewardedReferrals,
+		r.cfg.Reward.Referrer,
+		r.cfg.Reward.Invitee,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while qualify referral", "err", err)
+			return referral.QualifyResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to qualify referral", "err", err)
+		return referral.QualifyResult{}, fmt.Errorf("could not qualify referral: %w", err)
+	}
+
+	return result, nil
+}
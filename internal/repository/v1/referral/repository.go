@@ -0,0 +1,30 @@
+package referral
+
+import (
+	"github.com/go-jedi/lingramm_backend/config"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/referral/attribute"
+	getorcreatecode "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral/get_or_create_code"
+	getstatsbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral/get_stats_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/referral/qualify"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	Attribute            attribute.IAttribute
+	GetOrCreateCode      getorcreatecode.IGetOrCreateCode
+	GetStatsByTelegramID getstatsbytelegramid.IGetStatsByTelegramID
+	Qualify              qualify.IQualify
+}
+
+func New(
+	queryTimeout int64,
+	cfg config.ReferralConfig,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		Attribute:            attribute.New(queryTimeout, logger),
+		GetOrCreateCode:      getorcreatecode.New(queryTimeout, logger),
+		GetStatsByTelegramID: getstatsbytelegramid.New(queryTimeout, logger),
+		Qualify:              qualify.New(queryTimeout, cfg, logger),
+	}
+}
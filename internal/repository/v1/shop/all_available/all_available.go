@@ -0,0 +1,77 @@
+package allavailable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllAvailable --output=mocks --case=underscore
+type IAllAvailable interface {
+	Execute(ctx context.Context, tx pgx.Tx) ([]shop.ShopItem, error)
+}
+
+type AllAvailable struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllAvailable {
+	r := &AllAvailable{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllAvailable) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AllAvailable) Execute(ctx context.Context, tx pgx.Tx) ([]shop.ShopItem, error) {
+	r.logger.Debug("[get all available shop items] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT COALESCE(
+			JSONB_AGG(TO_JSONB(si) ORDER BY si.price, si.id),
+			'[]'::JSONB
+		)
+		FROM shop_items si
+		WHERE si.is_active
+		AND (si.available_from IS NULL OR si.available_from <= NOW())
+		AND (si.available_until IS NULL OR si.available_until > NOW())
+		AND (si.stock IS NULL OR si.stock > 0);
+	`
+
+	var result []shop.ShopItem
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all available shop items", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all available shop items", "err", err)
+		return nil, fmt.Errorf("could not get all available shop items: %w", err)
+	}
+
+	return result, nil
+}
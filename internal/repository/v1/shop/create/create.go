@@ -0,0 +1,108 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/utils/nullify"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto shop.CreateDTO) (shop.ShopItem, error)
+}
+
+type Create struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Create {
+	r := &Create{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Create) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto shop.CreateDTO) (shop.ShopItem, error) {
+	r.logger.Debug("[create a new shop item] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		INSERT INTO shop_items(
+		    name,
+		    description,
+		    type,
+		    value,
+		    price,
+		    stock,
+		    per_user_limit,
+		    available_from,
+		    available_until,
+		    is_active,
+		    price_stars
+		) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING *;
+	`
+
+	var result shop.ShopItem
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		r.getArgs(dto)...,
+	).Scan(
+		&result.ID, &result.Name, &result.Description,
+		&result.Type, &result.Value, &result.Price,
+		&result.Stock, &result.PerUserLimit,
+		&result.AvailableFrom, &result.AvailableUntil,
+		&result.IsActive, &result.CreatedAt, &result.UpdatedAt,
+		&result.PriceStars,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while create a new shop item", "err", err)
+			return shop.ShopItem{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to create a new shop item", "err", err)
+		return shop.ShopItem{}, fmt.Errorf("could not create a new shop item: %w", err)
+	}
+
+	return result, nil
+}
+
+// getArgs get args.
+func (r *Create) getArgs(dto shop.CreateDTO) []interface{} {
+	return []interface{}{
+		dto.Name,
+		nullify.EmptyString(dto.Description),
+		dto.Type,
+		nullify.EmptyInt64(dto.Value),
+		dto.Price,
+		nullify.EmptyInt64(dto.Stock),
+		nullify.EmptyInt64(dto.PerUserLimit),
+		dto.AvailableFrom,
+		dto.AvailableUntil,
+		dto.IsActive,
+		nullify.EmptyInt64(dto.PriceStars),
+	}
+}
@@ -0,0 +1,79 @@
+package deletebyid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteByID --output=mocks --case=underscore
+type IDeleteByID interface {
+	Execute(ctx context.Context, tx pgx.Tx, id int64) (shop.ShopItem, error)
+}
+
+type DeleteByID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *DeleteByID {
+	r := &DeleteByID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *DeleteByID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *DeleteByID) Execute(ctx context.Context, tx pgx.Tx, id int64) (shop.ShopItem, error) {
+	r.logger.Debug("[delete shop item by id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		DELETE FROM shop_items
+		WHERE id = $1
+		RETURNING *;
+	`
+
+	var result shop.ShopItem
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		id,
+	).Scan(
+		&result.ID, &result.Name, &result.Description,
+		&result.Type, &result.Value, &result.Price,
+		&result.Stock, &result.PerUserLimit,
+		&result.AvailableFrom, &result.AvailableUntil,
+		&result.IsActive, &result.CreatedAt, &result.UpdatedAt,
+		&result.PriceStars,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while delete shop item by id", "err", err)
+			return shop.ShopItem{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to delete shop item by id", "err", err)
+		return shop.ShopItem{}, fmt.Errorf("could not delete shop item by id: %w", err)
+	}
+
+	return result, nil
+}
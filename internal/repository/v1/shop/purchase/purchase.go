@@ -0,0 +1,68 @@
+package purchase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IPurchase --output=mocks --case=underscore
+type IPurchase interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto shop.PurchaseDTO) (shop.PurchaseResult, error)
+}
+
+type Purchase struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Purchase {
+	r := &Purchase{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Purchase) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Purchase) Execute(ctx context.Context, tx pgx.Tx, dto shop.PurchaseDTO) (shop.PurchaseResult, error) {
+	r.logger.Debug("[purchase shop item] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.shop_purchase($1, $2, $3, $4);`
+
+	var result shop.PurchaseResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.TelegramID, dto.ShopItemID, dto.Quantity, dto.IdempotencyKey,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while purchase shop item", "err", err)
+			return shop.PurchaseResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to purchase shop item", "err", err)
+		return shop.PurchaseResult{}, fmt.Errorf("could not purchase shop item: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,40 @@
+package shop
+
+import (
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/shop/all"
+	allavailable "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop/all_available"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/shop/create"
+	deletebyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop/delete_by_id"
+	existsbyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop/exists_by_id"
+	existsinusebyid "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop/exists_in_use_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/shop/purchase"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/shop/update"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	All             all.IAll
+	AllAvailable    allavailable.IAllAvailable
+	Create          create.ICreate
+	DeleteByID      deletebyid.IDeleteByID
+	ExistsByID      existsbyid.IExistsByID
+	ExistsInUseByID existsinusebyid.IExistsInUseByID
+	Purchase        purchase.IPurchase
+	Update          update.IUpdate
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		All:             all.New(queryTimeout, logger),
+		AllAvailable:    allavailable.New(queryTimeout, logger),
+		Create:          create.New(queryTimeout, logger),
+		DeleteByID:      deletebyid.New(queryTimeout, logger),
+		ExistsByID:      existsbyid.New(queryTimeout, logger),
+		ExistsInUseByID: existsinusebyid.New(queryTimeout, logger),
+		Purchase:        purchase.New(queryTimeout, logger),
+		Update:          update.New(queryTimeout, logger),
+	}
+}
@@ -0,0 +1,110 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/utils/nullify"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto shop.UpdateDTO) (shop.ShopItem, error)
+}
+
+type Update struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Update {
+	r := &Update{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Update) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Update) Execute(ctx context.Context, tx pgx.Tx, dto shop.UpdateDTO) (shop.ShopItem, error) {
+	r.logger.Debug("[update shop item] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		UPDATE shop_items SET
+		    name = $1,
+		    description = $2,
+		    type = $3,
+		    value = $4,
+		    price = $5,
+		    stock = $6,
+		    per_user_limit = $7,
+		    available_from = $8,
+		    available_until = $9,
+		    is_active = $10,
+		    price_stars = $11,
+		    updated_at = NOW()
+		WHERE id = $12
+		RETURNING *;
+	`
+
+	var result shop.ShopItem
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		r.getArgs(dto)...,
+	).Scan(
+		&result.ID, &result.Name, &result.Description,
+		&result.Type, &result.Value, &result.Price,
+		&result.Stock, &result.PerUserLimit,
+		&result.AvailableFrom, &result.AvailableUntil,
+		&result.IsActive, &result.CreatedAt, &result.UpdatedAt,
+		&result.PriceStars,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while update shop item", "err", err)
+			return shop.ShopItem{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to update shop item", "err", err)
+		return shop.ShopItem{}, fmt.Errorf("could not update shop item: %w", err)
+	}
+
+	return result, nil
+}
+
+// getArgs get args.
+func (r *Update) getArgs(dto shop.UpdateDTO) []interface{} {
+	return []interface{}{
+		dto.Name,
+		nullify.EmptyString(dto.Description),
+		dto.Type,
+		nullify.EmptyInt64(dto.Value),
+		dto.Price,
+		nullify.EmptyInt64(dto.Stock),
+		nullify.EmptyInt64(dto.PerUserLimit),
+		dto.AvailableFrom,
+		dto.AvailableUntil,
+		dto.IsActive,
+		nullify.EmptyInt64(dto.PriceStars),
+		dto.ID,
+	}
+}
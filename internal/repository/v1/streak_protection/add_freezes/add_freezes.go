@@ -0,0 +1,69 @@
+package addfreezes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAddFreezes --output=mocks --case=underscore
+type IAddFreezes interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto streakprotection.AddFreezesDTO) (*streakprotection.History, error)
+}
+
+type AddFreezes struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AddFreezes {
+	r := &AddFreezes{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AddFreezes) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AddFreezes) Execute(ctx context.Context, tx pgx.Tx, dto streakprotection.AddFreezesDTO) (*streakprotection.History, error) {
+	r.logger.Debug("[add streak freezes] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.streak_freeze_add($1, $2, $3, $4);`
+
+	var result *streakprotection.History
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.TelegramID, dto.Quantity,
+		dto.Action, dto.Price,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while add streak freezes", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to add streak freezes", "err", err)
+		return nil, fmt.Errorf("could not add streak freezes: %w", err)
+	}
+
+	return result, nil
+}
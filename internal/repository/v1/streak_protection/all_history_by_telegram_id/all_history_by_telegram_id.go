@@ -0,0 +1,68 @@
+package allhistorybytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllHistoryByTelegramID --output=mocks --case=underscore
+type IAllHistoryByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]streakprotection.History, error)
+}
+
+type AllHistoryByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllHistoryByTelegramID {
+	r := &AllHistoryByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllHistoryByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AllHistoryByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]streakprotection.History, error) {
+	r.logger.Debug("[get all streak protection history by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.streak_protection_history_get($1);`
+
+	var result []streakprotection.History
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all streak protection history by telegram id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all streak protection history by telegram id", "err", err)
+		return nil, fmt.Errorf("could not get all streak protection history by telegram id: %w", err)
+	}
+
+	return result, nil
+}
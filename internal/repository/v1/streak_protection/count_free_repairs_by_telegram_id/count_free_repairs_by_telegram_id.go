@@ -0,0 +1,75 @@
+package countfreerepairsbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICountFreeRepairsByTelegramID --output=mocks --case=underscore
+type ICountFreeRepairsByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (int64, error)
+}
+
+type CountFreeRepairsByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *CountFreeRepairsByTelegramID {
+	r := &CountFreeRepairsByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *CountFreeRepairsByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns count of streak repairs made without price (by subscription) in the current month.
+func (r *CountFreeRepairsByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (int64, error) {
+	r.logger.Debug("[count free streak repairs by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT COUNT(*)
+		FROM user_streak_protection_history
+		WHERE telegram_id = $1
+		AND action = 'repair'
+		AND price IS NULL
+		AND created_at >= DATE_TRUNC('month', NOW());
+	`
+
+	var count int64
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&count); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while count free streak repairs by telegram id", "err", err)
+			return 0, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to count free streak repairs by telegram id", "err", err)
+		return 0, fmt.Errorf("could not count free streak repairs by telegram id: %w", err)
+	}
+
+	return count, nil
+}
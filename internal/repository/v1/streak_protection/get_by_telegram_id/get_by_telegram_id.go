@@ -0,0 +1,68 @@
+package getbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByTelegramID --output=mocks --case=underscore
+type IGetByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (streakprotection.StreakProtection, error)
+}
+
+type GetByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetByTelegramID {
+	r := &GetByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (streakprotection.StreakProtection, error) {
+	r.logger.Debug("[get streak protection by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.streak_protection_get($1);`
+
+	var result streakprotection.StreakProtection
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get streak protection by telegram id", "err", err)
+			return streakprotection.StreakProtection{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get streak protection by telegram id", "err", err)
+		return streakprotection.StreakProtection{}, fmt.Errorf("could not get streak protection by telegram id: %w", err)
+	}
+
+	return result, nil
+}
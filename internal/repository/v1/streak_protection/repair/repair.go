@@ -0,0 +1,69 @@
+package repair
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+//go:generate mockery --name=IRepair --output=mocks --case=underscore
+type IRepair interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string, price *decimal.Decimal) (*streakprotection.History, error)
+}
+
+type Repair struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repair {
+	r := &Repair{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Repair) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Repair) Execute(ctx context.Context, tx pgx.Tx, telegramID string, price *decimal.Decimal) (*streakprotection.History, error) {
+	r.logger.Debug("[repair streak] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.streak_repair($1, $2);`
+
+	var result *streakprotection.History
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID, price,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while repair streak", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to repair streak", "err", err)
+		return nil, fmt.Errorf("could not repair streak: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package streakprotection
+
+import (
+	addfreezes "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection/add_freezes"
+	allhistorybytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection/all_history_by_telegram_id"
+	countfreerepairsbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection/count_free_repairs_by_telegram_id"
+	getbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection/get_by_telegram_id"
+	repair "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection/repair"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	AddFreezes                   addfreezes.IAddFreezes
+	AllHistoryByTelegramID       allhistorybytelegramid.IAllHistoryByTelegramID
+	CountFreeRepairsByTelegramID countfreerepairsbytelegramid.ICountFreeRepairsByTelegramID
+	GetByTelegramID              getbytelegramid.IGetByTelegramID
+	Repair                       repair.IRepair
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		AddFreezes:                   addfreezes.New(queryTimeout, logger),
+		AllHistoryByTelegramID:       allhistorybytelegramid.New(queryTimeout, logger),
+		CountFreeRepairsByTelegramID: countfreerepairsbytelegramid.New(queryTimeout, logger),
+		GetByTelegramID:              getbytelegramid.New(queryTimeout, logger),
+		Repair:                       repair.New(queryTimeout, logger),
+	}
+}
@@ -0,0 +1,112 @@
+package allplans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllPlans --output=mocks --case=underscore
+type IAllPlans interface {
+	Execute(ctx context.Context, tx pgx.Tx) ([]subscription.Plan, error)
+}
+
+type AllPlans struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllPlans {
+	r := &AllPlans{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllPlans) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns active subscription plans with entitlements ordered by duration.
+func (r *AllPlans) Execute(ctx context.Context, tx pgx.Tx) ([]subscription.Plan, error) {
+	r.logger.Debug("[get all subscription plans] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT
+			id,
+			code,
+			name,
+			duration_days,
+			grace_period_days,
+			price_stars,
+			is_trial,
+			is_active,
+			created_at,
+			updated_at,
+			COALESCE((
+				SELECT JSONB_AGG(JSONB_BUILD_OBJECT(
+					'code', e.code,
+					'limit', e.limit_value
+				) ORDER BY e.code)
+				FROM subscription_plan_entitlements e
+				WHERE e.plan_id = subscription_plans.id
+			), '[]'::JSONB)
+		FROM subscription_plans
+		WHERE is_active
+		ORDER BY duration_days, id;
+	`
+
+	rows, err := tx.Query(ctxTimeout, q)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all subscription plans", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all subscription plans", "err", err)
+		return nil, fmt.Errorf("could not get all subscription plans: %w", err)
+	}
+	defer rows.Close()
+
+	plans := make([]subscription.Plan, 0)
+
+	for rows.Next() {
+		var p subscription.Plan
+
+		if err := rows.Scan(
+			&p.ID, &p.Code, &p.Name,
+			&p.DurationDays, &p.GracePeriodDays, &p.PriceStars,
+			&p.IsTrial, &p.IsActive, &p.CreatedAt, &p.UpdatedAt,
+			&p.Entitlements,
+		); err != nil {
+			r.logger.Error("failed to scan row to get all subscription plans", "err", err)
+			return nil, fmt.Errorf("failed to scan row to get all subscription plans: %w", err)
+		}
+
+		plans = append(plans, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to get all subscription plans", "err", err)
+		return nil, fmt.Errorf("failed to get all subscription plans: %w", err)
+	}
+
+	return plans, nil
+}
@@ -58,6 +58,7 @@ func (r *CreateSubscription) Execute(ctx context.Context, tx pgx.Tx, telegramID
 	).Scan(
 		&ns.ID, &ns.TelegramID, &ns.SubscribedAt,
 		&ns.ExpiresAt, &ns.IsActive, &ns.CreatedAt, &ns.UpdatedAt,
+		&ns.PlanID, &ns.GraceExpiresAt, &ns.ReminderSentAt, &ns.TrialUsedAt,
 	); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while create a new subscription", "err", err)
@@ -0,0 +1,75 @@
+package existsplanbycode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsPlanByCode --output=mocks --case=underscore
+type IExistsPlanByCode interface {
+	Execute(ctx context.Context, tx pgx.Tx, code string) (bool, error)
+}
+
+type ExistsPlanByCode struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsPlanByCode {
+	r := &ExistsPlanByCode{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsPlanByCode) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute reports whether an active subscription plan with the code exists.
+func (r *ExistsPlanByCode) Execute(ctx context.Context, tx pgx.Tx, code string) (bool, error) {
+	r.logger.Debug("[check subscription plan exists by code] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM subscription_plans
+			WHERE code = $1
+			AND is_active
+		);
+	`
+
+	var ie bool
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		code,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check subscription plan exists by code", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check subscription plan exists by code", "err", err)
+		return false, fmt.Errorf("could not check subscription plan exists by code: %w", err)
+	}
+
+	return ie, nil
+}
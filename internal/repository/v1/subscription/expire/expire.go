@@ -0,0 +1,68 @@
+package expire
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExpire --output=mocks --case=underscore
+type IExpire interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto subscription.ExpireDTO) ([]subscription.Expiring, error)
+}
+
+type Expire struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Expire {
+	r := &Expire{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Expire) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Expire) Execute(ctx context.Context, tx pgx.Tx, dto subscription.ExpireDTO) ([]subscription.Expiring, error) {
+	r.logger.Debug("[expire subscriptions] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.subscriptions_expire($1);`
+
+	var result []subscription.Expiring
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.Limit,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while expire subscriptions", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to expire subscriptions", "err", err)
+		return nil, fmt.Errorf("could not expire subscriptions: %w", err)
+	}
+
+	return result, nil
+}
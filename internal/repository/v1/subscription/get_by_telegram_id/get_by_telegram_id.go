@@ -61,6 +61,7 @@ func (r *GetByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID str
 	).Scan(
 		&s.ID, &s.TelegramID, &s.SubscribedAt,
 		&s.ExpiresAt, &s.IsActive, &s.CreatedAt, &s.UpdatedAt,
+		&s.PlanID, &s.GraceExpiresAt, &s.ReminderSentAt, &s.TrialUsedAt,
 	); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while get subscription by telegram id", "err", err)
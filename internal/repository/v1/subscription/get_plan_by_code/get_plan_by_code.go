@@ -0,0 +1,86 @@
+package getplanbycode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetPlanByCode --output=mocks --case=underscore
+type IGetPlanByCode interface {
+	Execute(ctx context.Context, tx pgx.Tx, code string) (subscription.Plan, error)
+}
+
+type GetPlanByCode struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetPlanByCode {
+	r := &GetPlanByCode{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetPlanByCode) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *GetPlanByCode) Execute(ctx context.Context, tx pgx.Tx, code string) (subscription.Plan, error) {
+	r.logger.Debug("[get subscription plan by code] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT
+			id,
+			code,
+			name,
+			duration_days,
+			grace_period_days,
+			price_stars,
+			is_trial,
+			is_active,
+			created_at,
+			updated_at
+		FROM subscription_plans
+		WHERE code = $1;
+	`
+
+	var p subscription.Plan
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		code,
+	).Scan(
+		&p.ID, &p.Code, &p.Name,
+		&p.DurationDays, &p.GracePeriodDays, &p.PriceStars,
+		&p.IsTrial, &p.IsActive, &p.CreatedAt, &p.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get subscription plan by code", "err", err)
+			return subscription.Plan{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get subscription plan by code", "err", err)
+		return subscription.Plan{}, fmt.Errorf("could not get subscription plan by code: %w", err)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,96 @@
+package getsnapshotbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetSnapshotByTelegramID --output=mocks --case=underscore
+type IGetSnapshotByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (subscription.Snapshot, error)
+}
+
+type GetSnapshotByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *GetSnapshotByTelegramID {
+	r := &GetSnapshotByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *GetSnapshotByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute returns subscription state of the user with entitlements of the plan.
+// Subscription without plan was granted by rewards before plans existed, it gets entitlements of the monthly plan.
+func (r *GetSnapshotByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (subscription.Snapshot, error) {
+	r.logger.Debug("[get subscription snapshot by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT
+			u.telegram_id,
+			COALESCE(s.is_active, FALSE),
+			sp.code,
+			s.expires_at::TIMESTAMPTZ,
+			COALESCE(s.grace_expires_at, s.expires_at)::TIMESTAMPTZ,
+			COALESCE((
+				SELECT JSONB_AGG(JSONB_BUILD_OBJECT(
+					'code', e.code,
+					'limit', e.limit_value
+				) ORDER BY e.code)
+				FROM subscription_plan_entitlements e
+				WHERE s.id IS NOT NULL
+				AND e.plan_id = COALESCE(s.plan_id, (
+					SELECT id
+					FROM subscription_plans
+					WHERE code = 'monthly'
+				))
+			), '[]'::JSONB)
+		FROM (SELECT $1::TEXT AS telegram_id) u
+		LEFT JOIN subscriptions s ON s.telegram_id = u.telegram_id
+		LEFT JOIN subscription_plans sp ON sp.id = s.plan_id;
+	`
+
+	var result subscription.Snapshot
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(
+		&result.TelegramID, &result.IsActive, &result.PlanCode,
+		&result.ExpiresAt, &result.AccessUntil, &result.Entitlements,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get subscription snapshot by telegram id", "err", err)
+			return subscription.Snapshot{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get subscription snapshot by telegram id", "err", err)
+		return subscription.Snapshot{}, fmt.Errorf("could not get subscription snapshot by telegram id: %w", err)
+	}
+
+	return result, nil
+}
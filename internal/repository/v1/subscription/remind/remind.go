@@ -0,0 +1,69 @@
+package remind
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IRemind --output=mocks --case=underscore
+type IRemind interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto subscription.RemindDTO) ([]subscription.Expiring, error)
+}
+
+type Remind struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Remind {
+	r := &Remind{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Remind) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Remind) Execute(ctx context.Context, tx pgx.Tx, dto subscription.RemindDTO) ([]subscription.Expiring, error) {
+	r.logger.Debug("[remind expiring subscriptions] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.subscriptions_remind($1, $2);`
+
+	var result []subscription.Expiring
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.RemindBeforeHours,
+		dto.Limit,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while remind expiring subscriptions", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to remind expiring subscriptions", "err", err)
+		return nil, fmt.Errorf("could not remind expiring subscriptions: %w", err)
+	}
+
+	return result, nil
+}
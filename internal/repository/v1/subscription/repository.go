@@ -1,18 +1,32 @@
 package subscription
 
 import (
+	allplans "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/all_plans"
 	createsubscription "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/create_subscription"
 	createsubscriptionhistory "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/create_subscription_history"
 	existsbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/exists_by_telegram_id"
+	existsplanbycode "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/exists_plan_by_code"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/expire"
 	getbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/get_by_telegram_id"
+	getplanbycode "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/get_plan_by_code"
+	getsnapshotbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/get_snapshot_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/remind"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription/subscribe"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
 
 type Repository struct {
+	AllPlans                  allplans.IAllPlans
 	CreateSubscription        createsubscription.ICreateSubscription
 	CreateSubscriptionHistory createsubscriptionhistory.ICreateSubscriptionHistory
 	ExistsByTelegramID        existsbytelegramid.IExistsByTelegramID
+	ExistsPlanByCode          existsplanbycode.IExistsPlanByCode
+	Expire                    expire.IExpire
 	GetByTelegramID           getbytelegramid.IGetByTelegramID
+	GetPlanByCode             getplanbycode.IGetPlanByCode
+	GetSnapshotByTelegramID   getsnapshotbytelegramid.IGetSnapshotByTelegramID
+	Remind                    remind.IRemind
+	Subscribe                 subscribe.ISubscribe
 }
 
 func New(
@@ -20,9 +34,16 @@ func New(
 	logger logger.ILogger,
 ) *Repository {
 	return &Repository{
+		AllPlans:                  allplans.New(queryTimeout, logger),
 		CreateSubscription:        createsubscription.New(queryTimeout, logger),
 		CreateSubscriptionHistory: createsubscriptionhistory.New(queryTimeout, logger),
 		ExistsByTelegramID:        existsbytelegramid.New(queryTimeout, logger),
+		ExistsPlanByCode:          existsplanbycode.New(queryTimeout, logger),
+		Expire:                    expire.New(queryTimeout, logger),
 		GetByTelegramID:           getbytelegramid.New(queryTimeout, logger),
+		GetPlanByCode:             getplanbycode.New(queryTimeout, logger),
+		GetSnapshotByTelegramID:   getsnapshotbytelegramid.New(queryTimeout, logger),
+		Remind:                    remind.New(queryTimeout, logger),
+		Subscribe:                 subscribe.New(queryTimeout, logger),
 	}
 }
@@ -0,0 +1,80 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ISubscribe --output=mocks --case=underscore
+type ISubscribe interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto subscription.SubscribeDTO) (subscription.Subscription, error)
+}
+
+type Subscribe struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Subscribe {
+	r := &Subscribe{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Subscribe) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute subscribes user by plan.
+// Returns apperrors.ErrSubscriptionPlanNotAvailable if plan is not active or trial was already used,
+// both are checked by the database after the subscription is locked.
+func (r *Subscribe) Execute(ctx context.Context, tx pgx.Tx, dto subscription.SubscribeDTO) (subscription.Subscription, error) {
+	r.logger.Debug("[subscribe by plan] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.subscription_subscribe($1, $2) WHERE id IS NOT NULL;`
+
+	var s subscription.Subscription
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.TelegramID,
+		dto.PlanCode,
+	).Scan(
+		&s.ID, &s.TelegramID, &s.SubscribedAt,
+		&s.ExpiresAt, &s.IsActive, &s.CreatedAt, &s.UpdatedAt,
+		&s.PlanID, &s.GraceExpiresAt, &s.ReminderSentAt, &s.TrialUsedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return subscription.Subscription{}, apperrors.ErrSubscriptionPlanNotAvailable
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while subscribe by plan", "err", err)
+			return subscription.Subscription{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to subscribe by plan", "err", err)
+		return subscription.Subscription{}, fmt.Errorf("could not subscribe by plan: %w", err)
+	}
+
+	return s, nil
+}
@@ -66,6 +66,7 @@ func (r *Create) Execute(ctx context.Context, tx pgx.Tx, dto user.CreateDTO) (us
 		&nu.ID, &nu.TelegramID,
 		&nu.Username, &nu.FirstName, &nu.LastName,
 		&nu.CreatedAt, &nu.UpdatedAt,
+		&nu.Timezone, &nu.IsTimezoneManual,
 	); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while create a new user", "err", err)
@@ -79,6 +79,8 @@ func TestExecute(t *testing.T) {
 					mock.AnythingOfType("*string"),
 					mock.AnythingOfType("*time.Time"),
 					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("*bool"),
 				).Run(func(args mock.Arguments) {
 					id := args.Get(0).(*int64)
 					*id = testUser.ID
@@ -100,6 +102,12 @@ func TestExecute(t *testing.T) {
 
 					ua := args.Get(6).(*time.Time)
 					*ua = testUser.UpdatedAt
+
+					tz := args.Get(7).(*string)
+					*tz = testUser.Timezone
+
+					itm := args.Get(8).(*bool)
+					*itm = testUser.IsTimezoneManual
 				}).Return(nil)
 			},
 			mockLoggerBehavior: func(m *loggermocks.ILogger) {
@@ -134,6 +142,8 @@ func TestExecute(t *testing.T) {
 					mock.AnythingOfType("*string"),
 					mock.AnythingOfType("*time.Time"),
 					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("*bool"),
 				).Return(context.DeadlineExceeded)
 			},
 			mockLoggerBehavior: func(m *loggermocks.ILogger) {
@@ -169,6 +179,8 @@ func TestExecute(t *testing.T) {
 					mock.AnythingOfType("*string"),
 					mock.AnythingOfType("*time.Time"),
 					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("*bool"),
 				).Return(errors.New("database error"))
 			},
 			mockLoggerBehavior: func(m *loggermocks.ILogger) {
@@ -62,6 +62,7 @@ func (r *GetByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID str
 		&u.ID, &u.TelegramID,
 		&u.Username, &u.FirstName, &u.LastName,
 		&u.CreatedAt, &u.UpdatedAt,
+		&u.Timezone, &u.IsTimezoneManual,
 	); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while get user by telegram id", "err", err)
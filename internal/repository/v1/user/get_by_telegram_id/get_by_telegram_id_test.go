@@ -36,6 +36,7 @@ func TestExecute(t *testing.T) {
 			LastName:   gofakeit.LastName(),
 			CreatedAt:  gofakeit.Date(),
 			UpdatedAt:  gofakeit.Date(),
+			Timezone:   "Europe/Moscow",
 		}
 		queryTimeout = int64(2)
 	)
@@ -65,6 +66,8 @@ func TestExecute(t *testing.T) {
 					mock.AnythingOfType("*string"),
 					mock.AnythingOfType("*time.Time"),
 					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("*bool"),
 				).Run(func(args mock.Arguments) {
 					id := args.Get(0).(*int64)
 					*id = testUser.ID
@@ -86,6 +89,12 @@ func TestExecute(t *testing.T) {
 
 					ua := args.Get(6).(*time.Time)
 					*ua = testUser.UpdatedAt
+
+					tz := args.Get(7).(*string)
+					*tz = testUser.Timezone
+
+					itm := args.Get(8).(*bool)
+					*itm = testUser.IsTimezoneManual
 				}).Return(nil)
 			},
 			mockLoggerBehavior: func(m *loggermocks.ILogger) {
@@ -118,6 +127,8 @@ func TestExecute(t *testing.T) {
 					mock.AnythingOfType("*string"),
 					mock.AnythingOfType("*time.Time"),
 					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("*bool"),
 				).Return(context.DeadlineExceeded)
 			},
 			mockLoggerBehavior: func(m *loggermocks.ILogger) {
@@ -151,6 +162,8 @@ func TestExecute(t *testing.T) {
 					mock.AnythingOfType("*string"),
 					mock.AnythingOfType("*time.Time"),
 					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*string"),
+					mock.AnythingOfType("*bool"),
 				).Return(errors.New("database error"))
 			},
 			mockLoggerBehavior: func(m *loggermocks.ILogger) {
@@ -5,6 +5,7 @@ import (
 	"github.com/go-jedi/lingramm_backend/internal/repository/v1/user/exists"
 	"github.com/go-jedi/lingramm_backend/internal/repository/v1/user/exists_by_telegram_id"
 	"github.com/go-jedi/lingramm_backend/internal/repository/v1/user/get_by_telegram_id"
+	updatetimezone "github.com/go-jedi/lingramm_backend/internal/repository/v1/user/update_timezone"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
 
@@ -13,6 +14,7 @@ type Repository struct {
 	Exists             exists.IExists
 	ExistsByTelegramID existsbytelegramid.IExistsByTelegramID
 	GetByTelegramID    getbytelegramid.IGetByTelegramID
+	UpdateTimezone     updatetimezone.IUpdateTimezone
 }
 
 func New(
@@ -24,5 +26,6 @@ func New(
 		Exists:             exists.New(queryTimeout, logger),
 		ExistsByTelegramID: existsbytelegramid.New(queryTimeout, logger),
 		GetByTelegramID:    getbytelegramid.New(queryTimeout, logger),
+		UpdateTimezone:     updatetimezone.New(queryTimeout, logger),
 	}
 }
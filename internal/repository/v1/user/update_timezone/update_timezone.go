@@ -0,0 +1,92 @@
+package updatetimezone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/user"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdateTimezone --output=mocks --case=underscore
+type IUpdateTimezone interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto user.UpdateTimezoneDTO) (user.User, error)
+}
+
+type UpdateTimezone struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *UpdateTimezone {
+	r := &UpdateTimezone{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *UpdateTimezone) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute updates user timezone.
+// Timezone chosen by the user (is_timezone_manual) is overwritten only by another manual update,
+// otherwise the user is returned unchanged.
+func (r *UpdateTimezone) Execute(ctx context.Context, tx pgx.Tx, dto user.UpdateTimezoneDTO) (user.User, error) {
+	r.logger.Debug("[update user timezone] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		WITH updated AS (
+			UPDATE users SET
+				timezone = $2,
+				is_timezone_manual = $3,
+				updated_at = NOW()
+			WHERE telegram_id = $1
+			AND (is_timezone_manual = FALSE OR $3 = TRUE)
+			RETURNING *
+		)
+		SELECT * FROM updated
+		UNION ALL
+		SELECT *
+		FROM users
+		WHERE telegram_id = $1
+		AND NOT EXISTS (SELECT 1 FROM updated);
+	`
+
+	var u user.User
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.TelegramID, dto.Timezone, dto.IsManual,
+	).Scan(
+		&u.ID, &u.TelegramID,
+		&u.Username, &u.FirstName, &u.LastName,
+		&u.CreatedAt, &u.UpdatedAt,
+		&u.Timezone, &u.IsTimezoneManual,
+	); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while update user timezone", "err", err)
+			return user.User{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to update user timezone", "err", err)
+		return user.User{}, fmt.Errorf("could not update user timezone: %w", err)
+	}
+
+	return u, nil
+}
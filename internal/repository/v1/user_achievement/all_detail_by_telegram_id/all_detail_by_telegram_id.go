@@ -52,19 +52,43 @@ func (r *AllDetailByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegram
 		SELECT
 			JSONB_AGG(
 				JSONB_BUILD_OBJECT(
-					'id', ua.id,
-					'telegram_id', ua.telegram_id,
-					'name', a.name,
-					'description', a.description,
-					'achievement_path_file', aa.client_path_file,
-					'award_path_file', awa.client_path_file
+					'id', d.id,
+					'telegram_id', d.telegram_id,
+					'name', d.name,
+					'description', d.description,
+					'achievement_path_file', d.achievement_path_file,
+					'award_path_file', d.award_path_file,
+					'tier_group', d.tier_group,
+					'tier_level', d.tier_level,
+					'tier_count', d.tier_count
 				)
 			)
-		FROM user_achievements ua
-		INNER JOIN achievements a ON ua.achievement_id = a.id
-		INNER JOIN achievement_assets aa ON a.achievement_assets_id = aa.id
-		INNER JOIN award_assets awa ON a.award_assets_id = awa.id
-		WHERE ua.telegram_id = $1;
+		FROM (
+			SELECT
+				ua.id,
+				ua.telegram_id,
+				a.name,
+				a.description,
+				aa.client_path_file AS achievement_path_file,
+				awa.client_path_file AS award_path_file,
+				a.tier_group,
+				a.tier_level,
+				CASE WHEN a.tier_group IS NOT NULL THEN (
+					SELECT COUNT(*)
+					FROM achievements t
+					WHERE t.tier_group = a.tier_group
+				) END AS tier_count,
+				ROW_NUMBER() OVER (
+					PARTITION BY COALESCE('tier:' || a.tier_group, 'achievement:' || a.id)
+					ORDER BY a.tier_level DESC
+				) AS rn
+			FROM user_achievements ua
+			INNER JOIN achievements a ON ua.achievement_id = a.id
+			INNER JOIN achievement_assets aa ON a.achievement_assets_id = aa.id
+			INNER JOIN award_assets awa ON a.award_assets_id = awa.id
+			WHERE ua.telegram_id = $1
+		) d
+		WHERE d.rn = 1;
 	`
 
 	var d []userachievement.Detail
@@ -0,0 +1,68 @@
+package allprogressbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	userachievement "github.com/go-jedi/lingramm_backend/internal/domain/user_achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllProgressByTelegramID --output=mocks --case=underscore
+type IAllProgressByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]userachievement.Progress, error)
+}
+
+type AllProgressByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllProgressByTelegramID {
+	r := &AllProgressByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllProgressByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AllProgressByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]userachievement.Progress, error) {
+	r.logger.Debug("[get all achievement progress by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.achievement_progress_get($1);`
+
+	var result []userachievement.Progress
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all achievement progress by telegram id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all achievement progress by telegram id", "err", err)
+		return nil, fmt.Errorf("could not get all achievement progress by telegram id: %w", err)
+	}
+
+	return result, nil
+}
@@ -2,12 +2,14 @@ package userachievement
 
 import (
 	alldetailbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement/all_detail_by_telegram_id"
+	allprogressbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement/all_progress_by_telegram_id"
 	unlockavailableachievements "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement/unlock_available_achievements"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 )
 
 type Repository struct {
 	AllDetailByTelegramID       alldetailbytelegramid.IAllDetailByTelegramID
+	AllProgressByTelegramID     allprogressbytelegramid.IAllProgressByTelegramID
 	UnlockAvailableAchievements unlockavailableachievements.IUnlockAvailableAchievements
 }
 
@@ -17,6 +19,7 @@ func New(
 ) *Repository {
 	return &Repository{
 		AllDetailByTelegramID:       alldetailbytelegramid.New(queryTimeout, logger),
+		AllProgressByTelegramID:     allprogressbytelegramid.New(queryTimeout, logger),
 		UnlockAvailableAchievements: unlockavailableachievements.New(queryTimeout, logger),
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-jedi/lingramm_backend/config"
 	userdailytask "github.com/go-jedi/lingramm_backend/internal/domain/user_daily_task"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
@@ -19,15 +20,18 @@ type IAssignDailyTaskByTelegramID interface {
 
 type AssignDailyTaskByTelegramID struct {
 	queryTimeout int64
+	cfg          config.DailyTaskConfig
 	logger       logger.ILogger
 }
 
 func New(
 	queryTimeout int64,
+	cfg config.DailyTaskConfig,
 	logger logger.ILogger,
 ) *AssignDailyTaskByTelegramID {
 	r := &AssignDailyTaskByTelegramID{
 		queryTimeout: queryTimeout,
+		cfg:          cfg,
 		logger:       logger,
 	}
 
@@ -40,6 +44,24 @@ func (r *AssignDailyTaskByTelegramID) init() {
 	if r.queryTimeout == 0 {
 		r.queryTimeout = postgres.DefaultQueryTimeout
 	}
+
+	if r.cfg.Assignment.LevelWeight == 0 && r.cfg.Assignment.CompletionRateWeight == 0 {
+		r.cfg.Assignment.LevelWeight = userdailytask.DefaultLevelWeight
+		r.cfg.Assignment.CompletionRateWeight = userdailytask.DefaultCompletionRateWeight
+	}
+
+	if r.cfg.Assignment.MaxLevel == 0 {
+		r.cfg.Assignment.MaxLevel = userdailytask.DefaultMaxLevel
+	}
+
+	if r.cfg.Assignment.CompletionRateDays == 0 {
+		r.cfg.Assignment.CompletionRateDays = userdailytask.DefaultCompletionRateDays
+	}
+
+	if r.cfg.Assignment.MediumThreshold == 0 && r.cfg.Assignment.HardThreshold == 0 {
+		r.cfg.Assignment.MediumThreshold = userdailytask.DefaultMediumThreshold
+		r.cfg.Assignment.HardThreshold = userdailytask.DefaultHardThreshold
+	}
 }
 
 func (r *AssignDailyTaskByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (userdailytask.AssignDailyTaskByTelegramIDResponse, error) {
@@ -48,13 +70,19 @@ func (r *AssignDailyTaskByTelegramID) Execute(ctx context.Context, tx pgx.Tx, te
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
 	defer cancel()
 
-	q := `SELECT * FROM public.assign_daily_task($1);`
+	q := `SELECT * FROM public.assign_daily_task($1, $2, $3, $4, $5, $6, $7);`
 
 	var result userdailytask.AssignDailyTaskByTelegramIDResponse
 
 	if err := tx.QueryRow(
 		ctxTimeout, q,
 		telegramID,
+		r.cfg.Assignment.LevelWeight,
+		r.cfg.Assignment.CompletionRateWeight,
+		r.cfg.Assignment.MaxLevel,
+		r.cfg.Assignment.CompletionRateDays,
+		r.cfg.Assignment.MediumThreshold,
+		r.cfg.Assignment.HardThreshold,
 	).Scan(&result); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			r.logger.Error("request timed out while assign daily task by telegram id", "err", err)
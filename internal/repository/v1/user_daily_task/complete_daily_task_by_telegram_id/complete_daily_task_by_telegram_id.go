@@ -0,0 +1,68 @@
+package completedailytaskbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	userdailytask "github.com/go-jedi/lingramm_backend/internal/domain/user_daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICompleteDailyTaskByTelegramID --output=mocks --case=underscore
+type ICompleteDailyTaskByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (*userdailytask.CompleteDailyTaskByTelegramIDResponse, error)
+}
+
+type CompleteDailyTaskByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *CompleteDailyTaskByTelegramID {
+	r := &CompleteDailyTaskByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *CompleteDailyTaskByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *CompleteDailyTaskByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (*userdailytask.CompleteDailyTaskByTelegramIDResponse, error) {
+	r.logger.Debug("[complete daily task by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.daily_task_complete($1);`
+
+	var result *userdailytask.CompleteDailyTaskByTelegramIDResponse
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while complete daily task by telegram id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to complete daily task by telegram id", "err", err)
+		return nil, fmt.Errorf("could not complete daily task by telegram id: %w", err)
+	}
+
+	return result, nil
+}
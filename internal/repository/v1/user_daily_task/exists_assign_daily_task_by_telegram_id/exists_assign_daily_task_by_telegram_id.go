@@ -52,11 +52,7 @@ func (r *ExistsAssignDailyTaskByTelegramID) Execute(ctx context.Context, tx pgx.
 			SELECT 1
 			FROM user_daily_tasks
 			WHERE telegram_id = $1
-			AND (
-			    (occurred_at AT TIME ZONE 'Europe/Moscow')::DATE
-			) = (
-			    (NOW() AT TIME ZONE 'Europe/Moscow')::DATE
-			)
+			AND task_date = public.user_local_date($1)
 		);
 	`
 
@@ -1,7 +1,9 @@
 package userdailytask
 
 import (
+	"github.com/go-jedi/lingramm_backend/config"
 	assigndailytaskbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task/assign_daily_task_by_telegram_id"
+	completedailytaskbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task/complete_daily_task_by_telegram_id"
 	existsassigndailytaskbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task/exists_assign_daily_task_by_telegram_id"
 	getcurrentdailytaskbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task/get_current_daily_task_by_telegram_id"
 	getdailytaskweeksummarybytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task/get_daily_task_week_summary_by_telegram_id"
@@ -11,6 +13,7 @@ import (
 
 type Repository struct {
 	AssignDailyTaskByTelegramID         assigndailytaskbytelegramid.IAssignDailyTaskByTelegramID
+	CompleteDailyTaskByTelegramID       completedailytaskbytelegramid.ICompleteDailyTaskByTelegramID
 	ExistsAssignDailyTaskByTelegramID   existsassigndailytaskbytelegramid.IExistsAssignDailyTaskByTelegramID
 	GetCurrentDailyTaskByTelegramID     getcurrentdailytaskbytelegramid.IGetCurrentDailyTaskByTelegramID
 	GetDailyTaskWeekSummaryByTelegramID getdailytaskweeksummarybytelegramid.IGetDailyTaskWeekSummaryByTelegramID
@@ -19,10 +22,12 @@ type Repository struct {
 
 func New(
 	queryTimeout int64,
+	cfg config.DailyTaskConfig,
 	logger logger.ILogger,
 ) *Repository {
 	return &Repository{
-		AssignDailyTaskByTelegramID:         assigndailytaskbytelegramid.New(queryTimeout, logger),
+		AssignDailyTaskByTelegramID:         assigndailytaskbytelegramid.New(queryTimeout, cfg, logger),
+		CompleteDailyTaskByTelegramID:       completedailytaskbytelegramid.New(queryTimeout, logger),
 		ExistsAssignDailyTaskByTelegramID:   existsassigndailytaskbytelegramid.New(queryTimeout, logger),
 		GetCurrentDailyTaskByTelegramID:     getcurrentdailytaskbytelegramid.New(queryTimeout, logger),
 		GetDailyTaskWeekSummaryByTelegramID: getdailytaskweeksummarybytelegramid.New(queryTimeout, logger),
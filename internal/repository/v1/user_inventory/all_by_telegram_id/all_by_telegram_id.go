@@ -0,0 +1,89 @@
+package allbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	userinventory "github.com/go-jedi/lingramm_backend/internal/domain/user_inventory"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllByTelegramID --output=mocks --case=underscore
+type IAllByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]userinventory.UserInventoryItem, error)
+}
+
+type AllByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllByTelegramID {
+	r := &AllByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AllByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) ([]userinventory.UserInventoryItem, error) {
+	r.logger.Debug("[get all user inventory items by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT COALESCE(
+			JSONB_AGG(
+				TO_JSONB(uii) || JSONB_BUILD_OBJECT(
+					'remaining_quantity', uii.quantity - uii.consumed_quantity,
+					'is_boost_active', COALESCE(uii.active_until > NOW(), FALSE),
+					'item', JSONB_BUILD_OBJECT(
+						'id', si.id,
+						'name', si.name,
+						'description', si.description,
+						'type', si.type,
+						'value', si.value
+					)
+				)
+				ORDER BY uii.created_at DESC, uii.id DESC
+			),
+			'[]'::JSONB
+		)
+		FROM user_inventory_items uii
+		INNER JOIN shop_items si ON uii.shop_item_id = si.id
+		WHERE uii.telegram_id = $1;
+	`
+
+	var result []userinventory.UserInventoryItem
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all user inventory items by telegram id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all user inventory items by telegram id", "err", err)
+		return nil, fmt.Errorf("could not get all user inventory items by telegram id: %w", err)
+	}
+
+	return result, nil
+}
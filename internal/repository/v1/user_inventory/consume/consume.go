@@ -0,0 +1,68 @@
+package consume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	userinventory "github.com/go-jedi/lingramm_backend/internal/domain/user_inventory"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IConsume --output=mocks --case=underscore
+type IConsume interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto userinventory.ConsumeDTO) (userinventory.ConsumeResult, error)
+}
+
+type Consume struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Consume {
+	r := &Consume{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *Consume) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *Consume) Execute(ctx context.Context, tx pgx.Tx, dto userinventory.ConsumeDTO) (userinventory.ConsumeResult, error) {
+	r.logger.Debug("[consume user inventory item] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.inventory_item_consume($1, $2, $3);`
+
+	var result userinventory.ConsumeResult
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.TelegramID, dto.UserInventoryItemID, dto.Quantity,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while consume user inventory item", "err", err)
+			return userinventory.ConsumeResult{}, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to consume user inventory item", "err", err)
+		return userinventory.ConsumeResult{}, fmt.Errorf("could not consume user inventory item: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package existsactiveboostbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExistsActiveBoostByTelegramID --output=mocks --case=underscore
+type IExistsActiveBoostByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) (bool, error)
+}
+
+type ExistsActiveBoostByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *ExistsActiveBoostByTelegramID {
+	r := &ExistsActiveBoostByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *ExistsActiveBoostByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *ExistsActiveBoostByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) (bool, error) {
+	r.logger.Debug("[check active boost exists by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM user_inventory_items uii
+			INNER JOIN shop_items si ON si.id = uii.shop_item_id
+			WHERE uii.telegram_id = $1
+			AND si.type = 'boost'
+			AND uii.active_until > NOW()
+		);
+	`
+
+	ie := false
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		telegramID,
+	).Scan(&ie); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while check active boost exists by telegram id", "err", err)
+			return false, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to check active boost exists by telegram id", "err", err)
+		return false, fmt.Errorf("could not check active boost exists by telegram id: %w", err)
+	}
+
+	return ie, nil
+}
@@ -0,0 +1,25 @@
+package userinventory
+
+import (
+	allbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory/all_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory/consume"
+	existsactiveboostbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory/exists_active_boost_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	AllByTelegramID               allbytelegramid.IAllByTelegramID
+	Consume                       consume.IConsume
+	ExistsActiveBoostByTelegramID existsactiveboostbytelegramid.IExistsActiveBoostByTelegramID
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		AllByTelegramID:               allbytelegramid.New(queryTimeout, logger),
+		Consume:                       consume.New(queryTimeout, logger),
+		ExistsActiveBoostByTelegramID: existsactiveboostbytelegramid.New(queryTimeout, logger),
+	}
+}
@@ -0,0 +1,68 @@
+package allbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	userquest "github.com/go-jedi/lingramm_backend/internal/domain/user_quest"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllByTelegramID --output=mocks --case=underscore
+type IAllByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto userquest.AllByTelegramIDDTO) ([]userquest.UserQuest, error)
+}
+
+type AllByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AllByTelegramID {
+	r := &AllByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AllByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AllByTelegramID) Execute(ctx context.Context, tx pgx.Tx, dto userquest.AllByTelegramIDDTO) ([]userquest.UserQuest, error) {
+	r.logger.Debug("[get all user quests by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.user_quests_get($1, $2);`
+
+	var result []userquest.UserQuest
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.TelegramID, dto.Status,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while get all user quests by telegram id", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to get all user quests by telegram id", "err", err)
+		return nil, fmt.Errorf("could not get all user quests by telegram id: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package assignbytelegramid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAssignByTelegramID --output=mocks --case=underscore
+type IAssignByTelegramID interface {
+	Execute(ctx context.Context, tx pgx.Tx, telegramID string) error
+}
+
+type AssignByTelegramID struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *AssignByTelegramID {
+	r := &AssignByTelegramID{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *AssignByTelegramID) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+func (r *AssignByTelegramID) Execute(ctx context.Context, tx pgx.Tx, telegramID string) error {
+	r.logger.Debug("[assign quests by telegram id] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	q := `SELECT * FROM public.quests_assign($1);`
+
+	_, err := tx.Exec(
+		ctxTimeout, q,
+		telegramID,
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while assign quests by telegram id", "err", err)
+			return fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to assign quests by telegram id", "err", err)
+		return fmt.Errorf("could not assign quests by telegram id: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package userquest
+
+import (
+	allbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest/all_by_telegram_id"
+	assignbytelegramid "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest/assign_by_telegram_id"
+	syncuserquestprogress "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest/sync_user_quest_progress"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+type Repository struct {
+	AllByTelegramID       allbytelegramid.IAllByTelegramID
+	AssignByTelegramID    assignbytelegramid.IAssignByTelegramID
+	SyncUserQuestProgress syncuserquestprogress.ISyncUserQuestProgress
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *Repository {
+	return &Repository{
+		AllByTelegramID:       allbytelegramid.New(queryTimeout, logger),
+		AssignByTelegramID:    assignbytelegramid.New(queryTimeout, logger),
+		SyncUserQuestProgress: syncuserquestprogress.New(queryTimeout, logger),
+	}
+}
@@ -0,0 +1,75 @@
+package syncuserquestprogress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	userquest "github.com/go-jedi/lingramm_backend/internal/domain/user_quest"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+	jsoniter "github.com/json-iterator/go"
+)
+
+//go:generate mockery --name=ISyncUserQuestProgress --output=mocks --case=underscore
+type ISyncUserQuestProgress interface {
+	Execute(ctx context.Context, tx pgx.Tx, dto userquest.SyncUserQuestProgressDTO) ([]userquest.CompletedQuest, error)
+}
+
+type SyncUserQuestProgress struct {
+	queryTimeout int64
+	logger       logger.ILogger
+}
+
+func New(
+	queryTimeout int64,
+	logger logger.ILogger,
+) *SyncUserQuestProgress {
+	r := &SyncUserQuestProgress{
+		queryTimeout: queryTimeout,
+		logger:       logger,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (r *SyncUserQuestProgress) init() {
+	if r.queryTimeout == 0 {
+		r.queryTimeout = postgres.DefaultQueryTimeout
+	}
+}
+
+// Execute syncs progress of active user quests and returns quests completed by this progress.
+func (r *SyncUserQuestProgress) Execute(ctx context.Context, tx pgx.Tx, dto userquest.SyncUserQuestProgressDTO) ([]userquest.CompletedQuest, error) {
+	r.logger.Debug("[sync user quest progress] execute repository")
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(r.queryTimeout)*time.Second)
+	defer cancel()
+
+	rawActions, err := jsoniter.Marshal(dto.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	q := `SELECT * FROM public.quests_sync_progress($1, $2);`
+
+	var result []userquest.CompletedQuest
+
+	if err := tx.QueryRow(
+		ctxTimeout, q,
+		dto.TelegramID, rawActions,
+	).Scan(&result); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			r.logger.Error("request timed out while sync user quest progress", "err", err)
+			return nil, fmt.Errorf("the request timed out: %w", err)
+		}
+		r.logger.Error("failed to sync user quest progress", "err", err)
+		return nil, fmt.Errorf("could not sync user quest progress: %w", err)
+	}
+
+	return result, nil
+}
@@ -52,7 +52,8 @@ func (r *HasStreakDaysIncrementToday) Execute(ctx context.Context, tx pgx.Tx, te
 			SELECT 1
 			FROM user_stats
 			WHERE telegram_id = $1
-			AND last_active_at = CURRENT_DATE
+			AND streak_days > 0
+			AND last_streak_day = public.user_local_date($1)
 		);
 	`
 
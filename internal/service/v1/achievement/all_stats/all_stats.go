@@ -0,0 +1,73 @@
+package allstats
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllStats --output=mocks --case=underscore
+type IAllStats interface {
+	Execute(ctx context.Context) ([]achievement.StatsDetail, error)
+}
+
+type AllStats struct {
+	achievementRepository *achievementrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	achievementRepository *achievementrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllStats {
+	return &AllStats{
+		achievementRepository: achievementRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *AllStats) Execute(ctx context.Context) ([]achievement.StatsDetail, error) {
+	s.logger.Debug("[get all achievement stats] execute service")
+
+	var (
+		err    error
+		result []achievement.StatsDetail
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all achievement stats.
+	result, err = s.achievementRepository.AllStats.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -6,10 +6,12 @@ import (
 	"os"
 
 	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
 	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
 	achievementassets "github.com/go-jedi/lingramm_backend/internal/domain/file_server/achievement_assets"
 	awardassets "github.com/go-jedi/lingramm_backend/internal/domain/file_server/award_assets"
 	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
 	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
 	achievementassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/achievement_assets"
 	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
@@ -27,14 +29,15 @@ type ICreate interface {
 }
 
 type Create struct {
-	achievementRepository       *achievementrepository.Repository
-	achievementAssetsRepository *achievementassetsrepository.Repository
-	awardAssetsRepository       *awardassetsrepository.Repository
-	achievementTypeRepository   *achievementtyperepository.Repository
-	logger                      logger.ILogger
-	postgres                    *postgres.Postgres
-	redis                       *redis.Redis
-	fileServer                  *fileserver.FileServer
+	achievementRepository           *achievementrepository.Repository
+	achievementAssetsRepository     *achievementassetsrepository.Repository
+	awardAssetsRepository           *awardassetsrepository.Repository
+	achievementTypeRepository       *achievementtyperepository.Repository
+	achievementEvaluationRepository *achievementevaluationrepository.Repository
+	logger                          logger.ILogger
+	postgres                        *postgres.Postgres
+	redis                           *redis.Redis
+	fileServer                      *fileserver.FileServer
 }
 
 func New(
@@ -42,20 +45,22 @@ func New(
 	achievementAssetsRepository *achievementassetsrepository.Repository,
 	awardAssetsRepository *awardassetsrepository.Repository,
 	achievementTypeRepository *achievementtyperepository.Repository,
+	achievementEvaluationRepository *achievementevaluationrepository.Repository,
 	logger logger.ILogger,
 	postgres *postgres.Postgres,
 	redis *redis.Redis,
 	fileServer *fileserver.FileServer,
 ) *Create {
 	return &Create{
-		achievementRepository:       achievementRepository,
-		achievementAssetsRepository: achievementAssetsRepository,
-		awardAssetsRepository:       awardAssetsRepository,
-		achievementTypeRepository:   achievementTypeRepository,
-		logger:                      logger,
-		postgres:                    postgres,
-		redis:                       redis,
-		fileServer:                  fileServer,
+		achievementRepository:           achievementRepository,
+		achievementAssetsRepository:     achievementAssetsRepository,
+		awardAssetsRepository:           awardAssetsRepository,
+		achievementTypeRepository:       achievementTypeRepository,
+		achievementEvaluationRepository: achievementEvaluationRepository,
+		logger:                          logger,
+		postgres:                        postgres,
+		redis:                           redis,
+		fileServer:                      fileServer,
 	}
 }
 
@@ -72,6 +77,7 @@ func (s *Create) Execute(ctx context.Context, dto achievement.CreateDTO) (achiev
 		resultAchievement     achievement.Achievement
 		existsAchievement     bool
 		existsAchievementType bool
+		existsAchievementTier bool
 	)
 
 	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
@@ -102,6 +108,24 @@ func (s *Create) Execute(ctx context.Context, dto achievement.CreateDTO) (achiev
 		return achievement.Detail{}, err
 	}
 
+	if dto.AvailableFrom != nil && dto.AvailableTo != nil && !dto.AvailableFrom.Before(*dto.AvailableTo) { // if available window is empty.
+		err = apperrors.ErrAchievementInvalidAvailableWindow
+		return achievement.Detail{}, err
+	}
+
+	if dto.TierGroup != nil && dto.TierLevel != nil { // if achievement is a tier of chain.
+		// check achievement exists by tier.
+		existsAchievementTier, err = s.achievementRepository.ExistsAchievementByTier.Execute(ctx, tx, *dto.TierGroup, *dto.TierLevel, 0)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+
+		if existsAchievementTier { // if tier already taken.
+			err = apperrors.ErrAchievementTierAlreadyExists
+			return achievement.Detail{}, err
+		}
+	}
+
 	// convert png or jpg image achievement to webp and upload.
 	imageAchievementData, err = s.fileServer.AchievementAssets.UploadAndConvertToWebP(ctx, dto.FileAchievementHeader)
 	if err != nil {
@@ -149,6 +173,15 @@ func (s *Create) Execute(ctx context.Context, dto achievement.CreateDTO) (achiev
 		return achievement.Detail{}, err
 	}
 
+	// create achievement evaluation job, so users who already qualify get the achievement.
+	_, err = s.achievementEvaluationRepository.Create.Execute(ctx, tx, achievementevaluation.CreateDTO{
+		AchievementID: resultAchievement.ID,
+		Reason:        achievementevaluation.ReasonAchievementCreate,
+	})
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return achievement.Detail{}, err
@@ -189,8 +222,13 @@ func (s *Create) createAchievement(ctx context.Context, tx pgx.Tx, dto achieveme
 		AchievementAssetsID: achievementAssetsID,
 		AwardAssetsID:       awardAssetsID,
 		AchievementTypeID:   achievementTypeID,
+		AvailableFrom:       dto.AvailableFrom,
+		AvailableTo:         dto.AvailableTo,
 		Description:         dto.Description,
+		TierGroup:           dto.TierGroup,
+		TierLevel:           dto.TierLevel,
 		Name:                dto.Name,
+		IsSecret:            dto.IsSecret,
 	}
 
 	// create achievement.
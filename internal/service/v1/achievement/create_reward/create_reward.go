@@ -0,0 +1,86 @@
+package createreward
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreateReward --output=mocks --case=underscore
+type ICreateReward interface {
+	Execute(ctx context.Context, dto achievement.CreateRewardDTO) (achievement.Reward, error)
+}
+
+type CreateReward struct {
+	achievementRepository *achievementrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	achievementRepository *achievementrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *CreateReward {
+	return &CreateReward{
+		achievementRepository: achievementRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *CreateReward) Execute(ctx context.Context, dto achievement.CreateRewardDTO) (achievement.Reward, error) {
+	s.logger.Debug("[create a new achievement reward] execute service")
+
+	var (
+		err               error
+		result            achievement.Reward
+		achievementExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievement.Reward{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check achievement exists by id.
+	achievementExists, err = s.achievementRepository.ExistsAchievementByID.Execute(ctx, tx, dto.AchievementID)
+	if err != nil {
+		return achievement.Reward{}, err
+	}
+
+	if !achievementExists { // if achievement does not exist.
+		err = apperrors.ErrAchievementDoesNotExist
+		return achievement.Reward{}, err
+	}
+
+	// create a new achievement reward.
+	result, err = s.achievementRepository.CreateReward.Execute(ctx, tx, dto)
+	if err != nil {
+		return achievement.Reward{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievement.Reward{}, err
+	}
+
+	return result, nil
+}
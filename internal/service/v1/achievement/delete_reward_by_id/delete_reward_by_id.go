@@ -0,0 +1,86 @@
+package deleterewardbyid
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteRewardByID --output=mocks --case=underscore
+type IDeleteRewardByID interface {
+	Execute(ctx context.Context, id int64) (achievement.Reward, error)
+}
+
+type DeleteRewardByID struct {
+	achievementRepository *achievementrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	achievementRepository *achievementrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *DeleteRewardByID {
+	return &DeleteRewardByID{
+		achievementRepository: achievementRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *DeleteRewardByID) Execute(ctx context.Context, id int64) (achievement.Reward, error) {
+	s.logger.Debug("[delete achievement reward by id] execute service")
+
+	var (
+		err          error
+		result       achievement.Reward
+		rewardExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievement.Reward{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check achievement reward exists by id.
+	rewardExists, err = s.achievementRepository.ExistsRewardByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievement.Reward{}, err
+	}
+
+	if !rewardExists { // if achievement reward does not exist.
+		err = apperrors.ErrAchievementRewardDoesNotExist
+		return achievement.Reward{}, err
+	}
+
+	// delete achievement reward by id.
+	result, err = s.achievementRepository.DeleteRewardByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievement.Reward{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievement.Reward{}, err
+	}
+
+	return result, nil
+}
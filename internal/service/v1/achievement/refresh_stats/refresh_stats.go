@@ -0,0 +1,72 @@
+package refreshstats
+
+import (
+	"context"
+	"log"
+
+	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IRefreshStats --output=mocks --case=underscore
+type IRefreshStats interface {
+	Execute(ctx context.Context, activeDays int) (int64, error)
+}
+
+type RefreshStats struct {
+	achievementRepository *achievementrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	achievementRepository *achievementrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *RefreshStats {
+	return &RefreshStats{
+		achievementRepository: achievementRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *RefreshStats) Execute(ctx context.Context, activeDays int) (int64, error) {
+	s.logger.Debug("[refresh achievement stats] execute service")
+
+	var (
+		err    error
+		result int64
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// refresh achievement stats.
+	result, err = s.achievementRepository.RefreshStats.Execute(ctx, tx, activeDays)
+	if err != nil {
+		return 0, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
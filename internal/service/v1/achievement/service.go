@@ -2,13 +2,19 @@ package achievement
 
 import (
 	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
 	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
 	achievementassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/achievement_assets"
 	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
 	alldetail "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/all_detail"
+	allstats "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/all_stats"
 	"github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/create"
+	createreward "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/create_reward"
 	deletedetailbyachievementid "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/delete_detail_by_achievement_id"
+	deleterewardbyid "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/delete_reward_by_id"
 	getdetailbyachievementid "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/get_detail_by_achievement_id"
+	refreshstats "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/refresh_stats"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/achievement/update"
 	fileserver "github.com/go-jedi/lingramm_backend/pkg/file_server"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
@@ -17,9 +23,14 @@ import (
 
 type Service struct {
 	All                         alldetail.IAllDetail
+	AllStats                    allstats.IAllStats
 	Create                      create.ICreate
+	CreateReward                createreward.ICreateReward
 	DeleteDetailByAchievementID deletedetailbyachievementid.IDeleteDetailByAchievementID
+	DeleteRewardByID            deleterewardbyid.IDeleteRewardByID
 	GetDetailByAchievementID    getdetailbyachievementid.IGetDetailByAchievementID
+	RefreshStats                refreshstats.IRefreshStats
+	Update                      update.IUpdate
 }
 
 func New(
@@ -27,6 +38,7 @@ func New(
 	achievementAssetsRepository *achievementassetsrepository.Repository,
 	awardAssetsRepository *awardassetsrepository.Repository,
 	achievementTypeRepository *achievementtyperepository.Repository,
+	achievementEvaluationRepository *achievementevaluationrepository.Repository,
 	logger logger.ILogger,
 	postgres *postgres.Postgres,
 	redis *redis.Redis,
@@ -34,8 +46,13 @@ func New(
 ) *Service {
 	return &Service{
 		All:                         alldetail.New(achievementRepository, logger, postgres),
-		Create:                      create.New(achievementRepository, achievementAssetsRepository, awardAssetsRepository, achievementTypeRepository, logger, postgres, redis, fileServer),
+		AllStats:                    allstats.New(achievementRepository, logger, postgres),
+		Create:                      create.New(achievementRepository, achievementAssetsRepository, awardAssetsRepository, achievementTypeRepository, achievementEvaluationRepository, logger, postgres, redis, fileServer),
+		CreateReward:                createreward.New(achievementRepository, logger, postgres),
 		DeleteDetailByAchievementID: deletedetailbyachievementid.New(achievementRepository, achievementAssetsRepository, awardAssetsRepository, logger, postgres, redis),
+		DeleteRewardByID:            deleterewardbyid.New(achievementRepository, logger, postgres),
 		GetDetailByAchievementID:    getdetailbyachievementid.New(achievementRepository, logger, postgres),
+		RefreshStats:                refreshstats.New(achievementRepository, logger, postgres),
+		Update:                      update.New(achievementRepository, achievementAssetsRepository, awardAssetsRepository, achievementTypeRepository, achievementEvaluationRepository, logger, postgres, redis, fileServer),
 	}
 }
@@ -0,0 +1,320 @@
+package update
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementassets "github.com/go-jedi/lingramm_backend/internal/domain/file_server/achievement_assets"
+	awardassets "github.com/go-jedi/lingramm_backend/internal/domain/file_server/award_assets"
+	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	achievementassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/achievement_assets"
+	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	fileserver "github.com/go-jedi/lingramm_backend/pkg/file_server"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, dto achievement.UpdateDTO) (achievement.Detail, error)
+}
+
+type Update struct {
+	achievementRepository           *achievementrepository.Repository
+	achievementAssetsRepository     *achievementassetsrepository.Repository
+	awardAssetsRepository           *awardassetsrepository.Repository
+	achievementTypeRepository       *achievementtyperepository.Repository
+	achievementEvaluationRepository *achievementevaluationrepository.Repository
+	logger                          logger.ILogger
+	postgres                        *postgres.Postgres
+	redis                           *redis.Redis
+	fileServer                      *fileserver.FileServer
+}
+
+func New(
+	achievementRepository *achievementrepository.Repository,
+	achievementAssetsRepository *achievementassetsrepository.Repository,
+	awardAssetsRepository *awardassetsrepository.Repository,
+	achievementTypeRepository *achievementtyperepository.Repository,
+	achievementEvaluationRepository *achievementevaluationrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+	fileServer *fileserver.FileServer,
+) *Update {
+	return &Update{
+		achievementRepository:           achievementRepository,
+		achievementAssetsRepository:     achievementAssetsRepository,
+		awardAssetsRepository:           awardAssetsRepository,
+		achievementTypeRepository:       achievementTypeRepository,
+		achievementEvaluationRepository: achievementEvaluationRepository,
+		logger:                          logger,
+		postgres:                        postgres,
+		redis:                           redis,
+		fileServer:                      fileServer,
+	}
+}
+
+func (s *Update) Execute(ctx context.Context, dto achievement.UpdateDTO) (achievement.Detail, error) {
+	s.logger.Debug("[update a achievement] execute service")
+
+	var (
+		err                   error
+		currentDetail         achievement.Detail
+		imageAchievementData  achievementassets.UploadAndConvertToWebpResponse
+		achievementAsset      achievementassets.AchievementAssets
+		oldAchievementAsset   *achievementassets.AchievementAssets
+		imageAwardData        awardassets.UploadAndConvertToWebpResponse
+		awardAsset            awardassets.AwardAssets
+		oldAwardAsset         *awardassets.AwardAssets
+		achievementTypeData   achievementtype.AchievementType
+		resultAchievement     achievement.Achievement
+		existsAchievementByID bool
+		existsAchievement     bool
+		existsAchievementType bool
+		existsAchievementTier bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+			s.deleteAchievementFile(ctx, imageAchievementData.NameFileWithoutExtension, imageAchievementData.ServerPathFile)
+			s.deleteAwardFile(ctx, imageAwardData.NameFileWithoutExtension, imageAwardData.ServerPathFile)
+		}
+	}()
+
+	// check achievement exists by id.
+	existsAchievementByID, err = s.achievementRepository.ExistsAchievementByID.Execute(ctx, tx, dto.ID)
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+
+	if !existsAchievementByID { // if achievement does not exist.
+		err = apperrors.ErrAchievementDoesNotExist
+		return achievement.Detail{}, err
+	}
+
+	if dto.AvailableFrom != nil && dto.AvailableTo != nil && !dto.AvailableFrom.Before(*dto.AvailableTo) { // if available window is empty.
+		err = apperrors.ErrAchievementInvalidAvailableWindow
+		return achievement.Detail{}, err
+	}
+
+	if dto.TierGroup != nil && dto.TierLevel != nil { // if achievement is a tier of chain.
+		// check achievement exists by tier.
+		existsAchievementTier, err = s.achievementRepository.ExistsAchievementByTier.Execute(ctx, tx, *dto.TierGroup, *dto.TierLevel, dto.ID)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+
+		if existsAchievementTier { // if tier already taken.
+			err = apperrors.ErrAchievementTierAlreadyExists
+			return achievement.Detail{}, err
+		}
+	}
+
+	// get current achievement detail.
+	currentDetail, err = s.achievementRepository.GetDetailByAchievementID.Execute(ctx, tx, dto.ID)
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+
+	// check achievement type exists by name.
+	existsAchievementType, err = s.achievementTypeRepository.ExistsByName.Execute(ctx, tx, dto.AchievementType)
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+
+	if !existsAchievementType { // if achievement type does not exist.
+		err = apperrors.ErrAchievementTypeDoesNotExist
+		return achievement.Detail{}, err
+	}
+
+	// get achievement type by name.
+	achievementTypeData, err = s.achievementTypeRepository.GetByName.Execute(ctx, tx, dto.AchievementType)
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+
+	if achievementTypeData.ID != currentDetail.Achievement.AchievementTypeID { // if achievement type changed.
+		// check another achievement exists by event type.
+		existsAchievement, err = s.achievementRepository.ExistsAchievementByAchievementType.Execute(ctx, tx, dto.AchievementType)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+
+		if existsAchievement { // if achievement already exist.
+			err = apperrors.ErrAchievementAlreadyExists
+			return achievement.Detail{}, err
+		}
+	}
+
+	achievementAsset = currentDetail.AchievementAssets
+	if dto.FileAchievementHeader != nil { // if achievement image is swapped.
+		// convert png or jpg image achievement to webp and upload.
+		imageAchievementData, err = s.fileServer.AchievementAssets.UploadAndConvertToWebP(ctx, dto.FileAchievementHeader)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+
+		// create achievement asset.
+		achievementAsset, err = s.achievementAssetsRepository.Create.Execute(ctx, tx, imageAchievementData)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+
+		oldAchievementAsset = &currentDetail.AchievementAssets
+	}
+
+	awardAsset = currentDetail.AwardAssets
+	if dto.FileAwardHeader != nil { // if award image is swapped.
+		// convert png or jpg image award to webp and upload.
+		imageAwardData, err = s.fileServer.AwardAssets.UploadAndConvertToWebP(ctx, dto.FileAwardHeader)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+
+		// create award asset.
+		awardAsset, err = s.awardAssetsRepository.Create.Execute(ctx, tx, imageAwardData)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+
+		oldAwardAsset = &currentDetail.AwardAssets
+	}
+
+	// update achievement.
+	resultAchievement, err = s.achievementRepository.UpdateAchievement.Execute(ctx, tx, achievement.UpdateAchievementDTO{
+		ID:                  dto.ID,
+		AchievementAssetsID: achievementAsset.ID,
+		AwardAssetsID:       awardAsset.ID,
+		AchievementTypeID:   achievementTypeData.ID,
+		AvailableFrom:       dto.AvailableFrom,
+		AvailableTo:         dto.AvailableTo,
+		Description:         dto.Description,
+		TierGroup:           dto.TierGroup,
+		TierLevel:           dto.TierLevel,
+		Name:                dto.Name,
+		IsSecret:            dto.IsSecret,
+	})
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+
+	// delete replaced achievement asset.
+	if oldAchievementAsset != nil {
+		_, err = s.achievementAssetsRepository.DeleteByID.Execute(ctx, tx, oldAchievementAsset.ID)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+	}
+
+	// delete replaced award asset if nothing else references it (level rewards may share award assets).
+	if oldAwardAsset != nil {
+		oldAwardAsset, err = s.deleteAwardAssetIfUnused(ctx, tx, oldAwardAsset)
+		if err != nil {
+			return achievement.Detail{}, err
+		}
+	}
+
+	// create achievement evaluation job, so users who already qualify get the achievement.
+	_, err = s.achievementEvaluationRepository.Create.Execute(ctx, tx, achievementevaluation.CreateDTO{
+		AchievementID: resultAchievement.ID,
+		Reason:        achievementevaluation.ReasonAchievementUpdate,
+	})
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievement.Detail{}, err
+	}
+
+	// remove replaced files only after the new references are committed.
+	if oldAchievementAsset != nil {
+		s.deleteAchievementFile(ctx, oldAchievementAsset.NameFileWithoutExtension, oldAchievementAsset.ServerPathFile)
+	}
+	if oldAwardAsset != nil {
+		s.deleteAwardFile(ctx, oldAwardAsset.NameFileWithoutExtension, oldAwardAsset.ServerPathFile)
+	}
+
+	return achievement.Detail{
+		Achievement:       resultAchievement,
+		AchievementAssets: achievementAsset,
+		AwardAssets:       awardAsset,
+	}, nil
+}
+
+// deleteAwardAssetIfUnused delete award asset if it is not used anymore.
+// returns nil if asset is still in use and its file must be kept.
+func (s *Update) deleteAwardAssetIfUnused(ctx context.Context, tx pgx.Tx, awardAsset *awardassets.AwardAssets) (*awardassets.AwardAssets, error) {
+	// check award assets in use by id.
+	inUse, err := s.awardAssetsRepository.ExistsInUseByID.Execute(ctx, tx, awardAsset.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if inUse { // if award assets still in use.
+		return nil, nil
+	}
+
+	// delete award assets by id.
+	if _, err := s.awardAssetsRepository.DeleteByID.Execute(ctx, tx, awardAsset.ID); err != nil {
+		return nil, err
+	}
+
+	return awardAsset, nil
+}
+
+// deleteAchievementFile delete achievement file.
+func (s *Update) deleteAchievementFile(ctx context.Context, nameFileWithoutExtension string, path string) {
+	if path == "" {
+		return
+	}
+
+	if err := os.Remove(path); err != nil {
+		s.logger.Warn("failed to remove achievement file", "path", path, "error", err)
+
+		if err := s.redis.UnDeleteFileAchievement.Set(ctx, nameFileWithoutExtension, path); err != nil {
+			s.logger.Warn("failed to set un delete achievement file", "path", path, "error", err)
+		}
+	} else {
+		s.logger.Debug("achievement file removed", "path", path)
+	}
+}
+
+// deleteAwardFile delete award file.
+func (s *Update) deleteAwardFile(ctx context.Context, nameFileWithoutExtension string, path string) {
+	if path == "" {
+		return
+	}
+
+	if err := os.Remove(path); err != nil {
+		s.logger.Warn("failed to remove award file", "path", path, "error", err)
+
+		if err := s.redis.UnDeleteFileAward.Set(ctx, nameFileWithoutExtension, path); err != nil {
+			s.logger.Warn("failed to set un delete award file", "path", path, "error", err)
+		}
+	} else {
+		s.logger.Debug("award file removed", "path", path)
+	}
+}
@@ -0,0 +1,76 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// allLimit is how many of the latest jobs are returned.
+const allLimit = 100
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]achievementevaluation.Job, error)
+}
+
+type All struct {
+	achievementEvaluationRepository *achievementevaluationrepository.Repository
+	logger                          logger.ILogger
+	postgres                        *postgres.Postgres
+}
+
+func New(
+	achievementEvaluationRepository *achievementevaluationrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		achievementEvaluationRepository: achievementEvaluationRepository,
+		logger:                          logger,
+		postgres:                        postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]achievementevaluation.Job, error) {
+	s.logger.Debug("[get all achievement evaluation jobs] execute service")
+
+	var (
+		err    error
+		result []achievementevaluation.Job
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all achievement evaluation jobs.
+	result, err = s.achievementEvaluationRepository.All.Execute(ctx, tx, allLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
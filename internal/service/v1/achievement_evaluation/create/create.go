@@ -0,0 +1,90 @@
+package create
+
+import (
+	"context"
+	"log"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, dto achievementevaluation.CreateDTO) (achievementevaluation.Job, error)
+}
+
+type Create struct {
+	achievementEvaluationRepository *achievementevaluationrepository.Repository
+	achievementRepository           *achievementrepository.Repository
+	logger                          logger.ILogger
+	postgres                        *postgres.Postgres
+}
+
+func New(
+	achievementEvaluationRepository *achievementevaluationrepository.Repository,
+	achievementRepository *achievementrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Create {
+	return &Create{
+		achievementEvaluationRepository: achievementEvaluationRepository,
+		achievementRepository:           achievementRepository,
+		logger:                          logger,
+		postgres:                        postgres,
+	}
+}
+
+func (s *Create) Execute(ctx context.Context, dto achievementevaluation.CreateDTO) (achievementevaluation.Job, error) {
+	s.logger.Debug("[create achievement evaluation job] execute service")
+
+	var (
+		err               error
+		result            achievementevaluation.Job
+		achievementExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievementevaluation.Job{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check achievement exists by id.
+	achievementExists, err = s.achievementRepository.ExistsAchievementByID.Execute(ctx, tx, dto.AchievementID)
+	if err != nil {
+		return achievementevaluation.Job{}, err
+	}
+
+	if !achievementExists { // if achievement does not exist.
+		err = apperrors.ErrAchievementDoesNotExist
+		return achievementevaluation.Job{}, err
+	}
+
+	// create achievement evaluation job.
+	result, err = s.achievementEvaluationRepository.Create.Execute(ctx, tx, dto)
+	if err != nil {
+		return achievementevaluation.Job{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievementevaluation.Job{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,86 @@
+package getbyid
+
+import (
+	"context"
+	"log"
+
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByID --output=mocks --case=underscore
+type IGetByID interface {
+	Execute(ctx context.Context, id int64) (achievementevaluation.Job, error)
+}
+
+type GetByID struct {
+	achievementEvaluationRepository *achievementevaluationrepository.Repository
+	logger                          logger.ILogger
+	postgres                        *postgres.Postgres
+}
+
+func New(
+	achievementEvaluationRepository *achievementevaluationrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetByID {
+	return &GetByID{
+		achievementEvaluationRepository: achievementEvaluationRepository,
+		logger:                          logger,
+		postgres:                        postgres,
+	}
+}
+
+func (s *GetByID) Execute(ctx context.Context, id int64) (achievementevaluation.Job, error) {
+	s.logger.Debug("[get achievement evaluation job by id] execute service")
+
+	var (
+		err       error
+		result    achievementevaluation.Job
+		jobExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievementevaluation.Job{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check achievement evaluation job exists by id.
+	jobExists, err = s.achievementEvaluationRepository.ExistsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievementevaluation.Job{}, err
+	}
+
+	if !jobExists { // if achievement evaluation job does not exist.
+		err = apperrors.ErrAchievementEvaluationJobDoesNotExist
+		return achievementevaluation.Job{}, err
+	}
+
+	// get achievement evaluation job by id.
+	result, err = s.achievementEvaluationRepository.GetByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievementevaluation.Job{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievementevaluation.Job{}, err
+	}
+
+	return result, nil
+}
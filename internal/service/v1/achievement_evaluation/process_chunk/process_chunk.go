@@ -0,0 +1,370 @@
+package processchunk
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	achievementevaluation "github.com/go-jedi/lingramm_backend/internal/domain/achievement_evaluation"
+	eventtype "github.com/go-jedi/lingramm_backend/internal/domain/event_type"
+	userbalance "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/user_balance"
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	"github.com/go-jedi/lingramm_backend/internal/domain/notification"
+	userachievement "github.com/go-jedi/lingramm_backend/internal/domain/user_achievement"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/rabbitmq"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+// levelUp represents level history backfill and level rewards of the user
+// who reached a new level with experience points of unlocked achievements.
+type levelUp struct {
+	backFill level.BackFillMissingLevelHistoryByTelegramIDResponse
+	rewards  []level.UserLevelReward
+}
+
+//go:generate mockery --name=IProcessChunk --output=mocks --case=underscore
+type IProcessChunk interface {
+	Execute(ctx context.Context, dto achievementevaluation.ProcessChunkDTO) (*achievementevaluation.Job, error)
+}
+
+type ProcessChunk struct {
+	achievementEvaluationRepository *achievementevaluationrepository.Repository
+	eventTypeRepository             *eventtyperepository.Repository
+	internalCurrencyRepository      *internalcurrencyrepository.Repository
+	levelRepository                 *levelrepository.Repository
+	notificationRepository          *notificationrepository.Repository
+	logger                          logger.ILogger
+	rabbitMQ                        *rabbitmq.RabbitMQ
+	postgres                        *postgres.Postgres
+	redis                           *redis.Redis
+}
+
+func New(
+	achievementEvaluationRepository *achievementevaluationrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	levelRepository *levelrepository.Repository,
+	notificationRepository *notificationrepository.Repository,
+	logger logger.ILogger,
+	rabbitMQ *rabbitmq.RabbitMQ,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *ProcessChunk {
+	return &ProcessChunk{
+		achievementEvaluationRepository: achievementEvaluationRepository,
+		eventTypeRepository:             eventTypeRepository,
+		internalCurrencyRepository:      internalCurrencyRepository,
+		levelRepository:                 levelRepository,
+		notificationRepository:          notificationRepository,
+		logger:                          logger,
+		rabbitMQ:                        rabbitMQ,
+		postgres:                        postgres,
+		redis:                           redis,
+	}
+}
+
+func (s *ProcessChunk) Execute(ctx context.Context, dto achievementevaluation.ProcessChunkDTO) (*achievementevaluation.Job, error) {
+	s.logger.Debug("[process achievement evaluation job chunk] execute service")
+
+	var (
+		err           error
+		result        *achievementevaluation.ProcessChunkResponse
+		levelUps      map[string]levelUp
+		notifications []notification.Notification
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// process achievement evaluation job chunk.
+	result, err = s.achievementEvaluationRepository.ProcessChunk.Execute(ctx, tx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil { // nothing to evaluate.
+		// commit transaction.
+		err = tx.Commit(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	// grant internal currency rewards of unlocked achievements.
+	err = s.grantAchievementRewards(ctx, tx, result.Unlocked)
+	if err != nil {
+		return nil, err
+	}
+
+	// backfill level history and grant level rewards of users who received experience points.
+	levelUps, err = s.grantLevelUps(ctx, tx, result.Unlocked)
+	if err != nil {
+		return nil, err
+	}
+
+	// create notifications in database (only for recently active users).
+	notifications, err = s.createNotifications(ctx, tx, result.Unlocked, levelUps)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sendNotifications(ctx, notifications)
+
+	for i := range result.Unlocked {
+		// achievements changed, so cached achievement progress is outdated.
+		if err := s.redis.AchievementProgress.Delete(ctx, result.Unlocked[i].TelegramID); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to delete achievement progress from cache: %v", err))
+		}
+
+		if userachievement.AnyHasSubscriptionDays(result.Unlocked[i].Achievements) ||
+			level.AnyHasSubscriptionDays(levelUps[result.Unlocked[i].TelegramID].rewards) { // subscription changed, so cached subscription snapshot is outdated.
+			if err := s.redis.SubscriptionSnapshot.Delete(ctx, result.Unlocked[i].TelegramID); err != nil {
+				s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+			}
+		}
+	}
+
+	return result.Job, nil
+}
+
+// grantAchievementRewards accrues internal currency rewards of unlocked achievements
+// (experience points, subscription days and streak freezes are applied by the database,
+// level ups are granted by grantLevelUps).
+func (s *ProcessChunk) grantAchievementRewards(ctx context.Context, tx pgx.Tx, unlocked []achievementevaluation.UnlockedUser) error {
+	var (
+		err           error
+		eventTypeData eventtype.EventType
+	)
+
+	for i := range unlocked {
+		for j := range unlocked[i].Achievements {
+			rewards := unlocked[i].Achievements[j].Rewards
+
+			for k := range rewards {
+				if rewards[k].Type != achievement.RewardTypeInternalCurrency || rewards[k].Amount == nil {
+					continue
+				}
+
+				if eventTypeData.ID == 0 {
+					// get achievement reward event type data.
+					eventTypeData, err = s.eventTypeRepository.GetByName.Execute(ctx, tx, achievement.RewardEventType)
+					if err != nil {
+						return err
+					}
+				}
+
+				var (
+					description = fmt.Sprintf("Награда за достижение «%s»", unlocked[i].Achievements[j].AchievementName)
+					sourceType  = userbalance.SourceTypeAchievementReward
+				)
+
+				// add user balance.
+				if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+					EventTypeID: eventTypeData.ID,
+					Amount:      *rewards[k].Amount,
+					TelegramID:  unlocked[i].TelegramID,
+					Description: &description,
+					SourceType:  &sourceType,
+					SourceID:    &rewards[k].ID,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// grantLevelUps backfills missing level history and grants level rewards
+// of users who received experience points from unlocked achievements.
+func (s *ProcessChunk) grantLevelUps(ctx context.Context, tx pgx.Tx, unlocked []achievementevaluation.UnlockedUser) (map[string]levelUp, error) {
+	result := make(map[string]levelUp)
+
+	for i := range unlocked {
+		if !userachievement.AnyHasExperiencePoints(unlocked[i].Achievements) {
+			continue
+		}
+
+		// backfill missing level history by telegram id.
+		backFill, err := s.levelRepository.BackFillMissingLevelHistoryByTelegramID.Execute(ctx, tx, unlocked[i].TelegramID)
+		if err != nil {
+			return nil, err
+		}
+
+		// grant level rewards for every newly reached level.
+		rewards, err := s.grantLevelRewards(ctx, tx, unlocked[i].TelegramID)
+		if err != nil {
+			return nil, err
+		}
+
+		result[unlocked[i].TelegramID] = levelUp{
+			backFill: backFill,
+			rewards:  rewards,
+		}
+	}
+
+	return result, nil
+}
+
+// grantLevelRewards claims level rewards that were not granted yet
+// (subscription days are applied by the database) and accrues internal currency ones.
+func (s *ProcessChunk) grantLevelRewards(ctx context.Context, tx pgx.Tx, telegramID string) ([]level.UserLevelReward, error) {
+	// claim level rewards by telegram id.
+	levelRewards, err := s.levelRepository.ClaimRewardsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventTypeData eventtype.EventType
+
+	for i := range levelRewards {
+		if levelRewards[i].Type != level.RewardTypeInternalCurrency || levelRewards[i].Amount == nil {
+			continue
+		}
+
+		if eventTypeData.ID == 0 {
+			// get level up reward event type data.
+			eventTypeData, err = s.eventTypeRepository.GetByName.Execute(ctx, tx, level.LevelUpRewardEventType)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var (
+			description = fmt.Sprintf("Награда за %d уровень", levelRewards[i].LevelNumber)
+			sourceType  = userbalance.SourceTypeLevelReward
+		)
+
+		// add user balance.
+		if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+			EventTypeID: eventTypeData.ID,
+			Amount:      *levelRewards[i].Amount,
+			TelegramID:  telegramID,
+			Description: &description,
+			SourceType:  &sourceType,
+			SourceID:    &levelRewards[i].ID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return levelRewards, nil
+}
+
+// createNotifications create notifications for recently active users.
+func (s *ProcessChunk) createNotifications(
+	ctx context.Context,
+	tx pgx.Tx,
+	unlocked []achievementevaluation.UnlockedUser,
+	levelUps map[string]levelUp,
+) ([]notification.Notification, error) {
+	var dto []notification.CreateDTO
+
+	for i := range unlocked {
+		if !unlocked[i].IsActive { // inactive users will see achievements and levels in their profile.
+			continue
+		}
+
+		for j := range unlocked[i].Achievements {
+			dto = append(dto, notification.CreateDTO{
+				Message: notification.Message{
+					Title:   "Уведомление",
+					Text:    unlocked[i].Achievements[j].NotificationText(),
+					Rewards: unlocked[i].Achievements[j].NotificationRewards(),
+				},
+				Type:       notification.AchievementType,
+				TelegramID: unlocked[i].TelegramID,
+			})
+		}
+
+		lu := levelUps[unlocked[i].TelegramID]
+
+		if lu.backFill.IsLevelUp {
+			dto = append(dto, notification.CreateDTO{
+				Message: notification.Message{
+					Title: "Уведомление",
+					Text:  fmt.Sprintf("Поздравляем! Вы перешли на %d уровень!", lu.backFill.NewLevel),
+				},
+				Type:       notification.LevelType,
+				TelegramID: unlocked[i].TelegramID,
+			})
+		}
+
+		for j := range lu.rewards {
+			dto = append(dto, notification.CreateDTO{
+				Message: notification.Message{
+					Title: "Уведомление",
+					Text:  lu.rewards[j].NotificationText(),
+				},
+				Type:       notification.LevelType,
+				TelegramID: unlocked[i].TelegramID,
+			})
+		}
+	}
+
+	if len(dto) == 0 {
+		return nil, nil
+	}
+
+	// create notifications.
+	return s.notificationRepository.CreateNotifications.Execute(ctx, tx, dto)
+}
+
+// sendNotifications send notifications to users that are online.
+func (s *ProcessChunk) sendNotifications(ctx context.Context, notifications []notification.Notification) {
+	for i := range notifications {
+		// check exists user is online for send notification with message broker.
+		isUserPresence, err := s.redis.UserPresence.Exists(ctx, notifications[i].TelegramID)
+		if err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to check user presence: %v", err))
+			continue
+		}
+
+		if !isUserPresence {
+			continue
+		}
+
+		data := notification.SendNotificationDTO{
+			ID:         notifications[i].ID,
+			Message:    notifications[i].Message,
+			Type:       notifications[i].Type,
+			TelegramID: notifications[i].TelegramID,
+			CreatedAt:  notifications[i].CreatedAt,
+		}
+
+		// send notification in rabbitmq.
+		if err := s.rabbitMQ.Notification.Publisher.Execute(ctx, data.TelegramID, data); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to publish notification by rabbitmq: %v", err))
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package achievementevaluation
+
+import (
+	achievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement"
+	achievementevaluationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_evaluation"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation/all"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation/create"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation/get_by_id"
+	processchunk "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_evaluation/process_chunk"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/rabbitmq"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+)
+
+type Service struct {
+	All          all.IAll
+	Create       create.ICreate
+	GetByID      getbyid.IGetByID
+	ProcessChunk processchunk.IProcessChunk
+}
+
+func New(
+	achievementEvaluationRepository *achievementevaluationrepository.Repository,
+	achievementRepository *achievementrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	levelRepository *levelrepository.Repository,
+	notificationRepository *notificationrepository.Repository,
+	logger logger.ILogger,
+	rabbitMQ *rabbitmq.RabbitMQ,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Service {
+	return &Service{
+		All:     all.New(achievementEvaluationRepository, logger, postgres),
+		Create:  create.New(achievementEvaluationRepository, achievementRepository, logger, postgres),
+		GetByID: getbyid.New(achievementEvaluationRepository, logger, postgres),
+		ProcessChunk: processchunk.New(
+			achievementEvaluationRepository,
+			eventTypeRepository,
+			internalCurrencyRepository,
+			levelRepository,
+			notificationRepository,
+			logger,
+			rabbitMQ,
+			postgres,
+			redis,
+		),
+	}
+}
@@ -0,0 +1,73 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]achievementtype.AchievementType, error)
+}
+
+type All struct {
+	achievementTypeRepository *achievementtyperepository.Repository
+	logger                    logger.ILogger
+	postgres                  *postgres.Postgres
+}
+
+func New(
+	achievementTypeRepository *achievementtyperepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		achievementTypeRepository: achievementTypeRepository,
+		logger:                    logger,
+		postgres:                  postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]achievementtype.AchievementType, error) {
+	s.logger.Debug("[get all achievement types] execute service")
+
+	var (
+		err    error
+		result []achievementtype.AchievementType
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all achievement types.
+	result, err = s.achievementTypeRepository.All.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,86 @@
+package allversionsbyid
+
+import (
+	"context"
+	"log"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllVersionsByID --output=mocks --case=underscore
+type IAllVersionsByID interface {
+	Execute(ctx context.Context, id int64) ([]achievementtype.Version, error)
+}
+
+type AllVersionsByID struct {
+	achievementTypeRepository *achievementtyperepository.Repository
+	logger                    logger.ILogger
+	postgres                  *postgres.Postgres
+}
+
+func New(
+	achievementTypeRepository *achievementtyperepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllVersionsByID {
+	return &AllVersionsByID{
+		achievementTypeRepository: achievementTypeRepository,
+		logger:                    logger,
+		postgres:                  postgres,
+	}
+}
+
+func (s *AllVersionsByID) Execute(ctx context.Context, id int64) ([]achievementtype.Version, error) {
+	s.logger.Debug("[get all achievement type versions by id] execute service")
+
+	var (
+		err                   error
+		result                []achievementtype.Version
+		achievementTypeExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check achievement type exists by id.
+	achievementTypeExists, err = s.achievementTypeRepository.ExistsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !achievementTypeExists { // if achievement type does not exist.
+		err = apperrors.ErrAchievementTypeDoesNotExist
+		return nil, err
+	}
+
+	// get all criteria versions of achievement type.
+	result, err = s.achievementTypeRepository.AllVersionsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
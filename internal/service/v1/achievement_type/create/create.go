@@ -0,0 +1,91 @@
+package create
+
+import (
+	"context"
+	"log"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, dto achievementtype.CreateDTO) (achievementtype.AchievementType, error)
+}
+
+type Create struct {
+	achievementTypeRepository *achievementtyperepository.Repository
+	logger                    logger.ILogger
+	postgres                  *postgres.Postgres
+}
+
+func New(
+	achievementTypeRepository *achievementtyperepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Create {
+	return &Create{
+		achievementTypeRepository: achievementTypeRepository,
+		logger:                    logger,
+		postgres:                  postgres,
+	}
+}
+
+func (s *Create) Execute(ctx context.Context, dto achievementtype.CreateDTO) (achievementtype.AchievementType, error) {
+	s.logger.Debug("[create achievement type] execute service")
+
+	var (
+		err                   error
+		result                achievementtype.AchievementType
+		achievementTypeExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.HasCriteria() { // if achievement type has no criteria.
+		err = apperrors.ErrAchievementTypeCriteriaIsEmpty
+		return achievementtype.AchievementType{}, err
+	}
+
+	// check achievement type exists by name.
+	achievementTypeExists, err = s.achievementTypeRepository.ExistsByName.Execute(ctx, tx, dto.Name)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	if achievementTypeExists { // if achievement type already exists.
+		err = apperrors.ErrAchievementTypeAlreadyExists
+		return achievementtype.AchievementType{}, err
+	}
+
+	// create achievement type with first criteria version.
+	result, err = s.achievementTypeRepository.Create.Execute(ctx, tx, dto)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package deletebyid
+
+import (
+	"context"
+	"log"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteByID --output=mocks --case=underscore
+type IDeleteByID interface {
+	Execute(ctx context.Context, id int64) (achievementtype.AchievementType, error)
+}
+
+type DeleteByID struct {
+	achievementTypeRepository *achievementtyperepository.Repository
+	logger                    logger.ILogger
+	postgres                  *postgres.Postgres
+}
+
+func New(
+	achievementTypeRepository *achievementtyperepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *DeleteByID {
+	return &DeleteByID{
+		achievementTypeRepository: achievementTypeRepository,
+		logger:                    logger,
+		postgres:                  postgres,
+	}
+}
+
+func (s *DeleteByID) Execute(ctx context.Context, id int64) (achievementtype.AchievementType, error) {
+	s.logger.Debug("[delete achievement type by id] execute service")
+
+	var (
+		err                   error
+		result                achievementtype.AchievementType
+		achievementTypeExists bool
+		inUse                 bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check achievement type exists by id.
+	achievementTypeExists, err = s.achievementTypeRepository.ExistsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	if !achievementTypeExists { // if achievement type does not exist.
+		err = apperrors.ErrAchievementTypeDoesNotExist
+		return achievementtype.AchievementType{}, err
+	}
+
+	// check achievement type in use by id.
+	inUse, err = s.achievementTypeRepository.ExistsInUseByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	if inUse { // if achievement type is used by achievement.
+		err = apperrors.ErrAchievementTypeInUse
+		return achievementtype.AchievementType{}, err
+	}
+
+	// delete achievement type by id (criteria versions are deleted by cascade).
+	result, err = s.achievementTypeRepository.DeleteByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	return result, nil
+}
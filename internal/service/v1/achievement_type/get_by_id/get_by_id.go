@@ -0,0 +1,86 @@
+package getbyid
+
+import (
+	"context"
+	"log"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByID --output=mocks --case=underscore
+type IGetByID interface {
+	Execute(ctx context.Context, id int64) (achievementtype.AchievementType, error)
+}
+
+type GetByID struct {
+	achievementTypeRepository *achievementtyperepository.Repository
+	logger                    logger.ILogger
+	postgres                  *postgres.Postgres
+}
+
+func New(
+	achievementTypeRepository *achievementtyperepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetByID {
+	return &GetByID{
+		achievementTypeRepository: achievementTypeRepository,
+		logger:                    logger,
+		postgres:                  postgres,
+	}
+}
+
+func (s *GetByID) Execute(ctx context.Context, id int64) (achievementtype.AchievementType, error) {
+	s.logger.Debug("[get achievement type by id] execute service")
+
+	var (
+		err                   error
+		result                achievementtype.AchievementType
+		achievementTypeExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check achievement type exists by id.
+	achievementTypeExists, err = s.achievementTypeRepository.ExistsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	if !achievementTypeExists { // if achievement type does not exist.
+		err = apperrors.ErrAchievementTypeDoesNotExist
+		return achievementtype.AchievementType{}, err
+	}
+
+	// get achievement type by id.
+	result, err = s.achievementTypeRepository.GetByID.Execute(ctx, tx, id)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package achievementtype
+
+import (
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type/all"
+	allversionsbyid "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type/all_versions_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type/create"
+	deletebyid "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type/delete_by_id"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type/get_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/achievement_type/update"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	All             all.IAll
+	AllVersionsByID allversionsbyid.IAllVersionsByID
+	Create          create.ICreate
+	DeleteByID      deletebyid.IDeleteByID
+	GetByID         getbyid.IGetByID
+	Update          update.IUpdate
+}
+
+func New(
+	achievementTypeRepository *achievementtyperepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		All:             all.New(achievementTypeRepository, logger, postgres),
+		AllVersionsByID: allversionsbyid.New(achievementTypeRepository, logger, postgres),
+		Create:          create.New(achievementTypeRepository, logger, postgres),
+		DeleteByID:      deletebyid.New(achievementTypeRepository, logger, postgres),
+		GetByID:         getbyid.New(achievementTypeRepository, logger, postgres),
+		Update:          update.New(achievementTypeRepository, logger, postgres),
+	}
+}
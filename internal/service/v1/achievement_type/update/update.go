@@ -0,0 +1,112 @@
+package update
+
+import (
+	"context"
+	"log"
+
+	achievementtype "github.com/go-jedi/lingramm_backend/internal/domain/achievement_type"
+	achievementtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/achievement_type"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, dto achievementtype.UpdateDTO) (achievementtype.AchievementType, error)
+}
+
+type Update struct {
+	achievementTypeRepository *achievementtyperepository.Repository
+	logger                    logger.ILogger
+	postgres                  *postgres.Postgres
+}
+
+func New(
+	achievementTypeRepository *achievementtyperepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Update {
+	return &Update{
+		achievementTypeRepository: achievementTypeRepository,
+		logger:                    logger,
+		postgres:                  postgres,
+	}
+}
+
+func (s *Update) Execute(ctx context.Context, dto achievementtype.UpdateDTO) (achievementtype.AchievementType, error) {
+	s.logger.Debug("[update achievement type] execute service")
+
+	var (
+		err                   error
+		result                achievementtype.AchievementType
+		current               achievementtype.AchievementType
+		achievementTypeExists bool
+		nameExists            bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.HasCriteria() { // if achievement type has no criteria.
+		err = apperrors.ErrAchievementTypeCriteriaIsEmpty
+		return achievementtype.AchievementType{}, err
+	}
+
+	// check achievement type exists by id.
+	achievementTypeExists, err = s.achievementTypeRepository.ExistsByID.Execute(ctx, tx, dto.ID)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	if !achievementTypeExists { // if achievement type does not exist.
+		err = apperrors.ErrAchievementTypeDoesNotExist
+		return achievementtype.AchievementType{}, err
+	}
+
+	// get current achievement type by id.
+	current, err = s.achievementTypeRepository.GetByID.Execute(ctx, tx, dto.ID)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	if current.Name != dto.Name { // if achievement type is renamed.
+		// check achievement type exists by name.
+		nameExists, err = s.achievementTypeRepository.ExistsByName.Execute(ctx, tx, dto.Name)
+		if err != nil {
+			return achievementtype.AchievementType{}, err
+		}
+
+		if nameExists { // if achievement type already exists.
+			err = apperrors.ErrAchievementTypeAlreadyExists
+			return achievementtype.AchievementType{}, err
+		}
+	}
+
+	// update achievement type (bumps criteria version if criteria changed).
+	result, err = s.achievementTypeRepository.Update.Execute(ctx, tx, dto)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return achievementtype.AchievementType{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// allLimit is how many of the latest jobs are returned.
+const allLimit = 100
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]aggregaterebuild.Job, error)
+}
+
+type All struct {
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository
+	userRepository             *userrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		aggregateRebuildRepository: aggregateRebuildRepository,
+		userRepository:             userRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]aggregaterebuild.Job, error) {
+	s.logger.Debug("[get all aggregate rebuild jobs] execute service")
+
+	var (
+		err    error
+		result []aggregaterebuild.Job
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all aggregate rebuild jobs.
+	result, err = s.aggregateRebuildRepository.All.Execute(ctx, tx, allLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,97 @@
+package create
+
+import (
+	"context"
+	"log"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, dto aggregaterebuild.CreateDTO) (aggregaterebuild.Job, error)
+}
+
+type Create struct {
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository
+	userRepository             *userrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Create {
+	return &Create{
+		aggregateRebuildRepository: aggregateRebuildRepository,
+		userRepository:             userRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+func (s *Create) Execute(ctx context.Context, dto aggregaterebuild.CreateDTO) (aggregaterebuild.Job, error) {
+	s.logger.Debug("[create aggregate rebuild job] execute service")
+
+	var (
+		err        error
+		result     aggregaterebuild.Job
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return aggregaterebuild.Job{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if dto.Scope == aggregaterebuild.ScopeUser { // if rebuild only one user.
+		// check user exists by telegram id.
+		userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, *dto.TelegramID)
+		if err != nil {
+			return aggregaterebuild.Job{}, err
+		}
+
+		if !userExists { // if user does not exist.
+			err = apperrors.ErrUserDoesNotExist
+			return aggregaterebuild.Job{}, err
+		}
+	}
+
+	if dto.Scope == aggregaterebuild.ScopeWeekRange && *dto.WeekFrom > *dto.WeekTo { // dates are in YYYY-MM-DD format.
+		err = apperrors.ErrAggregateRebuildInvalidWeekRange
+		return aggregaterebuild.Job{}, err
+	}
+
+	// create aggregate rebuild job.
+	result, err = s.aggregateRebuildRepository.Create.Execute(ctx, tx, dto)
+	if err != nil {
+		return aggregaterebuild.Job{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return aggregaterebuild.Job{}, err
+	}
+
+	return result, nil
+}
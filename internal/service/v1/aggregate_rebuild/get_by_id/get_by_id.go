@@ -0,0 +1,90 @@
+package getbyid
+
+import (
+	"context"
+	"log"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByID --output=mocks --case=underscore
+type IGetByID interface {
+	Execute(ctx context.Context, id int64) (aggregaterebuild.Job, error)
+}
+
+type GetByID struct {
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository
+	userRepository             *userrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetByID {
+	return &GetByID{
+		aggregateRebuildRepository: aggregateRebuildRepository,
+		userRepository:             userRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+func (s *GetByID) Execute(ctx context.Context, id int64) (aggregaterebuild.Job, error) {
+	s.logger.Debug("[get aggregate rebuild job by id] execute service")
+
+	var (
+		err       error
+		result    aggregaterebuild.Job
+		jobExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return aggregaterebuild.Job{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check aggregate rebuild job exists by id.
+	jobExists, err = s.aggregateRebuildRepository.ExistsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return aggregaterebuild.Job{}, err
+	}
+
+	if !jobExists { // if aggregate rebuild job does not exist.
+		err = apperrors.ErrAggregateRebuildJobDoesNotExist
+		return aggregaterebuild.Job{}, err
+	}
+
+	// get aggregate rebuild job by id.
+	result, err = s.aggregateRebuildRepository.GetByID.Execute(ctx, tx, id)
+	if err != nil {
+		return aggregaterebuild.Job{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return aggregaterebuild.Job{}, err
+	}
+
+	return result, nil
+}
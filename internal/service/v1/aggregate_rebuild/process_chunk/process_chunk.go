@@ -0,0 +1,77 @@
+package processchunk
+
+import (
+	"context"
+	"log"
+
+	aggregaterebuild "github.com/go-jedi/lingramm_backend/internal/domain/aggregate_rebuild"
+	aggregaterebuildrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IProcessChunk --output=mocks --case=underscore
+type IProcessChunk interface {
+	Execute(ctx context.Context, dto aggregaterebuild.ProcessChunkDTO) (*aggregaterebuild.Job, error)
+}
+
+type ProcessChunk struct {
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository
+	userRepository             *userrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *ProcessChunk {
+	return &ProcessChunk{
+		aggregateRebuildRepository: aggregateRebuildRepository,
+		userRepository:             userRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+func (s *ProcessChunk) Execute(ctx context.Context, dto aggregaterebuild.ProcessChunkDTO) (*aggregaterebuild.Job, error) {
+	s.logger.Debug("[process aggregate rebuild job chunk] execute service")
+
+	var (
+		err    error
+		result *aggregaterebuild.Job
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// process aggregate rebuild job chunk.
+	result, err = s.aggregateRebuildRepository.ProcessChunk.Execute(ctx, tx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,33 @@
+package aggregaterebuild
+
+import (
+	aggregaterebuildrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/aggregate_rebuild"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild/all"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild/create"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild/get_by_id"
+	processchunk "github.com/go-jedi/lingramm_backend/internal/service/v1/aggregate_rebuild/process_chunk"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	All          all.IAll
+	Create       create.ICreate
+	GetByID      getbyid.IGetByID
+	ProcessChunk processchunk.IProcessChunk
+}
+
+func New(
+	aggregateRebuildRepository *aggregaterebuildrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		All:          all.New(aggregateRebuildRepository, userRepository, logger, postgres),
+		Create:       create.New(aggregateRebuildRepository, userRepository, logger, postgres),
+		GetByID:      getbyid.New(aggregateRebuildRepository, userRepository, logger, postgres),
+		ProcessChunk: processchunk.New(aggregateRebuildRepository, userRepository, logger, postgres),
+	}
+}
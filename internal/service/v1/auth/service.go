@@ -2,6 +2,7 @@ package auth
 
 import (
 	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
 	"github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	userdailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task"
 	"github.com/go-jedi/lingramm_backend/internal/service/v1/auth/check"
@@ -24,6 +25,7 @@ func New(
 	userRepository *user.Repository,
 	levelRepository *levelrepository.Repository,
 	userDailyTaskRepository *userdailytaskrepository.Repository,
+	referralRepository *referralrepository.Repository,
 	logger logger.ILogger,
 	postgres *postgres.Postgres,
 	redis *redis.Redis,
@@ -33,6 +35,6 @@ func New(
 	return &Service{
 		Check:   check.New(userRepository, logger, postgres, bigCache, jwt),
 		Refresh: refresh.New(userRepository, logger, postgres, redis, bigCache, jwt),
-		SignIn:  signin.New(userRepository, levelRepository, userDailyTaskRepository, logger, postgres, redis, bigCache, jwt),
+		SignIn:  signin.New(userRepository, levelRepository, userDailyTaskRepository, referralRepository, logger, postgres, redis, bigCache, jwt),
 	}
 }
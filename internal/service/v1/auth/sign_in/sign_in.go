@@ -8,8 +8,10 @@ import (
 
 	"github.com/go-jedi/lingramm_backend/internal/domain/auth"
 	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
 	"github.com/go-jedi/lingramm_backend/internal/domain/user"
 	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	userdailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task"
 	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
@@ -29,6 +31,7 @@ type SignIn struct {
 	userRepository          *userrepository.Repository
 	levelRepository         *levelrepository.Repository
 	userDailyTaskRepository *userdailytaskrepository.Repository
+	referralRepository      *referralrepository.Repository
 	logger                  logger.ILogger
 	postgres                *postgres.Postgres
 	redis                   *redis.Redis
@@ -40,6 +43,7 @@ func New(
 	userRepository *userrepository.Repository,
 	levelRepository *levelrepository.Repository,
 	userDailyTaskRepository *userdailytaskrepository.Repository,
+	referralRepository *referralrepository.Repository,
 	logger logger.ILogger,
 	postgres *postgres.Postgres,
 	redis *redis.Redis,
@@ -50,6 +54,7 @@ func New(
 		userRepository:          userRepository,
 		levelRepository:         levelRepository,
 		userDailyTaskRepository: userDailyTaskRepository,
+		referralRepository:      referralRepository,
 		logger:                  logger,
 		postgres:                postgres,
 		redis:                   redis,
@@ -88,7 +93,7 @@ func (s *SignIn) Execute(ctx context.Context, dto auth.SignInDTO) (auth.SignInRe
 	}
 
 	if ie {
-		u, err = s.getUserAndGenerateTokens(ctx, tx, dto.TelegramID)
+		u, err = s.getUserAndGenerateTokens(ctx, tx, dto.TelegramID, dto.Timezone)
 	} else {
 		u, err = s.createUser(ctx, tx, dto)
 	}
@@ -136,6 +141,7 @@ func (s *SignIn) createUser(ctx context.Context, tx pgx.Tx, dto auth.SignInDTO)
 		Username:   dto.Username,
 		FirstName:  dto.FirstName,
 		LastName:   dto.LastName,
+		Timezone:   dto.Timezone,
 	}
 
 	// create new user in the database.
@@ -154,6 +160,13 @@ func (s *SignIn) createUser(ctx context.Context, tx pgx.Tx, dto auth.SignInDTO)
 		return auth.SignInResp{}, err
 	}
 
+	// attribute new user to the referrer if the Mini App was opened by referral link.
+	if code, ok := referral.CodeFromStartParam(dto.StartParam); ok {
+		if err := s.attributeReferral(ctx, tx, nu.TelegramID, code); err != nil {
+			return auth.SignInResp{}, err
+		}
+	}
+
 	// generate access, refresh tokens.
 	tokens, err := s.jwt.Generate(nu.TelegramID)
 	if err != nil {
@@ -178,6 +191,21 @@ func (s *SignIn) createUser(ctx context.Context, tx pgx.Tx, dto auth.SignInDTO)
 	}, nil
 }
 
+// attributeReferral attributes new user to the owner of referral code.
+// Invalid code, self-referral or banned referrer do not prevent sign in, the user is just not attributed.
+func (s *SignIn) attributeReferral(ctx context.Context, tx pgx.Tx, telegramID string, code string) error {
+	result, err := s.referralRepository.Attribute.Execute(ctx, tx, telegramID, code)
+	if err != nil {
+		return err
+	}
+
+	if result.Status != referral.AttributeStatusAttributed {
+		s.logger.Warn("referral is not attributed", "telegram_id", telegramID, "code", code, "status", result.Status)
+	}
+
+	return nil
+}
+
 // createUserLevelHistory create user level history (level 1).
 func (s *SignIn) createUserLevelHistory(ctx context.Context, tx pgx.Tx, telegramID string) error {
 	const (
@@ -200,13 +228,19 @@ func (s *SignIn) createUserLevelHistory(ctx context.Context, tx pgx.Tx, telegram
 
 // getUserAndGenerateTokens get user from cache or database.
 // If the user is found to generate tokens.
-func (s *SignIn) getUserAndGenerateTokens(ctx context.Context, tx pgx.Tx, telegramID string) (auth.SignInResp, error) {
+func (s *SignIn) getUserAndGenerateTokens(ctx context.Context, tx pgx.Tx, telegramID string, timezone string) (auth.SignInResp, error) {
 	// get user from cache or database.
 	u, err := s.findOrReturnExisting(ctx, tx, telegramID)
 	if err != nil {
 		return auth.SignInResp{}, err
 	}
 
+	// sync timezone detected by the Mini App (timezone chosen by the user is kept).
+	u, err = s.syncTimezone(ctx, tx, u, timezone)
+	if err != nil {
+		return auth.SignInResp{}, err
+	}
+
 	// generate access, refresh tokens.
 	tokens, err := s.jwt.Generate(u.TelegramID)
 	if err != nil {
@@ -231,6 +265,21 @@ func (s *SignIn) getUserAndGenerateTokens(ctx context.Context, tx pgx.Tx, telegr
 	}, nil
 }
 
+// syncTimezone updates the user timezone with the one detected at sign-in,
+// unless nothing was detected, it did not change or the user has chosen the timezone manually.
+// Cached user may be stale, so timezone chosen by the user is also kept by the repository.
+func (s *SignIn) syncTimezone(ctx context.Context, tx pgx.Tx, u user.User, timezone string) (user.User, error) {
+	if timezone == "" || u.IsTimezoneManual || u.Timezone == timezone {
+		return u, nil
+	}
+
+	return s.userRepository.UpdateTimezone.Execute(ctx, tx, user.UpdateTimezoneDTO{
+		TelegramID: u.TelegramID,
+		Timezone:   timezone,
+		IsManual:   false,
+	})
+}
+
 // findOrReturnExisting attempts to retrieve a user from the cache by Telegram ID.
 // If the user is found in the cache and the data is valid, it returns the cached user.
 // Otherwise, it queries the database to retrieve the user by Telegram ID.
@@ -0,0 +1,73 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]currencyrate.CurrencyRate, error)
+}
+
+type All struct {
+	currencyRateRepository *currencyraterepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+}
+
+func New(
+	currencyRateRepository *currencyraterepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		currencyRateRepository: currencyRateRepository,
+		logger:                 logger,
+		postgres:               postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]currencyrate.CurrencyRate, error) {
+	s.logger.Debug("[get all currency rates] execute service")
+
+	var (
+		err    error
+		result []currencyrate.CurrencyRate
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all currency rates.
+	result, err = s.currencyRateRepository.All.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
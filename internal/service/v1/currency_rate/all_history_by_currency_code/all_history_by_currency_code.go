@@ -0,0 +1,73 @@
+package allhistorybycurrencycode
+
+import (
+	"context"
+	"log"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllHistoryByCurrencyCode --output=mocks --case=underscore
+type IAllHistoryByCurrencyCode interface {
+	Execute(ctx context.Context, currencyCode string) ([]currencyrate.History, error)
+}
+
+type AllHistoryByCurrencyCode struct {
+	currencyRateRepository *currencyraterepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+}
+
+func New(
+	currencyRateRepository *currencyraterepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllHistoryByCurrencyCode {
+	return &AllHistoryByCurrencyCode{
+		currencyRateRepository: currencyRateRepository,
+		logger:                 logger,
+		postgres:               postgres,
+	}
+}
+
+func (s *AllHistoryByCurrencyCode) Execute(ctx context.Context, currencyCode string) ([]currencyrate.History, error) {
+	s.logger.Debug("[get all currency rate history by currency code] execute service")
+
+	var (
+		err    error
+		result []currencyrate.History
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all currency rate history by currency code (history is kept after the rate is deleted).
+	result, err = s.currencyRateRepository.AllHistoryByCurrencyCode.Execute(ctx, tx, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
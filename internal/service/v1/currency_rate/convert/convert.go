@@ -0,0 +1,137 @@
+package convert
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IConvert --output=mocks --case=underscore
+type IConvert interface {
+	Execute(ctx context.Context, dto currencyrate.ConvertDTO) (currencyrate.ConvertResponse, error)
+}
+
+type Convert struct {
+	currencyRateRepository *currencyraterepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+	bigCache               *bigcachepkg.BigCache
+}
+
+func New(
+	currencyRateRepository *currencyraterepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	bigCache *bigcachepkg.BigCache,
+) *Convert {
+	return &Convert{
+		currencyRateRepository: currencyRateRepository,
+		logger:                 logger,
+		postgres:               postgres,
+		bigCache:               bigCache,
+	}
+}
+
+// Execute converts amount between internal currency and the currency.
+// Amount in the currency is rounded half away from zero, internal amount is rounded up.
+func (s *Convert) Execute(ctx context.Context, dto currencyrate.ConvertDTO) (currencyrate.ConvertResponse, error) {
+	s.logger.Debug("[convert amount] execute service")
+
+	var (
+		err          error
+		result       currencyrate.ConvertResponse
+		currencyRate currencyrate.CurrencyRate
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return currencyrate.ConvertResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.Amount.IsPositive() { // if amount is not positive.
+		err = apperrors.ErrConvertAmountMustBePositive
+		return currencyrate.ConvertResponse{}, err
+	}
+
+	// get currency rate by currency code from cache or database.
+	currencyRate, err = s.getCurrencyRateFromCacheOrDatabase(ctx, tx, dto.CurrencyCode)
+	if err != nil {
+		return currencyrate.ConvertResponse{}, err
+	}
+
+	result = currencyrate.ConvertResponse{
+		CurrencyCode: currencyRate.CurrencyCode,
+		Rate:         currencyRate.Rate,
+		Direction:    dto.Direction,
+		Amount:       dto.Amount,
+	}
+
+	switch dto.Direction {
+	case currencyrate.DirectionToFiat:
+		result.Result, err = currencyRate.ToFiat(dto.Amount)
+	case currencyrate.DirectionToInternal:
+		result.Result, err = currencyRate.ToInternal(dto.Amount)
+	}
+	if err != nil {
+		return currencyrate.ConvertResponse{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return currencyrate.ConvertResponse{}, err
+	}
+
+	return result, nil
+}
+
+// getCurrencyRateFromCacheOrDatabase get currency rate by currency code from cache or database.
+func (s *Convert) getCurrencyRateFromCacheOrDatabase(ctx context.Context, tx pgx.Tx, currencyCode string) (currencyrate.CurrencyRate, error) {
+	// Get currency rate by currency code from cache.
+	// If found and no error occurred, return data.
+	dataFromCache, err := s.bigCache.CurrencyRate.Get(currencyCode)
+	if err == nil {
+		return dataFromCache, nil
+	}
+
+	// check currency rate exists by currency code.
+	ie, err := s.currencyRateRepository.ExistsByCurrencyCode.Execute(ctx, tx, currencyCode)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	if !ie { // if currency rate does not exist.
+		return currencyrate.CurrencyRate{}, apperrors.ErrCurrencyRateDoesNotExist
+	}
+
+	// get currency rate by currency code from db.
+	dataFromDB, err := s.currencyRateRepository.GetByCurrencyCode.Execute(ctx, tx, currencyCode)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// set currency rate by currency code in cache.
+	if err := s.bigCache.CurrencyRate.Set(currencyCode, dataFromDB); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to set currency rate to cache for currency_code=%s: %v", currencyCode, err))
+	}
+
+	return dataFromDB, nil
+}
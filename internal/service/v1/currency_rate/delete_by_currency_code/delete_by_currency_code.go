@@ -0,0 +1,109 @@
+package deletebycurrencycode
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteByCurrencyCode --output=mocks --case=underscore
+type IDeleteByCurrencyCode interface {
+	Execute(ctx context.Context, dto currencyrate.DeleteByCurrencyCodeDTO) (currencyrate.CurrencyRate, error)
+}
+
+type DeleteByCurrencyCode struct {
+	currencyRateRepository *currencyraterepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+	bigCache               *bigcachepkg.BigCache
+}
+
+func New(
+	currencyRateRepository *currencyraterepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	bigCache *bigcachepkg.BigCache,
+) *DeleteByCurrencyCode {
+	return &DeleteByCurrencyCode{
+		currencyRateRepository: currencyRateRepository,
+		logger:                 logger,
+		postgres:               postgres,
+		bigCache:               bigCache,
+	}
+}
+
+// Execute deletes currency rate and records the change in currency rate history.
+func (s *DeleteByCurrencyCode) Execute(ctx context.Context, dto currencyrate.DeleteByCurrencyCodeDTO) (currencyrate.CurrencyRate, error) {
+	s.logger.Debug("[delete currency rate by currency code] execute service")
+
+	var (
+		err                error
+		result             currencyrate.CurrencyRate
+		currencyRateExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check currency rate exists by currency code.
+	currencyRateExists, err = s.currencyRateRepository.ExistsByCurrencyCode.Execute(ctx, tx, dto.CurrencyCode)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	if !currencyRateExists { // if currency rate does not exist.
+		err = apperrors.ErrCurrencyRateDoesNotExist
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// delete currency rate by currency code.
+	result, err = s.currencyRateRepository.DeleteByCurrencyCode.Execute(ctx, tx, dto.CurrencyCode)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// create currency rate history.
+	err = s.currencyRateRepository.CreateHistory.Execute(ctx, tx, currencyrate.CreateHistoryDTO{
+		CurrencyCode: dto.CurrencyCode,
+		Action:       currencyrate.ActionDelete,
+		OldRate:      &result.Rate,
+		NewRate:      nil,
+		ChangedBy:    dto.ChangedBy,
+	})
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// currency rate changed, so cached rate is outdated.
+	if err := s.bigCache.CurrencyRate.Delete(dto.CurrencyCode); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to delete currency rate from cache for currency_code=%s: %v", dto.CurrencyCode, err))
+	}
+
+	return result, nil
+}
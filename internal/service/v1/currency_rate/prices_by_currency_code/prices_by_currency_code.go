@@ -0,0 +1,146 @@
+package pricesbycurrencycode
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+//go:generate mockery --name=IPricesByCurrencyCode --output=mocks --case=underscore
+type IPricesByCurrencyCode interface {
+	Execute(ctx context.Context, currencyCode string) ([]currencyrate.OfferPrice, error)
+}
+
+type PricesByCurrencyCode struct {
+	currencyRateRepository *currencyraterepository.Repository
+	shopRepository         *shoprepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+	bigCache               *bigcachepkg.BigCache
+}
+
+func New(
+	currencyRateRepository *currencyraterepository.Repository,
+	shopRepository *shoprepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	bigCache *bigcachepkg.BigCache,
+) *PricesByCurrencyCode {
+	return &PricesByCurrencyCode{
+		currencyRateRepository: currencyRateRepository,
+		shopRepository:         shopRepository,
+		logger:                 logger,
+		postgres:               postgres,
+		bigCache:               bigCache,
+	}
+}
+
+// Execute returns available shop items priced in internal currency and in the currency.
+func (s *PricesByCurrencyCode) Execute(ctx context.Context, currencyCode string) ([]currencyrate.OfferPrice, error) {
+	s.logger.Debug("[get prices by currency code] execute service")
+
+	var (
+		err          error
+		result       []currencyrate.OfferPrice
+		currencyRate currencyrate.CurrencyRate
+		shopItems    []shop.ShopItem
+		fiatPrice    decimal.Decimal
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get currency rate by currency code from cache or database.
+	currencyRate, err = s.getCurrencyRateFromCacheOrDatabase(ctx, tx, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// get all available shop items.
+	shopItems, err = s.shopRepository.AllAvailable.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]currencyrate.OfferPrice, 0, len(shopItems))
+	for i := range shopItems {
+		fiatPrice, err = currencyRate.ToFiat(shopItems[i].Price)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, currencyrate.OfferPrice{
+			ShopItemID:   shopItems[i].ID,
+			Name:         shopItems[i].Name,
+			Type:         shopItems[i].Type,
+			Value:        shopItems[i].Value,
+			Price:        shopItems[i].Price,
+			FiatPrice:    fiatPrice,
+			CurrencyCode: currencyRate.CurrencyCode,
+		})
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getCurrencyRateFromCacheOrDatabase get currency rate by currency code from cache or database.
+func (s *PricesByCurrencyCode) getCurrencyRateFromCacheOrDatabase(ctx context.Context, tx pgx.Tx, currencyCode string) (currencyrate.CurrencyRate, error) {
+	// Get currency rate by currency code from cache.
+	// If found and no error occurred, return data.
+	dataFromCache, err := s.bigCache.CurrencyRate.Get(currencyCode)
+	if err == nil {
+		return dataFromCache, nil
+	}
+
+	// check currency rate exists by currency code.
+	ie, err := s.currencyRateRepository.ExistsByCurrencyCode.Execute(ctx, tx, currencyCode)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	if !ie { // if currency rate does not exist.
+		return currencyrate.CurrencyRate{}, apperrors.ErrCurrencyRateDoesNotExist
+	}
+
+	// get currency rate by currency code from db.
+	dataFromDB, err := s.currencyRateRepository.GetByCurrencyCode.Execute(ctx, tx, currencyCode)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// set currency rate by currency code in cache.
+	if err := s.bigCache.CurrencyRate.Set(currencyCode, dataFromDB); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to set currency rate to cache for currency_code=%s: %v", currencyCode, err))
+	}
+
+	return dataFromDB, nil
+}
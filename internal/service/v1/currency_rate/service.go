@@ -0,0 +1,44 @@
+package currencyrate
+
+import (
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate/all"
+	allhistorybycurrencycode "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate/all_history_by_currency_code"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate/convert"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate/create"
+	deletebycurrencycode "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate/delete_by_currency_code"
+	pricesbycurrencycode "github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate/prices_by_currency_code"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/currency_rate/update"
+	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	All                      all.IAll
+	AllHistoryByCurrencyCode allhistorybycurrencycode.IAllHistoryByCurrencyCode
+	Convert                  convert.IConvert
+	Create                   create.ICreate
+	DeleteByCurrencyCode     deletebycurrencycode.IDeleteByCurrencyCode
+	PricesByCurrencyCode     pricesbycurrencycode.IPricesByCurrencyCode
+	Update                   update.IUpdate
+}
+
+func New(
+	currencyRateRepository *currencyraterepository.Repository,
+	shopRepository *shoprepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	bigCache *bigcachepkg.BigCache,
+) *Service {
+	return &Service{
+		All:                      all.New(currencyRateRepository, logger, postgres),
+		AllHistoryByCurrencyCode: allhistorybycurrencycode.New(currencyRateRepository, logger, postgres),
+		Convert:                  convert.New(currencyRateRepository, logger, postgres, bigCache),
+		Create:                   create.New(currencyRateRepository, logger, postgres, bigCache),
+		DeleteByCurrencyCode:     deletebycurrencycode.New(currencyRateRepository, logger, postgres, bigCache),
+		PricesByCurrencyCode:     pricesbycurrencycode.New(currencyRateRepository, shopRepository, logger, postgres, bigCache),
+		Update:                   update.New(currencyRateRepository, logger, postgres, bigCache),
+	}
+}
@@ -0,0 +1,121 @@
+package update
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	currencyraterepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_rate"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, dto currencyrate.UpdateDTO) (currencyrate.CurrencyRate, error)
+}
+
+type Update struct {
+	currencyRateRepository *currencyraterepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+	bigCache               *bigcachepkg.BigCache
+}
+
+func New(
+	currencyRateRepository *currencyraterepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	bigCache *bigcachepkg.BigCache,
+) *Update {
+	return &Update{
+		currencyRateRepository: currencyRateRepository,
+		logger:                 logger,
+		postgres:               postgres,
+		bigCache:               bigCache,
+	}
+}
+
+// Execute updates currency rate and records the old and new rate in currency rate history.
+func (s *Update) Execute(ctx context.Context, dto currencyrate.UpdateDTO) (currencyrate.CurrencyRate, error) {
+	s.logger.Debug("[update currency rate] execute service")
+
+	var (
+		err                error
+		result             currencyrate.CurrencyRate
+		currencyRateExists bool
+		oldCurrencyRate    currencyrate.CurrencyRate
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.Rate.IsPositive() { // if rate is not positive.
+		err = apperrors.ErrCurrencyRateMustBePositive
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// check currency rate exists by currency code.
+	currencyRateExists, err = s.currencyRateRepository.ExistsByCurrencyCode.Execute(ctx, tx, dto.CurrencyCode)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	if !currencyRateExists { // if currency rate does not exist.
+		err = apperrors.ErrCurrencyRateDoesNotExist
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// get currency rate by currency code before update.
+	oldCurrencyRate, err = s.currencyRateRepository.GetByCurrencyCode.Execute(ctx, tx, dto.CurrencyCode)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// update currency rate.
+	result, err = s.currencyRateRepository.Update.Execute(ctx, tx, dto)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// create currency rate history.
+	err = s.currencyRateRepository.CreateHistory.Execute(ctx, tx, currencyrate.CreateHistoryDTO{
+		CurrencyCode: dto.CurrencyCode,
+		Action:       currencyrate.ActionUpdate,
+		OldRate:      &oldCurrencyRate.Rate,
+		NewRate:      &result.Rate,
+		ChangedBy:    dto.ChangedBy,
+	})
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	// currency rate changed, so cached rate is outdated.
+	if err := s.bigCache.CurrencyRate.Delete(dto.CurrencyCode); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to delete currency rate from cache for currency_code=%s: %v", dto.CurrencyCode, err))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,268 @@
+package send
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	currencytransfer "github.com/go-jedi/lingramm_backend/internal/domain/currency_transfer"
+	eventtype "github.com/go-jedi/lingramm_backend/internal/domain/event_type"
+	userbalance "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/user_balance"
+	"github.com/go-jedi/lingramm_backend/internal/domain/notification"
+	currencytransferrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_transfer"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/rabbitmq"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ISend --output=mocks --case=underscore
+type ISend interface {
+	Execute(ctx context.Context, dto currencytransfer.SendDTO) (currencytransfer.CurrencyTransfer, error)
+}
+
+type Send struct {
+	currencyTransferRepository *currencytransferrepository.Repository
+	userRepository             *userrepository.Repository
+	eventTypeRepository        *eventtyperepository.Repository
+	internalCurrencyRepository *internalcurrencyrepository.Repository
+	notificationRepository     *notificationrepository.Repository
+	logger                     logger.ILogger
+	rabbitMQ                   *rabbitmq.RabbitMQ
+	postgres                   *postgres.Postgres
+	redis                      *redis.Redis
+}
+
+func New(
+	currencyTransferRepository *currencytransferrepository.Repository,
+	userRepository *userrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	notificationRepository *notificationrepository.Repository,
+	logger logger.ILogger,
+	rabbitMQ *rabbitmq.RabbitMQ,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Send {
+	return &Send{
+		currencyTransferRepository: currencyTransferRepository,
+		userRepository:             userRepository,
+		eventTypeRepository:        eventTypeRepository,
+		internalCurrencyRepository: internalCurrencyRepository,
+		notificationRepository:     notificationRepository,
+		logger:                     logger,
+		rabbitMQ:                   rabbitMQ,
+		postgres:                   postgres,
+		redis:                      redis,
+	}
+}
+
+// Execute transfers internal currency from the sender to the recipient.
+// Sender is debited amount plus fee, recipient is credited amount, both balance transactions reference the transfer.
+func (s *Send) Execute(ctx context.Context, dto currencytransfer.SendDTO) (currencytransfer.CurrencyTransfer, error) {
+	s.logger.Debug("[send currency transfer] execute service")
+
+	var (
+		err                   error
+		result                currencytransfer.CurrencyTransfer
+		userExists            bool
+		sentEventTypeData     eventtype.EventType
+		receivedEventTypeData eventtype.EventType
+		createResult          currencytransfer.CreateResult
+		notifications         []notification.Notification
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.IsAmountValid() { // if amount is not positive or has more than two decimal places.
+		err = apperrors.ErrCurrencyTransferAmountIsInvalid
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.SenderTelegramID)
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	// get currency transfer sent event type data.
+	sentEventTypeData, err = s.eventTypeRepository.GetByName.Execute(ctx, tx, currencytransfer.SentEventType)
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	// get currency transfer received event type data.
+	receivedEventTypeData, err = s.eventTypeRepository.GetByName.Execute(ctx, tx, currencytransfer.ReceivedEventType)
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	// create currency transfer (the database locks balances of both users in telegram id order
+	// and checks bans, account age, daily limits and sender balance).
+	createResult, err = s.currencyTransferRepository.Create.Execute(ctx, tx, dto)
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	switch createResult.Status {
+	case currencytransfer.CreateStatusCreated:
+	case currencytransfer.CreateStatusSelfTransfer:
+		err = apperrors.ErrCurrencyTransferToSelf
+		return currencytransfer.CurrencyTransfer{}, err
+	case currencytransfer.CreateStatusRecipientNotFound:
+		err = apperrors.ErrCurrencyTransferRecipientDoesNotExist
+		return currencytransfer.CurrencyTransfer{}, err
+	case currencytransfer.CreateStatusSenderBanned:
+		err = apperrors.ErrCurrencyTransferSenderBanned
+		return currencytransfer.CurrencyTransfer{}, err
+	case currencytransfer.CreateStatusRecipientBanned:
+		err = apperrors.ErrCurrencyTransferRecipientBanned
+		return currencytransfer.CurrencyTransfer{}, err
+	case currencytransfer.CreateStatusAccountTooYoung:
+		err = apperrors.ErrCurrencyTransferAccountTooYoung
+		return currencytransfer.CurrencyTransfer{}, err
+	case currencytransfer.CreateStatusDailyAmountLimitReached:
+		err = apperrors.ErrCurrencyTransferDailyAmountLimitReached
+		return currencytransfer.CurrencyTransfer{}, err
+	case currencytransfer.CreateStatusDailyCountLimitReached:
+		err = apperrors.ErrCurrencyTransferDailyCountLimitReached
+		return currencytransfer.CurrencyTransfer{}, err
+	case currencytransfer.CreateStatusInsufficientBalance:
+		err = apperrors.ErrCurrencyTransferInsufficientBalance
+		return currencytransfer.CurrencyTransfer{}, err
+	default:
+		err = fmt.Errorf("unexpected currency transfer status: %s", createResult.Status)
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	if createResult.CurrencyTransfer == nil {
+		err = fmt.Errorf("currency transfer result is incomplete: %s", createResult.Status)
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	result = *createResult.CurrencyTransfer
+
+	sourceType := userbalance.SourceTypeCurrencyTransfer
+
+	// reduce sender balance by amount plus fee.
+	_, err = s.internalCurrencyRepository.ReduceUserBalance.Execute(ctx, tx, userbalance.ReduceUserBalanceDTO{
+		EventTypeID: sentEventTypeData.ID,
+		Amount:      result.Total(),
+		TelegramID:  result.SenderTelegramID,
+		Description: result.SentDescription(),
+		SourceType:  &sourceType,
+		SourceID:    &result.ID,
+	})
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	description := result.ReceivedDescription()
+
+	// add amount to recipient balance.
+	_, err = s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+		EventTypeID: receivedEventTypeData.ID,
+		Amount:      result.Amount,
+		TelegramID:  result.RecipientTelegramID,
+		Description: &description,
+		SourceType:  &sourceType,
+		SourceID:    &result.ID,
+	})
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	// create notifications in database.
+	notifications, err = s.createNotifications(ctx, tx, result)
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return currencytransfer.CurrencyTransfer{}, err
+	}
+
+	s.sendNotifications(ctx, notifications)
+
+	return result, nil
+}
+
+// createNotifications create notifications about the transfer for the sender and the recipient.
+func (s *Send) createNotifications(ctx context.Context, tx pgx.Tx, transfer currencytransfer.CurrencyTransfer) ([]notification.Notification, error) {
+	dto := []notification.CreateDTO{
+		{
+			Message: notification.Message{
+				Title: "Уведомление",
+				Text:  transfer.SentNotificationText(),
+			},
+			Type:       notification.CurrencyTransferType,
+			TelegramID: transfer.SenderTelegramID,
+		},
+		{
+			Message: notification.Message{
+				Title: "Уведомление",
+				Text:  transfer.ReceivedNotificationText(),
+			},
+			Type:       notification.CurrencyTransferType,
+			TelegramID: transfer.RecipientTelegramID,
+		},
+	}
+
+	// create notifications.
+	return s.notificationRepository.CreateNotifications.Execute(ctx, tx, dto)
+}
+
+// sendNotifications send notifications to users that are online.
+func (s *Send) sendNotifications(ctx context.Context, notifications []notification.Notification) {
+	for i := range notifications {
+		// check exists user is online for send notification with message broker.
+		isUserPresence, err := s.redis.UserPresence.Exists(ctx, notifications[i].TelegramID)
+		if err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to check user presence: %v", err))
+			continue
+		}
+
+		if !isUserPresence {
+			continue
+		}
+
+		data := notification.SendNotificationDTO{
+			ID:         notifications[i].ID,
+			Message:    notifications[i].Message,
+			Type:       notifications[i].Type,
+			TelegramID: notifications[i].TelegramID,
+			CreatedAt:  notifications[i].CreatedAt,
+		}
+
+		// send notification in rabbitmq.
+		if err := s.rabbitMQ.Notification.Publisher.Execute(ctx, data.TelegramID, data); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to publish notification by rabbitmq: %v", err))
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package currencytransfer
+
+import (
+	currencytransferrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/currency_transfer"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/currency_transfer/send"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/rabbitmq"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+)
+
+type Service struct {
+	Send send.ISend
+}
+
+func New(
+	currencyTransferRepository *currencytransferrepository.Repository,
+	userRepository *userrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	notificationRepository *notificationrepository.Repository,
+	logger logger.ILogger,
+	rabbitMQ *rabbitmq.RabbitMQ,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Service {
+	return &Service{
+		Send: send.New(
+			currencyTransferRepository,
+			userRepository,
+			eventTypeRepository,
+			internalCurrencyRepository,
+			notificationRepository,
+			logger,
+			rabbitMQ,
+			postgres,
+			redis,
+		),
+	}
+}
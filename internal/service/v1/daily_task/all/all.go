@@ -0,0 +1,73 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
+	dailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]dailytask.DailyTask, error)
+}
+
+type All struct {
+	dailyTaskRepository *dailytaskrepository.Repository
+	logger              logger.ILogger
+	postgres            *postgres.Postgres
+}
+
+func New(
+	dailyTaskRepository *dailytaskrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		dailyTaskRepository: dailyTaskRepository,
+		logger:              logger,
+		postgres:            postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]dailytask.DailyTask, error) {
+	s.logger.Debug("[get all daily tasks] execute service")
+
+	var (
+		err    error
+		result []dailytask.DailyTask
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all daily tasks.
+	result, err = s.dailyTaskRepository.All.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
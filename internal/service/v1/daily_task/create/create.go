@@ -6,6 +6,7 @@ import (
 
 	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
 	dailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
 	"github.com/jackc/pgx/v5"
@@ -57,6 +58,11 @@ func (s *Create) Execute(ctx context.Context, dto dailytask.CreateDTO) (dailytas
 		}
 	}()
 
+	if !dto.HasRequirements() { // if daily task has no requirements it would be completed immediately.
+		err = apperrors.ErrDailyTaskRequirementsAreEmpty
+		return dailytask.DailyTask{}, err
+	}
+
 	// create new daily task.
 	result, err = s.dailyTaskRepository.Create.Execute(ctx, tx, dto)
 	if err != nil {
@@ -0,0 +1,98 @@
+package deactivatebyid
+
+import (
+	"context"
+	"log"
+
+	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
+	dailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeactivateByID --output=mocks --case=underscore
+type IDeactivateByID interface {
+	Execute(ctx context.Context, id int64) (dailytask.DailyTask, error)
+}
+
+type DeactivateByID struct {
+	dailyTaskRepository *dailytaskrepository.Repository
+	logger              logger.ILogger
+	postgres            *postgres.Postgres
+}
+
+func New(
+	dailyTaskRepository *dailytaskrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *DeactivateByID {
+	return &DeactivateByID{
+		dailyTaskRepository: dailyTaskRepository,
+		logger:              logger,
+		postgres:            postgres,
+	}
+}
+
+func (s *DeactivateByID) Execute(ctx context.Context, id int64) (dailytask.DailyTask, error) {
+	s.logger.Debug("[deactivate daily task by id] execute service")
+
+	var (
+		err               error
+		result            dailytask.DailyTask
+		dailyTaskExists   bool
+		otherActiveExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check daily task exists by id.
+	dailyTaskExists, err = s.dailyTaskRepository.ExistsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+
+	if !dailyTaskExists { // if daily task does not exist.
+		err = apperrors.ErrDailyTaskDoesNotExist
+		return dailytask.DailyTask{}, err
+	}
+
+	// check other active daily task exists by id.
+	otherActiveExists, err = s.dailyTaskRepository.ExistsOtherActiveByID.Execute(ctx, tx, id)
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+
+	if !otherActiveExists { // if it is the last active daily task, users could not be assigned any.
+		err = apperrors.ErrDailyTaskLastActive
+		return dailytask.DailyTask{}, err
+	}
+
+	// deactivate daily task by id.
+	result, err = s.dailyTaskRepository.DeactivateByID.Execute(ctx, tx, id)
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+
+	return result, nil
+}
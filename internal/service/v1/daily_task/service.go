@@ -2,13 +2,19 @@ package dailytask
 
 import (
 	dailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task/all"
 	"github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task/create"
+	deactivatebyid "github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task/deactivate_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/daily_task/update"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
 )
 
 type Service struct {
-	Create create.ICreate
+	All            all.IAll
+	Create         create.ICreate
+	DeactivateByID deactivatebyid.IDeactivateByID
+	Update         update.IUpdate
 }
 
 func New(
@@ -17,6 +23,9 @@ func New(
 	postgres *postgres.Postgres,
 ) *Service {
 	return &Service{
-		Create: create.New(dailyTaskRepository, logger, postgres),
+		All:            all.New(dailyTaskRepository, logger, postgres),
+		Create:         create.New(dailyTaskRepository, logger, postgres),
+		DeactivateByID: deactivatebyid.New(dailyTaskRepository, logger, postgres),
+		Update:         update.New(dailyTaskRepository, logger, postgres),
 	}
 }
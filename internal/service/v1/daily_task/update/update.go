@@ -0,0 +1,105 @@
+package update
+
+import (
+	"context"
+	"log"
+
+	dailytask "github.com/go-jedi/lingramm_backend/internal/domain/daily_task"
+	dailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/daily_task"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, dto dailytask.UpdateDTO) (dailytask.DailyTask, error)
+}
+
+type Update struct {
+	dailyTaskRepository *dailytaskrepository.Repository
+	logger              logger.ILogger
+	postgres            *postgres.Postgres
+}
+
+func New(
+	dailyTaskRepository *dailytaskrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Update {
+	return &Update{
+		dailyTaskRepository: dailyTaskRepository,
+		logger:              logger,
+		postgres:            postgres,
+	}
+}
+
+func (s *Update) Execute(ctx context.Context, dto dailytask.UpdateDTO) (dailytask.DailyTask, error) {
+	s.logger.Debug("[update daily task] execute service")
+
+	var (
+		err               error
+		result            dailytask.DailyTask
+		dailyTaskExists   bool
+		otherActiveExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.HasRequirements() { // if daily task has no requirements it would be completed immediately.
+		err = apperrors.ErrDailyTaskRequirementsAreEmpty
+		return dailytask.DailyTask{}, err
+	}
+
+	// check daily task exists by id.
+	dailyTaskExists, err = s.dailyTaskRepository.ExistsByID.Execute(ctx, tx, dto.ID)
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+
+	if !dailyTaskExists { // if daily task does not exist.
+		err = apperrors.ErrDailyTaskDoesNotExist
+		return dailytask.DailyTask{}, err
+	}
+
+	if !dto.IsActive {
+		// check other active daily task exists by id.
+		otherActiveExists, err = s.dailyTaskRepository.ExistsOtherActiveByID.Execute(ctx, tx, dto.ID)
+		if err != nil {
+			return dailytask.DailyTask{}, err
+		}
+
+		if !otherActiveExists { // if it is the last active daily task, users could not be assigned any.
+			err = apperrors.ErrDailyTaskLastActive
+			return dailytask.DailyTask{}, err
+		}
+	}
+
+	// update daily task.
+	result, err = s.dailyTaskRepository.Update.Execute(ctx, tx, dto)
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return dailytask.DailyTask{}, err
+	}
+
+	return result, nil
+}
@@ -5,22 +5,31 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
 	"github.com/go-jedi/lingramm_backend/internal/domain/event"
 	eventtype "github.com/go-jedi/lingramm_backend/internal/domain/event_type"
 	experiencepoint "github.com/go-jedi/lingramm_backend/internal/domain/experience_point"
 	userbalance "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/user_balance"
 	"github.com/go-jedi/lingramm_backend/internal/domain/level"
 	"github.com/go-jedi/lingramm_backend/internal/domain/notification"
+	"github.com/go-jedi/lingramm_backend/internal/domain/quest"
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
 	userachievement "github.com/go-jedi/lingramm_backend/internal/domain/user_achievement"
 	userdailytask "github.com/go-jedi/lingramm_backend/internal/domain/user_daily_task"
+	userinventory "github.com/go-jedi/lingramm_backend/internal/domain/user_inventory"
+	userquest "github.com/go-jedi/lingramm_backend/internal/domain/user_quest"
 	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
 	experiencepointrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point"
 	internalcurrency "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	leaguerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/league"
 	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
 	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	userachievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement"
 	userdailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task"
+	userinventoryrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory"
+	userquestrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest"
 	userstatsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_stats"
 	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
@@ -45,7 +54,11 @@ type CreateEvents struct {
 	internalCurrencyRepository *internalcurrency.Repository
 	userAchievementRepository  *userachievementrepository.Repository
 	userDailyTaskRepository    *userdailytaskrepository.Repository
+	userQuestRepository        *userquestrepository.Repository
+	userInventoryRepository    *userinventoryrepository.Repository
 	notificationRepository     *notificationrepository.Repository
+	leagueRepository           *leaguerepository.Repository
+	referralRepository         *referralrepository.Repository
 	logger                     logger.ILogger
 	rabbitMQ                   *rabbitmq.RabbitMQ
 	postgres                   *postgres.Postgres
@@ -61,7 +74,11 @@ func New(
 	internalCurrencyRepository *internalcurrency.Repository,
 	userAchievementRepository *userachievementrepository.Repository,
 	userDailyTaskRepository *userdailytaskrepository.Repository,
+	userQuestRepository *userquestrepository.Repository,
+	userInventoryRepository *userinventoryrepository.Repository,
 	notificationRepository *notificationrepository.Repository,
+	leagueRepository *leaguerepository.Repository,
+	referralRepository *referralrepository.Repository,
 	logger logger.ILogger,
 	rabbitMQ *rabbitmq.RabbitMQ,
 	postgres *postgres.Postgres,
@@ -76,7 +93,11 @@ func New(
 		internalCurrencyRepository: internalCurrencyRepository,
 		userAchievementRepository:  userAchievementRepository,
 		userDailyTaskRepository:    userDailyTaskRepository,
+		userQuestRepository:        userQuestRepository,
+		userInventoryRepository:    userInventoryRepository,
 		notificationRepository:     notificationRepository,
+		leagueRepository:           leagueRepository,
+		referralRepository:         referralRepository,
 		logger:                     logger,
 		rabbitMQ:                   rabbitMQ,
 		postgres:                   postgres,
@@ -90,8 +111,16 @@ func (s *CreateEvents) Execute(ctx context.Context, dto event.CreateEventsDTO) e
 	var (
 		err                         error
 		eventTypeData               eventtype.EventType
+		deltaXP                     int64
 		backFillMissingLevelHistory level.BackFillMissingLevelHistoryByTelegramIDResponse
+		achievementBackFill         level.BackFillMissingLevelHistoryByTelegramIDResponse
+		levelRewards                []level.UserLevelReward
+		achievementLevelRewards     []level.UserLevelReward
 		unlockAvailableAchievements []userachievement.UnlockAvailableAchievementsResponse
+		isAchievementXPGranted      bool
+		completedDailyTask          *userdailytask.CompleteDailyTaskByTelegramIDResponse
+		completedQuests             []userquest.CompletedQuest
+		isQuestXPGranted            bool
 		notifications               []notification.Notification
 		isStreakDaysIncrementToday  bool
 		isAccrualInternalCurrency   bool
@@ -131,8 +160,20 @@ func (s *CreateEvents) Execute(ctx context.Context, dto event.CreateEventsDTO) e
 		return err
 	}
 
+	// get experience points of the event (multiplied while a boost is active).
+	deltaXP, err = s.getDeltaXP(ctx, tx, dto.TelegramID, eventTypeData.XP)
+	if err != nil {
+		return err
+	}
+
 	// create a new xp events.
-	err = s.createXPEvents(ctx, tx, dto.TelegramID, eventTypeData.Name, eventTypeData.XP)
+	err = s.createXPEvents(ctx, tx, dto.TelegramID, eventTypeData.Name, deltaXP)
+	if err != nil {
+		return err
+	}
+
+	// join weekly league by telegram id (no-op if user already in cohort this week).
+	_, err = s.leagueRepository.JoinByTelegramID.Execute(ctx, tx, dto.TelegramID)
 	if err != nil {
 		return err
 	}
@@ -144,7 +185,19 @@ func (s *CreateEvents) Execute(ctx context.Context, dto event.CreateEventsDTO) e
 	}
 
 	// sync user daily task progress.
-	err = s.syncUserDailyTaskProgress(ctx, tx, dto.TelegramID, dto.Actions, eventTypeData.XP)
+	err = s.syncUserDailyTaskProgress(ctx, tx, dto.TelegramID, dto.Actions, deltaXP)
+	if err != nil {
+		return err
+	}
+
+	// complete daily task (rewards and daily task streak are granted once per day).
+	completedDailyTask, err = s.completeDailyTask(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return err
+	}
+
+	// complete quests (experience points are applied by the database).
+	completedQuests, isQuestXPGranted, err = s.completeQuests(ctx, tx, dto.TelegramID, dto.Actions, deltaXP)
 	if err != nil {
 		return err
 	}
@@ -155,6 +208,12 @@ func (s *CreateEvents) Execute(ctx context.Context, dto event.CreateEventsDTO) e
 		return err
 	}
 
+	// grant level rewards for every newly reached level.
+	levelRewards, err = s.grantLevelRewards(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return err
+	}
+
 	// if amount is not nil and amount is positive number.
 	if eventTypeData.Amount != nil {
 		// check and accrual internal currency.
@@ -184,10 +243,44 @@ func (s *CreateEvents) Execute(ctx context.Context, dto event.CreateEventsDTO) e
 		return err
 	}
 
-	// здесь будем проверять выполнил ли пользователь ежедневное задание.
+	// grant achievement rewards (experience points, subscription days and streak freezes are applied by the database).
+	isAchievementXPGranted, err = s.grantAchievementRewards(ctx, tx, dto.TelegramID, unlockAvailableAchievements)
+	if err != nil {
+		return err
+	}
+
+	if isAchievementXPGranted || isQuestXPGranted { // experience points from achievement and quest rewards can reach a new level.
+		// backfill missing level history by telegram id.
+		achievementBackFill, err = s.levelRepository.BackFillMissingLevelHistoryByTelegramID.Execute(ctx, tx, dto.TelegramID)
+		if err != nil {
+			return err
+		}
+
+		if achievementBackFill.IsLevelUp {
+			if !backFillMissingLevelHistory.IsLevelUp {
+				backFillMissingLevelHistory.OldLevel = achievementBackFill.OldLevel
+			}
+			backFillMissingLevelHistory.IsLevelUp = true
+			backFillMissingLevelHistory.NewLevel = achievementBackFill.NewLevel
+		}
+
+		// grant level rewards for every newly reached level.
+		achievementLevelRewards, err = s.grantLevelRewards(ctx, tx, dto.TelegramID)
+		if err != nil {
+			return err
+		}
+
+		levelRewards = append(levelRewards, achievementLevelRewards...)
+	}
+
+	// grant referral rewards once the user reaches the milestone (level or streak days).
+	err = s.grantReferralRewards(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return err
+	}
 
 	// create notifications in database.
-	notifications, err = s.createNotifications(ctx, tx, dto.TelegramID, backFillMissingLevelHistory, unlockAvailableAchievements, isAccrualInternalCurrency)
+	notifications, err = s.createNotifications(ctx, tx, dto.TelegramID, backFillMissingLevelHistory, levelRewards, unlockAvailableAchievements, completedDailyTask, completedQuests, isAccrualInternalCurrency)
 	if err != nil {
 		return err
 	}
@@ -208,6 +301,17 @@ func (s *CreateEvents) Execute(ctx context.Context, dto event.CreateEventsDTO) e
 		return err
 	}
 
+	// user stats changed, so cached achievement progress is outdated.
+	if err := s.redis.AchievementProgress.Delete(ctx, dto.TelegramID); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to delete achievement progress from cache: %v", err))
+	}
+
+	if isSubscriptionExtended(levelRewards, unlockAvailableAchievements) { // subscription changed, so cached subscription snapshot is outdated.
+		if err := s.redis.SubscriptionSnapshot.Delete(ctx, dto.TelegramID); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+		}
+	}
+
 	return nil
 }
 
@@ -262,6 +366,25 @@ func (s *CreateEvents) getEventTypeData(ctx context.Context, tx pgx.Tx, eventTyp
 	return eventTypeData, nil
 }
 
+// getDeltaXP returns experience points of the event, multiplied while the user has an active boost.
+func (s *CreateEvents) getDeltaXP(ctx context.Context, tx pgx.Tx, telegramID string, xp int64) (int64, error) {
+	if xp <= 0 {
+		return xp, nil
+	}
+
+	// check active boost exists by telegram id.
+	ie, err := s.userInventoryRepository.ExistsActiveBoostByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !ie {
+		return xp, nil
+	}
+
+	return xp * userinventory.BoostXPMultiplier, nil
+}
+
 // createXPEvents create xp events.
 func (s *CreateEvents) createXPEvents(
 	ctx context.Context,
@@ -321,6 +444,123 @@ func (s *CreateEvents) syncUserDailyTaskProgress(
 	return s.userDailyTaskRepository.SyncUserDailyTaskProgress.Execute(ctx, tx, dto)
 }
 
+// completeDailyTask counts today daily task as completed if it was done
+// (experience points and daily task streak are applied by the database)
+// and accrues internal currency reward of the daily task completed event type.
+func (s *CreateEvents) completeDailyTask(ctx context.Context, tx pgx.Tx, telegramID string) (*userdailytask.CompleteDailyTaskByTelegramIDResponse, error) {
+	// complete daily task by telegram id.
+	completedDailyTask, err := s.userDailyTaskRepository.CompleteDailyTaskByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if completedDailyTask == nil { // daily task is not done yet or already counted today.
+		return nil, nil
+	}
+
+	// get daily task completed event type data.
+	eventTypeData, err := s.getEventTypeData(ctx, tx, userdailytask.CompletedEventType)
+	if err != nil {
+		return nil, err
+	}
+
+	if !eventTypeData.IsActive || eventTypeData.Amount == nil || !eventTypeData.Amount.IsPositive() {
+		return completedDailyTask, nil
+	}
+
+	var (
+		description = "Награда за выполнение ежедневного задания"
+		sourceType  = userbalance.SourceTypeDailyTask
+	)
+
+	// add user balance.
+	if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+		EventTypeID: eventTypeData.ID,
+		Amount:      *eventTypeData.Amount,
+		TelegramID:  telegramID,
+		Description: &description,
+		SourceType:  &sourceType,
+		SourceID:    &completedDailyTask.UserDailyTaskID,
+	}); err != nil {
+		return nil, err
+	}
+
+	completedDailyTask.Amount = eventTypeData.Amount
+
+	return completedDailyTask, nil
+}
+
+// completeQuests syncs progress of active quests, counts done quests as completed
+// (experience points are applied by the database), accrues their internal currency rewards
+// and reports whether experience points rewards were granted.
+func (s *CreateEvents) completeQuests(
+	ctx context.Context,
+	tx pgx.Tx,
+	telegramID string,
+	actions event.Actions,
+	deltaXP int64,
+) ([]userquest.CompletedQuest, bool, error) {
+	dto := userquest.SyncUserQuestProgressDTO{
+		TelegramID: telegramID,
+		Actions: userquest.Actions{
+			WordsLearned:     actions.WordsLearned,
+			TasksCompleted:   actions.TasksCompleted,
+			LessonsFinished:  actions.LessonsFinished,
+			WordsTranslate:   actions.WordsTranslate,
+			DialogCompleted:  actions.DialogCompleted,
+			ExperiencePoints: &deltaXP,
+		},
+	}
+
+	// sync user quest progress.
+	completedQuests, err := s.userQuestRepository.SyncUserQuestProgress.Execute(ctx, tx, dto)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var (
+		eventTypeData eventtype.EventType
+		isXPGranted   bool
+	)
+
+	for i := range completedQuests {
+		if completedQuests[i].ExperiencePoints > 0 {
+			isXPGranted = true
+		}
+
+		if completedQuests[i].Amount == nil || !completedQuests[i].Amount.IsPositive() {
+			continue
+		}
+
+		if eventTypeData.ID == 0 {
+			// get quest completed event type data.
+			eventTypeData, err = s.getEventTypeData(ctx, tx, quest.CompletedEventType)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		var (
+			description = fmt.Sprintf("Награда за задание «%s»", completedQuests[i].Name)
+			sourceType  = userbalance.SourceTypeQuest
+		)
+
+		// add user balance.
+		if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+			EventTypeID: eventTypeData.ID,
+			Amount:      *completedQuests[i].Amount,
+			TelegramID:  telegramID,
+			Description: &description,
+			SourceType:  &sourceType,
+			SourceID:    &completedQuests[i].UserQuestID,
+		}); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return completedQuests, isXPGranted, nil
+}
+
 // checkAndAccrualInternalCurrency check and accrual internal currency.
 func (s *CreateEvents) checkAndAccrualInternalCurrency(
 	ctx context.Context,
@@ -350,13 +590,118 @@ func (s *CreateEvents) checkAndAccrualInternalCurrency(
 	return true, nil
 }
 
+// grantLevelRewards claims level rewards that were not granted yet
+// (subscription days are applied by the database) and accrues internal currency ones.
+func (s *CreateEvents) grantLevelRewards(ctx context.Context, tx pgx.Tx, telegramID string) ([]level.UserLevelReward, error) {
+	// claim level rewards by telegram id.
+	levelRewards, err := s.levelRepository.ClaimRewardsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventTypeData eventtype.EventType
+
+	for i := range levelRewards {
+		if levelRewards[i].Type != level.RewardTypeInternalCurrency || levelRewards[i].Amount == nil {
+			continue
+		}
+
+		if eventTypeData.ID == 0 {
+			// get level up reward event type data.
+			eventTypeData, err = s.getEventTypeData(ctx, tx, level.LevelUpRewardEventType)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var (
+			description = fmt.Sprintf("Награда за %d уровень", levelRewards[i].LevelNumber)
+			sourceType  = userbalance.SourceTypeLevelReward
+		)
+
+		// add user balance.
+		if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+			EventTypeID: eventTypeData.ID,
+			Amount:      *levelRewards[i].Amount,
+			TelegramID:  telegramID,
+			Description: &description,
+			SourceType:  &sourceType,
+			SourceID:    &levelRewards[i].ID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return levelRewards, nil
+}
+
+// grantAchievementRewards accrues internal currency rewards of unlocked achievements
+// and reports whether experience points rewards were granted.
+func (s *CreateEvents) grantAchievementRewards(
+	ctx context.Context,
+	tx pgx.Tx,
+	telegramID string,
+	unlockAvailableAchievements []userachievement.UnlockAvailableAchievementsResponse,
+) (bool, error) {
+	var (
+		err           error
+		eventTypeData eventtype.EventType
+		isXPGranted   bool
+	)
+
+	for i := range unlockAvailableAchievements {
+		rewards := unlockAvailableAchievements[i].Rewards
+
+		for j := range rewards {
+			if rewards[j].Type == achievement.RewardTypeExperiencePoints {
+				isXPGranted = true
+				continue
+			}
+
+			if rewards[j].Type != achievement.RewardTypeInternalCurrency || rewards[j].Amount == nil {
+				continue
+			}
+
+			if eventTypeData.ID == 0 {
+				// get achievement reward event type data.
+				eventTypeData, err = s.getEventTypeData(ctx, tx, achievement.RewardEventType)
+				if err != nil {
+					return false, err
+				}
+			}
+
+			var (
+				description = fmt.Sprintf("Награда за достижение «%s»", unlockAvailableAchievements[i].AchievementName)
+				sourceType  = userbalance.SourceTypeAchievementReward
+			)
+
+			// add user balance.
+			if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+				EventTypeID: eventTypeData.ID,
+				Amount:      *rewards[j].Amount,
+				TelegramID:  telegramID,
+				Description: &description,
+				SourceType:  &sourceType,
+				SourceID:    &rewards[j].ID,
+			}); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return isXPGranted, nil
+}
+
 // createNotifications create notifications.
 func (s *CreateEvents) createNotifications(
 	ctx context.Context,
 	tx pgx.Tx,
 	telegramID string,
 	backFillMissingLevelHistory level.BackFillMissingLevelHistoryByTelegramIDResponse,
+	levelRewards []level.UserLevelReward,
 	unlockAvailableAchievements []userachievement.UnlockAvailableAchievementsResponse,
+	completedDailyTask *userdailytask.CompleteDailyTaskByTelegramIDResponse,
+	completedQuests []userquest.CompletedQuest,
 	isAccrualInternalCurrency bool,
 ) ([]notification.Notification, error) {
 	dto := []notification.CreateDTO{
@@ -381,12 +726,24 @@ func (s *CreateEvents) createNotifications(
 		})
 	}
 
+	for i := range levelRewards {
+		dto = append(dto, notification.CreateDTO{
+			Message: notification.Message{
+				Title: "Уведомление",
+				Text:  levelRewards[i].NotificationText(),
+			},
+			Type:       notification.LevelType,
+			TelegramID: telegramID,
+		})
+	}
+
 	if len(unlockAvailableAchievements) > 0 {
 		for i := range unlockAvailableAchievements {
 			dto = append(dto, notification.CreateDTO{
 				Message: notification.Message{
-					Title: "Уведомление",
-					Text:  fmt.Sprintf("Поздравляем! Вы получили достижение «%s»! Так держать!", unlockAvailableAchievements[i].AchievementName),
+					Title:   "Уведомление",
+					Text:    unlockAvailableAchievements[i].NotificationText(),
+					Rewards: unlockAvailableAchievements[i].NotificationRewards(),
 				},
 				Type:       notification.AchievementType,
 				TelegramID: telegramID,
@@ -394,6 +751,30 @@ func (s *CreateEvents) createNotifications(
 		}
 	}
 
+	if completedDailyTask != nil {
+		dto = append(dto, notification.CreateDTO{
+			Message: notification.Message{
+				Title:   "Уведомление",
+				Text:    completedDailyTask.NotificationText(),
+				Rewards: completedDailyTask.NotificationRewards(),
+			},
+			Type:       notification.DailyTaskType,
+			TelegramID: telegramID,
+		})
+	}
+
+	for i := range completedQuests {
+		dto = append(dto, notification.CreateDTO{
+			Message: notification.Message{
+				Title:   "Уведомление",
+				Text:    completedQuests[i].NotificationText(),
+				Rewards: completedQuests[i].NotificationRewards(),
+			},
+			Type:       notification.QuestType,
+			TelegramID: telegramID,
+		})
+	}
+
 	if isAccrualInternalCurrency {
 		dto = append(dto, notification.CreateDTO{
 			Message: notification.Message{
@@ -414,6 +795,11 @@ func (s *CreateEvents) createNotifications(
 	return notifications, nil
 }
 
+// isSubscriptionExtended reports whether granted rewards extend subscription of the user.
+func isSubscriptionExtended(levelRewards []level.UserLevelReward, unlockAvailableAchievements []userachievement.UnlockAvailableAchievementsResponse) bool {
+	return level.AnyHasSubscriptionDays(levelRewards) || userachievement.AnyHasSubscriptionDays(unlockAvailableAchievements)
+}
+
 // sendNotifications send notifications.
 func (s *CreateEvents) sendNotifications(ctx context.Context, notifications []notification.Notification) {
 	for i := range notifications {
@@ -431,3 +817,45 @@ func (s *CreateEvents) sendNotifications(ctx context.Context, notifications []no
 		}
 	}
 }
+
+// grantReferralRewards rewards the user and the referrer once the user reaches the milestone of referral program.
+func (s *CreateEvents) grantReferralRewards(ctx context.Context, tx pgx.Tx, telegramID string) error {
+	// qualify referral of the user (the database checks the milestone and rejects banned users).
+	qualifyResult, err := s.referralRepository.Qualify.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return err
+	}
+
+	if qualifyResult.Status != referral.QualifyStatusRewarded || qualifyResult.Referral == nil {
+		return nil
+	}
+
+	rewards := qualifyResult.Referral.Rewards()
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	// get referral reward event type data.
+	eventTypeData, err := s.getEventTypeData(ctx, tx, referral.RewardEventType)
+	if err != nil {
+		return err
+	}
+
+	sourceType := userbalance.SourceTypeReferral
+
+	for i := range rewards {
+		// add user balance.
+		if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+			EventTypeID: eventTypeData.ID,
+			Amount:      rewards[i].Amount,
+			TelegramID:  rewards[i].TelegramID,
+			Description: &rewards[i].Description,
+			SourceType:  &sourceType,
+			SourceID:    &qualifyResult.Referral.ID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
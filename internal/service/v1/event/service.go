@@ -4,11 +4,15 @@ import (
 	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
 	experiencepointrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point"
 	internalcurrency "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	leaguerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/league"
 	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
 	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	userachievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement"
 	userdailytaskrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_daily_task"
+	userinventoryrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory"
+	userquestrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest"
 	userstatsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_stats"
 	createevents "github.com/go-jedi/lingramm_backend/internal/service/v1/event/create_events"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
@@ -30,7 +34,11 @@ func New(
 	internalCurrencyRepository *internalcurrency.Repository,
 	userAchievementRepository *userachievementrepository.Repository,
 	userDailyTaskRepository *userdailytaskrepository.Repository,
+	userQuestRepository *userquestrepository.Repository,
+	userInventoryRepository *userinventoryrepository.Repository,
 	notificationRepository *notificationrepository.Repository,
+	leagueRepository *leaguerepository.Repository,
+	referralRepository *referralrepository.Repository,
 	logger logger.ILogger,
 	rabbitMQ *rabbitmq.RabbitMQ,
 	postgres *postgres.Postgres,
@@ -46,7 +54,11 @@ func New(
 			internalCurrencyRepository,
 			userAchievementRepository,
 			userDailyTaskRepository,
+			userQuestRepository,
+			userInventoryRepository,
 			notificationRepository,
+			leagueRepository,
+			referralRepository,
 			logger,
 			rabbitMQ,
 			postgres,
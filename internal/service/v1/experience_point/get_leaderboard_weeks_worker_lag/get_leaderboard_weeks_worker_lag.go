@@ -0,0 +1,73 @@
+package getleaderboardweeksworkerlag
+
+import (
+	"context"
+	"log"
+
+	experiencepoint "github.com/go-jedi/lingramm_backend/internal/domain/experience_point"
+	experiencepointrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetLeaderboardWeeksWorkerLag --output=mocks --case=underscore
+type IGetLeaderboardWeeksWorkerLag interface {
+	Execute(ctx context.Context, workerName string) (experiencepoint.GetLeaderboardWeeksWorkerLagResponse, error)
+}
+
+type GetLeaderboardWeeksWorkerLag struct {
+	experiencePointRepository *experiencepointrepository.Repository
+	logger                    logger.ILogger
+	postgres                  *postgres.Postgres
+}
+
+func New(
+	experiencePointRepository *experiencepointrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetLeaderboardWeeksWorkerLag {
+	return &GetLeaderboardWeeksWorkerLag{
+		experiencePointRepository: experiencePointRepository,
+		logger:                    logger,
+		postgres:                  postgres,
+	}
+}
+
+func (s *GetLeaderboardWeeksWorkerLag) Execute(ctx context.Context, workerName string) (experiencepoint.GetLeaderboardWeeksWorkerLagResponse, error) {
+	s.logger.Debug("[get leaderboard weeks worker lag] execute service")
+
+	var (
+		err    error
+		result experiencepoint.GetLeaderboardWeeksWorkerLagResponse
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return experiencepoint.GetLeaderboardWeeksWorkerLagResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get leaderboard weeks worker lag.
+	result, err = s.experiencePointRepository.GetLeaderboardWeeksWorkerLag.Execute(ctx, tx, workerName)
+	if err != nil {
+		return experiencepoint.GetLeaderboardWeeksWorkerLagResponse{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return experiencepoint.GetLeaderboardWeeksWorkerLagResponse{}, err
+	}
+
+	return result, nil
+}
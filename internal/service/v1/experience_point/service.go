@@ -4,6 +4,7 @@ import (
 	experiencepointrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/experience_point"
 	getleaderboardtopweek "github.com/go-jedi/lingramm_backend/internal/service/v1/experience_point/get_leaderboard_top_week"
 	getleaderboardtopweekforuser "github.com/go-jedi/lingramm_backend/internal/service/v1/experience_point/get_leaderboard_top_week_for_user"
+	getleaderboardweeksworkerlag "github.com/go-jedi/lingramm_backend/internal/service/v1/experience_point/get_leaderboard_weeks_worker_lag"
 	leaderboardweeksprocessbatch "github.com/go-jedi/lingramm_backend/internal/service/v1/experience_point/leaderboard_weeks_process_batch"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
@@ -12,6 +13,7 @@ import (
 type Service struct {
 	GetLeaderboardTopWeek        getleaderboardtopweek.IGetLeaderboardTopWeek
 	GetLeaderboardTopWeekForUser getleaderboardtopweekforuser.IGetLeaderboardTopWeekForUser
+	GetLeaderboardWeeksWorkerLag getleaderboardweeksworkerlag.IGetLeaderboardWeeksWorkerLag
 	LeaderboardWeeksProcessBatch leaderboardweeksprocessbatch.ILeaderboardWeeksProcessBatch
 }
 
@@ -23,6 +25,7 @@ func New(
 	return &Service{
 		GetLeaderboardTopWeek:        getleaderboardtopweek.New(experiencePointRepository, logger, postgres),
 		GetLeaderboardTopWeekForUser: getleaderboardtopweekforuser.New(experiencePointRepository, logger, postgres),
+		GetLeaderboardWeeksWorkerLag: getleaderboardweeksworkerlag.New(experiencePointRepository, logger, postgres),
 		LeaderboardWeeksProcessBatch: leaderboardweeksprocessbatch.New(experiencePointRepository, logger, postgres),
 	}
 }
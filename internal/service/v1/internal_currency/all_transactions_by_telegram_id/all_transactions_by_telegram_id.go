@@ -0,0 +1,114 @@
+package alltransactionsbytelegramid
+
+import (
+	"context"
+	"log"
+
+	balancetransaction "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/balance_transaction"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllTransactionsByTelegramID --output=mocks --case=underscore
+type IAllTransactionsByTelegramID interface {
+	Execute(ctx context.Context, dto balancetransaction.AllByTelegramIDDTO) (balancetransaction.AllByTelegramIDResponse, error)
+}
+
+type AllTransactionsByTelegramID struct {
+	internalCurrencyRepository *internalcurrency.Repository
+	userRepository             *userrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	internalCurrencyRepository *internalcurrency.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllTransactionsByTelegramID {
+	return &AllTransactionsByTelegramID{
+		internalCurrencyRepository: internalCurrencyRepository,
+		userRepository:             userRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+// Execute returns a page of balance transactions of the user from newest to oldest.
+// Next cursor is the id of the last returned transaction.
+func (s *AllTransactionsByTelegramID) Execute(ctx context.Context, dto balancetransaction.AllByTelegramIDDTO) (balancetransaction.AllByTelegramIDResponse, error) {
+	s.logger.Debug("[get all balance transactions by telegram id] execute service")
+
+	var (
+		err          error
+		result       balancetransaction.AllByTelegramIDResponse
+		userExists   bool
+		transactions []balancetransaction.Transaction
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return balancetransaction.AllByTelegramIDResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.IsDateRangeValid() { // if date range bounds are not ordered.
+		err = apperrors.ErrBalanceTransactionDateRangeIsInvalid
+		return balancetransaction.AllByTelegramIDResponse{}, err
+	}
+
+	if dto.Lang == "" { // if language is not set, titles are returned in default language.
+		dto.Lang = balancetransaction.DefaultLang
+	}
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return balancetransaction.AllByTelegramIDResponse{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return balancetransaction.AllByTelegramIDResponse{}, err
+	}
+
+	limit := dto.Limit
+	dto.Limit++ // one more transaction shows whether there is a next page.
+
+	// get all balance transactions by telegram id.
+	transactions, err = s.internalCurrencyRepository.AllTransactionsByTelegramID.Execute(ctx, tx, dto)
+	if err != nil {
+		return balancetransaction.AllByTelegramIDResponse{}, err
+	}
+
+	if int64(len(transactions)) > limit { // if there is a next page.
+		transactions = transactions[:limit]
+
+		result.HasMore = true
+		result.NextCursor = &transactions[len(transactions)-1].ID
+	}
+
+	result.Transactions = transactions
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return balancetransaction.AllByTelegramIDResponse{}, err
+	}
+
+	return result, nil
+}
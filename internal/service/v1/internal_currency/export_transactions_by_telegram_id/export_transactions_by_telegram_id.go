@@ -0,0 +1,187 @@
+package exporttransactionsbytelegramid
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"log"
+	"strconv"
+	"time"
+
+	balancetransaction "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/balance_transaction"
+	"github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExportTransactionsByTelegramID --output=mocks --case=underscore
+type IExportTransactionsByTelegramID interface {
+	Execute(ctx context.Context, dto balancetransaction.ExportByTelegramIDDTO) ([]byte, error)
+}
+
+type ExportTransactionsByTelegramID struct {
+	internalCurrencyRepository *internalcurrency.Repository
+	userRepository             *userrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	internalCurrencyRepository *internalcurrency.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *ExportTransactionsByTelegramID {
+	return &ExportTransactionsByTelegramID{
+		internalCurrencyRepository: internalCurrencyRepository,
+		userRepository:             userRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+// Execute exports balance transactions of the user to CSV.
+// Export is rejected if more than balancetransaction.ExportMaxRows transactions match the filters.
+func (s *ExportTransactionsByTelegramID) Execute(ctx context.Context, dto balancetransaction.ExportByTelegramIDDTO) ([]byte, error) {
+	s.logger.Debug("[export balance transactions by telegram id] execute service")
+
+	var (
+		err          error
+		result       []byte
+		userExists   bool
+		transactions []balancetransaction.Transaction
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.IsDateRangeValid() { // if date range bounds are not ordered.
+		err = apperrors.ErrBalanceTransactionDateRangeIsInvalid
+		return nil, err
+	}
+
+	if dto.Lang == "" { // if language is not set, titles are returned in default language.
+		dto.Lang = balancetransaction.DefaultLang
+	}
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return nil, err
+	}
+
+	// get all balance transactions by telegram id.
+	transactions, err = s.internalCurrencyRepository.AllTransactionsByTelegramID.Execute(ctx, tx, balancetransaction.AllByTelegramIDDTO{
+		TelegramID: dto.TelegramID,
+		Direction:  dto.Direction,
+		EventType:  dto.EventType,
+		DateFrom:   dto.DateFrom,
+		DateTo:     dto.DateTo,
+		Lang:       dto.Lang,
+		Limit:      balancetransaction.ExportMaxRows + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transactions) > balancetransaction.ExportMaxRows { // if export is too large.
+		err = apperrors.ErrBalanceTransactionsExportLimitExceeded
+		return nil, err
+	}
+
+	result, err = s.toCSV(transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// toCSV writes balance transactions to CSV with header row.
+func (s *ExportTransactionsByTelegramID) toCSV(transactions []balancetransaction.Transaction) ([]byte, error) {
+	var (
+		buf bytes.Buffer
+		w   = csv.NewWriter(&buf)
+	)
+
+	if err := w.Write([]string{
+		"id", "created_at", "direction", "amount", "balance_after",
+		"event_type", "title", "description", "source_type", "source_id",
+	}); err != nil {
+		return nil, err
+	}
+
+	for i := range transactions {
+		t := transactions[i]
+
+		var (
+			balanceAfter string
+			sourceID     string
+		)
+
+		if t.BalanceAfter != nil {
+			balanceAfter = t.BalanceAfter.StringFixed(2)
+		}
+
+		if t.SourceID != nil {
+			sourceID = strconv.FormatInt(*t.SourceID, 10)
+		}
+
+		if err := w.Write([]string{
+			strconv.FormatInt(t.ID, 10),
+			t.CreatedAt.Format(time.RFC3339),
+			t.Direction,
+			t.Amount.StringFixed(2),
+			balanceAfter,
+			t.EventTypeName,
+			stringValue(t.Title),
+			stringValue(t.Description),
+			stringValue(t.SourceType),
+			sourceID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
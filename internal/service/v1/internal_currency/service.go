@@ -3,6 +3,8 @@ package internalcurrency
 import (
 	"github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	alltransactionsbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/internal_currency/all_transactions_by_telegram_id"
+	exporttransactionsbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/internal_currency/export_transactions_by_telegram_id"
 	"github.com/go-jedi/lingramm_backend/internal/service/v1/internal_currency/get_user_balance"
 	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
@@ -10,7 +12,9 @@ import (
 )
 
 type Service struct {
-	GetUserBalance getuserbalance.IGetUserBalance
+	AllTransactionsByTelegramID    alltransactionsbytelegramid.IAllTransactionsByTelegramID
+	ExportTransactionsByTelegramID exporttransactionsbytelegramid.IExportTransactionsByTelegramID
+	GetUserBalance                 getuserbalance.IGetUserBalance
 }
 
 func New(
@@ -21,6 +25,18 @@ func New(
 	bigCache *bigcachepkg.BigCache,
 ) *Service {
 	return &Service{
+		AllTransactionsByTelegramID: alltransactionsbytelegramid.New(
+			internalCurrencyRepository,
+			userRepository,
+			logger,
+			postgres,
+		),
+		ExportTransactionsByTelegramID: exporttransactionsbytelegramid.New(
+			internalCurrencyRepository,
+			userRepository,
+			logger,
+			postgres,
+		),
 		GetUserBalance: getuserbalance.New(
 			internalCurrencyRepository,
 			userRepository,
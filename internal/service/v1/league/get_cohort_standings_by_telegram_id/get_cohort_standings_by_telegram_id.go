@@ -0,0 +1,102 @@
+package getcohortstandingsbytelegramid
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/league"
+	leaguerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/league"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetCohortStandingsByTelegramID --output=mocks --case=underscore
+type IGetCohortStandingsByTelegramID interface {
+	Execute(ctx context.Context, telegramID string) ([]league.GetCohortStandingsByTelegramIDResponse, error)
+}
+
+type GetCohortStandingsByTelegramID struct {
+	leagueRepository *leaguerepository.Repository
+	userRepository   *userrepository.Repository
+	logger           logger.ILogger
+	postgres         *postgres.Postgres
+}
+
+func New(
+	leagueRepository *leaguerepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetCohortStandingsByTelegramID {
+	return &GetCohortStandingsByTelegramID{
+		leagueRepository: leagueRepository,
+		userRepository:   userRepository,
+		logger:           logger,
+		postgres:         postgres,
+	}
+}
+
+func (s *GetCohortStandingsByTelegramID) Execute(ctx context.Context, telegramID string) ([]league.GetCohortStandingsByTelegramIDResponse, error) {
+	s.logger.Debug("[get league cohort standings by telegram id] execute service")
+
+	var (
+		err          error
+		result       []league.GetCohortStandingsByTelegramIDResponse
+		userExists   bool
+		memberExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return nil, err
+	}
+
+	// check league member exists by telegram id.
+	memberExists, err = s.leagueRepository.ExistsMemberByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !memberExists { // if user does not participate in league this week.
+		err = apperrors.ErrLeagueMemberDoesNotExist
+		return nil, err
+	}
+
+	// get league cohort standings by telegram id.
+	result, err = s.leagueRepository.GetCohortStandingsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
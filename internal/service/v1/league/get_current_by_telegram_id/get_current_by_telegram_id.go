@@ -0,0 +1,110 @@
+package getcurrentbytelegramid
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/league"
+	leaguerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/league"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetCurrentByTelegramID --output=mocks --case=underscore
+type IGetCurrentByTelegramID interface {
+	Execute(ctx context.Context, telegramID string) (league.GetCurrentByTelegramIDResponse, error)
+}
+
+type GetCurrentByTelegramID struct {
+	leagueRepository *leaguerepository.Repository
+	userRepository   *userrepository.Repository
+	logger           logger.ILogger
+	postgres         *postgres.Postgres
+}
+
+func New(
+	leagueRepository *leaguerepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetCurrentByTelegramID {
+	return &GetCurrentByTelegramID{
+		leagueRepository: leagueRepository,
+		userRepository:   userRepository,
+		logger:           logger,
+		postgres:         postgres,
+	}
+}
+
+func (s *GetCurrentByTelegramID) Execute(ctx context.Context, telegramID string) (league.GetCurrentByTelegramIDResponse, error) {
+	s.logger.Debug("[get current league by telegram id] execute service")
+
+	var (
+		err          error
+		result       league.GetCurrentByTelegramIDResponse
+		userExists   bool
+		memberExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return league.GetCurrentByTelegramIDResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return league.GetCurrentByTelegramIDResponse{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return league.GetCurrentByTelegramIDResponse{}, err
+	}
+
+	// check league member exists by telegram id.
+	memberExists, err = s.leagueRepository.ExistsMemberByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return league.GetCurrentByTelegramIDResponse{}, err
+	}
+
+	if !memberExists { // if user does not participate in league this week.
+		// join league by telegram id.
+		memberExists, err = s.leagueRepository.JoinByTelegramID.Execute(ctx, tx, telegramID)
+		if err != nil {
+			return league.GetCurrentByTelegramIDResponse{}, err
+		}
+
+		if !memberExists { // if previous week results are not finalized yet.
+			err = apperrors.ErrLeagueMemberDoesNotExist
+			return league.GetCurrentByTelegramIDResponse{}, err
+		}
+	}
+
+	// get current league by telegram id.
+	result, err = s.leagueRepository.GetCurrentByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return league.GetCurrentByTelegramIDResponse{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return league.GetCurrentByTelegramIDResponse{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,33 @@
+package league
+
+import (
+	leaguerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/league"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	allhistorybytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/league/all_history_by_telegram_id"
+	getcohortstandingsbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/league/get_cohort_standings_by_telegram_id"
+	getcurrentbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/league/get_current_by_telegram_id"
+	weeksfinalize "github.com/go-jedi/lingramm_backend/internal/service/v1/league/weeks_finalize"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	AllHistoryByTelegramID         allhistorybytelegramid.IAllHistoryByTelegramID
+	GetCohortStandingsByTelegramID getcohortstandingsbytelegramid.IGetCohortStandingsByTelegramID
+	GetCurrentByTelegramID         getcurrentbytelegramid.IGetCurrentByTelegramID
+	WeeksFinalize                  weeksfinalize.IWeeksFinalize
+}
+
+func New(
+	leagueRepository *leaguerepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		AllHistoryByTelegramID:         allhistorybytelegramid.New(leagueRepository, userRepository, logger, postgres),
+		GetCohortStandingsByTelegramID: getcohortstandingsbytelegramid.New(leagueRepository, userRepository, logger, postgres),
+		GetCurrentByTelegramID:         getcurrentbytelegramid.New(leagueRepository, userRepository, logger, postgres),
+		WeeksFinalize:                  weeksfinalize.New(leagueRepository, userRepository, logger, postgres),
+	}
+}
@@ -0,0 +1,77 @@
+package weeksfinalize
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/league"
+	leaguerepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/league"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IWeeksFinalize --output=mocks --case=underscore
+type IWeeksFinalize interface {
+	Execute(ctx context.Context, batchSize int64) (league.WeeksFinalizeResponse, error)
+}
+
+type WeeksFinalize struct {
+	leagueRepository *leaguerepository.Repository
+	userRepository   *userrepository.Repository
+	logger           logger.ILogger
+	postgres         *postgres.Postgres
+}
+
+func New(
+	leagueRepository *leaguerepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *WeeksFinalize {
+	return &WeeksFinalize{
+		leagueRepository: leagueRepository,
+		userRepository:   userRepository,
+		logger:           logger,
+		postgres:         postgres,
+	}
+}
+
+func (s *WeeksFinalize) Execute(ctx context.Context, batchSize int64) (league.WeeksFinalizeResponse, error) {
+	s.logger.Debug("[league weeks finalize] execute service")
+
+	var (
+		err    error
+		result league.WeeksFinalizeResponse
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return league.WeeksFinalizeResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// league weeks finalize.
+	result, err = s.leagueRepository.WeeksFinalize.Execute(ctx, tx, batchSize)
+	if err != nil {
+		return league.WeeksFinalizeResponse{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return league.WeeksFinalizeResponse{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// allLimit is how many of the latest jobs are returned.
+const allLimit = 100
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]ledgerreconciliation.Job, error)
+}
+
+type All struct {
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository
+	userRepository                 *userrepository.Repository
+	logger                         logger.ILogger
+	postgres                       *postgres.Postgres
+}
+
+func New(
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		ledgerReconciliationRepository: ledgerReconciliationRepository,
+		userRepository:                 userRepository,
+		logger:                         logger,
+		postgres:                       postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]ledgerreconciliation.Job, error) {
+	s.logger.Debug("[get all ledger reconciliation jobs] execute service")
+
+	var (
+		err    error
+		result []ledgerreconciliation.Job
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all ledger reconciliation jobs.
+	result, err = s.ledgerReconciliationRepository.All.Execute(ctx, tx, allLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
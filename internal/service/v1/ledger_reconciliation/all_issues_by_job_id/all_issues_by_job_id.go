@@ -0,0 +1,103 @@
+package allissuesbyjobid
+
+import (
+	"context"
+	"log"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllIssuesByJobID --output=mocks --case=underscore
+type IAllIssuesByJobID interface {
+	Execute(ctx context.Context, dto ledgerreconciliation.AllIssuesByJobIDDTO) (ledgerreconciliation.AllIssuesByJobIDResponse, error)
+}
+
+type AllIssuesByJobID struct {
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository
+	userRepository                 *userrepository.Repository
+	logger                         logger.ILogger
+	postgres                       *postgres.Postgres
+}
+
+func New(
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllIssuesByJobID {
+	return &AllIssuesByJobID{
+		ledgerReconciliationRepository: ledgerReconciliationRepository,
+		userRepository:                 userRepository,
+		logger:                         logger,
+		postgres:                       postgres,
+	}
+}
+
+func (s *AllIssuesByJobID) Execute(ctx context.Context, dto ledgerreconciliation.AllIssuesByJobIDDTO) (ledgerreconciliation.AllIssuesByJobIDResponse, error) {
+	s.logger.Debug("[get all ledger reconciliation issues by job id] execute service")
+
+	var (
+		err       error
+		result    ledgerreconciliation.AllIssuesByJobIDResponse
+		jobExists bool
+		issues    []ledgerreconciliation.Issue
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return ledgerreconciliation.AllIssuesByJobIDResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check ledger reconciliation job exists by id.
+	jobExists, err = s.ledgerReconciliationRepository.ExistsByID.Execute(ctx, tx, dto.JobID)
+	if err != nil {
+		return ledgerreconciliation.AllIssuesByJobIDResponse{}, err
+	}
+
+	if !jobExists { // if ledger reconciliation job does not exist.
+		err = apperrors.ErrLedgerReconciliationJobDoesNotExist
+		return ledgerreconciliation.AllIssuesByJobIDResponse{}, err
+	}
+
+	limit := dto.Limit
+	dto.Limit++ // one more issue shows whether there is a next page.
+
+	// get all ledger reconciliation issues by job id.
+	issues, err = s.ledgerReconciliationRepository.AllIssuesByJobID.Execute(ctx, tx, dto)
+	if err != nil {
+		return ledgerreconciliation.AllIssuesByJobIDResponse{}, err
+	}
+
+	if int64(len(issues)) > limit { // if there is a next page.
+		issues = issues[:limit]
+
+		result.HasMore = true
+		result.NextCursor = &issues[len(issues)-1].ID
+	}
+
+	result.Issues = issues
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return ledgerreconciliation.AllIssuesByJobIDResponse{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package create
+
+import (
+	"context"
+	"log"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, dto ledgerreconciliation.CreateDTO) (ledgerreconciliation.Job, error)
+}
+
+type Create struct {
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository
+	userRepository                 *userrepository.Repository
+	logger                         logger.ILogger
+	postgres                       *postgres.Postgres
+}
+
+func New(
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Create {
+	return &Create{
+		ledgerReconciliationRepository: ledgerReconciliationRepository,
+		userRepository:                 userRepository,
+		logger:                         logger,
+		postgres:                       postgres,
+	}
+}
+
+func (s *Create) Execute(ctx context.Context, dto ledgerreconciliation.CreateDTO) (ledgerreconciliation.Job, error) {
+	s.logger.Debug("[create ledger reconciliation job] execute service")
+
+	var (
+		err        error
+		result     ledgerreconciliation.Job
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return ledgerreconciliation.Job{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if dto.TelegramID != nil { // if reconcile only one user.
+		// check user exists by telegram id.
+		userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, *dto.TelegramID)
+		if err != nil {
+			return ledgerreconciliation.Job{}, err
+		}
+
+		if !userExists { // if user does not exist.
+			err = apperrors.ErrUserDoesNotExist
+			return ledgerreconciliation.Job{}, err
+		}
+	}
+
+	// create ledger reconciliation job.
+	result, err = s.ledgerReconciliationRepository.Create.Execute(ctx, tx, dto)
+	if err != nil {
+		return ledgerreconciliation.Job{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return ledgerreconciliation.Job{}, err
+	}
+
+	return result, nil
+}
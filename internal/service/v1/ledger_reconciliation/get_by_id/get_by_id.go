@@ -0,0 +1,90 @@
+package getbyid
+
+import (
+	"context"
+	"log"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetByID --output=mocks --case=underscore
+type IGetByID interface {
+	Execute(ctx context.Context, id int64) (ledgerreconciliation.Job, error)
+}
+
+type GetByID struct {
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository
+	userRepository                 *userrepository.Repository
+	logger                         logger.ILogger
+	postgres                       *postgres.Postgres
+}
+
+func New(
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetByID {
+	return &GetByID{
+		ledgerReconciliationRepository: ledgerReconciliationRepository,
+		userRepository:                 userRepository,
+		logger:                         logger,
+		postgres:                       postgres,
+	}
+}
+
+func (s *GetByID) Execute(ctx context.Context, id int64) (ledgerreconciliation.Job, error) {
+	s.logger.Debug("[get ledger reconciliation job by id] execute service")
+
+	var (
+		err       error
+		result    ledgerreconciliation.Job
+		jobExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return ledgerreconciliation.Job{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check ledger reconciliation job exists by id.
+	jobExists, err = s.ledgerReconciliationRepository.ExistsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return ledgerreconciliation.Job{}, err
+	}
+
+	if !jobExists { // if ledger reconciliation job does not exist.
+		err = apperrors.ErrLedgerReconciliationJobDoesNotExist
+		return ledgerreconciliation.Job{}, err
+	}
+
+	// get ledger reconciliation job by id.
+	result, err = s.ledgerReconciliationRepository.GetByID.Execute(ctx, tx, id)
+	if err != nil {
+		return ledgerreconciliation.Job{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return ledgerreconciliation.Job{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package processchunk
+
+import (
+	"context"
+	"log"
+
+	ledgerreconciliation "github.com/go-jedi/lingramm_backend/internal/domain/ledger_reconciliation"
+	ledgerreconciliationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IProcessChunk --output=mocks --case=underscore
+type IProcessChunk interface {
+	Execute(ctx context.Context, dto ledgerreconciliation.ProcessChunkDTO) (*ledgerreconciliation.Job, error)
+}
+
+type ProcessChunk struct {
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository
+	userRepository                 *userrepository.Repository
+	logger                         logger.ILogger
+	postgres                       *postgres.Postgres
+}
+
+func New(
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *ProcessChunk {
+	return &ProcessChunk{
+		ledgerReconciliationRepository: ledgerReconciliationRepository,
+		userRepository:                 userRepository,
+		logger:                         logger,
+		postgres:                       postgres,
+	}
+}
+
+func (s *ProcessChunk) Execute(ctx context.Context, dto ledgerreconciliation.ProcessChunkDTO) (*ledgerreconciliation.Job, error) {
+	s.logger.Debug("[process ledger reconciliation job chunk] execute service")
+
+	var (
+		err    error
+		result *ledgerreconciliation.Job
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// process ledger reconciliation job chunk.
+	result, err = s.ledgerReconciliationRepository.ProcessChunk.Execute(ctx, tx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,39 @@
+package ledgerreconciliation
+
+import (
+	ledgerreconciliationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/ledger_reconciliation"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation/all"
+	allissuesbyjobid "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation/all_issues_by_job_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation/create"
+	getbyid "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation/get_by_id"
+	processchunk "github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation/process_chunk"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/ledger_reconciliation/schedule"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	All              all.IAll
+	AllIssuesByJobID allissuesbyjobid.IAllIssuesByJobID
+	Create           create.ICreate
+	GetByID          getbyid.IGetByID
+	ProcessChunk     processchunk.IProcessChunk
+	Schedule         schedule.ISchedule
+}
+
+func New(
+	ledgerReconciliationRepository *ledgerreconciliationrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		All:              all.New(ledgerReconciliationRepository, userRepository, logger, postgres),
+		AllIssuesByJobID: allissuesbyjobid.New(ledgerReconciliationRepository, userRepository, logger, postgres),
+		Create:           create.New(ledgerReconciliationRepository, userRepository, logger, postgres),
+		GetByID:          getbyid.New(ledgerReconciliationRepository, userRepository, logger, postgres),
+		ProcessChunk:     processchunk.New(ledgerReconciliationRepository, userRepository, logger, postgres),
+		Schedule:         schedule.New(ledgerReconciliationRepository, userRepository, logger, postgres),
+	}
+}
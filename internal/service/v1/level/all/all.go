@@ -0,0 +1,77 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]level.Level, error)
+}
+
+type All struct {
+	levelRepository       *levelrepository.Repository
+	awardAssetsRepository *awardassetsrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	levelRepository *levelrepository.Repository,
+	awardAssetsRepository *awardassetsrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		levelRepository:       levelRepository,
+		awardAssetsRepository: awardAssetsRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]level.Level, error) {
+	s.logger.Debug("[get all levels] execute service")
+
+	var (
+		err    error
+		result []level.Level
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all levels.
+	result, err = s.levelRepository.All.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
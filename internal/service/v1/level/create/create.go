@@ -0,0 +1,114 @@
+package create
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, dto level.CreateDTO) (level.Level, error)
+}
+
+type Create struct {
+	levelRepository       *levelrepository.Repository
+	awardAssetsRepository *awardassetsrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	levelRepository *levelrepository.Repository,
+	awardAssetsRepository *awardassetsrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Create {
+	return &Create{
+		levelRepository:       levelRepository,
+		awardAssetsRepository: awardAssetsRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *Create) Execute(ctx context.Context, dto level.CreateDTO) (level.Level, error) {
+	s.logger.Debug("[create a new level] execute service")
+
+	var (
+		err               error
+		result            level.Level
+		levelExists       bool
+		levelNameExists   bool
+		thresholdConflict bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return level.Level{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check level exists by level number.
+	levelExists, err = s.levelRepository.ExistsByLevelNumber.Execute(ctx, tx, dto.LevelNumber)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	if levelExists { // if level already exists.
+		err = apperrors.ErrLevelAlreadyExists
+		return level.Level{}, err
+	}
+
+	// check level exists by level name.
+	levelNameExists, err = s.levelRepository.ExistsByLevelName.Execute(ctx, tx, dto.LevelName, dto.LevelNumber)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	if levelNameExists { // if level name is taken by another level.
+		err = apperrors.ErrLevelAlreadyExists
+		return level.Level{}, err
+	}
+
+	// check level threshold conflict.
+	thresholdConflict, err = s.levelRepository.ExistsThresholdConflict.Execute(ctx, tx, dto.LevelNumber, dto.RequiredExperience)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	if thresholdConflict { // if required experience breaks the level curve order.
+		err = apperrors.ErrLevelThresholdConflict
+		return level.Level{}, err
+	}
+
+	// create a new level.
+	result, err = s.levelRepository.Create.Execute(ctx, tx, dto)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,104 @@
+package createreward
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreateReward --output=mocks --case=underscore
+type ICreateReward interface {
+	Execute(ctx context.Context, dto level.CreateRewardDTO) (level.LevelReward, error)
+}
+
+type CreateReward struct {
+	levelRepository       *levelrepository.Repository
+	awardAssetsRepository *awardassetsrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	levelRepository *levelrepository.Repository,
+	awardAssetsRepository *awardassetsrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *CreateReward {
+	return &CreateReward{
+		levelRepository:       levelRepository,
+		awardAssetsRepository: awardAssetsRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *CreateReward) Execute(ctx context.Context, dto level.CreateRewardDTO) (level.LevelReward, error) {
+	s.logger.Debug("[create a new level reward] execute service")
+
+	var (
+		err              error
+		result           level.LevelReward
+		levelExists      bool
+		awardAssetExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return level.LevelReward{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check level exists by level number.
+	levelExists, err = s.levelRepository.ExistsByLevelNumber.Execute(ctx, tx, dto.LevelNumber)
+	if err != nil {
+		return level.LevelReward{}, err
+	}
+
+	if !levelExists { // if level does not exist.
+		err = apperrors.ErrLevelDoesNotExist
+		return level.LevelReward{}, err
+	}
+
+	if dto.Type == level.RewardTypeAwardAsset { // if reward is award asset.
+		// check award asset exists by id.
+		awardAssetExists, err = s.awardAssetsRepository.ExistsByID.Execute(ctx, tx, *dto.AwardAssetID)
+		if err != nil {
+			return level.LevelReward{}, err
+		}
+
+		if !awardAssetExists { // if award asset does not exist.
+			err = apperrors.ErrAwardAssetsDoesNotExist
+			return level.LevelReward{}, err
+		}
+	}
+
+	// create a new level reward.
+	result, err = s.levelRepository.CreateReward.Execute(ctx, tx, dto)
+	if err != nil {
+		return level.LevelReward{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return level.LevelReward{}, err
+	}
+
+	return result, nil
+}
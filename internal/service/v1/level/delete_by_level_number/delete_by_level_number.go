@@ -0,0 +1,102 @@
+package deletebylevelnumber
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteByLevelNumber --output=mocks --case=underscore
+type IDeleteByLevelNumber interface {
+	Execute(ctx context.Context, levelNumber int64) (level.Level, error)
+}
+
+type DeleteByLevelNumber struct {
+	levelRepository       *levelrepository.Repository
+	awardAssetsRepository *awardassetsrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	levelRepository *levelrepository.Repository,
+	awardAssetsRepository *awardassetsrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *DeleteByLevelNumber {
+	return &DeleteByLevelNumber{
+		levelRepository:       levelRepository,
+		awardAssetsRepository: awardAssetsRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *DeleteByLevelNumber) Execute(ctx context.Context, levelNumber int64) (level.Level, error) {
+	s.logger.Debug("[delete level by level number] execute service")
+
+	var (
+		err         error
+		result      level.Level
+		levelExists bool
+		levelInUse  bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return level.Level{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check level exists by level number.
+	levelExists, err = s.levelRepository.ExistsByLevelNumber.Execute(ctx, tx, levelNumber)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	if !levelExists { // if level does not exist.
+		err = apperrors.ErrLevelDoesNotExist
+		return level.Level{}, err
+	}
+
+	// check level in use by level number.
+	levelInUse, err = s.levelRepository.ExistsInUseByLevelNumber.Execute(ctx, tx, levelNumber)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	if levelInUse { // if users already reached the level.
+		err = apperrors.ErrLevelInUse
+		return level.Level{}, err
+	}
+
+	// delete level by level number.
+	result, err = s.levelRepository.DeleteByLevelNumber.Execute(ctx, tx, levelNumber)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return level.Level{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,90 @@
+package deleterewardbyid
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/level"
+	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteRewardByID --output=mocks --case=underscore
+type IDeleteRewardByID interface {
+	Execute(ctx context.Context, id int64) (level.LevelReward, error)
+}
+
+type DeleteRewardByID struct {
+	levelRepository       *levelrepository.Repository
+	awardAssetsRepository *awardassetsrepository.Repository
+	logger                logger.ILogger
+	postgres              *postgres.Postgres
+}
+
+func New(
+	levelRepository *levelrepository.Repository,
+	awardAssetsRepository *awardassetsrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *DeleteRewardByID {
+	return &DeleteRewardByID{
+		levelRepository:       levelRepository,
+		awardAssetsRepository: awardAssetsRepository,
+		logger:                logger,
+		postgres:              postgres,
+	}
+}
+
+func (s *DeleteRewardByID) Execute(ctx context.Context, id int64) (level.LevelReward, error) {
+	s.logger.Debug("[delete level reward by id] execute service")
+
+	var (
+		err          error
+		result       level.LevelReward
+		rewardExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return level.LevelReward{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check level reward exists by id.
+	rewardExists, err = s.levelRepository.ExistsRewardByID.Execute(ctx, tx, id)
+	if err != nil {
+		return level.LevelReward{}, err
+	}
+
+	if !rewardExists { // if level reward does not exist.
+		err = apperrors.ErrLevelRewardDoesNotExist
+		return level.LevelReward{}, err
+	}
+
+	// delete level reward by id.
+	result, err = s.levelRepository.DeleteRewardByID.Execute(ctx, tx, id)
+	if err != nil {
+		return level.LevelReward{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return level.LevelReward{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,42 @@
+package level
+
+import (
+	awardassetsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/file_server/award_assets"
+	levelrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/level"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/level/all"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/level/create"
+	createreward "github.com/go-jedi/lingramm_backend/internal/service/v1/level/create_reward"
+	deletebylevelnumber "github.com/go-jedi/lingramm_backend/internal/service/v1/level/delete_by_level_number"
+	deleterewardbyid "github.com/go-jedi/lingramm_backend/internal/service/v1/level/delete_reward_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/level/generate"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/level/update"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	All                 all.IAll
+	Create              create.ICreate
+	CreateReward        createreward.ICreateReward
+	DeleteByLevelNumber deletebylevelnumber.IDeleteByLevelNumber
+	DeleteRewardByID    deleterewardbyid.IDeleteRewardByID
+	Generate            generate.IGenerate
+	Update              update.IUpdate
+}
+
+func New(
+	levelRepository *levelrepository.Repository,
+	awardAssetsRepository *awardassetsrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		All:                 all.New(levelRepository, awardAssetsRepository, logger, postgres),
+		Create:              create.New(levelRepository, awardAssetsRepository, logger, postgres),
+		CreateReward:        createreward.New(levelRepository, awardAssetsRepository, logger, postgres),
+		DeleteByLevelNumber: deletebylevelnumber.New(levelRepository, awardAssetsRepository, logger, postgres),
+		DeleteRewardByID:    deleterewardbyid.New(levelRepository, awardAssetsRepository, logger, postgres),
+		Generate:            generate.New(levelRepository, awardAssetsRepository, logger, postgres),
+		Update:              update.New(levelRepository, awardAssetsRepository, logger, postgres),
+	}
+}
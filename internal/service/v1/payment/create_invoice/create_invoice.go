@@ -0,0 +1,161 @@
+package createinvoice
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	paymentrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/telegram"
+	"github.com/go-jedi/lingramm_backend/pkg/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreateInvoice --output=mocks --case=underscore
+type ICreateInvoice interface {
+	Execute(ctx context.Context, dto payment.CreateInvoiceDTO) (payment.CreateInvoiceResponse, error)
+}
+
+type CreateInvoice struct {
+	paymentRepository *paymentrepository.Repository
+	userRepository    *userrepository.Repository
+	logger            logger.ILogger
+	postgres          *postgres.Postgres
+	telegram          telegram.ITelegram
+	uuid              uuid.IUUID
+}
+
+func New(
+	paymentRepository *paymentrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	telegram telegram.ITelegram,
+	uuid uuid.IUUID,
+) *CreateInvoice {
+	return &CreateInvoice{
+		paymentRepository: paymentRepository,
+		userRepository:    userRepository,
+		logger:            logger,
+		postgres:          postgres,
+		telegram:          telegram,
+		uuid:              uuid,
+	}
+}
+
+// Execute creates pending payment in telegram stars and invoice link to pay it.
+func (s *CreateInvoice) Execute(ctx context.Context, dto payment.CreateInvoiceDTO) (payment.CreateInvoiceResponse, error) {
+	s.logger.Debug("[create payment invoice] execute service")
+
+	var (
+		err            error
+		result         payment.CreateInvoiceResponse
+		userExists     bool
+		invoicePayload string
+		createResult   payment.CreateResult
+		invoiceLink    string
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return payment.CreateInvoiceResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return payment.CreateInvoiceResponse{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return payment.CreateInvoiceResponse{}, err
+	}
+
+	// generate invoice payload, telegram sends it back in pre checkout query and successful payment.
+	invoicePayload, err = s.uuid.Generate()
+	if err != nil {
+		return payment.CreateInvoiceResponse{}, err
+	}
+
+	if dto.Quantity == 0 { // if quantity is not set.
+		dto.Quantity = 1
+	}
+
+	// create payment (the database checks that the product is sold for telegram stars and fixes the price).
+	createResult, err = s.paymentRepository.Create.Execute(ctx, tx, payment.CreateDTO{
+		TelegramID:     dto.TelegramID,
+		ProductType:    dto.ProductType,
+		PlanCode:       dto.PlanCode,
+		ShopItemID:     dto.ShopItemID,
+		Quantity:       dto.Quantity,
+		InvoicePayload: invoicePayload,
+	})
+	if err != nil {
+		return payment.CreateInvoiceResponse{}, err
+	}
+
+	switch createResult.Status {
+	case payment.CreateStatusCreated:
+	case payment.CreateStatusNotAvailable:
+		err = apperrors.ErrPaymentProductIsNotAvailable
+		return payment.CreateInvoiceResponse{}, err
+	case payment.CreateStatusOutOfStock:
+		err = apperrors.ErrShopItemOutOfStock
+		return payment.CreateInvoiceResponse{}, err
+	case payment.CreateStatusLimitReached:
+		err = apperrors.ErrShopItemPurchaseLimitReached
+		return payment.CreateInvoiceResponse{}, err
+	default:
+		err = fmt.Errorf("unexpected payment create status: %s", createResult.Status)
+		return payment.CreateInvoiceResponse{}, err
+	}
+
+	if createResult.Payment == nil {
+		err = fmt.Errorf("payment create result is incomplete: %s", createResult.Status)
+		return payment.CreateInvoiceResponse{}, err
+	}
+
+	// create invoice link in telegram (on error the payment is rolled back).
+	invoiceLink, err = s.telegram.CreateInvoiceLink(ctx, telegram.CreateInvoiceLinkParams{
+		Title:       createResult.Payment.Title,
+		Description: createResult.Payment.Description,
+		Payload:     createResult.Payment.InvoicePayload,
+		Currency:    telegram.CurrencyStars,
+		Prices: []telegram.LabeledPrice{{
+			Label:  createResult.Payment.Title,
+			Amount: createResult.Payment.Amount,
+		}},
+	})
+	if err != nil {
+		return payment.CreateInvoiceResponse{}, err
+	}
+
+	result = payment.CreateInvoiceResponse{
+		Payment:     *createResult.Payment,
+		InvoiceLink: invoiceLink,
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return payment.CreateInvoiceResponse{}, err
+	}
+
+	return result, nil
+}
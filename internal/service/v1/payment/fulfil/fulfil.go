@@ -0,0 +1,109 @@
+package fulfil
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	paymentrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IFulfil --output=mocks --case=underscore
+type IFulfil interface {
+	Execute(ctx context.Context, dto payment.FulfilDTO) (payment.Payment, error)
+}
+
+type Fulfil struct {
+	paymentRepository *paymentrepository.Repository
+	logger            logger.ILogger
+	postgres          *postgres.Postgres
+	redis             *redis.Redis
+}
+
+func New(
+	paymentRepository *paymentrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Fulfil {
+	return &Fulfil{
+		paymentRepository: paymentRepository,
+		logger:            logger,
+		postgres:          postgres,
+		redis:             redis,
+	}
+}
+
+// Execute grants the product of successful payment.
+// Repeated successful payment update returns the already processed payment without granting the product again.
+func (s *Fulfil) Execute(ctx context.Context, dto payment.FulfilDTO) (payment.Payment, error) {
+	s.logger.Debug("[fulfil payment] execute service")
+
+	var (
+		err          error
+		result       payment.Payment
+		fulfilResult payment.FulfilResult
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// fulfil payment (the database locks the payment, grants the product once
+	// and returns the already processed payment on repeated update).
+	fulfilResult, err = s.paymentRepository.Fulfil.Execute(ctx, tx, dto)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+
+	switch fulfilResult.Status {
+	case payment.StatusPaid, payment.FulfilStatusDuplicate:
+	case payment.StatusFailed:
+		// stars are charged but the product is not granted, payment has to be refunded.
+		s.logger.Error("payment is paid but product was not granted", "invoice_payload", dto.InvoicePayload)
+	case payment.FulfilStatusNotFound:
+		err = apperrors.ErrPaymentDoesNotExist
+		return payment.Payment{}, err
+	default:
+		err = fmt.Errorf("unexpected payment fulfil status: %s", fulfilResult.Status)
+		return payment.Payment{}, err
+	}
+
+	if fulfilResult.Payment == nil {
+		err = fmt.Errorf("payment fulfil result is incomplete: %s", fulfilResult.Status)
+		return payment.Payment{}, err
+	}
+
+	result = *fulfilResult.Payment
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+
+	if result.ProductType == payment.ProductTypeSubscriptionPlan { // subscription is granted, so cached subscription snapshot is outdated.
+		if err := s.redis.SubscriptionSnapshot.Delete(ctx, result.TelegramID); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package precheckout
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	paymentrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/telegram"
+	"github.com/jackc/pgx/v5"
+)
+
+// Messages shown to the user by telegram when the pre checkout query is declined.
+const (
+	errorMessagePaymentNotFound = "Платёж не найден, создайте новый счёт"
+	errorMessagePaymentInvalid  = "Счёт больше недействителен, создайте новый счёт"
+)
+
+//go:generate mockery --name=IPreCheckout --output=mocks --case=underscore
+type IPreCheckout interface {
+	Execute(ctx context.Context, dto payment.PreCheckoutDTO) error
+}
+
+type PreCheckout struct {
+	paymentRepository *paymentrepository.Repository
+	logger            logger.ILogger
+	postgres          *postgres.Postgres
+	telegram          telegram.ITelegram
+}
+
+func New(
+	paymentRepository *paymentrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	telegram telegram.ITelegram,
+) *PreCheckout {
+	return &PreCheckout{
+		paymentRepository: paymentRepository,
+		logger:            logger,
+		postgres:          postgres,
+		telegram:          telegram,
+	}
+}
+
+// Execute checks that the payment of pre checkout query is pending and matches the query,
+// then confirms or declines the query in telegram.
+func (s *PreCheckout) Execute(ctx context.Context, dto payment.PreCheckoutDTO) error {
+	s.logger.Debug("[pre checkout payment] execute service")
+
+	var (
+		err          error
+		errorMessage string
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	errorMessage, err = s.check(ctx, tx, dto)
+	if err != nil {
+		return err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	// answer pre checkout query (empty error message confirms the payment).
+	return s.telegram.AnswerPreCheckoutQuery(ctx, telegram.AnswerPreCheckoutQueryParams{
+		PreCheckoutQueryID: dto.QueryID,
+		OK:                 errorMessage == "",
+		ErrorMessage:       errorMessage,
+	})
+}
+
+// check returns message for the user if the payment can not be paid.
+func (s *PreCheckout) check(ctx context.Context, tx pgx.Tx, dto payment.PreCheckoutDTO) (string, error) {
+	// check payment exists by invoice payload.
+	paymentExists, err := s.paymentRepository.ExistsByInvoicePayload.Execute(ctx, tx, dto.InvoicePayload)
+	if err != nil {
+		return "", err
+	}
+
+	if !paymentExists { // if payment does not exist.
+		return errorMessagePaymentNotFound, nil
+	}
+
+	// get payment by invoice payload.
+	paymentData, err := s.paymentRepository.GetByInvoicePayload.Execute(ctx, tx, dto.InvoicePayload)
+	if err != nil {
+		return "", err
+	}
+
+	if paymentData.Status != payment.StatusPending ||
+		paymentData.TelegramID != dto.TelegramID ||
+		paymentData.Currency != dto.Currency ||
+		paymentData.Amount != dto.TotalAmount {
+		s.logger.Warn("pre checkout query does not match payment", "payment_id", paymentData.ID, "status", paymentData.Status)
+		return errorMessagePaymentInvalid, nil
+	}
+
+	return "", nil
+}
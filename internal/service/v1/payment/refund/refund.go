@@ -0,0 +1,130 @@
+package refund
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/payment"
+	paymentrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/go-jedi/lingramm_backend/pkg/telegram"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IRefund --output=mocks --case=underscore
+type IRefund interface {
+	Execute(ctx context.Context, dto payment.RefundDTO) (payment.Payment, error)
+}
+
+type Refund struct {
+	paymentRepository *paymentrepository.Repository
+	logger            logger.ILogger
+	postgres          *postgres.Postgres
+	redis             *redis.Redis
+	telegram          telegram.ITelegram
+}
+
+func New(
+	paymentRepository *paymentrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+	telegram telegram.ITelegram,
+) *Refund {
+	return &Refund{
+		paymentRepository: paymentRepository,
+		logger:            logger,
+		postgres:          postgres,
+		redis:             redis,
+		telegram:          telegram,
+	}
+}
+
+// Execute refunds stars of paid payment and revokes granted product.
+func (s *Refund) Execute(ctx context.Context, dto payment.RefundDTO) (payment.Payment, error) {
+	s.logger.Debug("[refund payment] execute service")
+
+	var (
+		err           error
+		result        payment.Payment
+		paymentExists bool
+		paymentData   payment.Payment
+		userID        int64
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check payment exists by id.
+	paymentExists, err = s.paymentRepository.ExistsByID.Execute(ctx, tx, dto.PaymentID)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+
+	if !paymentExists { // if payment does not exist.
+		err = apperrors.ErrPaymentDoesNotExist
+		return payment.Payment{}, err
+	}
+
+	// get payment by id.
+	paymentData, err = s.paymentRepository.GetByID.Execute(ctx, tx, dto.PaymentID)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+
+	if !paymentData.IsRefundable() { // if payment is not paid or already refunded.
+		err = apperrors.ErrPaymentIsNotRefundable
+		return payment.Payment{}, err
+	}
+
+	// refund payment in database (the database locks the payment and revokes granted product).
+	result, err = s.paymentRepository.Refund.Execute(ctx, tx, dto.PaymentID)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+
+	userID, err = strconv.ParseInt(result.TelegramID, 10, 64)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+
+	// refund stars in telegram (on error the refund is rolled back).
+	err = s.telegram.RefundStarPayment(ctx, telegram.RefundStarPaymentParams{
+		UserID:                  userID,
+		TelegramPaymentChargeID: *result.TelegramPaymentChargeID,
+	})
+	if err != nil {
+		return payment.Payment{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+
+	if result.ProductType == payment.ProductTypeSubscriptionPlan { // subscription is shortened, so cached subscription snapshot is outdated.
+		if err := s.redis.SubscriptionSnapshot.Delete(ctx, result.TelegramID); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+		}
+	}
+
+	return result, nil
+}
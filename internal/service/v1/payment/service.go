@@ -0,0 +1,39 @@
+package payment
+
+import (
+	paymentrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/payment"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	createinvoice "github.com/go-jedi/lingramm_backend/internal/service/v1/payment/create_invoice"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/payment/fulfil"
+	precheckout "github.com/go-jedi/lingramm_backend/internal/service/v1/payment/pre_checkout"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/payment/refund"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/go-jedi/lingramm_backend/pkg/telegram"
+	"github.com/go-jedi/lingramm_backend/pkg/uuid"
+)
+
+type Service struct {
+	CreateInvoice createinvoice.ICreateInvoice
+	Fulfil        fulfil.IFulfil
+	PreCheckout   precheckout.IPreCheckout
+	Refund        refund.IRefund
+}
+
+func New(
+	paymentRepository *paymentrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+	telegram telegram.ITelegram,
+	uuid uuid.IUUID,
+) *Service {
+	return &Service{
+		CreateInvoice: createinvoice.New(paymentRepository, userRepository, logger, postgres, telegram, uuid),
+		Fulfil:        fulfil.New(paymentRepository, logger, postgres, redis),
+		PreCheckout:   precheckout.New(paymentRepository, logger, postgres, telegram),
+		Refund:        refund.New(paymentRepository, logger, postgres, redis, telegram),
+	}
+}
@@ -0,0 +1,86 @@
+package allcodesbybatchid
+
+import (
+	"context"
+	"log"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	promocoderepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllCodesByBatchID --output=mocks --case=underscore
+type IAllCodesByBatchID interface {
+	Execute(ctx context.Context, batchID int64) ([]promocode.PromoCode, error)
+}
+
+type AllCodesByBatchID struct {
+	promoCodeRepository *promocoderepository.Repository
+	logger              logger.ILogger
+	postgres            *postgres.Postgres
+}
+
+func New(
+	promoCodeRepository *promocoderepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllCodesByBatchID {
+	return &AllCodesByBatchID{
+		promoCodeRepository: promoCodeRepository,
+		logger:              logger,
+		postgres:            postgres,
+	}
+}
+
+func (s *AllCodesByBatchID) Execute(ctx context.Context, batchID int64) ([]promocode.PromoCode, error) {
+	s.logger.Debug("[get all promo codes by batch id] execute service")
+
+	var (
+		err         error
+		result      []promocode.PromoCode
+		batchExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check promo code batch exists by id.
+	batchExists, err = s.promoCodeRepository.ExistsBatchByID.Execute(ctx, tx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !batchExists { // if promo code batch does not exist.
+		err = apperrors.ErrPromoCodeBatchDoesNotExist
+		return nil, err
+	}
+
+	// get all promo codes by batch id.
+	result, err = s.promoCodeRepository.AllCodesByBatchID.Execute(ctx, tx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,218 @@
+package createbatch
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	promocoderepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/utils/randcode"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxGenerateAttempts number of attempts to generate codes that do not exist yet.
+const maxGenerateAttempts = 5
+
+//go:generate mockery --name=ICreateBatch --output=mocks --case=underscore
+type ICreateBatch interface {
+	Execute(ctx context.Context, dto promocode.CreateBatchDTO) (promocode.CreateBatchResponse, error)
+}
+
+type CreateBatch struct {
+	promoCodeRepository *promocoderepository.Repository
+	shopRepository      *shoprepository.Repository
+	logger              logger.ILogger
+	postgres            *postgres.Postgres
+}
+
+func New(
+	promoCodeRepository *promocoderepository.Repository,
+	shopRepository *shoprepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *CreateBatch {
+	return &CreateBatch{
+		promoCodeRepository: promoCodeRepository,
+		shopRepository:      shopRepository,
+		logger:              logger,
+		postgres:            postgres,
+	}
+}
+
+// Execute creates a batch with one custom code or count generated codes.
+func (s *CreateBatch) Execute(ctx context.Context, dto promocode.CreateBatchDTO) (promocode.CreateBatchResponse, error) {
+	s.logger.Debug("[create a new promo code batch] execute service")
+
+	var (
+		err             error
+		result          promocode.CreateBatchResponse
+		shopItemExists  bool
+		promoCodeExists bool
+		batch           promocode.Batch
+		codes           []string
+	)
+
+	if dto.Amount != nil && !dto.Amount.IsPositive() { // if amount is not positive.
+		err = apperrors.ErrPromoCodeAmountMustBePositive
+		return promocode.CreateBatchResponse{}, err
+	}
+
+	if dto.ExpiresAt != nil && !dto.ExpiresAt.After(time.Now()) { // if batch is already expired.
+		err = apperrors.ErrPromoCodeExpiresAtMustBeInFuture
+		return promocode.CreateBatchResponse{}, err
+	}
+
+	if dto.Code != nil {
+		code := strings.ToUpper(*dto.Code)
+		dto.Code = &code
+	}
+
+	if dto.Code == nil && dto.MaxRedemptions == nil { // generated codes are single-use by default.
+		maxRedemptions := int64(1)
+		dto.MaxRedemptions = &maxRedemptions
+	}
+
+	if dto.PerUserLimit == 0 { // if per user limit is not set.
+		dto.PerUserLimit = 1
+	}
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return promocode.CreateBatchResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if dto.ShopItemID != nil {
+		// check shop item exists by id.
+		shopItemExists, err = s.shopRepository.ExistsByID.Execute(ctx, tx, *dto.ShopItemID)
+		if err != nil {
+			return promocode.CreateBatchResponse{}, err
+		}
+
+		if !shopItemExists { // if shop item does not exist.
+			err = apperrors.ErrShopItemDoesNotExist
+			return promocode.CreateBatchResponse{}, err
+		}
+	}
+
+	if dto.Code != nil {
+		// check promo code exists by code.
+		promoCodeExists, err = s.promoCodeRepository.ExistsByCode.Execute(ctx, tx, *dto.Code)
+		if err != nil {
+			return promocode.CreateBatchResponse{}, err
+		}
+
+		if promoCodeExists { // if promo code already exists.
+			err = apperrors.ErrPromoCodeAlreadyExists
+			return promocode.CreateBatchResponse{}, err
+		}
+	}
+
+	// create batch.
+	batch, err = s.promoCodeRepository.CreateBatch.Execute(ctx, tx, dto)
+	if err != nil {
+		return promocode.CreateBatchResponse{}, err
+	}
+
+	// create codes of batch.
+	codes, err = s.createCodes(ctx, tx, batch.ID, dto)
+	if err != nil {
+		return promocode.CreateBatchResponse{}, err
+	}
+
+	result = promocode.CreateBatchResponse{
+		Batch: batch,
+		Codes: codes,
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return promocode.CreateBatchResponse{}, err
+	}
+
+	return result, nil
+}
+
+// createCodes creates custom code or generates count codes,
+// codes that already exist are generated again.
+func (s *CreateBatch) createCodes(ctx context.Context, tx pgx.Tx, batchID int64, dto promocode.CreateBatchDTO) ([]string, error) {
+	if dto.Code != nil {
+		created, err := s.promoCodeRepository.CreateCodes.Execute(ctx, tx, batchID, []string{*dto.Code})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(created) == 0 { // if code was created concurrently.
+			return nil, apperrors.ErrPromoCodeAlreadyExists
+		}
+
+		return created, nil
+	}
+
+	var prefix string
+	if dto.Prefix != nil {
+		prefix = strings.ToUpper(*dto.Prefix)
+	}
+
+	codes := make([]string, 0, dto.Count)
+
+	for attempt := 0; attempt < maxGenerateAttempts && int64(len(codes)) < dto.Count; attempt++ {
+		generated, err := s.generate(prefix, dto.Count-int64(len(codes)))
+		if err != nil {
+			return nil, err
+		}
+
+		created, err := s.promoCodeRepository.CreateCodes.Execute(ctx, tx, batchID, generated)
+		if err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, created...)
+	}
+
+	if int64(len(codes)) < dto.Count { // if unique codes could not be generated.
+		return nil, apperrors.ErrPromoCodeGenerationFailed
+	}
+
+	return codes, nil
+}
+
+// generate generates count distinct codes with prefix.
+func (s *CreateBatch) generate(prefix string, count int64) ([]string, error) {
+	var (
+		codes = make([]string, 0, count)
+		seen  = make(map[string]struct{}, count)
+	)
+
+	for int64(len(codes)) < count {
+		code, err := randcode.Generate(prefix, promocode.GeneratedCodeLength)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[code]; ok {
+			continue
+		}
+
+		seen[code] = struct{}{}
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
@@ -0,0 +1,182 @@
+package redeem
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	userbalance "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/user_balance"
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	promocoderepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IRedeem --output=mocks --case=underscore
+type IRedeem interface {
+	Execute(ctx context.Context, dto promocode.RedeemDTO) (promocode.Redemption, error)
+}
+
+type Redeem struct {
+	promoCodeRepository        *promocoderepository.Repository
+	userRepository             *userrepository.Repository
+	eventTypeRepository        *eventtyperepository.Repository
+	internalCurrencyRepository *internalcurrencyrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+	redis                      *redis.Redis
+}
+
+func New(
+	promoCodeRepository *promocoderepository.Repository,
+	userRepository *userrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Redeem {
+	return &Redeem{
+		promoCodeRepository:        promoCodeRepository,
+		userRepository:             userRepository,
+		eventTypeRepository:        eventTypeRepository,
+		internalCurrencyRepository: internalCurrencyRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+		redis:                      redis,
+	}
+}
+
+// Execute redeems promo code by user and grants its reward in the same transaction.
+func (s *Redeem) Execute(ctx context.Context, dto promocode.RedeemDTO) (promocode.Redemption, error) {
+	s.logger.Debug("[redeem promo code] execute service")
+
+	var (
+		err          error
+		result       promocode.Redemption
+		userExists   bool
+		redeemResult promocode.RedeemResult
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return promocode.Redemption{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return promocode.Redemption{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return promocode.Redemption{}, err
+	}
+
+	// redeem promo code (the database locks the code and checks limits of the batch).
+	redeemResult, err = s.promoCodeRepository.Redeem.Execute(ctx, tx, dto.TelegramID, dto.Code)
+	if err != nil {
+		return promocode.Redemption{}, err
+	}
+
+	switch redeemResult.Status {
+	case promocode.RedeemStatusRedeemed:
+	case promocode.RedeemStatusNotFound:
+		err = apperrors.ErrPromoCodeDoesNotExist
+		return promocode.Redemption{}, err
+	case promocode.RedeemStatusInactive:
+		err = apperrors.ErrPromoCodeIsNotActive
+		return promocode.Redemption{}, err
+	case promocode.RedeemStatusExpired:
+		err = apperrors.ErrPromoCodeExpired
+		return promocode.Redemption{}, err
+	case promocode.RedeemStatusExhausted:
+		err = apperrors.ErrPromoCodeExhausted
+		return promocode.Redemption{}, err
+	case promocode.RedeemStatusLimitReached:
+		err = apperrors.ErrPromoCodeRedemptionLimitReached
+		return promocode.Redemption{}, err
+	case promocode.RedeemStatusRewardUnavailable:
+		err = apperrors.ErrPromoCodeRewardIsNotAvailable
+		return promocode.Redemption{}, err
+	default:
+		err = fmt.Errorf("unexpected promo code redeem status: %s", redeemResult.Status)
+		return promocode.Redemption{}, err
+	}
+
+	if redeemResult.Redemption == nil {
+		err = fmt.Errorf("promo code redeem result is incomplete: %s", redeemResult.Status)
+		return promocode.Redemption{}, err
+	}
+
+	result = *redeemResult.Redemption
+
+	if result.RewardType == promocode.RewardTypeInternalCurrency {
+		// add reward to user balance.
+		err = s.addUserBalance(ctx, tx, result)
+		if err != nil {
+			return promocode.Redemption{}, err
+		}
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return promocode.Redemption{}, err
+	}
+
+	if result.RewardType == promocode.RewardTypeSubscriptionDays {
+		// subscription is extended, so cached subscription snapshot is outdated.
+		if err := s.redis.SubscriptionSnapshot.Delete(ctx, dto.TelegramID); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+		}
+	}
+
+	return result, nil
+}
+
+// addUserBalance adds internal currency reward of redemption to user balance.
+func (s *Redeem) addUserBalance(ctx context.Context, tx pgx.Tx, redemption promocode.Redemption) error {
+	if redemption.Amount == nil {
+		return fmt.Errorf("promo code redemption has no amount: %d", redemption.ID)
+	}
+
+	// get promo code reward event type data.
+	eventTypeData, err := s.eventTypeRepository.GetByName.Execute(ctx, tx, promocode.RewardEventType)
+	if err != nil {
+		return err
+	}
+
+	var (
+		description = fmt.Sprintf("Промокод %s", redemption.Code)
+		sourceType  = userbalance.SourceTypePromoCode
+	)
+
+	_, err = s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+		EventTypeID: eventTypeData.ID,
+		Amount:      *redemption.Amount,
+		TelegramID:  redemption.TelegramID,
+		Description: &description,
+		SourceType:  &sourceType,
+		SourceID:    &redemption.ID,
+	})
+
+	return err
+}
@@ -0,0 +1,73 @@
+package report
+
+import (
+	"context"
+	"log"
+
+	promocode "github.com/go-jedi/lingramm_backend/internal/domain/promo_code"
+	promocoderepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IReport --output=mocks --case=underscore
+type IReport interface {
+	Execute(ctx context.Context) ([]promocode.BatchReport, error)
+}
+
+type Report struct {
+	promoCodeRepository *promocoderepository.Repository
+	logger              logger.ILogger
+	postgres            *postgres.Postgres
+}
+
+func New(
+	promoCodeRepository *promocoderepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Report {
+	return &Report{
+		promoCodeRepository: promoCodeRepository,
+		logger:              logger,
+		postgres:            postgres,
+	}
+}
+
+func (s *Report) Execute(ctx context.Context) ([]promocode.BatchReport, error) {
+	s.logger.Debug("[get promo code report] execute service")
+
+	var (
+		err    error
+		result []promocode.BatchReport
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get promo code report.
+	result, err = s.promoCodeRepository.Report.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
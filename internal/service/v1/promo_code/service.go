@@ -0,0 +1,41 @@
+package promocode
+
+import (
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	promocoderepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/promo_code"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	allcodesbybatchid "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code/all_codes_by_batch_id"
+	createbatch "github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code/create_batch"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code/redeem"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/promo_code/report"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+)
+
+type Service struct {
+	AllCodesByBatchID allcodesbybatchid.IAllCodesByBatchID
+	CreateBatch       createbatch.ICreateBatch
+	Redeem            redeem.IRedeem
+	Report            report.IReport
+}
+
+func New(
+	promoCodeRepository *promocoderepository.Repository,
+	userRepository *userrepository.Repository,
+	shopRepository *shoprepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Service {
+	return &Service{
+		AllCodesByBatchID: allcodesbybatchid.New(promoCodeRepository, logger, postgres),
+		CreateBatch:       createbatch.New(promoCodeRepository, shopRepository, logger, postgres),
+		Redeem:            redeem.New(promoCodeRepository, userRepository, eventTypeRepository, internalCurrencyRepository, logger, postgres, redis),
+		Report:            report.New(promoCodeRepository, logger, postgres),
+	}
+}
@@ -0,0 +1,73 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/quest"
+	questrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/quest"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]quest.Quest, error)
+}
+
+type All struct {
+	questRepository *questrepository.Repository
+	logger          logger.ILogger
+	postgres        *postgres.Postgres
+}
+
+func New(
+	questRepository *questrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		questRepository: questRepository,
+		logger:          logger,
+		postgres:        postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]quest.Quest, error) {
+	s.logger.Debug("[get all quests] execute service")
+
+	var (
+		err    error
+		result []quest.Quest
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all quests.
+	result, err = s.questRepository.All.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package create
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/quest"
+	questrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/quest"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ICreate --output=mocks --case=underscore
+type ICreate interface {
+	Execute(ctx context.Context, dto quest.CreateDTO) (quest.Quest, error)
+}
+
+type Create struct {
+	questRepository *questrepository.Repository
+	logger          logger.ILogger
+	postgres        *postgres.Postgres
+}
+
+func New(
+	questRepository *questrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Create {
+	return &Create{
+		questRepository: questRepository,
+		logger:          logger,
+		postgres:        postgres,
+	}
+}
+
+func (s *Create) Execute(ctx context.Context, dto quest.CreateDTO) (quest.Quest, error) {
+	s.logger.Debug("[create a new quest] execute service")
+
+	var (
+		err    error
+		result quest.Quest
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return quest.Quest{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.HasRequirements() { // if quest has no requirements it would be completed immediately.
+		err = apperrors.ErrQuestRequirementsAreEmpty
+		return quest.Quest{}, err
+	}
+
+	if dto.RewardAmount != nil && !dto.RewardAmount.IsPositive() { // if reward amount is not positive.
+		err = apperrors.ErrQuestRewardAmountMustBePositive
+		return quest.Quest{}, err
+	}
+
+	// create new quest.
+	result, err = s.questRepository.Create.Execute(ctx, tx, dto)
+	if err != nil {
+		return quest.Quest{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return quest.Quest{}, err
+	}
+
+	return result, nil
+}
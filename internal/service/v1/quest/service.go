@@ -0,0 +1,25 @@
+package quest
+
+import (
+	questrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/quest"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/quest/all"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/quest/create"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	All    all.IAll
+	Create create.ICreate
+}
+
+func New(
+	questRepository *questrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		All:    all.New(questRepository, logger, postgres),
+		Create: create.New(questRepository, logger, postgres),
+	}
+}
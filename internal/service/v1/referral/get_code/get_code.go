@@ -0,0 +1,133 @@
+package getcode
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/utils/randcode"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxGenerateAttempts number of attempts to generate code that is not taken by another user.
+const maxGenerateAttempts = 5
+
+//go:generate mockery --name=IGetCode --output=mocks --case=underscore
+type IGetCode interface {
+	Execute(ctx context.Context, telegramID string) (referral.CodeResponse, error)
+}
+
+type GetCode struct {
+	referralRepository *referralrepository.Repository
+	userRepository     *userrepository.Repository
+	logger             logger.ILogger
+	postgres           *postgres.Postgres
+}
+
+func New(
+	referralRepository *referralrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetCode {
+	return &GetCode{
+		referralRepository: referralRepository,
+		userRepository:     userRepository,
+		logger:             logger,
+		postgres:           postgres,
+	}
+}
+
+// Execute returns referral code of the user, the code is generated on first request.
+func (s *GetCode) Execute(ctx context.Context, telegramID string) (referral.CodeResponse, error) {
+	s.logger.Debug("[get referral code] execute service")
+
+	var (
+		err        error
+		result     referral.CodeResponse
+		userExists bool
+		code       *referral.Code
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return referral.CodeResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return referral.CodeResponse{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return referral.CodeResponse{}, err
+	}
+
+	// get or create referral code.
+	code, err = s.getOrCreateCode(ctx, tx, telegramID)
+	if err != nil {
+		return referral.CodeResponse{}, err
+	}
+
+	result = referral.CodeResponse{
+		Code:       code.Code,
+		StartParam: referral.StartParamPrefix + code.Code,
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return referral.CodeResponse{}, err
+	}
+
+	return result, nil
+}
+
+// getOrCreateCode returns existing code of the user or saves a generated one,
+// code taken by another user is generated again.
+func (s *GetCode) getOrCreateCode(ctx context.Context, tx pgx.Tx, telegramID string) (*referral.Code, error) {
+	for attempt := 0; attempt < maxGenerateAttempts; attempt++ {
+		code, err := randcode.Generate("", referral.CodeLength)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := s.referralRepository.GetOrCreateCode.Execute(ctx, tx, telegramID, code)
+		if err != nil {
+			return nil, err
+		}
+
+		switch result.Status {
+		case referral.CodeStatusOK:
+			if result.ReferralCode == nil {
+				return nil, fmt.Errorf("referral code result is incomplete: %s", result.Status)
+			}
+
+			return result.ReferralCode, nil
+		case referral.CodeStatusTaken:
+			continue
+		default:
+			return nil, fmt.Errorf("unexpected referral code status: %s", result.Status)
+		}
+	}
+
+	return nil, apperrors.ErrReferralCodeGenerationFailed
+}
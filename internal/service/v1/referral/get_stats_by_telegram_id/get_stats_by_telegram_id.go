@@ -0,0 +1,90 @@
+package getstatsbytelegramid
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetStatsByTelegramID --output=mocks --case=underscore
+type IGetStatsByTelegramID interface {
+	Execute(ctx context.Context, telegramID string) (referral.Stats, error)
+}
+
+type GetStatsByTelegramID struct {
+	referralRepository *referralrepository.Repository
+	userRepository     *userrepository.Repository
+	logger             logger.ILogger
+	postgres           *postgres.Postgres
+}
+
+func New(
+	referralRepository *referralrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *GetStatsByTelegramID {
+	return &GetStatsByTelegramID{
+		referralRepository: referralRepository,
+		userRepository:     userRepository,
+		logger:             logger,
+		postgres:           postgres,
+	}
+}
+
+func (s *GetStatsByTelegramID) Execute(ctx context.Context, telegramID string) (referral.Stats, error) {
+	s.logger.Debug("[get referral stats by telegram id] execute service")
+
+	var (
+		err        error
+		result     referral.Stats
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return referral.Stats{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return referral.Stats{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return referral.Stats{}, err
+	}
+
+	// get referral stats by telegram id.
+	result, err = s.referralRepository.GetStatsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return referral.Stats{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return referral.Stats{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,27 @@
+package referral
+
+import (
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	getcode "github.com/go-jedi/lingramm_backend/internal/service/v1/referral/get_code"
+	getstatsbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/referral/get_stats_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	GetCode              getcode.IGetCode
+	GetStatsByTelegramID getstatsbytelegramid.IGetStatsByTelegramID
+}
+
+func New(
+	referralRepository *referralrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		GetCode:              getcode.New(referralRepository, userRepository, logger, postgres),
+		GetStatsByTelegramID: getstatsbytelegramid.New(referralRepository, userRepository, logger, postgres),
+	}
+}
@@ -0,0 +1,73 @@
+package all
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAll --output=mocks --case=underscore
+type IAll interface {
+	Execute(ctx context.Context) ([]shop.ShopItem, error)
+}
+
+type All struct {
+	shopRepository *shoprepository.Repository
+	logger         logger.ILogger
+	postgres       *postgres.Postgres
+}
+
+func New(
+	shopRepository *shoprepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *All {
+	return &All{
+		shopRepository: shopRepository,
+		logger:         logger,
+		postgres:       postgres,
+	}
+}
+
+func (s *All) Execute(ctx context.Context) ([]shop.ShopItem, error) {
+	s.logger.Debug("[get all shop items] execute service")
+
+	var (
+		err    error
+		result []shop.ShopItem
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all shop items.
+	result, err = s.shopRepository.All.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package allavailable
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllAvailable --output=mocks --case=underscore
+type IAllAvailable interface {
+	Execute(ctx context.Context) ([]shop.ShopItem, error)
+}
+
+type AllAvailable struct {
+	shopRepository *shoprepository.Repository
+	logger         logger.ILogger
+	postgres       *postgres.Postgres
+}
+
+func New(
+	shopRepository *shoprepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllAvailable {
+	return &AllAvailable{
+		shopRepository: shopRepository,
+		logger:         logger,
+		postgres:       postgres,
+	}
+}
+
+func (s *AllAvailable) Execute(ctx context.Context) ([]shop.ShopItem, error) {
+	s.logger.Debug("[get all available shop items] execute service")
+
+	var (
+		err    error
+		result []shop.ShopItem
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all available shop items.
+	result, err = s.shopRepository.AllAvailable.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package deletebyid
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IDeleteByID --output=mocks --case=underscore
+type IDeleteByID interface {
+	Execute(ctx context.Context, id int64) (shop.ShopItem, error)
+}
+
+type DeleteByID struct {
+	shopRepository *shoprepository.Repository
+	logger         logger.ILogger
+	postgres       *postgres.Postgres
+}
+
+func New(
+	shopRepository *shoprepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *DeleteByID {
+	return &DeleteByID{
+		shopRepository: shopRepository,
+		logger:         logger,
+		postgres:       postgres,
+	}
+}
+
+func (s *DeleteByID) Execute(ctx context.Context, id int64) (shop.ShopItem, error) {
+	s.logger.Debug("[delete shop item by id] execute service")
+
+	var (
+		err            error
+		result         shop.ShopItem
+		shopItemExists bool
+		shopItemInUse  bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check shop item exists by id.
+	shopItemExists, err = s.shopRepository.ExistsByID.Execute(ctx, tx, id)
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+
+	if !shopItemExists { // if shop item does not exist.
+		err = apperrors.ErrShopItemDoesNotExist
+		return shop.ShopItem{}, err
+	}
+
+	// check shop item in use by id.
+	shopItemInUse, err = s.shopRepository.ExistsInUseByID.Execute(ctx, tx, id)
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+
+	if shopItemInUse { // if shop item was purchased, it can only be deactivated.
+		err = apperrors.ErrShopItemInUse
+		return shop.ShopItem{}, err
+	}
+
+	// delete shop item by id.
+	result, err = s.shopRepository.DeleteByID.Execute(ctx, tx, id)
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+
+	return result, nil
+}
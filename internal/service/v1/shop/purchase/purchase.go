@@ -0,0 +1,174 @@
+package purchase
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	eventtype "github.com/go-jedi/lingramm_backend/internal/domain/event_type"
+	userbalance "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/user_balance"
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IPurchase --output=mocks --case=underscore
+type IPurchase interface {
+	Execute(ctx context.Context, dto shop.PurchaseDTO) (shop.PurchaseResponse, error)
+}
+
+type Purchase struct {
+	shopRepository             *shoprepository.Repository
+	userRepository             *userrepository.Repository
+	eventTypeRepository        *eventtyperepository.Repository
+	internalCurrencyRepository *internalcurrencyrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	shopRepository *shoprepository.Repository,
+	userRepository *userrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Purchase {
+	return &Purchase{
+		shopRepository:             shopRepository,
+		userRepository:             userRepository,
+		eventTypeRepository:        eventTypeRepository,
+		internalCurrencyRepository: internalCurrencyRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+// Execute purchases shop item for internal currency.
+// Repeated request with the same idempotency key returns the existing purchase without debiting currency again.
+func (s *Purchase) Execute(ctx context.Context, dto shop.PurchaseDTO) (shop.PurchaseResponse, error) {
+	s.logger.Debug("[purchase shop item] execute service")
+
+	var (
+		err            error
+		result         shop.PurchaseResponse
+		userExists     bool
+		shopItemExists bool
+		eventTypeData  eventtype.EventType
+		purchaseResult shop.PurchaseResult
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return shop.PurchaseResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return shop.PurchaseResponse{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return shop.PurchaseResponse{}, err
+	}
+
+	// check shop item exists by id.
+	shopItemExists, err = s.shopRepository.ExistsByID.Execute(ctx, tx, dto.ShopItemID)
+	if err != nil {
+		return shop.PurchaseResponse{}, err
+	}
+
+	if !shopItemExists { // if shop item does not exist.
+		err = apperrors.ErrShopItemDoesNotExist
+		return shop.PurchaseResponse{}, err
+	}
+
+	// get shop purchase event type data.
+	eventTypeData, err = s.eventTypeRepository.GetByName.Execute(ctx, tx, shop.PurchaseEventType)
+	if err != nil {
+		return shop.PurchaseResponse{}, err
+	}
+
+	// purchase shop item (the database locks user purchases, checks availability,
+	// stock and per user limit and adds item to user inventory).
+	purchaseResult, err = s.shopRepository.Purchase.Execute(ctx, tx, dto)
+	if err != nil {
+		return shop.PurchaseResponse{}, err
+	}
+
+	switch purchaseResult.Status {
+	case shop.PurchaseStatusCreated, shop.PurchaseStatusDuplicate:
+	case shop.PurchaseStatusNotAvailable:
+		err = apperrors.ErrShopItemIsNotAvailable
+		return shop.PurchaseResponse{}, err
+	case shop.PurchaseStatusOutOfStock:
+		err = apperrors.ErrShopItemOutOfStock
+		return shop.PurchaseResponse{}, err
+	case shop.PurchaseStatusLimitReached:
+		err = apperrors.ErrShopItemPurchaseLimitReached
+		return shop.PurchaseResponse{}, err
+	case shop.PurchaseStatusIdempotencyConflict:
+		err = apperrors.ErrIdempotencyKeyConflict
+		return shop.PurchaseResponse{}, err
+	default:
+		err = fmt.Errorf("unexpected shop purchase status: %s", purchaseResult.Status)
+		return shop.PurchaseResponse{}, err
+	}
+
+	if purchaseResult.Purchase == nil || purchaseResult.InventoryItem == nil {
+		err = fmt.Errorf("shop purchase result is incomplete: %s", purchaseResult.Status)
+		return shop.PurchaseResponse{}, err
+	}
+
+	result = shop.PurchaseResponse{
+		Purchase:      *purchaseResult.Purchase,
+		InventoryItem: *purchaseResult.InventoryItem,
+		IsDuplicate:   purchaseResult.Status == shop.PurchaseStatusDuplicate,
+	}
+
+	if !result.IsDuplicate { // currency is debited only for a new purchase.
+		var (
+			description = fmt.Sprintf("Покупка в магазине: товар #%d (%d шт.)", dto.ShopItemID, dto.Quantity)
+			sourceType  = userbalance.SourceTypeShopPurchase
+		)
+
+		// reduce user balance (insufficient balance rolls back the whole purchase).
+		_, err = s.internalCurrencyRepository.ReduceUserBalance.Execute(ctx, tx, userbalance.ReduceUserBalanceDTO{
+			EventTypeID: eventTypeData.ID,
+			Amount:      result.Purchase.TotalPrice,
+			TelegramID:  dto.TelegramID,
+			Description: description,
+			SourceType:  &sourceType,
+			SourceID:    &result.Purchase.ID,
+		})
+		if err != nil {
+			return shop.PurchaseResponse{}, err
+		}
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return shop.PurchaseResponse{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,43 @@
+package shop
+
+import (
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/shop/all"
+	allavailable "github.com/go-jedi/lingramm_backend/internal/service/v1/shop/all_available"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/shop/create"
+	deletebyid "github.com/go-jedi/lingramm_backend/internal/service/v1/shop/delete_by_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/shop/purchase"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/shop/update"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	All          all.IAll
+	AllAvailable allavailable.IAllAvailable
+	Create       create.ICreate
+	DeleteByID   deletebyid.IDeleteByID
+	Purchase     purchase.IPurchase
+	Update       update.IUpdate
+}
+
+func New(
+	shopRepository *shoprepository.Repository,
+	userRepository *userrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		All:          all.New(shopRepository, logger, postgres),
+		AllAvailable: allavailable.New(shopRepository, logger, postgres),
+		Create:       create.New(shopRepository, logger, postgres),
+		DeleteByID:   deletebyid.New(shopRepository, logger, postgres),
+		Purchase:     purchase.New(shopRepository, userRepository, eventTypeRepository, internalCurrencyRepository, logger, postgres),
+		Update:       update.New(shopRepository, logger, postgres),
+	}
+}
@@ -0,0 +1,96 @@
+package update
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/shop"
+	shoprepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/shop"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdate --output=mocks --case=underscore
+type IUpdate interface {
+	Execute(ctx context.Context, dto shop.UpdateDTO) (shop.ShopItem, error)
+}
+
+type Update struct {
+	shopRepository *shoprepository.Repository
+	logger         logger.ILogger
+	postgres       *postgres.Postgres
+}
+
+func New(
+	shopRepository *shoprepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Update {
+	return &Update{
+		shopRepository: shopRepository,
+		logger:         logger,
+		postgres:       postgres,
+	}
+}
+
+func (s *Update) Execute(ctx context.Context, dto shop.UpdateDTO) (shop.ShopItem, error) {
+	s.logger.Debug("[update shop item] execute service")
+
+	var (
+		err            error
+		result         shop.ShopItem
+		shopItemExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	if !dto.Price.IsPositive() { // if price is not positive.
+		err = apperrors.ErrShopItemPriceMustBePositive
+		return shop.ShopItem{}, err
+	}
+
+	if !dto.IsAvailabilityWindowValid() { // if availability window bounds are not ordered.
+		err = apperrors.ErrShopItemAvailabilityWindowIsInvalid
+		return shop.ShopItem{}, err
+	}
+
+	// check shop item exists by id.
+	shopItemExists, err = s.shopRepository.ExistsByID.Execute(ctx, tx, dto.ID)
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+
+	if !shopItemExists { // if shop item does not exist.
+		err = apperrors.ErrShopItemDoesNotExist
+		return shop.ShopItem{}, err
+	}
+
+	// update shop item.
+	result, err = s.shopRepository.Update.Execute(ctx, tx, dto)
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return shop.ShopItem{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,90 @@
+package allhistorybytelegramid
+
+import (
+	"context"
+	"log"
+
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	streakprotectionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllHistoryByTelegramID --output=mocks --case=underscore
+type IAllHistoryByTelegramID interface {
+	Execute(ctx context.Context, telegramID string) ([]streakprotection.History, error)
+}
+
+type AllHistoryByTelegramID struct {
+	streakProtectionRepository *streakprotectionrepository.Repository
+	userRepository             *userrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	streakProtectionRepository *streakprotectionrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllHistoryByTelegramID {
+	return &AllHistoryByTelegramID{
+		streakProtectionRepository: streakProtectionRepository,
+		userRepository:             userRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+func (s *AllHistoryByTelegramID) Execute(ctx context.Context, telegramID string) ([]streakprotection.History, error) {
+	s.logger.Debug("[get all streak protection history by telegram id] execute service")
+
+	var (
+		err        error
+		result     []streakprotection.History
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return nil, err
+	}
+
+	// get all streak protection history by telegram id.
+	result, err = s.streakProtectionRepository.AllHistoryByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package buyfreeze
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	eventtype "github.com/go-jedi/lingramm_backend/internal/domain/event_type"
+	userbalance "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/user_balance"
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	streakprotectionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+//go:generate mockery --name=IBuyFreeze --output=mocks --case=underscore
+type IBuyFreeze interface {
+	Execute(ctx context.Context, dto streakprotection.BuyFreezeDTO) (streakprotection.StreakProtection, error)
+}
+
+type BuyFreeze struct {
+	streakProtectionRepository *streakprotectionrepository.Repository
+	userRepository             *userrepository.Repository
+	eventTypeRepository        *eventtyperepository.Repository
+	internalCurrencyRepository *internalcurrencyrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+}
+
+func New(
+	streakProtectionRepository *streakprotectionrepository.Repository,
+	userRepository *userrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *BuyFreeze {
+	return &BuyFreeze{
+		streakProtectionRepository: streakProtectionRepository,
+		userRepository:             userRepository,
+		eventTypeRepository:        eventTypeRepository,
+		internalCurrencyRepository: internalCurrencyRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+	}
+}
+
+// Execute buys streak freezes for internal currency.
+// Freezes are limited, so purchase exceeding the limit is rejected entirely.
+func (s *BuyFreeze) Execute(ctx context.Context, dto streakprotection.BuyFreezeDTO) (streakprotection.StreakProtection, error) {
+	s.logger.Debug("[buy streak freeze] execute service")
+
+	var (
+		err           error
+		result        streakprotection.StreakProtection
+		userExists    bool
+		eventTypeData eventtype.EventType
+		history       *streakprotection.History
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return streakprotection.StreakProtection{}, err
+	}
+
+	// get streak freeze purchase event type data.
+	eventTypeData, err = s.eventTypeRepository.GetByName.Execute(ctx, tx, streakprotection.FreezePurchaseEventType)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	if !eventTypeData.IsActive || eventTypeData.Amount == nil || !eventTypeData.Amount.IsPositive() { // if price is not set.
+		err = apperrors.ErrStreakProtectionPriceIsNotSet
+		return streakprotection.StreakProtection{}, err
+	}
+
+	price := eventTypeData.Amount.Mul(decimal.NewFromInt(dto.Quantity))
+
+	// add streak freezes (the database locks user stats and caps freezes by the limit).
+	history, err = s.streakProtectionRepository.AddFreezes.Execute(ctx, tx, streakprotection.AddFreezesDTO{
+		TelegramID: dto.TelegramID,
+		Quantity:   dto.Quantity,
+		Action:     streakprotection.ActionFreezePurchase,
+		Price:      &price,
+	})
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	if history == nil || history.Quantity < dto.Quantity { // if freezes exceed the limit.
+		err = apperrors.ErrStreakFreezeLimitReached
+		return streakprotection.StreakProtection{}, err
+	}
+
+	var (
+		description = fmt.Sprintf("Покупка заморозки streak (%d шт.)", dto.Quantity)
+		sourceType  = userbalance.SourceTypeStreakFreeze
+	)
+
+	// reduce user balance.
+	_, err = s.internalCurrencyRepository.ReduceUserBalance.Execute(ctx, tx, userbalance.ReduceUserBalanceDTO{
+		EventTypeID: eventTypeData.ID,
+		Amount:      price,
+		TelegramID:  dto.TelegramID,
+		Description: description,
+		SourceType:  &sourceType,
+		SourceID:    &history.ID,
+	})
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	// get streak protection by telegram id.
+	result, err = s.streakProtectionRepository.GetByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,201 @@
+package repair
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	eventtype "github.com/go-jedi/lingramm_backend/internal/domain/event_type"
+	userbalance "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/user_balance"
+	streakprotection "github.com/go-jedi/lingramm_backend/internal/domain/streak_protection"
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	streakprotectionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection"
+	subscriptionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IRepair --output=mocks --case=underscore
+type IRepair interface {
+	Execute(ctx context.Context, dto streakprotection.RepairDTO) (streakprotection.StreakProtection, error)
+}
+
+type Repair struct {
+	streakProtectionRepository *streakprotectionrepository.Repository
+	userRepository             *userrepository.Repository
+	eventTypeRepository        *eventtyperepository.Repository
+	internalCurrencyRepository *internalcurrencyrepository.Repository
+	subscriptionRepository     *subscriptionrepository.Repository
+	logger                     logger.ILogger
+	postgres                   *postgres.Postgres
+	redis                      *redis.Redis
+}
+
+func New(
+	streakProtectionRepository *streakprotectionrepository.Repository,
+	userRepository *userrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	subscriptionRepository *subscriptionrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Repair {
+	return &Repair{
+		streakProtectionRepository: streakProtectionRepository,
+		userRepository:             userRepository,
+		eventTypeRepository:        eventTypeRepository,
+		internalCurrencyRepository: internalCurrencyRepository,
+		subscriptionRepository:     subscriptionRepository,
+		logger:                     logger,
+		postgres:                   postgres,
+		redis:                      redis,
+	}
+}
+
+// Execute restores lost streak for internal currency.
+// Repair is available only within a limited time after the streak was lost.
+// Subscription with streak repair entitlement makes repair free
+// (limit of the entitlement is count of free repairs per month).
+func (s *Repair) Execute(ctx context.Context, dto streakprotection.RepairDTO) (streakprotection.StreakProtection, error) {
+	s.logger.Debug("[repair streak] execute service")
+
+	var (
+		err           error
+		result        streakprotection.StreakProtection
+		userExists    bool
+		isFree        bool
+		eventTypeData eventtype.EventType
+		history       *streakprotection.History
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return streakprotection.StreakProtection{}, err
+	}
+
+	// check repair is free by subscription.
+	isFree, err = s.isFree(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	if !isFree {
+		// get streak repair purchase event type data.
+		eventTypeData, err = s.eventTypeRepository.GetByName.Execute(ctx, tx, streakprotection.RepairPurchaseEventType)
+		if err != nil {
+			return streakprotection.StreakProtection{}, err
+		}
+
+		if !eventTypeData.IsActive || eventTypeData.Amount == nil || !eventTypeData.Amount.IsPositive() { // if price is not set.
+			err = apperrors.ErrStreakProtectionPriceIsNotSet
+			return streakprotection.StreakProtection{}, err
+		}
+	}
+
+	// repair streak (the database locks user stats and checks repair is still available).
+	// free repair is saved in history without price.
+	history, err = s.streakProtectionRepository.Repair.Execute(ctx, tx, dto.TelegramID, eventTypeData.Amount)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	if history == nil { // if streak was not lost or repair time is over.
+		err = apperrors.ErrStreakRepairUnavailable
+		return streakprotection.StreakProtection{}, err
+	}
+
+	if !isFree {
+		var (
+			description = fmt.Sprintf("Восстановление streak (%d дн.)", history.StreakDays)
+			sourceType  = userbalance.SourceTypeStreakRepair
+		)
+
+		// reduce user balance.
+		_, err = s.internalCurrencyRepository.ReduceUserBalance.Execute(ctx, tx, userbalance.ReduceUserBalanceDTO{
+			EventTypeID: eventTypeData.ID,
+			Amount:      *eventTypeData.Amount,
+			TelegramID:  dto.TelegramID,
+			Description: description,
+			SourceType:  &sourceType,
+			SourceID:    &history.ID,
+		})
+		if err != nil {
+			return streakprotection.StreakProtection{}, err
+		}
+	}
+
+	// get streak protection by telegram id.
+	result, err = s.streakProtectionRepository.GetByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return streakprotection.StreakProtection{}, err
+	}
+
+	// streak changed, so cached achievement progress is outdated.
+	if err := s.redis.AchievementProgress.Delete(ctx, dto.TelegramID); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to delete achievement progress from cache: %v", err))
+	}
+
+	return result, nil
+}
+
+// isFree reports whether subscription of the user grants free streak repair
+// and free repairs of the current month are not used up.
+func (s *Repair) isFree(ctx context.Context, tx pgx.Tx, telegramID string) (bool, error) {
+	// get subscription snapshot by telegram id.
+	snapshot, err := s.subscriptionRepository.GetSnapshotByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return false, err
+	}
+
+	entitlement, ok := snapshot.Entitlement(subscription.EntitlementStreakRepair, time.Now())
+	if !ok { // if subscription does not grant free repair.
+		return false, nil
+	}
+
+	if entitlement.Limit == nil { // if free repairs are unlimited.
+		return true, nil
+	}
+
+	// count free repairs of the current month.
+	count, err := s.streakProtectionRepository.CountFreeRepairsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return false, err
+	}
+
+	return count < *entitlement.Limit, nil
+}
@@ -0,0 +1,41 @@
+package streakprotection
+
+import (
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
+	streakprotectionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/streak_protection"
+	subscriptionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	allhistorybytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection/all_history_by_telegram_id"
+	buyfreeze "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection/buy_freeze"
+	getbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection/get_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/streak_protection/repair"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+)
+
+type Service struct {
+	AllHistoryByTelegramID allhistorybytelegramid.IAllHistoryByTelegramID
+	BuyFreeze              buyfreeze.IBuyFreeze
+	GetByTelegramID        getbytelegramid.IGetByTelegramID
+	Repair                 repair.IRepair
+}
+
+func New(
+	streakProtectionRepository *streakprotectionrepository.Repository,
+	userRepository *userrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	subscriptionRepository *subscriptionrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Service {
+	return &Service{
+		AllHistoryByTelegramID: allhistorybytelegramid.New(streakProtectionRepository, userRepository, logger, postgres),
+		BuyFreeze:              buyfreeze.New(streakProtectionRepository, userRepository, eventTypeRepository, internalCurrencyRepository, logger, postgres),
+		GetByTelegramID:        getbytelegramid.New(streakProtectionRepository, userRepository, logger, postgres),
+		Repair:                 repair.New(streakProtectionRepository, userRepository, eventTypeRepository, internalCurrencyRepository, subscriptionRepository, logger, postgres, redis),
+	}
+}
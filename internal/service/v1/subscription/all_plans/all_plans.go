@@ -0,0 +1,77 @@
+package allplans
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	subscriptionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllPlans --output=mocks --case=underscore
+type IAllPlans interface {
+	Execute(ctx context.Context) ([]subscription.Plan, error)
+}
+
+type AllPlans struct {
+	subscriptionRepository *subscriptionrepository.Repository
+	userRepository         *userrepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+}
+
+func New(
+	subscriptionRepository *subscriptionrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllPlans {
+	return &AllPlans{
+		subscriptionRepository: subscriptionRepository,
+		userRepository:         userRepository,
+		logger:                 logger,
+		postgres:               postgres,
+	}
+}
+
+func (s *AllPlans) Execute(ctx context.Context) ([]subscription.Plan, error) {
+	s.logger.Debug("[get all subscription plans] execute service")
+
+	var (
+		err    error
+		result []subscription.Plan
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// get all subscription plans.
+	result, err = s.subscriptionRepository.AllPlans.Execute(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
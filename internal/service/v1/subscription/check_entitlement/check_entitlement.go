@@ -0,0 +1,51 @@
+package checkentitlement
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	getsnapshotbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/get_snapshot_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+)
+
+//go:generate mockery --name=ICheckEntitlement --output=mocks --case=underscore
+type ICheckEntitlement interface {
+	Execute(ctx context.Context, telegramID string, code string) (subscription.Entitlement, subscription.Snapshot, error)
+}
+
+type CheckEntitlement struct {
+	getSnapshotByTelegramID getsnapshotbytelegramid.IGetSnapshotByTelegramID
+	logger                  logger.ILogger
+}
+
+func New(
+	getSnapshotByTelegramID getsnapshotbytelegramid.IGetSnapshotByTelegramID,
+	logger logger.ILogger,
+) *CheckEntitlement {
+	return &CheckEntitlement{
+		getSnapshotByTelegramID: getSnapshotByTelegramID,
+		logger:                  logger,
+	}
+}
+
+// Execute checks that subscription of the user grants the entitlement and returns it.
+// If it does not, apperrors.ErrPremiumRequired is returned with the snapshot,
+// so the caller can tell the client what subscription the user has.
+func (s *CheckEntitlement) Execute(ctx context.Context, telegramID string, code string) (subscription.Entitlement, subscription.Snapshot, error) {
+	s.logger.Debug("[check subscription entitlement] execute service")
+
+	// get subscription snapshot by telegram id.
+	snapshot, err := s.getSnapshotByTelegramID.Execute(ctx, telegramID)
+	if err != nil {
+		return subscription.Entitlement{}, subscription.Snapshot{}, err
+	}
+
+	entitlement, ok := snapshot.Entitlement(code, time.Now())
+	if !ok { // if subscription does not grant the entitlement.
+		return subscription.Entitlement{}, snapshot, apperrors.ErrPremiumRequired
+	}
+
+	return entitlement, snapshot, nil
+}
@@ -0,0 +1,161 @@
+package expire
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/notification"
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	subscriptionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/rabbitmq"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IExpire --output=mocks --case=underscore
+type IExpire interface {
+	Execute(ctx context.Context, dto subscription.ExpireDTO) ([]subscription.Expiring, error)
+}
+
+type Expire struct {
+	subscriptionRepository *subscriptionrepository.Repository
+	notificationRepository *notificationrepository.Repository
+	logger                 logger.ILogger
+	rabbitMQ               *rabbitmq.RabbitMQ
+	postgres               *postgres.Postgres
+	redis                  *redis.Redis
+}
+
+func New(
+	subscriptionRepository *subscriptionrepository.Repository,
+	notificationRepository *notificationrepository.Repository,
+	logger logger.ILogger,
+	rabbitMQ *rabbitmq.RabbitMQ,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Expire {
+	return &Expire{
+		subscriptionRepository: subscriptionRepository,
+		notificationRepository: notificationRepository,
+		logger:                 logger,
+		rabbitMQ:               rabbitMQ,
+		postgres:               postgres,
+		redis:                  redis,
+	}
+}
+
+// Execute deactivates a batch of subscriptions whose grace period has ended,
+// writes subscription history and notifies users.
+func (s *Expire) Execute(ctx context.Context, dto subscription.ExpireDTO) ([]subscription.Expiring, error) {
+	s.logger.Debug("[expire subscriptions] execute service")
+
+	var (
+		err           error
+		result        []subscription.Expiring
+		notifications []notification.Notification
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// expire subscriptions.
+	result, err = s.subscriptionRepository.Expire.Execute(ctx, tx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	// create notifications in database.
+	notifications, err = s.createNotifications(ctx, tx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// subscriptions are deactivated, so cached subscription snapshots are outdated.
+	for i := range result {
+		if err := s.redis.SubscriptionSnapshot.Delete(ctx, result[i].TelegramID); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+		}
+	}
+
+	s.sendNotifications(ctx, notifications)
+
+	return result, nil
+}
+
+// createNotifications create notifications about expired subscriptions.
+func (s *Expire) createNotifications(
+	ctx context.Context,
+	tx pgx.Tx,
+	expired []subscription.Expiring,
+) ([]notification.Notification, error) {
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	dto := make([]notification.CreateDTO, 0, len(expired))
+
+	for i := range expired {
+		dto = append(dto, notification.CreateDTO{
+			Message: notification.Message{
+				Title: "Уведомление",
+				Text:  expired[i].ExpiredText(),
+			},
+			Type:       notification.SubscriptionType,
+			TelegramID: expired[i].TelegramID,
+		})
+	}
+
+	// create notifications.
+	return s.notificationRepository.CreateNotifications.Execute(ctx, tx, dto)
+}
+
+// sendNotifications send notifications to users that are online.
+func (s *Expire) sendNotifications(ctx context.Context, notifications []notification.Notification) {
+	for i := range notifications {
+		// check exists user is online for send notification with message broker.
+		isUserPresence, err := s.redis.UserPresence.Exists(ctx, notifications[i].TelegramID)
+		if err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to check user presence: %v", err))
+			continue
+		}
+
+		if !isUserPresence {
+			continue
+		}
+
+		data := notification.SendNotificationDTO{
+			ID:         notifications[i].ID,
+			Message:    notifications[i].Message,
+			Type:       notifications[i].Type,
+			TelegramID: notifications[i].TelegramID,
+			CreatedAt:  notifications[i].CreatedAt,
+		}
+
+		// send notification in rabbitmq.
+		if err := s.rabbitMQ.Notification.Publisher.Execute(ctx, data.TelegramID, data); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to publish notification by rabbitmq: %v", err))
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package getsnapshotbytelegramid
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	subscriptionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IGetSnapshotByTelegramID --output=mocks --case=underscore
+type IGetSnapshotByTelegramID interface {
+	Execute(ctx context.Context, telegramID string) (subscription.Snapshot, error)
+}
+
+type GetSnapshotByTelegramID struct {
+	subscriptionRepository *subscriptionrepository.Repository
+	userRepository         *userrepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+	redis                  *redis.Redis
+}
+
+func New(
+	subscriptionRepository *subscriptionrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *GetSnapshotByTelegramID {
+	return &GetSnapshotByTelegramID{
+		subscriptionRepository: subscriptionRepository,
+		userRepository:         userRepository,
+		logger:                 logger,
+		postgres:               postgres,
+		redis:                  redis,
+	}
+}
+
+// Execute returns subscription state of the user with entitlements of the plan.
+func (s *GetSnapshotByTelegramID) Execute(ctx context.Context, telegramID string) (subscription.Snapshot, error) {
+	s.logger.Debug("[get subscription snapshot by telegram id] execute service")
+
+	// get subscription snapshot from cache (it is removed every time subscription changes).
+	cached, err := s.redis.SubscriptionSnapshot.Get(ctx, telegramID)
+	if err == nil {
+		return cached, nil
+	}
+
+	var (
+		result     subscription.Snapshot
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return subscription.Snapshot{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return subscription.Snapshot{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return subscription.Snapshot{}, err
+	}
+
+	// get subscription snapshot by telegram id.
+	result, err = s.subscriptionRepository.GetSnapshotByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return subscription.Snapshot{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return subscription.Snapshot{}, err
+	}
+
+	// save subscription snapshot in cache.
+	if err := s.redis.SubscriptionSnapshot.Set(ctx, telegramID, result); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to cache subscription snapshot: %v", err))
+	}
+
+	return result, nil
+}
@@ -1,27 +1,54 @@
 package subscription
 
 import (
+	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
 	subscriptionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription"
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	allplans "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/all_plans"
+	checkentitlement "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/check_entitlement"
 	existsbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/exists_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/expire"
 	getbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/get_by_telegram_id"
+	getsnapshotbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/get_snapshot_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/remind"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/subscription/subscribe"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/rabbitmq"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
 )
 
 type Service struct {
-	ExistsByTelegramID existsbytelegramid.IExistsByTelegramID
-	GetByTelegramID    getbytelegramid.IGetByTelegramID
+	AllPlans                allplans.IAllPlans
+	CheckEntitlement        checkentitlement.ICheckEntitlement
+	ExistsByTelegramID      existsbytelegramid.IExistsByTelegramID
+	Expire                  expire.IExpire
+	GetByTelegramID         getbytelegramid.IGetByTelegramID
+	GetSnapshotByTelegramID getsnapshotbytelegramid.IGetSnapshotByTelegramID
+	Remind                  remind.IRemind
+	Subscribe               subscribe.ISubscribe
 }
 
 func New(
 	subscriptionRepository *subscriptionrepository.Repository,
+	notificationRepository *notificationrepository.Repository,
 	userRepository *userrepository.Repository,
 	logger logger.ILogger,
+	rabbitMQ *rabbitmq.RabbitMQ,
 	postgres *postgres.Postgres,
+	redis *redis.Redis,
 ) *Service {
+	// entitlement check shares snapshot loading (with cache) of the subscription.
+	getSnapshotByTelegramID := getsnapshotbytelegramid.New(subscriptionRepository, userRepository, logger, postgres, redis)
+
 	return &Service{
-		ExistsByTelegramID: existsbytelegramid.New(subscriptionRepository, userRepository, logger, postgres),
-		GetByTelegramID:    getbytelegramid.New(subscriptionRepository, userRepository, logger, postgres),
+		AllPlans:                allplans.New(subscriptionRepository, userRepository, logger, postgres),
+		CheckEntitlement:        checkentitlement.New(getSnapshotByTelegramID, logger),
+		ExistsByTelegramID:      existsbytelegramid.New(subscriptionRepository, userRepository, logger, postgres),
+		Expire:                  expire.New(subscriptionRepository, notificationRepository, logger, rabbitMQ, postgres, redis),
+		GetByTelegramID:         getbytelegramid.New(subscriptionRepository, userRepository, logger, postgres),
+		GetSnapshotByTelegramID: getSnapshotByTelegramID,
+		Remind:                  remind.New(subscriptionRepository, notificationRepository, logger, rabbitMQ, postgres, redis),
+		Subscribe:               subscribe.New(subscriptionRepository, userRepository, logger, postgres, redis),
 	}
 }
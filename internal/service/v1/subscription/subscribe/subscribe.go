@@ -0,0 +1,129 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	subscriptionrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/subscription"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=ISubscribe --output=mocks --case=underscore
+type ISubscribe interface {
+	Execute(ctx context.Context, dto subscription.SubscribeDTO) (subscription.Subscription, error)
+}
+
+type Subscribe struct {
+	subscriptionRepository *subscriptionrepository.Repository
+	userRepository         *userrepository.Repository
+	logger                 logger.ILogger
+	postgres               *postgres.Postgres
+	redis                  *redis.Redis
+}
+
+func New(
+	subscriptionRepository *subscriptionrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Subscribe {
+	return &Subscribe{
+		subscriptionRepository: subscriptionRepository,
+		userRepository:         userRepository,
+		logger:                 logger,
+		postgres:               postgres,
+		redis:                  redis,
+	}
+}
+
+func (s *Subscribe) Execute(ctx context.Context, dto subscription.SubscribeDTO) (subscription.Subscription, error) {
+	s.logger.Debug("[subscribe by plan] execute service")
+
+	var (
+		err        error
+		result     subscription.Subscription
+		userExists bool
+		planExists bool
+		plan       subscription.Plan
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return subscription.Subscription{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return subscription.Subscription{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return subscription.Subscription{}, err
+	}
+
+	// check subscription plan exists by code.
+	planExists, err = s.subscriptionRepository.ExistsPlanByCode.Execute(ctx, tx, dto.PlanCode)
+	if err != nil {
+		return subscription.Subscription{}, err
+	}
+
+	if !planExists { // if subscription plan does not exist or is not active.
+		err = apperrors.ErrSubscriptionPlanDoesNotExist
+		return subscription.Subscription{}, err
+	}
+
+	// get subscription plan by code.
+	plan, err = s.subscriptionRepository.GetPlanByCode.Execute(ctx, tx, dto.PlanCode)
+	if err != nil {
+		return subscription.Subscription{}, err
+	}
+
+	// subscribe by plan (remaining time of active subscription is kept).
+	// plan activity and trial usage are checked by the database after the subscription is locked,
+	// so concurrent requests cannot subscribe trial twice.
+	result, err = s.subscriptionRepository.Subscribe.Execute(ctx, tx, dto)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrSubscriptionPlanNotAvailable) {
+			if plan.IsTrial {
+				err = apperrors.ErrSubscriptionTrialAlreadyUsed
+			} else {
+				err = apperrors.ErrSubscriptionPlanDoesNotExist
+			}
+		}
+		return subscription.Subscription{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return subscription.Subscription{}, err
+	}
+
+	// subscription changed, so cached subscription snapshot is outdated.
+	if err := s.redis.SubscriptionSnapshot.Delete(ctx, dto.TelegramID); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+	}
+
+	return result, nil
+}
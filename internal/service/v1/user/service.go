@@ -3,20 +3,25 @@ package user
 import (
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	getbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/user/get_by_telegram_id"
+	updatetimezone "github.com/go-jedi/lingramm_backend/internal/service/v1/user/update_timezone"
+	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
 )
 
 type Service struct {
 	GetByTelegramID getbytelegramid.IGetByTelegramID
+	UpdateTimezone  updatetimezone.IUpdateTimezone
 }
 
 func New(
 	userRepository *userrepository.Repository,
 	logger logger.ILogger,
 	postgres *postgres.Postgres,
+	bigCache *bigcachepkg.BigCache,
 ) *Service {
 	return &Service{
 		GetByTelegramID: getbytelegramid.New(userRepository, logger, postgres),
+		UpdateTimezone:  updatetimezone.New(userRepository, logger, postgres, bigCache),
 	}
 }
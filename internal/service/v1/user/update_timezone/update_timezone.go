@@ -0,0 +1,99 @@
+package updatetimezone
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-jedi/lingramm_backend/internal/domain/user"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	bigcachepkg "github.com/go-jedi/lingramm_backend/pkg/bigcache"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IUpdateTimezone --output=mocks --case=underscore
+type IUpdateTimezone interface {
+	Execute(ctx context.Context, dto user.UpdateTimezoneDTO) (user.User, error)
+}
+
+type UpdateTimezone struct {
+	userRepository *userrepository.Repository
+	logger         logger.ILogger
+	postgres       *postgres.Postgres
+	bigCache       *bigcachepkg.BigCache
+}
+
+func New(
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	bigCache *bigcachepkg.BigCache,
+) *UpdateTimezone {
+	return &UpdateTimezone{
+		userRepository: userRepository,
+		logger:         logger,
+		postgres:       postgres,
+		bigCache:       bigCache,
+	}
+}
+
+func (s *UpdateTimezone) Execute(ctx context.Context, dto user.UpdateTimezoneDTO) (user.User, error) {
+	s.logger.Debug("[update user timezone] execute service")
+
+	var (
+		err        error
+		result     user.User
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return user.User{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return user.User{}, err
+	}
+
+	// timezone chosen by the user is not overwritten at sign-in.
+	dto.IsManual = true
+
+	// update user timezone.
+	result, err = s.userRepository.UpdateTimezone.Execute(ctx, tx, dto)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	// refresh cached user, sign in reads timezone from the cache.
+	if err := s.bigCache.User.Set(result.TelegramID, result); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to cache user: %v", err))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package allprogressbytelegramid
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	userachievement "github.com/go-jedi/lingramm_backend/internal/domain/user_achievement"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	userachievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllProgressByTelegramID --output=mocks --case=underscore
+type IAllProgressByTelegramID interface {
+	Execute(ctx context.Context, telegramID string) ([]userachievement.Progress, error)
+}
+
+type AllProgressByTelegramID struct {
+	userAchievementRepository *userachievementrepository.Repository
+	userRepository            *userrepository.Repository
+	logger                    logger.ILogger
+	postgres                  *postgres.Postgres
+	redis                     *redis.Redis
+}
+
+func New(
+	userAchievementRepository *userachievementrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *AllProgressByTelegramID {
+	return &AllProgressByTelegramID{
+		userAchievementRepository: userAchievementRepository,
+		userRepository:            userRepository,
+		logger:                    logger,
+		postgres:                  postgres,
+		redis:                     redis,
+	}
+}
+
+func (s *AllProgressByTelegramID) Execute(ctx context.Context, telegramID string) ([]userachievement.Progress, error) {
+	s.logger.Debug("[get all achievement progress by telegram id] execute service")
+
+	// get achievement progress from cache (it is removed every time user events are processed).
+	cached, err := s.redis.AchievementProgress.Get(ctx, telegramID)
+	if err == nil {
+		return cached, nil
+	}
+
+	var (
+		result     []userachievement.Progress
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return nil, err
+	}
+
+	// get all achievement progress by telegram id.
+	result, err = s.userAchievementRepository.AllProgressByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// save achievement progress in cache.
+	if err := s.redis.AchievementProgress.Set(ctx, telegramID, result); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to cache achievement progress: %v", err))
+	}
+
+	return result, nil
+}
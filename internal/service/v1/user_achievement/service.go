@@ -4,12 +4,15 @@ import (
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	userachievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement"
 	alldetailbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/user_achievement/all_detail_by_telegram_id"
+	allprogressbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/user_achievement/all_progress_by_telegram_id"
 	"github.com/go-jedi/lingramm_backend/pkg/logger"
 	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
 )
 
 type Service struct {
-	AllDetailByTelegramID alldetailbytelegramid.IAllDetailByTelegramID
+	AllDetailByTelegramID   alldetailbytelegramid.IAllDetailByTelegramID
+	AllProgressByTelegramID allprogressbytelegramid.IAllProgressByTelegramID
 }
 
 func New(
@@ -17,8 +20,10 @@ func New(
 	userRepository *userrepository.Repository,
 	logger logger.ILogger,
 	postgres *postgres.Postgres,
+	redis *redis.Redis,
 ) *Service {
 	return &Service{
-		AllDetailByTelegramID: alldetailbytelegramid.New(userAchievementRepository, userRepository, logger, postgres),
+		AllDetailByTelegramID:   alldetailbytelegramid.New(userAchievementRepository, userRepository, logger, postgres),
+		AllProgressByTelegramID: allprogressbytelegramid.New(userAchievementRepository, userRepository, logger, postgres, redis),
 	}
 }
@@ -0,0 +1,90 @@
+package allbytelegramid
+
+import (
+	"context"
+	"log"
+
+	userinventory "github.com/go-jedi/lingramm_backend/internal/domain/user_inventory"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	userinventoryrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllByTelegramID --output=mocks --case=underscore
+type IAllByTelegramID interface {
+	Execute(ctx context.Context, telegramID string) ([]userinventory.UserInventoryItem, error)
+}
+
+type AllByTelegramID struct {
+	userInventoryRepository *userinventoryrepository.Repository
+	userRepository          *userrepository.Repository
+	logger                  logger.ILogger
+	postgres                *postgres.Postgres
+}
+
+func New(
+	userInventoryRepository *userinventoryrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllByTelegramID {
+	return &AllByTelegramID{
+		userInventoryRepository: userInventoryRepository,
+		userRepository:          userRepository,
+		logger:                  logger,
+		postgres:                postgres,
+	}
+}
+
+func (s *AllByTelegramID) Execute(ctx context.Context, telegramID string) ([]userinventory.UserInventoryItem, error) {
+	s.logger.Debug("[get all user inventory items by telegram id] execute service")
+
+	var (
+		err        error
+		result     []userinventory.UserInventoryItem
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return nil, err
+	}
+
+	// get all user inventory items by telegram id.
+	result, err = s.userInventoryRepository.AllByTelegramID.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package consume
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	userinventory "github.com/go-jedi/lingramm_backend/internal/domain/user_inventory"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	userinventoryrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IConsume --output=mocks --case=underscore
+type IConsume interface {
+	Execute(ctx context.Context, dto userinventory.ConsumeDTO) (userinventory.InventoryItem, error)
+}
+
+type Consume struct {
+	userInventoryRepository *userinventoryrepository.Repository
+	userRepository          *userrepository.Repository
+	logger                  logger.ILogger
+	postgres                *postgres.Postgres
+	redis                   *redis.Redis
+}
+
+func New(
+	userInventoryRepository *userinventoryrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Consume {
+	return &Consume{
+		userInventoryRepository: userInventoryRepository,
+		userRepository:          userRepository,
+		logger:                  logger,
+		postgres:                postgres,
+		redis:                   redis,
+	}
+}
+
+func (s *Consume) Execute(ctx context.Context, dto userinventory.ConsumeDTO) (userinventory.InventoryItem, error) {
+	s.logger.Debug("[consume user inventory item] execute service")
+
+	var (
+		err           error
+		result        userinventory.InventoryItem
+		userExists    bool
+		consumeResult userinventory.ConsumeResult
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return userinventory.InventoryItem{}, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return userinventory.InventoryItem{}, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return userinventory.InventoryItem{}, err
+	}
+
+	// consume user inventory item (the database locks the item and applies its effect).
+	consumeResult, err = s.userInventoryRepository.Consume.Execute(ctx, tx, dto)
+	if err != nil {
+		return userinventory.InventoryItem{}, err
+	}
+
+	switch consumeResult.Status {
+	case userinventory.ConsumeStatusConsumed:
+	case userinventory.ConsumeStatusNotFound:
+		err = apperrors.ErrInventoryItemDoesNotExist
+		return userinventory.InventoryItem{}, err
+	case userinventory.ConsumeStatusNotConsumable:
+		err = apperrors.ErrInventoryItemIsNotConsumable
+		return userinventory.InventoryItem{}, err
+	case userinventory.ConsumeStatusNotEnough:
+		err = apperrors.ErrInventoryItemNotEnough
+		return userinventory.InventoryItem{}, err
+	case userinventory.ConsumeStatusLimitReached:
+		err = apperrors.ErrStreakFreezeLimitReached
+		return userinventory.InventoryItem{}, err
+	default:
+		err = fmt.Errorf("unexpected inventory item consume status: %s", consumeResult.Status)
+		return userinventory.InventoryItem{}, err
+	}
+
+	if consumeResult.InventoryItem == nil {
+		err = fmt.Errorf("inventory item consume result is incomplete: %s", consumeResult.Status)
+		return userinventory.InventoryItem{}, err
+	}
+
+	result = *consumeResult.InventoryItem
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return userinventory.InventoryItem{}, err
+	}
+
+	// consumed item may extend subscription, so cached subscription snapshot is outdated.
+	if err := s.redis.SubscriptionSnapshot.Delete(ctx, dto.TelegramID); err != nil {
+		s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+	}
+
+	return result, nil
+}
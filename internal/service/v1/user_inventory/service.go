@@ -0,0 +1,29 @@
+package userinventory
+
+import (
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	userinventoryrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_inventory"
+	allbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/user_inventory/all_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/internal/service/v1/user_inventory/consume"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/go-jedi/lingramm_backend/pkg/redis"
+)
+
+type Service struct {
+	AllByTelegramID allbytelegramid.IAllByTelegramID
+	Consume         consume.IConsume
+}
+
+func New(
+	userInventoryRepository *userinventoryrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+	redis *redis.Redis,
+) *Service {
+	return &Service{
+		AllByTelegramID: allbytelegramid.New(userInventoryRepository, userRepository, logger, postgres),
+		Consume:         consume.New(userInventoryRepository, userRepository, logger, postgres, redis),
+	}
+}
@@ -0,0 +1,96 @@
+package allbytelegramid
+
+import (
+	"context"
+	"log"
+
+	userquest "github.com/go-jedi/lingramm_backend/internal/domain/user_quest"
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	userquestrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest"
+	"github.com/go-jedi/lingramm_backend/pkg/apperrors"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockery --name=IAllByTelegramID --output=mocks --case=underscore
+type IAllByTelegramID interface {
+	Execute(ctx context.Context, dto userquest.AllByTelegramIDDTO) ([]userquest.UserQuest, error)
+}
+
+type AllByTelegramID struct {
+	userQuestRepository *userquestrepository.Repository
+	userRepository      *userrepository.Repository
+	logger              logger.ILogger
+	postgres            *postgres.Postgres
+}
+
+func New(
+	userQuestRepository *userquestrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *AllByTelegramID {
+	return &AllByTelegramID{
+		userQuestRepository: userQuestRepository,
+		userRepository:      userRepository,
+		logger:              logger,
+		postgres:            postgres,
+	}
+}
+
+func (s *AllByTelegramID) Execute(ctx context.Context, dto userquest.AllByTelegramIDDTO) ([]userquest.UserQuest, error) {
+	s.logger.Debug("[get all user quests by telegram id] execute service")
+
+	var (
+		err        error
+		result     []userquest.UserQuest
+		userExists bool
+	)
+
+	tx, err := s.postgres.Pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(ctx); rbErr != nil {
+				log.Printf("failed rollback the transaction: %v", rbErr)
+			}
+		}
+	}()
+
+	// check user exists by telegram id.
+	userExists, err = s.userRepository.ExistsByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userExists { // if user does not exist.
+		err = apperrors.ErrUserDoesNotExist
+		return nil, err
+	}
+
+	// assign quests of the current period that user does not have yet.
+	err = s.userQuestRepository.AssignByTelegramID.Execute(ctx, tx, dto.TelegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	// get all user quests by telegram id.
+	result, err = s.userQuestRepository.AllByTelegramID.Execute(ctx, tx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit transaction.
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
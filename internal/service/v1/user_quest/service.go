@@ -0,0 +1,24 @@
+package userquest
+
+import (
+	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
+	userquestrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_quest"
+	allbytelegramid "github.com/go-jedi/lingramm_backend/internal/service/v1/user_quest/all_by_telegram_id"
+	"github.com/go-jedi/lingramm_backend/pkg/logger"
+	"github.com/go-jedi/lingramm_backend/pkg/postgres"
+)
+
+type Service struct {
+	AllByTelegramID allbytelegramid.IAllByTelegramID
+}
+
+func New(
+	userQuestRepository *userquestrepository.Repository,
+	userRepository *userrepository.Repository,
+	logger logger.ILogger,
+	postgres *postgres.Postgres,
+) *Service {
+	return &Service{
+		AllByTelegramID: allbytelegramid.New(userQuestRepository, userRepository, logger, postgres),
+	}
+}
@@ -5,9 +5,16 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/go-jedi/lingramm_backend/internal/domain/achievement"
+	eventtype "github.com/go-jedi/lingramm_backend/internal/domain/event_type"
+	userbalance "github.com/go-jedi/lingramm_backend/internal/domain/internal_currency/user_balance"
 	"github.com/go-jedi/lingramm_backend/internal/domain/notification"
+	"github.com/go-jedi/lingramm_backend/internal/domain/referral"
 	userachievement "github.com/go-jedi/lingramm_backend/internal/domain/user_achievement"
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
 	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	userachievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement"
 	userstatsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_stats"
@@ -25,14 +32,17 @@ type IEnsureStreakDaysIncrementToday interface {
 }
 
 type EnsureStreakDaysIncrementToday struct {
-	userStatsRepository       *userstatsrepository.Repository
-	userRepository            *userrepository.Repository
-	userAchievementRepository *userachievementrepository.Repository
-	notificationRepository    *notificationrepository.Repository
-	logger                    logger.ILogger
-	rabbitMQ                  *rabbitmq.RabbitMQ
-	postgres                  *postgres.Postgres
-	redis                     *redis.Redis
+	userStatsRepository        *userstatsrepository.Repository
+	userRepository             *userrepository.Repository
+	userAchievementRepository  *userachievementrepository.Repository
+	notificationRepository     *notificationrepository.Repository
+	eventTypeRepository        *eventtyperepository.Repository
+	internalCurrencyRepository *internalcurrencyrepository.Repository
+	referralRepository         *referralrepository.Repository
+	logger                     logger.ILogger
+	rabbitMQ                   *rabbitmq.RabbitMQ
+	postgres                   *postgres.Postgres
+	redis                      *redis.Redis
 }
 
 func New(
@@ -40,20 +50,26 @@ func New(
 	userRepository *userrepository.Repository,
 	userAchievementRepository *userachievementrepository.Repository,
 	notificationRepository *notificationrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	referralRepository *referralrepository.Repository,
 	logger logger.ILogger,
 	rabbitMQ *rabbitmq.RabbitMQ,
 	postgres *postgres.Postgres,
 	redis *redis.Redis,
 ) *EnsureStreakDaysIncrementToday {
 	return &EnsureStreakDaysIncrementToday{
-		userStatsRepository:       userStatsRepository,
-		userRepository:            userRepository,
-		userAchievementRepository: userAchievementRepository,
-		notificationRepository:    notificationRepository,
-		logger:                    logger,
-		rabbitMQ:                  rabbitMQ,
-		postgres:                  postgres,
-		redis:                     redis,
+		userStatsRepository:        userStatsRepository,
+		userRepository:             userRepository,
+		userAchievementRepository:  userAchievementRepository,
+		notificationRepository:     notificationRepository,
+		eventTypeRepository:        eventTypeRepository,
+		internalCurrencyRepository: internalCurrencyRepository,
+		referralRepository:         referralRepository,
+		logger:                     logger,
+		rabbitMQ:                   rabbitMQ,
+		postgres:                   postgres,
+		redis:                      redis,
 	}
 }
 
@@ -128,6 +144,12 @@ func (s *EnsureStreakDaysIncrementToday) Execute(ctx context.Context, telegramID
 	}
 
 	if len(unlockAvailableAchievements) > 0 {
+		// grant internal currency rewards of unlocked achievements.
+		err = s.grantAchievementRewards(ctx, tx, telegramID, unlockAvailableAchievements)
+		if err != nil {
+			return err
+		}
+
 		// create notifications in database.
 		notifications, err = s.createNotifications(ctx, tx, telegramID, unlockAvailableAchievements)
 		if err != nil {
@@ -145,12 +167,81 @@ func (s *EnsureStreakDaysIncrementToday) Execute(ctx context.Context, telegramID
 		}
 	}
 
+	// grant referral rewards once the user reaches the milestone (streak days).
+	err = s.grantReferralRewards(ctx, tx, telegramID)
+	if err != nil {
+		return err
+	}
+
 	// commit transaction.
 	err = tx.Commit(ctx)
 	if err != nil {
 		return err
 	}
 
+	if !isStreakDaysIncrementToday { // streak days changed, so cached achievement progress is outdated.
+		if err := s.redis.AchievementProgress.Delete(ctx, telegramID); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to delete achievement progress from cache: %v", err))
+		}
+	}
+
+	if userachievement.AnyHasSubscriptionDays(unlockAvailableAchievements) { // subscription changed, so cached subscription snapshot is outdated.
+		if err := s.redis.SubscriptionSnapshot.Delete(ctx, telegramID); err != nil {
+			s.logger.Warn(fmt.Sprintf("failed to delete subscription snapshot from cache: %v", err))
+		}
+	}
+
+	return nil
+}
+
+// grantAchievementRewards accrues internal currency rewards of unlocked achievements
+// (experience points, subscription days and streak freezes are applied by the database).
+func (s *EnsureStreakDaysIncrementToday) grantAchievementRewards(
+	ctx context.Context,
+	tx pgx.Tx,
+	telegramID string,
+	unlockAvailableAchievements []userachievement.UnlockAvailableAchievementsResponse,
+) error {
+	var (
+		err           error
+		eventTypeData eventtype.EventType
+	)
+
+	for i := range unlockAvailableAchievements {
+		rewards := unlockAvailableAchievements[i].Rewards
+
+		for j := range rewards {
+			if rewards[j].Type != achievement.RewardTypeInternalCurrency || rewards[j].Amount == nil {
+				continue
+			}
+
+			if eventTypeData.ID == 0 {
+				// get achievement reward event type data.
+				eventTypeData, err = s.eventTypeRepository.GetByName.Execute(ctx, tx, achievement.RewardEventType)
+				if err != nil {
+					return err
+				}
+			}
+
+			var (
+				description = fmt.Sprintf("Награда за достижение «%s»", unlockAvailableAchievements[i].AchievementName)
+				sourceType  = userbalance.SourceTypeAchievementReward
+			)
+
+			// add user balance.
+			if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+				EventTypeID: eventTypeData.ID,
+				Amount:      *rewards[j].Amount,
+				TelegramID:  telegramID,
+				Description: &description,
+				SourceType:  &sourceType,
+				SourceID:    &rewards[j].ID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -167,8 +258,9 @@ func (s *EnsureStreakDaysIncrementToday) createNotifications(
 		for i := range unlockAvailableAchievements {
 			dto = append(dto, notification.CreateDTO{
 				Message: notification.Message{
-					Title: "Уведомление",
-					Text:  fmt.Sprintf("Поздравляем! Вы получили достижение «%s»! Так держать!", unlockAvailableAchievements[i].AchievementName),
+					Title:   "Уведомление",
+					Text:    unlockAvailableAchievements[i].NotificationText(),
+					Rewards: unlockAvailableAchievements[i].NotificationRewards(),
 				},
 				Type:       notification.AchievementType,
 				TelegramID: telegramID,
@@ -202,3 +294,45 @@ func (s *EnsureStreakDaysIncrementToday) sendNotifications(ctx context.Context,
 		}
 	}
 }
+
+// grantReferralRewards rewards the user and the referrer once the user reaches the milestone of referral program.
+func (s *EnsureStreakDaysIncrementToday) grantReferralRewards(ctx context.Context, tx pgx.Tx, telegramID string) error {
+	// qualify referral of the user (the database checks the milestone and rejects banned users).
+	qualifyResult, err := s.referralRepository.Qualify.Execute(ctx, tx, telegramID)
+	if err != nil {
+		return err
+	}
+
+	if qualifyResult.Status != referral.QualifyStatusRewarded || qualifyResult.Referral == nil {
+		return nil
+	}
+
+	rewards := qualifyResult.Referral.Rewards()
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	// get referral reward event type data.
+	eventTypeData, err := s.eventTypeRepository.GetByName.Execute(ctx, tx, referral.RewardEventType)
+	if err != nil {
+		return err
+	}
+
+	sourceType := userbalance.SourceTypeReferral
+
+	for i := range rewards {
+		// add user balance.
+		if _, err := s.internalCurrencyRepository.AddUserBalance.Execute(ctx, tx, userbalance.AddUserBalanceDTO{
+			EventTypeID: eventTypeData.ID,
+			Amount:      rewards[i].Amount,
+			TelegramID:  rewards[i].TelegramID,
+			Description: &rewards[i].Description,
+			SourceType:  &sourceType,
+			SourceID:    &qualifyResult.Referral.ID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package userstats
 
 import (
+	eventtyperepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/event_type"
+	internalcurrencyrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/internal_currency"
 	notificationrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/notification"
+	referralrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/referral"
 	userrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user"
 	userachievementrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_achievement"
 	userstatsrepository "github.com/go-jedi/lingramm_backend/internal/repository/v1/user_stats"
@@ -25,6 +28,9 @@ func New(
 	userRepository *userrepository.Repository,
 	userAchievementRepository *userachievementrepository.Repository,
 	notificationRepository *notificationrepository.Repository,
+	eventTypeRepository *eventtyperepository.Repository,
+	internalCurrencyRepository *internalcurrencyrepository.Repository,
+	referralRepository *referralrepository.Repository,
 	logger logger.ILogger,
 	rabbitMQ *rabbitmq.RabbitMQ,
 	postgres *postgres.Postgres,
@@ -36,6 +42,9 @@ func New(
 			userRepository,
 			userAchievementRepository,
 			notificationRepository,
+			eventTypeRepository,
+			internalCurrencyRepository,
+			referralRepository,
 			logger,
 			rabbitMQ,
 			postgres,
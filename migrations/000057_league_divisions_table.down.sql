@@ -0,0 +1 @@
+DROP TABLE IF EXISTS league_divisions;
\ No newline at end of file
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS league_divisions( -- Лиги (дивизионы), по которым распределяются пользователи на неделю.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    name VARCHAR(50) NOT NULL UNIQUE, -- Название лиги.
+    tier INTEGER NOT NULL UNIQUE, -- Уровень лиги (1 - самая низкая).
+    cohort_size INTEGER NOT NULL DEFAULT 30, -- Максимальное количество участников в одной группе.
+    promote_count INTEGER NOT NULL DEFAULT 0, -- Сколько лучших участников группы повышаются в конце недели.
+    demote_count INTEGER NOT NULL DEFAULT 0, -- Сколько худших участников группы понижаются в конце недели.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    CONSTRAINT check_league_divisions_tier_positive CHECK (tier > 0),
+    CONSTRAINT check_league_divisions_cohort_size_positive CHECK (cohort_size > 0),
+    CONSTRAINT check_league_divisions_promote_count_nonneg CHECK (promote_count >= 0),
+    CONSTRAINT check_league_divisions_demote_count_nonneg CHECK (demote_count >= 0),
+    CONSTRAINT check_league_divisions_zones CHECK (promote_count + demote_count <= cohort_size)
+);
+
+INSERT INTO league_divisions (name, tier, cohort_size, promote_count, demote_count) VALUES
+('bronze', 1, 30, 10, 0),
+('silver', 2, 30, 7, 5),
+('gold', 3, 30, 5, 5),
+('sapphire', 4, 30, 5, 5),
+('diamond', 5, 30, 0, 5);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS league_cohorts;
\ No newline at end of file
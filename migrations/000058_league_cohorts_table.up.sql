@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS league_cohorts( -- Недельные группы (~30 человек) внутри лиги.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    division_id BIGINT NOT NULL, -- Идентификатор лиги.
+    week_start DATE NOT NULL, -- Понедельник недели (Europe/Moscow).
+    members_count INTEGER NOT NULL DEFAULT 0, -- Текущее количество участников группы.
+    is_finalized BOOLEAN NOT NULL DEFAULT FALSE, -- Подведены ли итоги недели для группы.
+    finalized_at TIMESTAMP WITH TIME ZONE, -- Когда были подведены итоги.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    FOREIGN KEY (division_id) REFERENCES league_divisions(id),
+    CONSTRAINT check_league_cohorts_members_count_nonneg CHECK (members_count >= 0)
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS league_member_result;
\ No newline at end of file
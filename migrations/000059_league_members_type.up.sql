@@ -0,0 +1 @@
+CREATE TYPE league_member_result AS ENUM ('promoted', 'stayed', 'demoted');
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS league_members;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS league_members( -- Участники недельных групп лиг.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    cohort_id BIGINT NOT NULL, -- Идентификатор группы.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    week_start DATE NOT NULL, -- Понедельник недели (Europe/Moscow).
+    final_position INTEGER, -- Итоговое место в группе (заполняется при подведении итогов).
+    final_xp BIGINT, -- Итоговый XP за неделю (заполняется при подведении итогов).
+    result league_member_result, -- Итог недели: повышение, остался, понижение.
+    joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Когда пользователь попал в группу.
+    FOREIGN KEY (cohort_id) REFERENCES league_cohorts(id),
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    CONSTRAINT league_members_week_start_telegram_id_uniq UNIQUE (week_start, telegram_id)
+);
\ No newline at end of file
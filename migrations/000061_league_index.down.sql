@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_league_cohorts_division_id_week_start;
+DROP INDEX IF EXISTS idx_league_cohorts_week_start_not_finalized;
+DROP INDEX IF EXISTS idx_league_members_cohort_id;
+DROP INDEX IF EXISTS idx_league_members_telegram_id_week_start;
\ No newline at end of file
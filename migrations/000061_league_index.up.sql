@@ -0,0 +1,11 @@
+-- Поиск свободной группы в лиге за неделю.
+CREATE INDEX IF NOT EXISTS idx_league_cohorts_division_id_week_start ON league_cohorts (division_id, week_start);
+
+-- Группы, по которым ещё не подведены итоги.
+CREATE INDEX IF NOT EXISTS idx_league_cohorts_week_start_not_finalized ON league_cohorts (week_start) WHERE is_finalized = FALSE;
+
+-- Участники группы.
+CREATE INDEX IF NOT EXISTS idx_league_members_cohort_id ON league_members (cohort_id);
+
+-- История пользователя.
+CREATE INDEX IF NOT EXISTS idx_league_members_telegram_id_week_start ON league_members (telegram_id, week_start DESC);
\ No newline at end of file
@@ -0,0 +1,73 @@
+CREATE OR REPLACE FUNCTION public.league_cohort_ranking(
+    _cohort_id BIGINT
+) RETURNS TABLE (
+    member_id BIGINT,
+    telegram_id TEXT,
+    display_name TEXT,
+    xp BIGINT,
+    position INTEGER,
+    zone TEXT
+)
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+#variable_conflict use_column
+BEGIN
+    IF _cohort_id IS NULL THEN
+        RAISE EXCEPTION 'cohort_id IS NULL';
+    END IF;
+
+    -- XP участника берётся из недельного агрегата leaderboard_weeks.
+    -- Зона: повышение (топ promote_count, если есть лига выше и XP > 0),
+    -- понижение (последние demote_count, если есть лига ниже), иначе безопасная зона.
+    RETURN QUERY
+    WITH cohort AS (
+        SELECT
+            lc.id,
+            lc.week_start,
+            ld.tier,
+            ld.promote_count,
+            ld.demote_count,
+            (SELECT MIN(tier) FROM league_divisions) AS min_tier,
+            (SELECT MAX(tier) FROM league_divisions) AS max_tier
+        FROM league_cohorts lc
+        INNER JOIN league_divisions ld ON lc.division_id = ld.id
+        WHERE lc.id = _cohort_id
+    ),
+    ranked AS (
+        SELECT
+            lm.id AS member_id,
+            lm.telegram_id,
+            COALESCE(
+                NULLIF(u.username, ''),
+                NULLIF(CONCAT_WS(' ', u.first_name, u.last_name), '')
+            )::TEXT AS display_name,
+            COALESCE(lbw.xp, 0)::BIGINT AS xp,
+            ROW_NUMBER() OVER (
+                ORDER BY COALESCE(lbw.xp, 0) DESC, lm.joined_at, lm.telegram_id
+            )::INTEGER AS position,
+            COUNT(*) OVER ()::INTEGER AS members_count
+        FROM league_members lm
+        INNER JOIN cohort c ON lm.cohort_id = c.id
+        LEFT JOIN leaderboard_weeks lbw ON lbw.week_start = c.week_start AND lbw.telegram_id = lm.telegram_id
+        LEFT JOIN users u ON lm.telegram_id = u.telegram_id
+    )
+    SELECT
+        r.member_id,
+        r.telegram_id,
+        r.display_name,
+        r.xp,
+        r.position,
+        CASE
+            WHEN c.tier < c.max_tier AND r.position <= c.promote_count AND r.xp > 0
+                THEN 'promotion'
+            WHEN c.tier > c.min_tier AND r.position > r.members_count - c.demote_count AND r.position > c.promote_count
+                THEN 'demotion'
+            ELSE 'safe'
+        END AS zone
+    FROM ranked r
+    CROSS JOIN cohort c
+    ORDER BY r.position;
+END;
+$$;
\ No newline at end of file
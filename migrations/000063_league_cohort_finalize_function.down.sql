@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.league_cohort_finalize(BIGINT);
\ No newline at end of file
@@ -0,0 +1,58 @@
+CREATE OR REPLACE FUNCTION public.league_cohort_finalize(
+    _cohort_id BIGINT
+) RETURNS BOOLEAN
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _week_start DATE;
+    _is_finalized BOOLEAN;
+BEGIN
+    IF _cohort_id IS NULL THEN
+        RAISE EXCEPTION 'cohort_id IS NULL';
+    END IF;
+
+    SELECT
+        week_start,
+        is_finalized
+    INTO _week_start, _is_finalized
+    FROM league_cohorts
+    WHERE id = _cohort_id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'league cohort % does not exist', _cohort_id;
+    END IF;
+
+    IF _is_finalized THEN
+        RETURN FALSE;
+    END IF;
+
+    -- итоги подводятся только после окончания недели (MSK) с запасом в 10 минут,
+    -- чтобы воркер leaderboard_weeks успел учесть последние события.
+    IF (NOW() AT TIME ZONE 'Europe/Moscow') < (_week_start + 7)::TIMESTAMP + INTERVAL '10 minutes' THEN
+        RETURN FALSE;
+    END IF;
+
+    UPDATE league_members lm
+    SET
+        final_position = r.position,
+        final_xp = r.xp,
+        result = CASE r.zone
+            WHEN 'promotion' THEN 'promoted'
+            WHEN 'demotion' THEN 'demoted'
+            ELSE 'stayed'
+        END::league_member_result
+    FROM public.league_cohort_ranking(_cohort_id) r
+    WHERE lm.id = r.member_id;
+
+    UPDATE league_cohorts
+    SET
+        is_finalized = TRUE,
+        finalized_at = NOW()
+    WHERE id = _cohort_id;
+
+    RETURN TRUE;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.league_join(TEXT);
\ No newline at end of file
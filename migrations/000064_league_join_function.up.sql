@@ -0,0 +1,121 @@
+CREATE OR REPLACE FUNCTION public.league_join(
+    _telegram_id TEXT
+) RETURNS BOOLEAN
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _week_start DATE := DATE_TRUNC('week', (NOW() AT TIME ZONE 'Europe/Moscow'))::DATE;
+    _prev_cohort_id BIGINT;
+    _prev_tier INTEGER;
+    _prev_result league_member_result;
+    _prev_is_finalized BOOLEAN;
+    _target_tier INTEGER;
+    _division_id BIGINT;
+    _cohort_size INTEGER;
+    _cohort_id BIGINT;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- пользователь уже участвует в лиге на этой неделе.
+    IF EXISTS (
+        SELECT 1
+        FROM league_members
+        WHERE week_start = _week_start
+        AND telegram_id = _telegram_id
+    ) THEN
+        RETURN FALSE;
+    END IF;
+
+    -- последняя неделя, в которой участвовал пользователь.
+    SELECT
+        lm.cohort_id,
+        ld.tier,
+        lc.is_finalized
+    INTO _prev_cohort_id, _prev_tier, _prev_is_finalized
+    FROM league_members lm
+    INNER JOIN league_cohorts lc ON lm.cohort_id = lc.id
+    INNER JOIN league_divisions ld ON lc.division_id = ld.id
+    WHERE lm.telegram_id = _telegram_id
+    ORDER BY lm.week_start DESC
+    LIMIT 1;
+
+    IF _prev_cohort_id IS NULL THEN
+        -- новый участник попадает в самую низкую лигу.
+        SELECT MIN(tier)
+        INTO _target_tier
+        FROM league_divisions;
+    ELSE
+        IF NOT _prev_is_finalized THEN
+            PERFORM public.league_cohort_finalize(_prev_cohort_id);
+        END IF;
+
+        SELECT result
+        INTO _prev_result
+        FROM league_members
+        WHERE cohort_id = _prev_cohort_id
+        AND telegram_id = _telegram_id;
+
+        -- итоги прошлой недели ещё не подведены, ждём.
+        IF _prev_result IS NULL THEN
+            RETURN FALSE;
+        END IF;
+
+        _target_tier := CASE _prev_result
+            WHEN 'promoted' THEN _prev_tier + 1
+            WHEN 'demoted' THEN _prev_tier - 1
+            ELSE _prev_tier
+        END;
+    END IF;
+
+    -- ближайшая существующая лига к целевому уровню.
+    SELECT
+        id,
+        tier,
+        cohort_size
+    INTO _division_id, _target_tier, _cohort_size
+    FROM league_divisions
+    ORDER BY ABS(tier - _target_tier), tier
+    LIMIT 1;
+
+    IF _division_id IS NULL THEN
+        RAISE EXCEPTION 'league divisions are not configured';
+    END IF;
+
+    -- сериализуем распределение по группам внутри лиги на неделю.
+    PERFORM PG_ADVISORY_XACT_LOCK(HASHTEXT('league_join:' || _division_id::TEXT || ':' || _week_start::TEXT));
+
+    SELECT id
+    INTO _cohort_id
+    FROM league_cohorts
+    WHERE division_id = _division_id
+    AND week_start = _week_start
+    AND members_count < _cohort_size
+    ORDER BY id
+    LIMIT 1
+    FOR UPDATE;
+
+    IF _cohort_id IS NULL THEN
+        INSERT INTO league_cohorts (division_id, week_start)
+        VALUES (_division_id, _week_start)
+        RETURNING id INTO _cohort_id;
+    END IF;
+
+    INSERT INTO league_members (cohort_id, telegram_id, week_start)
+    VALUES (_cohort_id, _telegram_id, _week_start)
+    ON CONFLICT (week_start, telegram_id) DO NOTHING;
+
+    IF NOT FOUND THEN
+        RETURN FALSE;
+    END IF;
+
+    UPDATE league_cohorts
+    SET members_count = members_count + 1
+    WHERE id = _cohort_id;
+
+    RETURN TRUE;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.league_weeks_finalize(INTEGER);
\ No newline at end of file
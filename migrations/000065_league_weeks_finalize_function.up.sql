@@ -0,0 +1,44 @@
+CREATE OR REPLACE FUNCTION public.league_weeks_finalize(
+    _batch_size INTEGER
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _current_week_start DATE := DATE_TRUNC('week', (NOW() AT TIME ZONE 'Europe/Moscow'))::DATE;
+    _cohort_id BIGINT;
+    _cohorts_count INTEGER := 0;
+    _members_count INTEGER := 0;
+    _cnt INTEGER;
+BEGIN
+    IF _batch_size IS NULL THEN
+        RAISE EXCEPTION 'batch_size IS NULL';
+    END IF;
+
+    FOR _cohort_id IN
+        SELECT id
+        FROM league_cohorts
+        WHERE is_finalized = FALSE
+        AND week_start < _current_week_start
+        ORDER BY week_start, id
+        LIMIT _batch_size
+        FOR UPDATE SKIP LOCKED
+    LOOP
+        IF public.league_cohort_finalize(_cohort_id) THEN
+            SELECT members_count
+            INTO _cnt
+            FROM league_cohorts
+            WHERE id = _cohort_id;
+
+            _cohorts_count := _cohorts_count + 1;
+            _members_count := _members_count + COALESCE(_cnt, 0);
+        END IF;
+    END LOOP;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'cohorts_count', _cohorts_count,
+        'members_count', _members_count
+    );
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.league_current_get(TEXT);
\ No newline at end of file
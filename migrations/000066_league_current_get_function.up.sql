@@ -0,0 +1,49 @@
+CREATE OR REPLACE FUNCTION public.league_current_get(
+    _telegram_id TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _week_start DATE := DATE_TRUNC('week', (NOW() AT TIME ZONE 'Europe/Moscow'))::DATE;
+    _cohort_id BIGINT;
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    SELECT cohort_id
+    INTO _cohort_id
+    FROM league_members
+    WHERE week_start = _week_start
+    AND telegram_id = _telegram_id;
+
+    IF _cohort_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    SELECT JSONB_BUILD_OBJECT(
+        'cohort_id', lc.id,
+        'division_id', ld.id,
+        'division_name', ld.name,
+        'tier', ld.tier,
+        'week_start', TO_CHAR(lc.week_start, 'YYYY-MM-DD"T"00:00:00"Z"'),
+        'week_end', TO_CHAR(lc.week_start + 7, 'YYYY-MM-DD"T"00:00:00"Z"'),
+        'members_count', lc.members_count,
+        'promote_count', ld.promote_count,
+        'demote_count', ld.demote_count,
+        'position', r.position,
+        'xp', r.xp,
+        'zone', r.zone
+    )
+    INTO _response
+    FROM league_cohorts lc
+    INNER JOIN league_divisions ld ON lc.division_id = ld.id
+    INNER JOIN public.league_cohort_ranking(lc.id) r ON r.telegram_id = _telegram_id
+    WHERE lc.id = _cohort_id;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
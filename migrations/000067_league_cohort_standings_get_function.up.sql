@@ -0,0 +1,46 @@
+CREATE OR REPLACE FUNCTION public.league_cohort_standings_get(
+    _telegram_id TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _week_start DATE := DATE_TRUNC('week', (NOW() AT TIME ZONE 'Europe/Moscow'))::DATE;
+    _cohort_id BIGINT;
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    SELECT cohort_id
+    INTO _cohort_id
+    FROM league_members
+    WHERE week_start = _week_start
+    AND telegram_id = _telegram_id;
+
+    IF _cohort_id IS NULL THEN
+        RETURN '[]'::JSONB;
+    END IF;
+
+    SELECT COALESCE(
+        JSONB_AGG(
+            JSONB_BUILD_OBJECT(
+                'position', r.position,
+                'telegram_id', r.telegram_id,
+                'display_name', r.display_name,
+                'xp', r.xp,
+                'zone', r.zone,
+                'is_me', r.telegram_id = _telegram_id
+            )
+            ORDER BY r.position
+        ),
+        '[]'::JSONB
+    )
+    INTO _response
+    FROM public.league_cohort_ranking(_cohort_id) r;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.league_history_get(TEXT);
\ No newline at end of file
@@ -0,0 +1,38 @@
+CREATE OR REPLACE FUNCTION public.league_history_get(
+    _telegram_id TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    SELECT COALESCE(
+        JSONB_AGG(
+            JSONB_BUILD_OBJECT(
+                'week_start', TO_CHAR(lm.week_start, 'YYYY-MM-DD"T"00:00:00"Z"'),
+                'division_name', ld.name,
+                'tier', ld.tier,
+                'is_finalized', lc.is_finalized,
+                'final_position', lm.final_position,
+                'final_xp', lm.final_xp,
+                'result', lm.result
+            )
+            ORDER BY lm.week_start DESC
+        ),
+        '[]'::JSONB
+    )
+    INTO _response
+    FROM league_members lm
+    INNER JOIN league_cohorts lc ON lm.cohort_id = lc.id
+    INNER JOIN league_divisions ld ON lc.division_id = ld.id
+    WHERE lm.telegram_id = _telegram_id;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.leaderboard_weeks_worker_lag_get(TEXT);
\ No newline at end of file
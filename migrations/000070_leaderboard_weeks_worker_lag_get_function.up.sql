@@ -0,0 +1,45 @@
+CREATE OR REPLACE FUNCTION public.leaderboard_weeks_worker_lag_get(
+    _worker_name TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _last_event_id BIGINT;
+    _updated_at TIMESTAMP WITH TIME ZONE;
+    _max_event_id BIGINT;
+BEGIN
+    IF _worker_name IS NULL THEN
+        RAISE EXCEPTION 'worker_name IS NULL';
+    END IF;
+
+    SELECT
+        last_event_id,
+        updated_at
+    INTO _last_event_id, _updated_at
+    FROM leaderboard_weeks_worker_state
+    WHERE name = _worker_name;
+
+    SELECT
+        COALESCE(MAX(id), 0)
+    INTO _max_event_id
+    FROM xp_events;
+
+    -- lag_events: сколько событий ещё не учтено в leaderboard_weeks.
+    -- lag_seconds: сколько секунд назад воркер последний раз продвигал чекпоинт (0, если отставания нет).
+    RETURN JSONB_BUILD_OBJECT(
+        'worker_name', _worker_name,
+        'last_event_id', COALESCE(_last_event_id, 0),
+        'max_event_id', _max_event_id,
+        'lag_events', GREATEST(_max_event_id - COALESCE(_last_event_id, 0), 0),
+        'lag_seconds',
+            CASE
+                WHEN _max_event_id <= COALESCE(_last_event_id, 0) THEN 0
+                WHEN _updated_at IS NULL THEN NULL
+                ELSE FLOOR(EXTRACT(EPOCH FROM (NOW() - _updated_at)))::BIGINT
+            END,
+        'updated_at', _updated_at
+    );
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TYPE IF EXISTS aggregate_rebuild_job_scope;
+DROP TYPE IF EXISTS aggregate_rebuild_job_status;
\ No newline at end of file
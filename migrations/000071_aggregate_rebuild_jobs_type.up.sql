@@ -0,0 +1,2 @@
+CREATE TYPE aggregate_rebuild_job_scope AS ENUM ('user', 'week_range', 'all');
+CREATE TYPE aggregate_rebuild_job_status AS ENUM ('pending', 'running', 'completed', 'failed');
\ No newline at end of file
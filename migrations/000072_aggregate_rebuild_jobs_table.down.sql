@@ -0,0 +1 @@
+DROP TABLE IF EXISTS aggregate_rebuild_jobs;
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS aggregate_rebuild_jobs( -- Задачи пересчёта агрегатов (leaderboard_weeks, user_stats, user_level_history) из журнала xp_events.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    scope aggregate_rebuild_job_scope NOT NULL, -- Область пересчёта: один пользователь, диапазон недель или всё.
+    telegram_id TEXT, -- Telegram id пользователя (для scope = 'user').
+    week_from DATE, -- Первая неделя диапазона (понедельник), включительно.
+    week_to DATE, -- Последняя неделя диапазона (понедельник), включительно.
+    dry_run BOOLEAN NOT NULL DEFAULT TRUE, -- Только посчитать расхождения, ничего не записывая.
+    status aggregate_rebuild_job_status NOT NULL DEFAULT 'pending', -- Статус задачи.
+    cursor_user_id BIGINT NOT NULL DEFAULT 0, -- users.id последнего обработанного пользователя (для продолжения с места остановки).
+    processed_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей обработано.
+    total_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей нужно обработать.
+    leaderboard_weeks_diff BIGINT NOT NULL DEFAULT 0, -- Количество расхождений в leaderboard_weeks.
+    user_stats_diff BIGINT NOT NULL DEFAULT 0, -- Количество расхождений в user_stats.
+    user_level_history_diff BIGINT NOT NULL DEFAULT 0, -- Количество расхождений в user_level_history.
+    error TEXT, -- Текст ошибки (для status = 'failed').
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    finished_at TIMESTAMP WITH TIME ZONE, -- Дата завершения задачи.
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    CONSTRAINT check_aggregate_rebuild_jobs_user_scope CHECK (scope <> 'user' OR telegram_id IS NOT NULL),
+    CONSTRAINT check_aggregate_rebuild_jobs_week_range_scope CHECK (scope <> 'week_range' OR (week_from IS NOT NULL AND week_to IS NOT NULL AND week_from <= week_to))
+);
+
+-- Поиск незавершённых задач воркером.
+CREATE INDEX IF NOT EXISTS idx_aggregate_rebuild_jobs_status_unfinished ON aggregate_rebuild_jobs (id) WHERE status IN ('pending', 'running');
\ No newline at end of file
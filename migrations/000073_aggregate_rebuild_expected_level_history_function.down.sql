@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.aggregate_rebuild_expected_level_history(TEXT);
\ No newline at end of file
@@ -0,0 +1,69 @@
+CREATE OR REPLACE FUNCTION public.aggregate_rebuild_expected_level_history(
+    _telegram_id TEXT
+) RETURNS TABLE (
+    level_number BIGINT,
+    xp_event_id BIGINT,
+    xp_at_reach BIGINT,
+    reached_at TIMESTAMP WITH TIME ZONE
+)
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+#variable_conflict use_column
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- та же логика, что и в back_fill_missing_level_history, но без учёта уже записанной истории:
+    -- первое событие, на котором накопительный XP пересёк порог уровня.
+    RETURN QUERY
+    WITH ordered AS (
+        SELECT
+            e.id,
+            e.occurred_at,
+            SUM(e.delta_xp) OVER (
+                ORDER BY e.occurred_at, e.id
+                ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW
+            )::BIGINT AS cum_xp
+        FROM xp_events e
+        WHERE e.telegram_id = _telegram_id
+    ),
+    top_level AS (
+        SELECT l.level_number
+        FROM levels l
+        WHERE l.required_experience <= (
+            SELECT COALESCE(MAX(o.cum_xp), 0)
+            FROM ordered o
+        )
+        ORDER BY l.required_experience DESC
+        LIMIT 1
+    ),
+    first_hits AS (
+        SELECT
+            l.level_number,
+            l.required_experience,
+            o.id AS event_id,
+            o.occurred_at,
+            o.cum_xp,
+            ROW_NUMBER() OVER (
+                PARTITION BY l.level_number
+                ORDER BY o.occurred_at, o.id
+            ) AS rn
+        FROM levels l
+        INNER JOIN ordered o ON l.required_experience <= o.cum_xp
+        WHERE l.required_experience > 0
+    )
+    SELECT
+        fh.level_number,
+        fh.event_id,
+        CASE
+            WHEN fh.level_number = (SELECT tl.level_number FROM top_level tl) THEN fh.cum_xp
+            ELSE fh.required_experience
+        END,
+        fh.occurred_at
+    FROM first_hits fh
+    WHERE fh.rn = 1;
+END;
+$$;
\ No newline at end of file
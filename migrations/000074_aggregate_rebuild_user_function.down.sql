@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.aggregate_rebuild_user(TEXT, DATE, DATE, BOOLEAN, BOOLEAN);
\ No newline at end of file
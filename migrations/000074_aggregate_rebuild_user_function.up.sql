@@ -0,0 +1,212 @@
+CREATE OR REPLACE FUNCTION public.aggregate_rebuild_user(
+    _telegram_id TEXT,
+    _week_from DATE, -- NULL = без нижней границы.
+    _week_to DATE, -- NULL = без верхней границы.
+    _with_user_stats BOOLEAN, -- пересчитывать ли user_stats и user_level_history.
+    _dry_run BOOLEAN
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _cutoff_event_id BIGINT;
+    _leaderboard_weeks_diff INTEGER := 0;
+    _user_stats_diff INTEGER := 0;
+    _user_level_history_diff INTEGER := 0;
+    _expected_xp BIGINT;
+    _expected_level BIGINT;
+    _max_cum_xp BIGINT;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+    IF _with_user_stats IS NULL THEN
+        RAISE EXCEPTION 'with_user_stats IS NULL';
+    END IF;
+    IF _dry_run IS NULL THEN
+        RAISE EXCEPTION 'dry_run IS NULL';
+    END IF;
+
+    -- не даём воркеру leaderboard_weeks работать параллельно с пересчётом
+    -- (тот же advisory lock, что и в leaderboard_weeks_process_batch).
+    PERFORM PG_ADVISORY_XACT_LOCK(HASHTEXT('leaderboard_weeks_worker:' || name))
+    FROM leaderboard_weeks_worker_state
+    ORDER BY name;
+
+    -- в leaderboard_weeks учитываются только события, которые воркер уже обработал,
+    -- остальные он добавит сам при следующем проходе.
+    SELECT COALESCE(MAX(last_event_id), 0)
+    INTO _cutoff_event_id
+    FROM leaderboard_weeks_worker_state;
+
+    --
+    -- leaderboard_weeks.
+    --
+    WITH expected AS (
+        SELECT
+            e.week_start,
+            SUM(e.delta_xp)::BIGINT AS xp
+        FROM xp_events e
+        WHERE e.telegram_id = _telegram_id
+        AND e.id <= _cutoff_event_id
+        AND (_week_from IS NULL OR e.week_start >= _week_from)
+        AND (_week_to IS NULL OR e.week_start <= _week_to)
+        GROUP BY e.week_start
+    ),
+    actual AS (
+        SELECT
+            lbw.week_start,
+            lbw.xp
+        FROM leaderboard_weeks lbw
+        WHERE lbw.telegram_id = _telegram_id
+        AND (_week_from IS NULL OR lbw.week_start >= _week_from)
+        AND (_week_to IS NULL OR lbw.week_start <= _week_to)
+    )
+    SELECT COUNT(*)
+    INTO _leaderboard_weeks_diff
+    FROM expected ex
+    FULL JOIN actual ac ON ex.week_start = ac.week_start
+    WHERE COALESCE(ex.xp, 0) <> COALESCE(ac.xp, 0);
+
+    IF NOT _dry_run AND _leaderboard_weeks_diff > 0 THEN
+        INSERT INTO leaderboard_weeks(
+            week_start,
+            telegram_id,
+            xp
+        )
+        SELECT
+            e.week_start,
+            _telegram_id,
+            SUM(e.delta_xp)::BIGINT
+        FROM xp_events e
+        WHERE e.telegram_id = _telegram_id
+        AND e.id <= _cutoff_event_id
+        AND (_week_from IS NULL OR e.week_start >= _week_from)
+        AND (_week_to IS NULL OR e.week_start <= _week_to)
+        GROUP BY e.week_start
+        ON CONFLICT (week_start, telegram_id)
+        DO UPDATE SET xp = EXCLUDED.xp
+        WHERE leaderboard_weeks.xp IS DISTINCT FROM EXCLUDED.xp;
+
+        DELETE FROM leaderboard_weeks lbw
+        WHERE lbw.telegram_id = _telegram_id
+        AND (_week_from IS NULL OR lbw.week_start >= _week_from)
+        AND (_week_to IS NULL OR lbw.week_start <= _week_to)
+        AND NOT EXISTS (
+            SELECT 1
+            FROM xp_events e
+            WHERE e.telegram_id = _telegram_id
+            AND e.week_start = lbw.week_start
+            AND e.id <= _cutoff_event_id
+        );
+    END IF;
+
+    IF _with_user_stats THEN
+        PERFORM 1
+        FROM user_stats
+        WHERE telegram_id = _telegram_id
+        FOR UPDATE;
+
+        --
+        -- user_stats (experience_points, level).
+        --
+        WITH ordered AS (
+            SELECT
+                SUM(e.delta_xp) OVER (
+                    ORDER BY e.occurred_at, e.id
+                    ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW
+                )::BIGINT AS cum_xp
+            FROM xp_events e
+            WHERE e.telegram_id = _telegram_id
+        )
+        SELECT COALESCE(MAX(cum_xp), 0)
+        INTO _max_cum_xp
+        FROM ordered;
+
+        SELECT COALESCE(SUM(delta_xp), 0)::BIGINT
+        INTO _expected_xp
+        FROM xp_events
+        WHERE telegram_id = _telegram_id;
+
+        SELECT COALESCE((
+            SELECT l.level_number
+            FROM levels l
+            WHERE l.required_experience <= _max_cum_xp
+            ORDER BY l.required_experience DESC
+            LIMIT 1
+        ), 1)
+        INTO _expected_level;
+
+        SELECT COUNT(*)
+        INTO _user_stats_diff
+        FROM user_stats us
+        WHERE us.telegram_id = _telegram_id
+        AND (
+            us.experience_points IS DISTINCT FROM _expected_xp
+            OR us.level IS DISTINCT FROM _expected_level
+        );
+
+        IF NOT _dry_run AND _user_stats_diff > 0 THEN
+            UPDATE user_stats SET
+                experience_points = _expected_xp,
+                level = _expected_level,
+                updated_at = NOW()
+            WHERE telegram_id = _telegram_id;
+        END IF;
+
+        --
+        -- user_level_history.
+        --
+        SELECT COUNT(*)
+        INTO _user_level_history_diff
+        FROM public.aggregate_rebuild_expected_level_history(_telegram_id) ex
+        FULL JOIN (
+            SELECT *
+            FROM user_level_history
+            WHERE telegram_id = _telegram_id
+        ) ac ON ex.level_number = ac.level_number
+        WHERE ex.level_number IS NULL
+        OR ac.level_number IS NULL
+        OR ex.xp_event_id IS DISTINCT FROM ac.xp_event_id
+        OR ex.xp_at_reach IS DISTINCT FROM ac.xp_at_reach
+        OR ex.reached_at IS DISTINCT FROM ac.reached_at;
+
+        IF NOT _dry_run AND _user_level_history_diff > 0 THEN
+            DELETE FROM user_level_history ulh
+            WHERE ulh.telegram_id = _telegram_id
+            AND NOT EXISTS (
+                SELECT 1
+                FROM public.aggregate_rebuild_expected_level_history(_telegram_id) ex
+                WHERE ex.level_number = ulh.level_number
+            );
+
+            INSERT INTO user_level_history(
+                telegram_id,
+                level_number,
+                xp_event_id,
+                xp_at_reach,
+                reached_at
+            )
+            SELECT
+                _telegram_id,
+                ex.level_number,
+                ex.xp_event_id,
+                ex.xp_at_reach,
+                ex.reached_at
+            FROM public.aggregate_rebuild_expected_level_history(_telegram_id) ex
+            ON CONFLICT (telegram_id, level_number)
+            DO UPDATE SET
+                xp_event_id = EXCLUDED.xp_event_id,
+                xp_at_reach = EXCLUDED.xp_at_reach,
+                reached_at = EXCLUDED.reached_at;
+        END IF;
+    END IF;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'leaderboard_weeks_diff', _leaderboard_weeks_diff,
+        'user_stats_diff', _user_stats_diff,
+        'user_level_history_diff', _user_level_history_diff
+    );
+END;
+$$;
\ No newline at end of file
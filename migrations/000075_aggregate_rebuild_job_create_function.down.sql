@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.aggregate_rebuild_job_create(JSONB);
\ No newline at end of file
@@ -0,0 +1,54 @@
+CREATE OR REPLACE FUNCTION public.aggregate_rebuild_job_create(
+    _src JSONB
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _scope aggregate_rebuild_job_scope;
+    _telegram_id TEXT;
+    _total_users BIGINT;
+    _job aggregate_rebuild_jobs;
+BEGIN
+    IF _src IS NULL THEN
+        RAISE EXCEPTION 'src IS NULL';
+    END IF;
+
+    _scope := (_src->>'scope')::aggregate_rebuild_job_scope;
+    _telegram_id := NULLIF(_src->>'telegram_id', '');
+
+    IF _scope IS NULL THEN
+        RAISE EXCEPTION 'scope IS NULL';
+    END IF;
+
+    -- для пересчёта одного пользователя обрабатывается ровно одна запись,
+    -- для остальных областей — все пользователи.
+    IF _scope = 'user' THEN
+        _total_users := 1;
+    ELSE
+        SELECT COUNT(*)
+        INTO _total_users
+        FROM users;
+    END IF;
+
+    INSERT INTO aggregate_rebuild_jobs(
+        scope,
+        telegram_id,
+        week_from,
+        week_to,
+        dry_run,
+        total_users
+    ) VALUES(
+        _scope,
+        CASE WHEN _scope = 'user' THEN _telegram_id END,
+        CASE WHEN _scope = 'week_range' THEN date_trunc('week', (_src->>'week_from')::DATE)::DATE END,
+        CASE WHEN _scope = 'week_range' THEN date_trunc('week', (_src->>'week_to')::DATE)::DATE END,
+        COALESCE((_src->>'dry_run')::BOOLEAN, TRUE),
+        _total_users
+    )
+    RETURNING * INTO _job;
+
+    RETURN TO_JSONB(_job);
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,99 @@
+CREATE OR REPLACE FUNCTION public.aggregate_rebuild_job_process_chunk(
+    _job_id BIGINT, -- NULL = самая старая незавершённая задача.
+    _chunk_size INTEGER
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _job aggregate_rebuild_jobs;
+    _user RECORD;
+    _diff JSONB;
+    _last_user_id BIGINT;
+    _processed BIGINT := 0;
+    _leaderboard_weeks_diff BIGINT := 0;
+    _user_stats_diff BIGINT := 0;
+    _user_level_history_diff BIGINT := 0;
+    _has_more BOOLEAN;
+BEGIN
+    IF _chunk_size IS NULL OR _chunk_size <= 0 THEN
+        RAISE EXCEPTION 'chunk_size IS NULL OR <= 0';
+    END IF;
+
+    -- блокируем задачу, чтобы несколько экземпляров не обрабатывали её одновременно.
+    SELECT *
+    INTO _job
+    FROM aggregate_rebuild_jobs
+    WHERE (_job_id IS NULL OR id = _job_id)
+    AND status IN ('pending', 'running')
+    ORDER BY id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED;
+
+    IF NOT FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    _last_user_id := _job.cursor_user_id;
+
+    BEGIN
+        FOR _user IN
+            SELECT
+                u.id,
+                u.telegram_id
+            FROM users u
+            WHERE u.id > _job.cursor_user_id
+            AND (_job.scope <> 'user' OR u.telegram_id = _job.telegram_id)
+            ORDER BY u.id
+            LIMIT _chunk_size
+        LOOP
+            _diff := public.aggregate_rebuild_user(
+                _user.telegram_id,
+                _job.week_from,
+                _job.week_to,
+                _job.scope <> 'week_range', -- при пересчёте диапазона недель user_stats не трогаем.
+                _job.dry_run
+            );
+
+            _leaderboard_weeks_diff := _leaderboard_weeks_diff + (_diff->>'leaderboard_weeks_diff')::BIGINT;
+            _user_stats_diff := _user_stats_diff + (_diff->>'user_stats_diff')::BIGINT;
+            _user_level_history_diff := _user_level_history_diff + (_diff->>'user_level_history_diff')::BIGINT;
+            _processed := _processed + 1;
+            _last_user_id := _user.id;
+        END LOOP;
+
+        SELECT EXISTS(
+            SELECT 1
+            FROM users u
+            WHERE u.id > _last_user_id
+            AND (_job.scope <> 'user' OR u.telegram_id = _job.telegram_id)
+        ) INTO _has_more;
+
+        UPDATE aggregate_rebuild_jobs SET
+            status = CASE WHEN _has_more THEN 'running' ELSE 'completed' END::aggregate_rebuild_job_status,
+            cursor_user_id = _last_user_id,
+            processed_users = processed_users + _processed,
+            total_users = GREATEST(total_users, processed_users + _processed),
+            leaderboard_weeks_diff = leaderboard_weeks_diff + _leaderboard_weeks_diff,
+            user_stats_diff = user_stats_diff + _user_stats_diff,
+            user_level_history_diff = user_level_history_diff + _user_level_history_diff,
+            updated_at = NOW(),
+            finished_at = CASE WHEN _has_more THEN NULL ELSE NOW() END
+        WHERE id = _job.id
+        RETURNING * INTO _job;
+    EXCEPTION
+        WHEN OTHERS THEN
+            -- изменения текущей пачки откатываются, задача помечается как упавшая.
+            UPDATE aggregate_rebuild_jobs SET
+                status = 'failed',
+                error = SQLERRM,
+                updated_at = NOW(),
+                finished_at = NOW()
+            WHERE id = _job.id
+            RETURNING * INTO _job;
+    END;
+
+    RETURN TO_JSONB(_job);
+END;
+$$;
\ No newline at end of file
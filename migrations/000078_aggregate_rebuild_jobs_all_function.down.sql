@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.aggregate_rebuild_jobs_all(INTEGER);
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE TYPE level_reward_type AS ENUM ('internal_currency', 'award_asset', 'subscription_days');
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_level_rewards;
+DROP TABLE IF EXISTS level_rewards;
+DELETE FROM event_types WHERE name = 'level_up_reward';
\ No newline at end of file
@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS level_rewards( -- Награды за достижение уровня.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    level_number BIGINT NOT NULL, -- Номер уровня, за который выдаётся награда.
+    type level_reward_type NOT NULL, -- Тип награды.
+    amount NUMERIC(20, 2), -- Сумма внутренней валюты (для type = 'internal_currency').
+    award_asset_id BIGINT, -- Идентификатор награды (для type = 'award_asset').
+    subscription_days INTEGER, -- Количество дней подписки (для type = 'subscription_days').
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    FOREIGN KEY (level_number) REFERENCES levels(level_number) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (award_asset_id) REFERENCES award_assets(id),
+    CONSTRAINT check_level_rewards_internal_currency CHECK (type <> 'internal_currency' OR (amount IS NOT NULL AND amount > 0)),
+    CONSTRAINT check_level_rewards_award_asset CHECK (type <> 'award_asset' OR award_asset_id IS NOT NULL),
+    CONSTRAINT check_level_rewards_subscription_days CHECK (type <> 'subscription_days' OR (subscription_days IS NOT NULL AND subscription_days > 0))
+);
+
+CREATE TABLE IF NOT EXISTS user_level_rewards( -- Выданные пользователю награды за уровни. Гарантирует однократную выдачу.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    level_reward_id BIGINT, -- Идентификатор награды (NULL, если награду позже удалили).
+    level_number BIGINT NOT NULL, -- Номер уровня на момент выдачи.
+    type level_reward_type NOT NULL, -- Тип награды на момент выдачи.
+    amount NUMERIC(20, 2), -- Сумма внутренней валюты на момент выдачи.
+    award_asset_id BIGINT, -- Идентификатор награды на момент выдачи.
+    subscription_days INTEGER, -- Количество дней подписки на момент выдачи.
+    granted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата выдачи награды.
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (level_reward_id) REFERENCES level_rewards(id) ON DELETE SET NULL,
+    FOREIGN KEY (award_asset_id) REFERENCES award_assets(id),
+    CONSTRAINT unique_user_level_rewards_telegram_id_level_reward_id UNIQUE (telegram_id, level_reward_id)
+);
+
+INSERT INTO event_types(
+    name,
+    description
+) VALUES(
+    'level_up_reward',
+    'Событие по начислению награды за достижение уровня'
+);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_level_rewards_level_number;
+DROP INDEX IF EXISTS idx_user_level_rewards_telegram_id;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Награды конкретного уровня.
+CREATE INDEX IF NOT EXISTS idx_level_rewards_level_number ON level_rewards (level_number);
+
+-- Награды, выданные пользователю.
+CREATE INDEX IF NOT EXISTS idx_user_level_rewards_telegram_id ON user_level_rewards (telegram_id);
\ No newline at end of file
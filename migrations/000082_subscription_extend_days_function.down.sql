@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.subscription_extend_days(TEXT, INTEGER);
\ No newline at end of file
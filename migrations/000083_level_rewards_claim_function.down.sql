@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.level_rewards_claim(TEXT);
\ No newline at end of file
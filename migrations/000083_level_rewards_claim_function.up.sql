@@ -0,0 +1,60 @@
+CREATE OR REPLACE FUNCTION public.level_rewards_claim(
+    _telegram_id TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _granted JSONB;
+    _r RECORD;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- выдаём награды за все достигнутые уровни, которые ещё не выдавались
+    -- (в том числе за несколько уровней сразу). Награды, добавленные после
+    -- достижения уровня, задним числом не выдаются.
+    WITH granted AS (
+        INSERT INTO user_level_rewards(
+            telegram_id,
+            level_reward_id,
+            level_number,
+            type,
+            amount,
+            award_asset_id,
+            subscription_days
+        )
+        SELECT
+            _telegram_id,
+            lr.id,
+            lr.level_number,
+            lr.type,
+            lr.amount,
+            lr.award_asset_id,
+            lr.subscription_days
+        FROM level_rewards lr
+        INNER JOIN user_level_history ulh ON ulh.telegram_id = _telegram_id
+        AND ulh.level_number = lr.level_number
+        WHERE ulh.reached_at >= lr.created_at
+        ORDER BY lr.level_number, lr.id
+        ON CONFLICT (telegram_id, level_reward_id) DO NOTHING
+        RETURNING *
+    )
+    SELECT COALESCE(JSONB_AGG(TO_JSONB(g) ORDER BY g.level_number, g.id), '[]'::JSONB)
+    INTO _granted
+    FROM granted g;
+
+    -- дни подписки начисляем сразу, внутренняя валюта начисляется сервисом через баланс.
+    FOR _r IN
+        SELECT (x->>'subscription_days')::INTEGER AS subscription_days
+        FROM JSONB_ARRAY_ELEMENTS(_granted) x
+        WHERE x->>'type' = 'subscription_days'
+    LOOP
+        PERFORM public.subscription_extend_days(_telegram_id, _r.subscription_days);
+    END LOOP;
+
+    RETURN _granted;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.levels_generate(BIGINT, BIGINT, NUMERIC);
\ No newline at end of file
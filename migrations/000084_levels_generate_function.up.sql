@@ -0,0 +1,62 @@
+CREATE OR REPLACE FUNCTION public.levels_generate(
+    _to_level BIGINT,
+    _base_experience BIGINT,
+    _exponent NUMERIC
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _max_level BIGINT;
+    _prev_experience BIGINT;
+    _required_experience BIGINT;
+    _created JSONB := '[]'::JSONB;
+    _l levels;
+BEGIN
+    IF _to_level IS NULL OR _to_level <= 0 THEN
+        RAISE EXCEPTION 'to_level IS NULL OR <= 0';
+    END IF;
+    IF _base_experience IS NULL OR _base_experience <= 0 THEN
+        RAISE EXCEPTION 'base_experience IS NULL OR <= 0';
+    END IF;
+    IF _exponent IS NULL OR _exponent <= 0 THEN
+        RAISE EXCEPTION 'exponent IS NULL OR <= 0';
+    END IF;
+
+    -- не даём двум генерациям работать одновременно.
+    LOCK TABLE levels IN SHARE ROW EXCLUSIVE MODE;
+
+    SELECT
+        COALESCE(MAX(level_number), 0),
+        COALESCE(MAX(required_experience), 0)
+    INTO _max_level, _prev_experience
+    FROM levels;
+
+    -- существующие уровни не меняются, достраиваются только недостающие:
+    -- required_experience(n) = base_experience * (n - 1) ^ exponent,
+    -- но всегда строго больше порога предыдущего уровня.
+    FOR _n IN (_max_level + 1).._to_level LOOP
+        _required_experience := GREATEST(
+            ROUND(_base_experience * POWER((_n - 1)::NUMERIC, _exponent))::BIGINT,
+            CASE WHEN _n = 1 THEN 0 ELSE _prev_experience + 1 END
+        );
+
+        INSERT INTO levels(
+            level_name,
+            level_number,
+            required_experience
+        ) VALUES(
+            'level ' || _n,
+            _n,
+            _required_experience
+        )
+        RETURNING * INTO _l;
+
+        _created := _created || JSONB_BUILD_ARRAY(TO_JSONB(_l));
+        _prev_experience := _required_experience;
+    END LOOP;
+
+    RETURN _created;
+END;
+$$;
\ No newline at end of file
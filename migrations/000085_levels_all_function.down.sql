@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.levels_all();
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE OR REPLACE FUNCTION public.levels_all() RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    RETURN COALESCE((
+        SELECT JSONB_AGG(
+            TO_JSONB(l) || JSONB_BUILD_OBJECT(
+                'rewards', COALESCE((
+                    SELECT JSONB_AGG(TO_JSONB(lr) ORDER BY lr.id)
+                    FROM level_rewards lr
+                    WHERE lr.level_number = l.level_number
+                ), '[]'::JSONB)
+            )
+            ORDER BY l.level_number
+        )
+        FROM levels l
+    ), '[]'::JSONB);
+END;
+$$;
\ No newline at end of file
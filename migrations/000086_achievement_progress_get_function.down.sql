@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.achievement_progress_get(TEXT);
\ No newline at end of file
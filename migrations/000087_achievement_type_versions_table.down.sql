@@ -0,0 +1,5 @@
+ALTER TABLE user_achievements DROP COLUMN IF EXISTS achievement_type_version;
+
+DROP TABLE IF EXISTS achievement_type_versions;
+
+ALTER TABLE achievement_types DROP COLUMN IF EXISTS version;
\ No newline at end of file
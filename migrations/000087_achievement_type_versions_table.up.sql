@@ -0,0 +1,50 @@
+ALTER TABLE achievement_types ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1; -- Текущая версия критериев типа достижения.
+
+CREATE TABLE IF NOT EXISTS achievement_type_versions( -- История версий критериев типов достижений. Новая версия создаётся при каждом изменении критериев.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    achievement_type_id BIGINT NOT NULL, -- Идентификатор типа достижения.
+    version BIGINT NOT NULL, -- Номер версии критериев.
+    streak_days_need BIGINT, -- Сколько дней подряд пользователь должен заходить в приложение.
+    daily_task_streak_days_need BIGINT, -- Сколько дней подряд пользователь должен выполнять ежедневные задания.
+    words_learned_need BIGINT, -- Сколько нужно выучить слов.
+    tasks_completed_need BIGINT, -- Сколько заданий нужно выполнить.
+    lessons_finished_need BIGINT, -- Сколько нужно пройти уроков.
+    words_translate_need BIGINT, -- Сколько нужно перевести слов.
+    dialog_completed_need BIGINT, -- Сколько нужно пройти диалогов.
+    experience_points_need BIGINT, -- Сколько нужно опыта.
+    level_need BIGINT, -- Какой уровень должен быть у пользователя.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    FOREIGN KEY (achievement_type_id) REFERENCES achievement_types(id) ON DELETE CASCADE,
+    CONSTRAINT unique_achievement_type_versions_achievement_type_id_version UNIQUE (achievement_type_id, version)
+);
+
+-- первая версия критериев для уже существующих типов достижений.
+INSERT INTO achievement_type_versions(
+    achievement_type_id,
+    version,
+    streak_days_need,
+    daily_task_streak_days_need,
+    words_learned_need,
+    tasks_completed_need,
+    lessons_finished_need,
+    words_translate_need,
+    dialog_completed_need,
+    experience_points_need,
+    level_need
+)
+SELECT
+    at.id,
+    at.version,
+    at.streak_days_need,
+    at.daily_task_streak_days_need,
+    at.words_learned_need,
+    at.tasks_completed_need,
+    at.lessons_finished_need,
+    at.words_translate_need,
+    at.dialog_completed_need,
+    at.experience_points_need,
+    at.level_need
+FROM achievement_types at
+ON CONFLICT (achievement_type_id, version) DO NOTHING;
+
+ALTER TABLE user_achievements ADD COLUMN IF NOT EXISTS achievement_type_version BIGINT NOT NULL DEFAULT 1; -- Версия критериев, по которой пользователь получил достижение.
\ No newline at end of file
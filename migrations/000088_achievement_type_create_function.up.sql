@@ -0,0 +1,77 @@
+CREATE OR REPLACE FUNCTION public.achievement_type_create(
+    _src JSONB
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _achievement_type achievement_types;
+BEGIN
+    IF _src IS NULL THEN
+        RAISE EXCEPTION 'src IS NULL';
+    END IF;
+
+    IF NULLIF(_src->>'name', '') IS NULL THEN
+        RAISE EXCEPTION 'name IS NULL';
+    END IF;
+
+    INSERT INTO achievement_types(
+        name,
+        description,
+        streak_days_need,
+        daily_task_streak_days_need,
+        words_learned_need,
+        tasks_completed_need,
+        lessons_finished_need,
+        words_translate_need,
+        dialog_completed_need,
+        experience_points_need,
+        level_need,
+        is_active
+    ) VALUES(
+        _src->>'name',
+        _src->>'description',
+        (_src->>'streak_days_need')::BIGINT,
+        (_src->>'daily_task_streak_days_need')::BIGINT,
+        (_src->>'words_learned_need')::BIGINT,
+        (_src->>'tasks_completed_need')::BIGINT,
+        (_src->>'lessons_finished_need')::BIGINT,
+        (_src->>'words_translate_need')::BIGINT,
+        (_src->>'dialog_completed_need')::BIGINT,
+        (_src->>'experience_points_need')::BIGINT,
+        (_src->>'level_need')::BIGINT,
+        COALESCE((_src->>'is_active')::BOOLEAN, TRUE)
+    )
+    RETURNING * INTO _achievement_type;
+
+    -- фиксируем первую версию критериев.
+    INSERT INTO achievement_type_versions(
+        achievement_type_id,
+        version,
+        streak_days_need,
+        daily_task_streak_days_need,
+        words_learned_need,
+        tasks_completed_need,
+        lessons_finished_need,
+        words_translate_need,
+        dialog_completed_need,
+        experience_points_need,
+        level_need
+    ) VALUES(
+        _achievement_type.id,
+        _achievement_type.version,
+        _achievement_type.streak_days_need,
+        _achievement_type.daily_task_streak_days_need,
+        _achievement_type.words_learned_need,
+        _achievement_type.tasks_completed_need,
+        _achievement_type.lessons_finished_need,
+        _achievement_type.words_translate_need,
+        _achievement_type.dialog_completed_need,
+        _achievement_type.experience_points_need,
+        _achievement_type.level_need
+    );
+
+    RETURN TO_JSONB(_achievement_type);
+END;
+$$;
\ No newline at end of file
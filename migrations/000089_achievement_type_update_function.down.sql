@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.achievement_type_update(JSONB);
\ No newline at end of file
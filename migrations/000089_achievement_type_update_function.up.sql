@@ -0,0 +1,110 @@
+CREATE OR REPLACE FUNCTION public.achievement_type_update(
+    _src JSONB
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _id BIGINT;
+    _old achievement_types;
+    _new achievement_types;
+    _criteria_changed BOOLEAN;
+BEGIN
+    IF _src IS NULL THEN
+        RAISE EXCEPTION 'src IS NULL';
+    END IF;
+
+    _id := (_src->>'id')::BIGINT;
+
+    IF _id IS NULL THEN
+        RAISE EXCEPTION 'id IS NULL';
+    END IF;
+
+    IF NULLIF(_src->>'name', '') IS NULL THEN
+        RAISE EXCEPTION 'name IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _old
+    FROM achievement_types
+    WHERE id = _id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'achievement type % does not exist', _id;
+    END IF;
+
+    -- новая версия создаётся только при изменении критериев,
+    -- правки названия, описания и активности версию не меняют.
+    _criteria_changed := ROW(
+        _old.streak_days_need,
+        _old.daily_task_streak_days_need,
+        _old.words_learned_need,
+        _old.tasks_completed_need,
+        _old.lessons_finished_need,
+        _old.words_translate_need,
+        _old.dialog_completed_need,
+        _old.experience_points_need,
+        _old.level_need
+    ) IS DISTINCT FROM ROW(
+        (_src->>'streak_days_need')::BIGINT,
+        (_src->>'daily_task_streak_days_need')::BIGINT,
+        (_src->>'words_learned_need')::BIGINT,
+        (_src->>'tasks_completed_need')::BIGINT,
+        (_src->>'lessons_finished_need')::BIGINT,
+        (_src->>'words_translate_need')::BIGINT,
+        (_src->>'dialog_completed_need')::BIGINT,
+        (_src->>'experience_points_need')::BIGINT,
+        (_src->>'level_need')::BIGINT
+    );
+
+    UPDATE achievement_types SET
+        name = _src->>'name',
+        description = _src->>'description',
+        streak_days_need = (_src->>'streak_days_need')::BIGINT,
+        daily_task_streak_days_need = (_src->>'daily_task_streak_days_need')::BIGINT,
+        words_learned_need = (_src->>'words_learned_need')::BIGINT,
+        tasks_completed_need = (_src->>'tasks_completed_need')::BIGINT,
+        lessons_finished_need = (_src->>'lessons_finished_need')::BIGINT,
+        words_translate_need = (_src->>'words_translate_need')::BIGINT,
+        dialog_completed_need = (_src->>'dialog_completed_need')::BIGINT,
+        experience_points_need = (_src->>'experience_points_need')::BIGINT,
+        level_need = (_src->>'level_need')::BIGINT,
+        is_active = COALESCE((_src->>'is_active')::BOOLEAN, _old.is_active),
+        version = CASE WHEN _criteria_changed THEN _old.version + 1 ELSE _old.version END,
+        updated_at = NOW()
+    WHERE id = _id
+    RETURNING * INTO _new;
+
+    IF _criteria_changed THEN
+        INSERT INTO achievement_type_versions(
+            achievement_type_id,
+            version,
+            streak_days_need,
+            daily_task_streak_days_need,
+            words_learned_need,
+            tasks_completed_need,
+            lessons_finished_need,
+            words_translate_need,
+            dialog_completed_need,
+            experience_points_need,
+            level_need
+        ) VALUES(
+            _new.id,
+            _new.version,
+            _new.streak_days_need,
+            _new.daily_task_streak_days_need,
+            _new.words_learned_need,
+            _new.tasks_completed_need,
+            _new.lessons_finished_need,
+            _new.words_translate_need,
+            _new.dialog_completed_need,
+            _new.experience_points_need,
+            _new.level_need
+        );
+    END IF;
+
+    RETURN TO_JSONB(_new);
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,11 @@
+ALTER TABLE achievements
+    DROP CONSTRAINT IF EXISTS unique_achievements_tier_group_tier_level,
+    DROP CONSTRAINT IF EXISTS check_achievements_tier,
+    DROP CONSTRAINT IF EXISTS check_achievements_available_window;
+
+ALTER TABLE achievements
+    DROP COLUMN IF EXISTS tier_level,
+    DROP COLUMN IF EXISTS tier_group,
+    DROP COLUMN IF EXISTS available_to,
+    DROP COLUMN IF EXISTS available_from,
+    DROP COLUMN IF EXISTS is_secret;
\ No newline at end of file
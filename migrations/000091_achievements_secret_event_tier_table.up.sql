@@ -0,0 +1,11 @@
+ALTER TABLE achievements
+    ADD COLUMN IF NOT EXISTS is_secret BOOLEAN NOT NULL DEFAULT FALSE, -- Секретное достижение: название и условия скрыты ("???") до получения.
+    ADD COLUMN IF NOT EXISTS available_from TIMESTAMP WITH TIME ZONE, -- Начало окна, в которое достижение можно получить (NULL — без ограничения).
+    ADD COLUMN IF NOT EXISTS available_to TIMESTAMP WITH TIME ZONE, -- Конец окна, в которое достижение можно получить (NULL — без ограничения).
+    ADD COLUMN IF NOT EXISTS tier_group TEXT, -- Название цепочки ступеней (например, "words_learned"), NULL — достижение вне цепочки.
+    ADD COLUMN IF NOT EXISTS tier_level INTEGER; -- Номер ступени в цепочке (1 — бронза, 2 — серебро, 3 — золото и т.д.).
+
+ALTER TABLE achievements
+    ADD CONSTRAINT check_achievements_available_window CHECK (available_from IS NULL OR available_to IS NULL OR available_from < available_to),
+    ADD CONSTRAINT check_achievements_tier CHECK ((tier_group IS NULL) = (tier_level IS NULL) AND (tier_level IS NULL OR tier_level > 0)),
+    ADD CONSTRAINT unique_achievements_tier_group_tier_level UNIQUE (tier_group, tier_level);
\ No newline at end of file
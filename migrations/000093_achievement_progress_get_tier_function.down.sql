@@ -0,0 +1,110 @@
+CREATE OR REPLACE FUNCTION public.achievement_progress_get(
+    _telegram_id TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    WITH metrics AS (
+        SELECT
+            us.streak_days,
+            us.daily_task_streak_days,
+            us.words_learned,
+            us.tasks_completed,
+            us.lessons_finished,
+            us.words_translate,
+            us.dialog_completed,
+            us.experience_points,
+            us.level
+        FROM user_stats us
+        WHERE us.telegram_id = _telegram_id
+    ),
+    criteria AS (
+        -- по одной строке на каждый заданный критерий типа достижения.
+        SELECT
+            a.id AS achievement_id,
+            c.metric,
+            c.current,
+            c.need,
+            ROUND(LEAST(c.current::NUMERIC / c.need, 1) * 100, 2) AS percent
+        FROM achievements a
+        INNER JOIN achievement_types at ON a.achievement_type_id = at.id
+        INNER JOIN metrics m ON TRUE
+        CROSS JOIN LATERAL (
+            VALUES
+                ('streak_days', m.streak_days, at.streak_days_need),
+                ('daily_task_streak_days', m.daily_task_streak_days, at.daily_task_streak_days_need),
+                ('words_learned', m.words_learned, at.words_learned_need),
+                ('tasks_completed', m.tasks_completed, at.tasks_completed_need),
+                ('lessons_finished', m.lessons_finished, at.lessons_finished_need),
+                ('words_translate', m.words_translate, at.words_translate_need),
+                ('dialog_completed', m.dialog_completed, at.dialog_completed_need),
+                ('experience_points', m.experience_points, at.experience_points_need),
+                ('level', m.level, at.level_need)
+        ) AS c(metric, current, need)
+        WHERE at.is_active
+        AND c.need IS NOT NULL
+        AND c.need > 0
+    ),
+    progress AS (
+        SELECT
+            a.id AS achievement_id,
+            a.name,
+            a.description,
+            at.name AS achievement_type,
+            ua.unlocked_at,
+            -- общий прогресс — среднее по критериям (каждый ограничен 100%).
+            CASE
+                WHEN ua.id IS NOT NULL THEN 100
+                ELSE COALESCE(ROUND(AVG(cr.percent), 2), 100)
+            END AS overall_percent,
+            COALESCE(
+                JSONB_AGG(
+                    JSONB_BUILD_OBJECT(
+                        'metric', cr.metric,
+                        'current', cr.current,
+                        'need', cr.need,
+                        'percent', cr.percent
+                    )
+                    ORDER BY cr.metric
+                ) FILTER (WHERE cr.metric IS NOT NULL),
+                '[]'::JSONB
+            ) AS criteria
+        FROM achievements a
+        INNER JOIN achievement_types at ON a.achievement_type_id = at.id
+        LEFT JOIN criteria cr ON cr.achievement_id = a.id
+        LEFT JOIN user_achievements ua ON ua.achievement_id = a.id
+        AND ua.telegram_id = _telegram_id
+        WHERE at.is_active
+        GROUP BY a.id, a.name, a.description, at.name, ua.id, ua.unlocked_at
+    )
+    SELECT COALESCE(
+        JSONB_AGG(
+            JSONB_BUILD_OBJECT(
+                'achievement_id', p.achievement_id,
+                'name', p.name,
+                'description', p.description,
+                'achievement_type', p.achievement_type,
+                'is_unlocked', p.unlocked_at IS NOT NULL,
+                'unlocked_at', p.unlocked_at,
+                'overall_percent', p.overall_percent,
+                'criteria', p.criteria
+            )
+            -- сначала неполученные, ближайшие к выполнению — выше.
+            ORDER BY (p.unlocked_at IS NOT NULL), p.overall_percent DESC, p.achievement_id
+        ),
+        '[]'::JSONB
+    )
+    INTO _response
+    FROM progress p;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_balance_transactions_source_type_source_id;
+
+ALTER TABLE balance_transactions
+    DROP COLUMN IF EXISTS source_id,
+    DROP COLUMN IF EXISTS source_type;
\ No newline at end of file
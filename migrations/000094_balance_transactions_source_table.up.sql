@@ -0,0 +1,5 @@
+ALTER TABLE balance_transactions
+    ADD COLUMN IF NOT EXISTS source_type TEXT, -- Тип источника операции (например, "achievement_reward", "level_reward").
+    ADD COLUMN IF NOT EXISTS source_id BIGINT; -- Идентификатор записи источника операции.
+
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_source_type_source_id ON balance_transactions(source_type, source_id);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS achievement_reward_type;
\ No newline at end of file
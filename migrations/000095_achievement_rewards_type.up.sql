@@ -0,0 +1 @@
+CREATE TYPE achievement_reward_type AS ENUM ('internal_currency', 'experience_points', 'subscription_days');
\ No newline at end of file
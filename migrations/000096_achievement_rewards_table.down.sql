@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_achievement_rewards;
+DROP TABLE IF EXISTS achievement_rewards;
+DELETE FROM event_types WHERE name = 'achievement_reward';
\ No newline at end of file
@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS achievement_rewards( -- Награды за получение достижения.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    achievement_id BIGINT NOT NULL, -- Идентификатор достижения, за которое выдаётся награда.
+    type achievement_reward_type NOT NULL, -- Тип награды.
+    amount NUMERIC(20, 2), -- Сумма внутренней валюты (для type = 'internal_currency').
+    experience_points INTEGER, -- Количество опыта (для type = 'experience_points').
+    subscription_days INTEGER, -- Количество дней подписки (для type = 'subscription_days').
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    FOREIGN KEY (achievement_id) REFERENCES achievements(id) ON DELETE CASCADE,
+    CONSTRAINT check_achievement_rewards_internal_currency CHECK (type <> 'internal_currency' OR (amount IS NOT NULL AND amount > 0)),
+    CONSTRAINT check_achievement_rewards_experience_points CHECK (type <> 'experience_points' OR (experience_points IS NOT NULL AND experience_points > 0)),
+    CONSTRAINT check_achievement_rewards_subscription_days CHECK (type <> 'subscription_days' OR (subscription_days IS NOT NULL AND subscription_days > 0))
+);
+
+CREATE TABLE IF NOT EXISTS user_achievement_rewards( -- Выданные пользователю награды за достижения. Гарантирует однократную выдачу.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    user_achievement_id BIGINT NOT NULL, -- Идентификатор полученного достижения.
+    achievement_id BIGINT NOT NULL, -- Идентификатор достижения на момент выдачи.
+    achievement_reward_id BIGINT, -- Идентификатор награды (NULL, если награду позже удалили).
+    type achievement_reward_type NOT NULL, -- Тип награды на момент выдачи.
+    amount NUMERIC(20, 2), -- Сумма внутренней валюты на момент выдачи.
+    experience_points INTEGER, -- Количество опыта на момент выдачи.
+    subscription_days INTEGER, -- Количество дней подписки на момент выдачи.
+    granted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата выдачи награды.
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (user_achievement_id) REFERENCES user_achievements(id) ON DELETE CASCADE,
+    FOREIGN KEY (achievement_reward_id) REFERENCES achievement_rewards(id) ON DELETE SET NULL,
+    CONSTRAINT unique_user_achievement_rewards_user_achievement_id_achievement_reward_id UNIQUE (user_achievement_id, achievement_reward_id)
+);
+
+INSERT INTO event_types(
+    name,
+    description
+) VALUES(
+    'achievement_reward',
+    'Событие по начислению награды за получение достижения'
+);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_achievement_rewards_achievement_id;
+DROP INDEX IF EXISTS idx_user_achievement_rewards_telegram_id;
\ No newline at end of file
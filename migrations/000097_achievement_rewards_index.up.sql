@@ -0,0 +1,5 @@
+-- Награды конкретного достижения.
+CREATE INDEX IF NOT EXISTS idx_achievement_rewards_achievement_id ON achievement_rewards (achievement_id);
+
+-- Награды, выданные пользователю.
+CREATE INDEX IF NOT EXISTS idx_user_achievement_rewards_telegram_id ON user_achievement_rewards (telegram_id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TYPE IF EXISTS achievement_evaluation_job_reason;
+DROP TYPE IF EXISTS achievement_evaluation_job_status;
\ No newline at end of file
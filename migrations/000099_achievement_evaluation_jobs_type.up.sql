@@ -0,0 +1,2 @@
+CREATE TYPE achievement_evaluation_job_reason AS ENUM ('achievement_create', 'achievement_update', 'manual');
+CREATE TYPE achievement_evaluation_job_status AS ENUM ('pending', 'running', 'completed', 'failed');
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS achievement_evaluation_jobs;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS achievement_evaluation_jobs( -- Задачи ретроактивной проверки достижения по всем пользователям.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    achievement_id BIGINT NOT NULL, -- Идентификатор проверяемого достижения.
+    reason achievement_evaluation_job_reason NOT NULL, -- Причина запуска: создание, изменение достижения или ручной запуск.
+    status achievement_evaluation_job_status NOT NULL DEFAULT 'pending', -- Статус задачи.
+    cursor_user_id BIGINT NOT NULL DEFAULT 0, -- users.id последнего обработанного пользователя (для продолжения с места остановки).
+    processed_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей проверено.
+    total_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей нужно проверить.
+    unlocked_users BIGINT NOT NULL DEFAULT 0, -- Скольким пользователям выдано достижение.
+    notified_users BIGINT NOT NULL DEFAULT 0, -- Скольким недавно активным пользователям отправлено уведомление.
+    error TEXT, -- Текст ошибки (для status = 'failed').
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    finished_at TIMESTAMP WITH TIME ZONE, -- Дата завершения задачи.
+    FOREIGN KEY (achievement_id) REFERENCES achievements(id) ON DELETE CASCADE
+);
+
+-- Поиск незавершённых задач воркером.
+CREATE INDEX IF NOT EXISTS idx_achievement_evaluation_jobs_status_unfinished ON achievement_evaluation_jobs (id) WHERE status IN ('pending', 'running');
\ No newline at end of file
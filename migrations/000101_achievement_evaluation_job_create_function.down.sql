@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.achievement_evaluation_job_create(JSONB);
\ No newline at end of file
@@ -0,0 +1,58 @@
+CREATE OR REPLACE FUNCTION public.achievement_evaluation_job_create(
+    _src JSONB
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _achievement_id BIGINT;
+    _reason achievement_evaluation_job_reason;
+    _total_users BIGINT;
+    _job achievement_evaluation_jobs;
+BEGIN
+    IF _src IS NULL THEN
+        RAISE EXCEPTION 'src IS NULL';
+    END IF;
+
+    _achievement_id := (_src->>'achievement_id')::BIGINT;
+    _reason := COALESCE(NULLIF(_src->>'reason', ''), 'manual')::achievement_evaluation_job_reason;
+
+    IF _achievement_id IS NULL THEN
+        RAISE EXCEPTION 'achievement_id IS NULL';
+    END IF;
+
+    -- задача, которая ещё не начала обрабатываться, проверит актуальные условия,
+    -- поэтому повторные изменения достижения не создают новых задач.
+    SELECT *
+    INTO _job
+    FROM achievement_evaluation_jobs
+    WHERE achievement_id = _achievement_id
+    AND status = 'pending'
+    ORDER BY id
+    LIMIT 1
+    FOR UPDATE;
+
+    IF FOUND THEN
+        RETURN TO_JSONB(_job);
+    END IF;
+
+    SELECT COUNT(*)
+    INTO _total_users
+    FROM users u
+    INNER JOIN user_stats us ON u.telegram_id = us.telegram_id;
+
+    INSERT INTO achievement_evaluation_jobs(
+        achievement_id,
+        reason,
+        total_users
+    ) VALUES(
+        _achievement_id,
+        _reason,
+        _total_users
+    )
+    RETURNING * INTO _job;
+
+    RETURN TO_JSONB(_job);
+END;
+$$;
\ No newline at end of file
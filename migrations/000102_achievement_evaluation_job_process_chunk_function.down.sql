@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.achievement_evaluation_job_process_chunk(BIGINT, INTEGER, TIMESTAMP WITH TIME ZONE);
\ No newline at end of file
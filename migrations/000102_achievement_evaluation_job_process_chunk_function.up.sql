@@ -0,0 +1,129 @@
+CREATE OR REPLACE FUNCTION public.achievement_evaluation_job_process_chunk(
+    _job_id BIGINT, -- NULL = самая старая незавершённая задача.
+    _chunk_size INTEGER,
+    _active_since TIMESTAMP WITH TIME ZONE -- пользователи, активные после этой даты, получают уведомления.
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _job achievement_evaluation_jobs;
+    _user RECORD;
+    _achievements JSONB;
+    _unlocked JSONB := '[]'::JSONB;
+    _last_user_id BIGINT;
+    _processed BIGINT := 0;
+    _unlocked_users BIGINT := 0;
+    _notified_users BIGINT := 0;
+    _has_more BOOLEAN;
+BEGIN
+    IF _chunk_size IS NULL OR _chunk_size <= 0 THEN
+        RAISE EXCEPTION 'chunk_size IS NULL OR <= 0';
+    END IF;
+
+    -- блокируем задачу, чтобы несколько экземпляров не обрабатывали её одновременно.
+    SELECT *
+    INTO _job
+    FROM achievement_evaluation_jobs
+    WHERE (_job_id IS NULL OR id = _job_id)
+    AND status IN ('pending', 'running')
+    ORDER BY id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED;
+
+    IF NOT FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    _last_user_id := _job.cursor_user_id;
+
+    BEGIN
+        FOR _user IN
+            SELECT
+                u.id,
+                u.telegram_id,
+                us.last_active_at
+            FROM users u
+            INNER JOIN user_stats us ON u.telegram_id = us.telegram_id
+            WHERE u.id > _job.cursor_user_id
+            ORDER BY u.id
+            LIMIT _chunk_size
+        LOOP
+            _processed := _processed + 1;
+            _last_user_id := _user.id;
+
+            -- пользователь уже получил достижение.
+            CONTINUE WHEN EXISTS(
+                SELECT 1
+                FROM user_achievements ua
+                WHERE ua.telegram_id = _user.telegram_id
+                AND ua.achievement_id = _job.achievement_id
+            );
+
+            -- выдаём все доступные достижения, награды начисляются так же, как при событиях.
+            _achievements := public.unlock_available_achievements(_user.telegram_id);
+
+            IF JSONB_ARRAY_LENGTH(_achievements) = 0 THEN
+                CONTINUE;
+            END IF;
+
+            IF EXISTS(
+                SELECT 1
+                FROM JSONB_ARRAY_ELEMENTS(_achievements) x
+                WHERE (x->>'achievement_id')::BIGINT = _job.achievement_id
+            ) THEN
+                _unlocked_users := _unlocked_users + 1;
+            END IF;
+
+            IF _user.last_active_at IS NOT NULL AND _user.last_active_at >= _active_since THEN
+                _notified_users := _notified_users + 1;
+            END IF;
+
+            _unlocked := _unlocked || JSONB_BUILD_ARRAY(
+                JSONB_BUILD_OBJECT(
+                    'telegram_id', _user.telegram_id,
+                    'is_active', _user.last_active_at IS NOT NULL AND _user.last_active_at >= _active_since,
+                    'achievements', _achievements
+                )
+            );
+        END LOOP;
+
+        SELECT EXISTS(
+            SELECT 1
+            FROM users u
+            INNER JOIN user_stats us ON u.telegram_id = us.telegram_id
+            WHERE u.id > _last_user_id
+        ) INTO _has_more;
+
+        UPDATE achievement_evaluation_jobs SET
+            status = CASE WHEN _has_more THEN 'running' ELSE 'completed' END::achievement_evaluation_job_status,
+            cursor_user_id = _last_user_id,
+            processed_users = processed_users + _processed,
+            total_users = GREATEST(total_users, processed_users + _processed),
+            unlocked_users = unlocked_users + _unlocked_users,
+            notified_users = notified_users + _notified_users,
+            updated_at = NOW(),
+            finished_at = CASE WHEN _has_more THEN NULL ELSE NOW() END
+        WHERE id = _job.id
+        RETURNING * INTO _job;
+    EXCEPTION
+        WHEN OTHERS THEN
+            -- изменения текущей пачки откатываются, задача помечается как упавшая.
+            _unlocked := '[]'::JSONB;
+
+            UPDATE achievement_evaluation_jobs SET
+                status = 'failed',
+                error = SQLERRM,
+                updated_at = NOW(),
+                finished_at = NOW()
+            WHERE id = _job.id
+            RETURNING * INTO _job;
+    END;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'job', TO_JSONB(_job),
+        'unlocked', _unlocked
+    );
+END;
+$$;
\ No newline at end of file
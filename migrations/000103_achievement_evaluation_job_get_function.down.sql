@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.achievement_evaluation_job_get(BIGINT);
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE OR REPLACE FUNCTION public.achievement_evaluation_job_get(
+    _id BIGINT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _job achievement_evaluation_jobs;
+BEGIN
+    IF _id IS NULL THEN
+        RAISE EXCEPTION 'id IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _job
+    FROM achievement_evaluation_jobs
+    WHERE id = _id;
+
+    IF NOT FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    RETURN TO_JSONB(_job);
+END;
+$$;
\ No newline at end of file
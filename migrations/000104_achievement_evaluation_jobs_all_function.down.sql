@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.achievement_evaluation_jobs_all(INTEGER);
\ No newline at end of file
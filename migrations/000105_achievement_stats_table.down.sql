@@ -0,0 +1 @@
+DROP TABLE IF EXISTS achievement_stats;
\ No newline at end of file
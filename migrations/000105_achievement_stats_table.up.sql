@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS achievement_stats( -- Статистика редкости достижений. Пересчитывается периодически.
+    achievement_id BIGINT PRIMARY KEY, -- Идентификатор достижения.
+    unlock_count BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей получили достижение.
+    active_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей были активны за период расчёта.
+    active_unlock_count BIGINT NOT NULL DEFAULT 0, -- Сколько активных пользователей получили достижение.
+    unlock_percent NUMERIC(5, 2) NOT NULL DEFAULT 0, -- Процент активных пользователей, получивших достижение.
+    first_unlocker_telegram_id TEXT, -- Telegram id первого получившего достижение.
+    first_unlocked_at TIMESTAMP WITH TIME ZONE, -- Когда достижение получили впервые.
+    median_time_to_unlock BIGINT, -- Медианное время (в секундах) от регистрации пользователя до получения достижения.
+    calculated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата последнего пересчёта.
+    FOREIGN KEY (achievement_id) REFERENCES achievements(id) ON DELETE CASCADE,
+    FOREIGN KEY (first_unlocker_telegram_id) REFERENCES users(telegram_id)
+);
\ No newline at end of file
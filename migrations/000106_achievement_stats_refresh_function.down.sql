@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.achievement_stats_refresh(INTEGER);
\ No newline at end of file
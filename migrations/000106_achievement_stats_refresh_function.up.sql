@@ -0,0 +1,84 @@
+CREATE OR REPLACE FUNCTION public.achievement_stats_refresh(
+    _active_days INTEGER -- пользователи, активные за это количество дней, считаются активными.
+) RETURNS BIGINT
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _active_users BIGINT;
+    _count BIGINT;
+BEGIN
+    IF _active_days IS NULL OR _active_days <= 0 THEN
+        RAISE EXCEPTION 'active_days IS NULL OR <= 0';
+    END IF;
+
+    SELECT COUNT(*)
+    INTO _active_users
+    FROM user_stats
+    WHERE last_active_at >= NOW() - MAKE_INTERVAL(days => _active_days);
+
+    WITH unlocked AS (
+        SELECT
+            ua.achievement_id,
+            ua.telegram_id,
+            ua.unlocked_at,
+            EXTRACT(EPOCH FROM ua.unlocked_at - u.created_at) AS time_to_unlock,
+            us.last_active_at >= NOW() - MAKE_INTERVAL(days => _active_days) AS is_active,
+            ROW_NUMBER() OVER (PARTITION BY ua.achievement_id ORDER BY ua.unlocked_at, ua.id) AS rn
+        FROM user_achievements ua
+        INNER JOIN users u ON ua.telegram_id = u.telegram_id
+        LEFT JOIN user_stats us ON ua.telegram_id = us.telegram_id
+    ),
+    stats AS (
+        SELECT
+            a.id AS achievement_id,
+            COUNT(un.telegram_id) AS unlock_count,
+            COUNT(un.telegram_id) FILTER (WHERE un.is_active) AS active_unlock_count,
+            MAX(un.telegram_id) FILTER (WHERE un.rn = 1) AS first_unlocker_telegram_id,
+            MIN(un.unlocked_at) AS first_unlocked_at,
+            PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY un.time_to_unlock) AS median_time_to_unlock
+        FROM achievements a
+        LEFT JOIN unlocked un ON a.id = un.achievement_id
+        GROUP BY a.id
+    )
+    INSERT INTO achievement_stats(
+        achievement_id,
+        unlock_count,
+        active_users,
+        active_unlock_count,
+        unlock_percent,
+        first_unlocker_telegram_id,
+        first_unlocked_at,
+        median_time_to_unlock,
+        calculated_at
+    )
+    SELECT
+        s.achievement_id,
+        s.unlock_count,
+        _active_users,
+        s.active_unlock_count,
+        CASE
+            WHEN _active_users = 0 THEN 0
+            ELSE ROUND(s.active_unlock_count * 100.0 / _active_users, 2)
+        END,
+        s.first_unlocker_telegram_id,
+        s.first_unlocked_at,
+        ROUND(s.median_time_to_unlock)::BIGINT,
+        NOW()
+    FROM stats s
+    ON CONFLICT (achievement_id) DO UPDATE SET
+        unlock_count = EXCLUDED.unlock_count,
+        active_users = EXCLUDED.active_users,
+        active_unlock_count = EXCLUDED.active_unlock_count,
+        unlock_percent = EXCLUDED.unlock_percent,
+        first_unlocker_telegram_id = EXCLUDED.first_unlocker_telegram_id,
+        first_unlocked_at = EXCLUDED.first_unlocked_at,
+        median_time_to_unlock = EXCLUDED.median_time_to_unlock,
+        calculated_at = EXCLUDED.calculated_at;
+
+    GET DIAGNOSTICS _count = ROW_COUNT;
+
+    RETURN _count;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- тип уведомления о выполнении ежедневного задания.
+ALTER TYPE notifications_type ADD VALUE IF NOT EXISTS 'daily_task';
\ No newline at end of file
@@ -0,0 +1,4 @@
+DELETE FROM event_types WHERE name = 'daily_task_completed';
+
+ALTER TABLE user_daily_tasks
+    DROP COLUMN IF EXISTS completed_at;
\ No newline at end of file
@@ -0,0 +1,25 @@
+ALTER TABLE user_daily_tasks
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP WITH TIME ZONE; -- Когда ежедневное задание было засчитано (награда и streak выдаются один раз).
+
+-- ранее выполненные задания считаем уже засчитанными, чтобы не выдавать за них награду повторно.
+UPDATE user_daily_tasks SET
+    completed_at = occurred_at
+WHERE is_completed
+AND completed_at IS NULL;
+
+-- награда за выполнение ежедневного задания настраивается через xp и amount этого события.
+INSERT INTO event_types(
+    name,
+    description,
+    xp,
+    amount,
+    notification_message,
+    is_send_notification
+) VALUES(
+    'daily_task_completed',
+    'Событие по выполнению ежедневного задания пользователем',
+    10,
+    10.00,
+    'Ежедневное задание выполнено',
+    TRUE
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.daily_task_complete(_telegram_id TEXT);
\ No newline at end of file
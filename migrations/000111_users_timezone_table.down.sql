@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS is_timezone_manual,
+    DROP COLUMN IF EXISTS timezone;
\ No newline at end of file
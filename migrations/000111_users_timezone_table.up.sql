@@ -0,0 +1,3 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'Europe/Moscow', -- IANA часовой пояс пользователя (границы дня и недели).
+    ADD COLUMN IF NOT EXISTS is_timezone_manual BOOLEAN NOT NULL DEFAULT FALSE; -- Часовой пояс выбран пользователем вручную (не перезаписывается при входе).
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.user_local_date(TEXT, TIMESTAMP WITH TIME ZONE);
\ No newline at end of file
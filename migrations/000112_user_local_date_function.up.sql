@@ -0,0 +1,22 @@
+-- локальная дата пользователя с учётом его часового пояса.
+CREATE OR REPLACE FUNCTION public.user_local_date(
+    _telegram_id TEXT,
+    _ts TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+) RETURNS DATE
+    SECURITY DEFINER
+    STABLE
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _timezone TEXT;
+BEGIN
+    SELECT
+        timezone
+    INTO _timezone
+    FROM users
+    WHERE telegram_id = _telegram_id;
+
+    RETURN (_ts AT TIME ZONE COALESCE(_timezone, 'Europe/Moscow'))::DATE;
+END;
+$$;
\ No newline at end of file
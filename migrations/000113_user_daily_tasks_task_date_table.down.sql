@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS idx_user_daily_tasks_telegram_id_task_date_unique;
+
+ALTER TABLE user_daily_tasks
+    DROP COLUMN IF EXISTS week_start;
+
+ALTER TABLE user_daily_tasks
+    DROP COLUMN IF EXISTS task_date;
+
+ALTER TABLE user_daily_tasks
+    ADD COLUMN week_start DATE GENERATED ALWAYS AS (date_trunc('week', occurred_at AT TIME ZONE 'Europe/Moscow')::DATE) STORED;
+
+-- За сегодня/по дате в MSK.
+CREATE INDEX IF NOT EXISTS idx_user_daily_tasks_telegram_id_occurred_at ON user_daily_tasks (telegram_id, ((occurred_at AT TIME ZONE 'Europe/Moscow')::DATE));
+
+-- Идемпотентность дня.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_daily_tasks_telegram_id_occurred_at_unique ON user_daily_tasks (telegram_id, ((occurred_at AT TIME ZONE 'Europe/Moscow')::DATE));
\ No newline at end of file
@@ -0,0 +1,23 @@
+-- день ежедневного задания по часовому поясу пользователя на момент назначения.
+ALTER TABLE user_daily_tasks
+    ADD COLUMN IF NOT EXISTS task_date DATE;
+
+UPDATE user_daily_tasks SET
+    task_date = (occurred_at AT TIME ZONE 'Europe/Moscow')::DATE
+WHERE task_date IS NULL;
+
+ALTER TABLE user_daily_tasks
+    ALTER COLUMN task_date SET NOT NULL;
+
+ALTER TABLE user_daily_tasks
+    DROP COLUMN IF EXISTS week_start;
+
+ALTER TABLE user_daily_tasks
+    ADD COLUMN week_start DATE GENERATED ALWAYS AS (date_trunc('week', task_date)::DATE) STORED;
+
+DROP INDEX IF EXISTS idx_user_daily_tasks_telegram_id_occurred_at;
+
+DROP INDEX IF EXISTS idx_user_daily_tasks_telegram_id_occurred_at_unique;
+
+-- Идемпотентность дня (по локальной дате пользователя).
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_daily_tasks_telegram_id_task_date_unique ON user_daily_tasks (telegram_id, task_date);
\ No newline at end of file
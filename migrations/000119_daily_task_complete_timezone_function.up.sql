@@ -0,0 +1,106 @@
+CREATE OR REPLACE FUNCTION public.daily_task_complete(_telegram_id TEXT) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _today DATE;
+    _udt_id BIGINT;
+    _last_daily_task_streak_days DATE;
+    _daily_task_streak_days BIGINT;
+    _event_type_id BIGINT;
+    _xp INTEGER;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- сегодняшний день по часовому поясу пользователя.
+    _today := public.user_local_date(_telegram_id);
+
+    -- блокируем строку в таблице user_stats.
+    SELECT
+        last_daily_task_streak_days,
+        daily_task_streak_days
+    INTO
+        _last_daily_task_streak_days,
+        _daily_task_streak_days
+    FROM user_stats
+    WHERE telegram_id = _telegram_id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'user_stats row is missing for %', _telegram_id;
+    END IF;
+
+    -- засчитываем сегодняшнее ежедневное задание только один раз.
+    UPDATE user_daily_tasks SET
+        completed_at = NOW()
+    WHERE id = (
+        SELECT
+            udt.id
+        FROM user_daily_tasks udt
+        WHERE udt.telegram_id = _telegram_id
+        AND udt.task_date = _today
+        ORDER BY udt.occurred_at DESC
+        LIMIT 1
+        FOR UPDATE
+    )
+    AND is_completed
+    AND completed_at IS NULL
+    RETURNING id INTO _udt_id;
+
+    IF _udt_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    -- продолжаем streak, если вчерашнее задание было выполнено, иначе начинаем заново.
+    IF _last_daily_task_streak_days = _today THEN
+        _daily_task_streak_days := GREATEST(_daily_task_streak_days, 1);
+    ELSIF _last_daily_task_streak_days = _today - 1 THEN
+        _daily_task_streak_days := _daily_task_streak_days + 1;
+    ELSE
+        _daily_task_streak_days := 1;
+    END IF;
+
+    -- опыт за выполнение ежедневного задания.
+    SELECT
+        id,
+        xp
+    INTO
+        _event_type_id,
+        _xp
+    FROM event_types
+    WHERE name = 'daily_task_completed'
+    AND is_active;
+
+    IF _event_type_id IS NOT NULL AND _xp <> 0 THEN
+        INSERT INTO xp_events(
+            event_type_id,
+            telegram_id,
+            delta_xp
+        ) VALUES(
+            _event_type_id,
+            _telegram_id,
+            _xp
+        );
+    ELSE
+        _xp := 0;
+    END IF;
+
+    -- опыт в user_stats равен сумме xp_events, поэтому сразу учитываем выданный опыт.
+    UPDATE user_stats SET
+        daily_task_streak_days = _daily_task_streak_days,
+        last_daily_task_streak_days = _today,
+        experience_points = experience_points + _xp,
+        updated_at = NOW()
+    WHERE telegram_id = _telegram_id;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'user_daily_task_id', _udt_id,
+        'date', TO_CHAR(_today, 'YYYY-MM-DD"T"00:00:00"Z"'),
+        'daily_task_streak_days', _daily_task_streak_days,
+        'experience_points', _xp
+    );
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS streak_protection_action;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE TYPE streak_protection_action AS ENUM ('freeze_purchase', 'freeze_reward', 'freeze_consume', 'repair');
\ No newline at end of file
@@ -0,0 +1,16 @@
+-- значение перечисления нельзя удалить, поэтому пересоздаём тип без 'streak_freezes'.
+DELETE FROM user_achievement_rewards WHERE type = 'streak_freezes';
+
+DELETE FROM achievement_rewards WHERE type = 'streak_freezes';
+
+ALTER TYPE achievement_reward_type RENAME TO achievement_reward_type_old;
+
+CREATE TYPE achievement_reward_type AS ENUM ('internal_currency', 'experience_points', 'subscription_days');
+
+ALTER TABLE achievement_rewards
+    ALTER COLUMN type TYPE achievement_reward_type USING type::TEXT::achievement_reward_type;
+
+ALTER TABLE user_achievement_rewards
+    ALTER COLUMN type TYPE achievement_reward_type USING type::TEXT::achievement_reward_type;
+
+DROP TYPE IF EXISTS achievement_reward_type_old;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- награда за достижение в виде заморозок streak.
+ALTER TYPE achievement_reward_type ADD VALUE IF NOT EXISTS 'streak_freezes';
\ No newline at end of file
@@ -0,0 +1,10 @@
+DELETE FROM event_types WHERE name IN ('streak_freeze_purchase', 'streak_repair_purchase');
+
+DROP INDEX IF EXISTS idx_user_streak_protection_history_telegram_id_id;
+
+DROP TABLE IF EXISTS user_streak_protection_history;
+
+ALTER TABLE user_stats
+    DROP COLUMN IF EXISTS streak_lost_on,
+    DROP COLUMN IF EXISTS lost_streak_days,
+    DROP COLUMN IF EXISTS streak_freezes;
\ No newline at end of file
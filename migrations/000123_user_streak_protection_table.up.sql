@@ -0,0 +1,39 @@
+ALTER TABLE user_stats
+    ADD COLUMN IF NOT EXISTS streak_freezes BIGINT NOT NULL DEFAULT 0 CHECK (streak_freezes >= 0), -- Сколько заморозок streak есть у пользователя.
+    ADD COLUMN IF NOT EXISTS lost_streak_days BIGINT NOT NULL DEFAULT 0, -- Потерянный streak, который можно восстановить.
+    ADD COLUMN IF NOT EXISTS streak_lost_on DATE; -- Дата, когда streak был потерян (восстановление доступно ограниченное время).
+
+CREATE TABLE IF NOT EXISTS user_streak_protection_history( -- История заморозок и восстановлений streak.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    action streak_protection_action NOT NULL, -- Действие (покупка/получение/использование заморозки, восстановление).
+    quantity BIGINT NOT NULL CHECK (quantity > 0), -- Количество заморозок (для восстановления всегда 1).
+    protected_date DATE, -- Защищённый день (пропущенный день, за который использована заморозка или восстановлен streak).
+    streak_days BIGINT NOT NULL, -- Streak после действия.
+    price NUMERIC(20, 2), -- Стоимость покупки во внутренней валюте.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_streak_protection_history_telegram_id_id ON user_streak_protection_history (telegram_id, id DESC);
+
+-- стоимость заморозки и восстановления streak настраивается через amount этих событий.
+INSERT INTO event_types(
+    name,
+    description,
+    amount
+) VALUES(
+    'streak_freeze_purchase',
+    'Событие по покупке заморозки streak пользователем',
+    50.00
+);
+
+INSERT INTO event_types(
+    name,
+    description,
+    amount
+) VALUES(
+    'streak_repair_purchase',
+    'Событие по покупке восстановления streak пользователем',
+    100.00
+);
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE user_achievement_rewards
+    DROP COLUMN IF EXISTS streak_freezes;
+
+ALTER TABLE achievement_rewards
+    DROP CONSTRAINT IF EXISTS check_achievement_rewards_streak_freezes,
+    DROP COLUMN IF EXISTS streak_freezes;
\ No newline at end of file
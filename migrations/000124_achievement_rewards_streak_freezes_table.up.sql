@@ -0,0 +1,6 @@
+ALTER TABLE achievement_rewards
+    ADD COLUMN IF NOT EXISTS streak_freezes INTEGER, -- Количество заморозок streak (для type = 'streak_freezes').
+    ADD CONSTRAINT check_achievement_rewards_streak_freezes CHECK (type::TEXT <> 'streak_freezes' OR (streak_freezes IS NOT NULL AND streak_freezes > 0));
+
+ALTER TABLE user_achievement_rewards
+    ADD COLUMN IF NOT EXISTS streak_freezes INTEGER; -- Количество заморозок streak на момент выдачи.
\ No newline at end of file
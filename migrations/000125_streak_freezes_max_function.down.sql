@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.streak_freezes_max();
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- сколько заморозок streak пользователь может держать одновременно.
+CREATE OR REPLACE FUNCTION public.streak_freezes_max() RETURNS BIGINT
+    IMMUTABLE
+    LANGUAGE sql
+AS
+$$
+    SELECT 2::BIGINT;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.streak_freeze_add(TEXT, BIGINT, streak_protection_action, NUMERIC);
\ No newline at end of file
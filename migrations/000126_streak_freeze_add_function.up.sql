@@ -0,0 +1,72 @@
+-- начисляет заморозки streak (не больше лимита) и пишет историю.
+-- возвращает запись истории или NULL, если лимит заморозок уже достигнут.
+CREATE OR REPLACE FUNCTION public.streak_freeze_add(
+    _telegram_id TEXT,
+    _quantity BIGINT,
+    _action streak_protection_action,
+    _price NUMERIC(20, 2) DEFAULT NULL
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _streak_freezes BIGINT;
+    _streak_days BIGINT;
+    _added BIGINT;
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    IF _quantity IS NULL OR _quantity <= 0 THEN
+        RAISE EXCEPTION 'quantity must be positive';
+    END IF;
+
+    -- блокируем строку в таблице user_stats.
+    SELECT
+        streak_freezes,
+        streak_days
+    INTO
+        _streak_freezes,
+        _streak_days
+    FROM user_stats
+    WHERE telegram_id = _telegram_id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'user_stats row is missing for %', _telegram_id;
+    END IF;
+
+    _added := LEAST(_quantity, public.streak_freezes_max() - _streak_freezes);
+
+    IF _added <= 0 THEN
+        RETURN NULL;
+    END IF;
+
+    UPDATE user_stats SET
+        streak_freezes = streak_freezes + _added,
+        updated_at = NOW()
+    WHERE telegram_id = _telegram_id;
+
+    INSERT INTO user_streak_protection_history(
+        telegram_id,
+        action,
+        quantity,
+        streak_days,
+        price
+    ) VALUES(
+        _telegram_id,
+        _action,
+        _added,
+        _streak_days,
+        _price
+    )
+    RETURNING TO_JSONB(user_streak_protection_history.*) || JSONB_BUILD_OBJECT(
+        'protected_date', TO_CHAR(protected_date, 'YYYY-MM-DD"T"00:00:00"Z"')
+    ) INTO _response;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.streak_protection_get(_telegram_id TEXT);
\ No newline at end of file
@@ -0,0 +1,35 @@
+CREATE OR REPLACE FUNCTION public.streak_protection_get(_telegram_id TEXT) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _today DATE;
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- сегодняшний день по часовому поясу пользователя.
+    _today := public.user_local_date(_telegram_id);
+
+    -- восстановление доступно в день потери streak и на следующий день.
+    SELECT JSONB_BUILD_OBJECT(
+        'streak_days', us.streak_days,
+        'streak_freezes', us.streak_freezes,
+        'max_streak_freezes', public.streak_freezes_max(),
+        'lost_streak_days', us.lost_streak_days,
+        'is_repair_available', (us.lost_streak_days > 0 AND us.streak_lost_on >= _today - 1),
+        'repair_available_until',
+            CASE WHEN us.lost_streak_days > 0 AND us.streak_lost_on >= _today - 1
+                THEN TO_CHAR(us.streak_lost_on + 1, 'YYYY-MM-DD"T"00:00:00"Z"')
+            END
+    )
+    INTO _response
+    FROM user_stats us
+    WHERE us.telegram_id = _telegram_id;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.streak_repair(TEXT, NUMERIC);
\ No newline at end of file
@@ -0,0 +1,77 @@
+-- восстанавливает streak, потерянный из-за пропуска вчерашнего дня.
+-- возвращает запись истории или NULL, если восстановление недоступно.
+CREATE OR REPLACE FUNCTION public.streak_repair(
+    _telegram_id TEXT,
+    _price NUMERIC(20, 2) DEFAULT NULL
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _today DATE;
+    _streak_days BIGINT;
+    _lost_streak_days BIGINT;
+    _streak_lost_on DATE;
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- сегодняшний день по часовому поясу пользователя.
+    _today := public.user_local_date(_telegram_id);
+
+    -- блокируем строку в таблице user_stats.
+    SELECT
+        streak_days,
+        lost_streak_days,
+        streak_lost_on
+    INTO
+        _streak_days,
+        _lost_streak_days,
+        _streak_lost_on
+    FROM user_stats
+    WHERE telegram_id = _telegram_id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'user_stats row is missing for %', _telegram_id;
+    END IF;
+
+    IF _lost_streak_days <= 0 OR _streak_lost_on IS NULL OR _streak_lost_on < _today - 1 THEN
+        RETURN NULL;
+    END IF;
+
+    -- пропущенный день считается защищённым: потерянный streak продолжается текущим.
+    _streak_days := _lost_streak_days + _streak_days;
+
+    UPDATE user_stats SET
+        streak_days = _streak_days,
+        lost_streak_days = 0,
+        streak_lost_on = NULL,
+        updated_at = NOW()
+    WHERE telegram_id = _telegram_id;
+
+    INSERT INTO user_streak_protection_history(
+        telegram_id,
+        action,
+        quantity,
+        protected_date,
+        streak_days,
+        price
+    ) VALUES(
+        _telegram_id,
+        'repair',
+        1,
+        _streak_lost_on - 1,
+        _streak_days,
+        _price
+    )
+    RETURNING TO_JSONB(user_streak_protection_history.*) || JSONB_BUILD_OBJECT(
+        'protected_date', TO_CHAR(protected_date, 'YYYY-MM-DD"T"00:00:00"Z"')
+    ) INTO _response;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.streak_protection_history_get(TEXT, INTEGER);
\ No newline at end of file
@@ -0,0 +1,36 @@
+CREATE OR REPLACE FUNCTION public.streak_protection_history_get(
+    _telegram_id TEXT,
+    _limit INTEGER DEFAULT 100
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    SELECT COALESCE(
+        JSONB_AGG(
+            TO_JSONB(h) || JSONB_BUILD_OBJECT(
+                'protected_date', TO_CHAR(h.protected_date, 'YYYY-MM-DD"T"00:00:00"Z"')
+            )
+            ORDER BY h.id DESC
+        ),
+        '[]'::JSONB
+    )
+    INTO _response
+    FROM (
+        SELECT *
+        FROM user_streak_protection_history
+        WHERE telegram_id = _telegram_id
+        ORDER BY id DESC
+        LIMIT _limit
+    ) h;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,103 @@
+CREATE OR REPLACE FUNCTION public.ensure_streak_days_increment_today(_telegram_id TEXT) RETURNS VOID
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _today DATE;
+    _streak_days BIGINT;
+    _last_streak_day DATE;
+    _streak_freezes BIGINT;
+    _missed_days BIGINT;
+    _freezes_used BIGINT := 0;
+    _lost_streak_days BIGINT;
+    _streak_lost_on DATE;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- сегодняшний день по часовому поясу пользователя.
+    _today := public.user_local_date(_telegram_id);
+
+    -- блокируем строку в таблице user_stats.
+    SELECT
+        streak_days,
+        last_streak_day,
+        streak_freezes,
+        lost_streak_days,
+        streak_lost_on
+    INTO
+        _streak_days,
+        _last_streak_day,
+        _streak_freezes,
+        _lost_streak_days,
+        _streak_lost_on
+    FROM user_stats
+    WHERE telegram_id = _telegram_id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RETURN;
+    END IF;
+
+    -- обновляем только если наступил новый день ИЛИ
+    -- это первый реальный учёт (streak=0, day уже = today из-за DEFAULT).
+    IF NOT (
+        _today > _last_streak_day OR (
+            _today = _last_streak_day AND _streak_days = 0
+        )
+    ) THEN
+        RETURN;
+    END IF;
+
+    _missed_days := _today - _last_streak_day - 1;
+
+    IF _missed_days = 0 THEN
+        -- вчера был учтен, то +1.
+        _streak_days := _streak_days + 1;
+    ELSIF _missed_days > 0 AND _streak_days > 0 AND _streak_freezes >= _missed_days THEN
+        -- заморозок хватает на все пропущенные дни: автоматически используем их, streak продолжается.
+        _freezes_used := _missed_days;
+
+        INSERT INTO user_streak_protection_history(
+            telegram_id,
+            action,
+            quantity,
+            protected_date,
+            streak_days
+        )
+        SELECT
+            _telegram_id,
+            'freeze_consume',
+            1,
+            d::DATE,
+            _streak_days
+        FROM GENERATE_SERIES(_last_streak_day + 1, _today - 1, INTERVAL '1 day') d;
+
+        _streak_days := _streak_days + 1;
+    ELSE
+        -- был разрыв по дате, то 1.
+        -- если пропущен только вчерашний день, то потерянный streak можно восстановить.
+        IF _missed_days = 1 AND _streak_days > 0 THEN
+            _lost_streak_days := _streak_days;
+            _streak_lost_on := _today;
+        ELSE
+            _lost_streak_days := 0;
+            _streak_lost_on := NULL;
+        END IF;
+
+        _streak_days := 1;
+    END IF;
+
+    UPDATE user_stats SET
+        streak_days = _streak_days,
+        last_streak_day = _today,
+        streak_freezes = streak_freezes - _freezes_used,
+        lost_streak_days = _lost_streak_days,
+        streak_lost_on = _streak_lost_on,
+        last_active_at = NOW(),
+        updated_at = NOW()
+    WHERE telegram_id = _telegram_id;
+END;
+$$;
\ No newline at end of file
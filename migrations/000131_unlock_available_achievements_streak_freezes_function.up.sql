@@ -0,0 +1,216 @@
+CREATE OR REPLACE FUNCTION public.unlock_available_achievements(_telegram_id TEXT) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _response JSONB;
+    _event_type_id BIGINT;
+    _total_xp BIGINT;
+    _r RECORD;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    WITH metrics AS (
+        SELECT
+            us.streak_days,
+            us.words_learned,
+            us.tasks_completed,
+            us.lessons_finished,
+            us.experience_points,
+            us.level,
+            us.daily_task_streak_days,
+            us.words_translate,
+            us.dialog_completed
+        FROM user_stats us
+        WHERE us.telegram_id = _telegram_id
+    ),
+    eligible_base AS (
+        SELECT a.id, a.name, at.version, a.tier_group, a.tier_level
+        FROM achievements a
+        INNER JOIN achievement_types at ON a.achievement_type_id = at.id
+        INNER JOIN metrics m ON TRUE
+        WHERE at.is_active
+        -- достижения с окном доступности можно получить только внутри окна.
+        AND (
+            a.available_from IS NULL
+            OR NOW() >= a.available_from
+        )
+        AND (
+            a.available_to IS NULL
+            OR NOW() < a.available_to
+        )
+        AND (
+            at.streak_days_need IS NULL
+            OR m.streak_days >= at.streak_days_need
+        )
+        AND (
+            at.daily_task_streak_days_need IS NULL
+            OR m.daily_task_streak_days >= at.daily_task_streak_days_need
+        )
+        AND (
+            at.words_learned_need IS NULL
+            OR m.words_learned >= at.words_learned_need
+        )
+        AND (
+            at.tasks_completed_need IS NULL
+            OR m.tasks_completed >= at.tasks_completed_need
+        )
+        AND (
+            at.lessons_finished_need IS NULL
+            OR m.lessons_finished >= at.lessons_finished_need
+        )
+        AND (
+            at.words_translate_need IS NULL
+            OR m.words_translate >= at.words_translate_need
+        )
+        AND (
+            at.dialog_completed_need IS NULL
+            OR m.dialog_completed >= at.dialog_completed_need
+        )
+        AND (
+            at.experience_points_need IS NULL
+            OR m.experience_points >= at.experience_points_need
+        )
+        AND (
+            at.level_need IS NULL
+            OR m.level >= at.level_need
+        )
+    ),
+    eligible AS (
+        -- ступень цепочки можно получить, только если все младшие ступени
+        -- уже получены или получаются в этом же вызове.
+        SELECT eb.id, eb.name, eb.version
+        FROM eligible_base eb
+        WHERE eb.tier_group IS NULL
+        OR NOT EXISTS (
+            SELECT 1
+            FROM achievements lt
+            WHERE lt.tier_group = eb.tier_group
+            AND lt.tier_level < eb.tier_level
+            AND NOT EXISTS (
+                SELECT 1
+                FROM user_achievements ua
+                WHERE ua.telegram_id = _telegram_id
+                AND ua.achievement_id = lt.id
+            )
+            AND NOT EXISTS (
+                SELECT 1
+                FROM eligible_base eb2
+                WHERE eb2.id = lt.id
+            )
+        )
+    ),
+    inserted AS (
+        INSERT INTO user_achievements(
+            telegram_id,
+            achievement_id,
+            achievement_type_version,
+            unlocked_at
+        )
+        SELECT _telegram_id, e.id, e.version, NOW()
+        FROM eligible e
+        ON CONFLICT (telegram_id, achievement_id) DO NOTHING
+        RETURNING id, achievement_id, unlocked_at
+    ),
+    rewarded AS (
+        -- награды фиксируются в момент получения достижения (снимок условий награды).
+        INSERT INTO user_achievement_rewards(
+            telegram_id,
+            user_achievement_id,
+            achievement_id,
+            achievement_reward_id,
+            type,
+            amount,
+            experience_points,
+            subscription_days,
+            streak_freezes
+        )
+        SELECT
+            _telegram_id,
+            i.id,
+            i.achievement_id,
+            ar.id,
+            ar.type,
+            ar.amount,
+            ar.experience_points,
+            ar.subscription_days,
+            ar.streak_freezes
+        FROM inserted i
+        INNER JOIN achievement_rewards ar ON ar.achievement_id = i.achievement_id
+        ON CONFLICT (user_achievement_id, achievement_reward_id) DO NOTHING
+        RETURNING *
+    )
+    SELECT COALESCE(
+        JSONB_AGG(
+            JSONB_BUILD_OBJECT(
+                'achievement_id', i.achievement_id,
+                'achievement_name', a.name,
+                'unlocked_at', i.unlocked_at,
+                'rewards', COALESCE(
+                    (
+                        SELECT JSONB_AGG(TO_JSONB(r) ORDER BY r.id)
+                        FROM rewarded r
+                        WHERE r.user_achievement_id = i.id
+                    ),
+                    '[]'::JSONB
+                )
+            )
+        ),
+        '[]'::JSONB
+    )
+    INTO _response
+    FROM inserted i
+    INNER JOIN achievements a ON i.achievement_id = a.id;
+
+    -- дни подписки, заморозки streak и опыт начисляем сразу, внутренняя валюта начисляется сервисом через баланс.
+    FOR _r IN
+        SELECT
+            x->>'type' AS type,
+            (x->>'experience_points')::INTEGER AS experience_points,
+            (x->>'subscription_days')::INTEGER AS subscription_days,
+            (x->>'streak_freezes')::INTEGER AS streak_freezes
+        FROM JSONB_ARRAY_ELEMENTS(_response) ua,
+        JSONB_ARRAY_ELEMENTS(ua->'rewards') x
+        WHERE x->>'type' IN ('experience_points', 'subscription_days', 'streak_freezes')
+    LOOP
+        IF _r.type = 'subscription_days' THEN
+            PERFORM public.subscription_extend_days(_telegram_id, _r.subscription_days);
+        ELSIF _r.type = 'streak_freezes' THEN
+            -- заморозки сверх максимума не начисляются.
+            PERFORM public.streak_freeze_add(_telegram_id, _r.streak_freezes, 'freeze_reward');
+        ELSE
+            IF _event_type_id IS NULL THEN
+                SELECT id
+                INTO _event_type_id
+                FROM event_types
+                WHERE name = 'achievement_reward';
+            END IF;
+
+            INSERT INTO xp_events(
+                event_type_id,
+                telegram_id,
+                delta_xp
+            ) VALUES(
+                _event_type_id,
+                _telegram_id,
+                _r.experience_points
+            );
+
+            _total_xp := COALESCE(_total_xp, 0) + _r.experience_points;
+        END IF;
+    END LOOP;
+
+    -- опыт в user_stats равен сумме xp_events, поэтому сразу учитываем выданный опыт.
+    IF _total_xp IS NOT NULL THEN
+        UPDATE user_stats SET
+            experience_points = experience_points + _total_xp,
+            updated_at = NOW()
+        WHERE telegram_id = _telegram_id;
+    END IF;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
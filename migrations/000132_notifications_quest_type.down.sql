@@ -0,0 +1,11 @@
+-- значение перечисления нельзя удалить, поэтому пересоздаём тип без 'quest'.
+DELETE FROM notifications WHERE type::TEXT = 'quest';
+
+ALTER TYPE notifications_type RENAME TO notifications_type_old;
+
+CREATE TYPE notifications_type AS ENUM ('achievement', 'internal_currency', 'level', 'mini_game', 'daily_task');
+
+ALTER TABLE notifications
+    ALTER COLUMN type TYPE notifications_type USING type::TEXT::notifications_type;
+
+DROP TYPE IF EXISTS notifications_type_old;
\ No newline at end of file
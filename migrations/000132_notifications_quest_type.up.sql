@@ -0,0 +1,2 @@
+-- тип уведомления о выполнении задания (квеста).
+ALTER TYPE notifications_type ADD VALUE IF NOT EXISTS 'quest';
\ No newline at end of file
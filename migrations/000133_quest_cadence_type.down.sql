@@ -0,0 +1 @@
+DROP TYPE IF EXISTS quest_cadence;
\ No newline at end of file
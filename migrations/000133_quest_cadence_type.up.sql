@@ -0,0 +1,2 @@
+-- периодичность задания: ежедневное, еженедельное, одноразовое (например, для знакомства с приложением).
+CREATE TYPE quest_cadence AS ENUM ('daily', 'weekly', 'one_off');
\ No newline at end of file
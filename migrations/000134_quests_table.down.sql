@@ -0,0 +1,5 @@
+DELETE FROM event_types WHERE name = 'quest_completed';
+
+DROP INDEX IF EXISTS idx_quests_is_active;
+
+DROP TABLE IF EXISTS quests;
\ No newline at end of file
@@ -0,0 +1,105 @@
+CREATE TABLE IF NOT EXISTS quests( -- Содержит описание заданий (квестов) с разной периодичностью.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    name TEXT NOT NULL, -- Название задания.
+    description TEXT, -- Описание задания.
+    cadence quest_cadence NOT NULL, -- Периодичность задания.
+    words_learned_need BIGINT NOT NULL DEFAULT 0 CHECK (words_learned_need >= 0), -- Сколько нужно выучить слов.
+    tasks_completed_need BIGINT NOT NULL DEFAULT 0 CHECK (tasks_completed_need >= 0), -- Сколько заданий нужно выполнить.
+    lessons_finished_need BIGINT NOT NULL DEFAULT 0 CHECK (lessons_finished_need >= 0), -- Сколько нужно пройти уроков.
+    words_translate_need BIGINT NOT NULL DEFAULT 0 CHECK (words_translate_need >= 0), -- Сколько нужно перевести слов.
+    dialog_completed_need BIGINT NOT NULL DEFAULT 0 CHECK (dialog_completed_need >= 0), -- Сколько нужно пройти диалогов.
+    experience_points_need BIGINT NOT NULL DEFAULT 0 CHECK (experience_points_need >= 0), -- Сколько нужно опыта.
+    reward_amount NUMERIC(20, 2) CHECK (reward_amount IS NULL OR reward_amount > 0), -- Награда во внутренней валюте.
+    reward_experience_points BIGINT NOT NULL DEFAULT 0 CHECK (reward_experience_points >= 0), -- Награда в опыте.
+    duration_days INTEGER CHECK (duration_days IS NULL OR duration_days > 0), -- Сколько дней есть на выполнение одноразового задания (NULL - без ограничения).
+    is_active BOOLEAN NOT NULL DEFAULT TRUE, -- Выдаётся ли задание пользователям.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    CHECK (
+        words_learned_need + tasks_completed_need + lessons_finished_need +
+        words_translate_need + dialog_completed_need + experience_points_need > 0
+    ),
+    -- ежедневные и еженедельные задания истекают в конце дня/недели пользователя.
+    CHECK (cadence = 'one_off' OR duration_days IS NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_quests_is_active ON quests (is_active);
+
+INSERT INTO quests(
+    name,
+    description,
+    cadence,
+    dialog_completed_need,
+    reward_amount,
+    reward_experience_points
+) VALUES(
+    'Первый диалог',
+    'Пройдите свой первый диалог',
+    'one_off',
+    1,
+    20.00,
+    20
+);
+
+INSERT INTO quests(
+    name,
+    description,
+    cadence,
+    words_translate_need,
+    reward_amount,
+    reward_experience_points,
+    duration_days
+) VALUES(
+    'Первые слова',
+    'Переведите 10 слов за первую неделю',
+    'one_off',
+    10,
+    30.00,
+    30,
+    7
+);
+
+INSERT INTO quests(
+    name,
+    description,
+    cadence,
+    dialog_completed_need,
+    reward_experience_points
+) VALUES(
+    'Диалог дня',
+    'Пройдите 2 диалога за день',
+    'daily',
+    2,
+    10
+);
+
+INSERT INTO quests(
+    name,
+    description,
+    cadence,
+    dialog_completed_need,
+    experience_points_need,
+    reward_amount,
+    reward_experience_points
+) VALUES(
+    'Неделя практики',
+    'Пройдите 15 диалогов и наберите 200 опыта за неделю',
+    'weekly',
+    15,
+    200,
+    50.00,
+    50
+);
+
+-- событие, от имени которого начисляется награда за выполнение задания.
+INSERT INTO event_types(
+    name,
+    description,
+    notification_message,
+    is_send_notification
+) VALUES(
+    'quest_completed',
+    'Событие по выполнению задания (квеста) пользователем',
+    'Задание выполнено',
+    TRUE
+);
\ No newline at end of file
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_user_quests_telegram_id_active;
+DROP INDEX IF EXISTS idx_user_quests_telegram_id_assigned_at;
+DROP INDEX IF EXISTS uidx_user_quests_telegram_id_quest_id_one_off;
+DROP INDEX IF EXISTS uidx_user_quests_telegram_id_quest_id_period_start;
+
+DROP TABLE IF EXISTS user_quests;
\ No newline at end of file
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS user_quests( -- Содержит задания (квесты), выданные пользователю.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    quest_id BIGINT NOT NULL, -- Идентификатор задания.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    words_learned BIGINT NOT NULL DEFAULT 0, -- Сколько слов выучено.
+    tasks_completed BIGINT NOT NULL DEFAULT 0, -- Сколько заданий выполнено.
+    lessons_finished BIGINT NOT NULL DEFAULT 0, -- Пройдено уроков.
+    words_translate BIGINT NOT NULL DEFAULT 0, -- Переведено новых слов.
+    dialog_completed BIGINT NOT NULL DEFAULT 0, -- Пройдено диалогов.
+    experience_points BIGINT NOT NULL DEFAULT 0, -- Шкала опыта.
+    period_start DATE, -- Начало периода по часовому поясу пользователя (день или неделя, NULL для одноразовых заданий).
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Когда задание было выдано.
+    expires_at TIMESTAMP WITH TIME ZONE, -- Когда задание истекает (NULL - без ограничения).
+    completed_at TIMESTAMP WITH TIME ZONE, -- Когда задание было выполнено (награда выдаётся один раз).
+    FOREIGN KEY (quest_id) REFERENCES quests(id),
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id)
+);
+
+-- одно задание за период (одноразовое задание выдаётся один раз).
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_user_quests_telegram_id_quest_id_period_start ON user_quests (telegram_id, quest_id, period_start) WHERE period_start IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_user_quests_telegram_id_quest_id_one_off ON user_quests (telegram_id, quest_id) WHERE period_start IS NULL;
+CREATE INDEX IF NOT EXISTS idx_user_quests_telegram_id_assigned_at ON user_quests (telegram_id, assigned_at DESC);
+CREATE INDEX IF NOT EXISTS idx_user_quests_telegram_id_active ON user_quests (telegram_id) WHERE completed_at IS NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.quests_assign(TEXT);
\ No newline at end of file
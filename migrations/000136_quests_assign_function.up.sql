@@ -0,0 +1,79 @@
+-- выдаёт пользователю активные задания, которых у него ещё нет в текущем периоде.
+CREATE OR REPLACE FUNCTION public.quests_assign(_telegram_id TEXT) RETURNS VOID
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _timezone TEXT;
+    _today DATE;
+    _week_start DATE;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    SELECT
+        COALESCE(timezone, 'Europe/Moscow')
+    INTO _timezone
+    FROM users
+    WHERE telegram_id = _telegram_id;
+
+    IF NOT FOUND THEN
+        RETURN;
+    END IF;
+
+    -- сегодняшний день и начало недели по часовому поясу пользователя.
+    _today := public.user_local_date(_telegram_id);
+    _week_start := DATE_TRUNC('week', _today)::DATE;
+
+    -- ежедневные задания истекают в конце дня пользователя.
+    INSERT INTO user_quests(
+        quest_id,
+        telegram_id,
+        period_start,
+        expires_at
+    )
+    SELECT
+        q.id,
+        _telegram_id,
+        _today,
+        (_today + 1)::TIMESTAMP AT TIME ZONE _timezone
+    FROM quests q
+    WHERE q.is_active
+    AND q.cadence = 'daily'
+    ON CONFLICT (telegram_id, quest_id, period_start) WHERE period_start IS NOT NULL DO NOTHING;
+
+    -- еженедельные задания истекают в конце недели пользователя.
+    INSERT INTO user_quests(
+        quest_id,
+        telegram_id,
+        period_start,
+        expires_at
+    )
+    SELECT
+        q.id,
+        _telegram_id,
+        _week_start,
+        (_week_start + 7)::TIMESTAMP AT TIME ZONE _timezone
+    FROM quests q
+    WHERE q.is_active
+    AND q.cadence = 'weekly'
+    ON CONFLICT (telegram_id, quest_id, period_start) WHERE period_start IS NOT NULL DO NOTHING;
+
+    -- одноразовые задания выдаются один раз, срок выполнения отсчитывается с момента выдачи.
+    INSERT INTO user_quests(
+        quest_id,
+        telegram_id,
+        expires_at
+    )
+    SELECT
+        q.id,
+        _telegram_id,
+        NOW() + q.duration_days * INTERVAL '1 day'
+    FROM quests q
+    WHERE q.is_active
+    AND q.cadence = 'one_off'
+    ON CONFLICT (telegram_id, quest_id) WHERE period_start IS NULL DO NOTHING;
+END;
+$$;
\ No newline at end of file
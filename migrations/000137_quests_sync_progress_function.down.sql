@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.quests_sync_progress(TEXT, JSONB);
\ No newline at end of file
@@ -0,0 +1,115 @@
+-- обновляет прогресс активных заданий пользователя и засчитывает выполненные.
+-- опыт за выполненные задания начисляется сразу, внутренняя валюта начисляется сервисом через баланс.
+-- возвращает только что выполненные задания.
+CREATE OR REPLACE FUNCTION public.quests_sync_progress(
+    _telegram_id TEXT,
+    _src JSONB
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _response JSONB;
+    _event_type_id BIGINT;
+    _total_xp BIGINT;
+    _r RECORD;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- выдаём задания текущего периода, чтобы учесть прогресс и по ним.
+    PERFORM public.quests_assign(_telegram_id);
+
+    UPDATE user_quests
+    SET
+        words_learned = GREATEST(0, words_learned + COALESCE((_src->>'words_learned')::BIGINT, 0)),
+        tasks_completed = GREATEST(0, tasks_completed + COALESCE((_src->>'tasks_completed')::BIGINT, 0)),
+        lessons_finished = GREATEST(0, lessons_finished + COALESCE((_src->>'lessons_finished')::BIGINT, 0)),
+        words_translate = GREATEST(0, words_translate + COALESCE((_src->>'words_translate')::BIGINT, 0)),
+        dialog_completed = GREATEST(0, dialog_completed + COALESCE((_src->>'dialog_completed')::BIGINT, 0)),
+        experience_points = GREATEST(0, experience_points + COALESCE((_src->>'experience_points')::BIGINT, 0))
+    WHERE telegram_id = _telegram_id
+    AND completed_at IS NULL
+    AND (expires_at IS NULL OR expires_at > NOW());
+
+    -- засчитываем каждое выполненное задание только один раз.
+    WITH completed AS (
+        UPDATE user_quests uq SET
+            completed_at = NOW()
+        FROM quests q
+        WHERE q.id = uq.quest_id
+        AND uq.telegram_id = _telegram_id
+        AND uq.completed_at IS NULL
+        AND (uq.expires_at IS NULL OR uq.expires_at > NOW())
+        AND (q.words_learned_need = 0 OR uq.words_learned >= q.words_learned_need)
+        AND (q.tasks_completed_need = 0 OR uq.tasks_completed >= q.tasks_completed_need)
+        AND (q.lessons_finished_need = 0 OR uq.lessons_finished >= q.lessons_finished_need)
+        AND (q.words_translate_need = 0 OR uq.words_translate >= q.words_translate_need)
+        AND (q.dialog_completed_need = 0 OR uq.dialog_completed >= q.dialog_completed_need)
+        AND (q.experience_points_need = 0 OR uq.experience_points >= q.experience_points_need)
+        RETURNING
+            uq.id,
+            uq.quest_id,
+            q.name,
+            q.cadence,
+            q.reward_amount,
+            q.reward_experience_points
+    )
+    SELECT COALESCE(
+        JSONB_AGG(
+            JSONB_STRIP_NULLS(
+                JSONB_BUILD_OBJECT(
+                    'user_quest_id', c.id,
+                    'quest_id', c.quest_id,
+                    'name', c.name,
+                    'cadence', c.cadence,
+                    'amount', c.reward_amount,
+                    'experience_points', c.reward_experience_points
+                )
+            )
+            ORDER BY c.id
+        ),
+        '[]'::JSONB
+    )
+    INTO _response
+    FROM completed c;
+
+    FOR _r IN
+        SELECT
+            (x->>'experience_points')::BIGINT AS experience_points
+        FROM JSONB_ARRAY_ELEMENTS(_response) x
+        WHERE (x->>'experience_points')::BIGINT > 0
+    LOOP
+        IF _event_type_id IS NULL THEN
+            SELECT id
+            INTO _event_type_id
+            FROM event_types
+            WHERE name = 'quest_completed';
+        END IF;
+
+        INSERT INTO xp_events(
+            event_type_id,
+            telegram_id,
+            delta_xp
+        ) VALUES(
+            _event_type_id,
+            _telegram_id,
+            _r.experience_points
+        );
+
+        _total_xp := COALESCE(_total_xp, 0) + _r.experience_points;
+    END LOOP;
+
+    -- опыт в user_stats равен сумме xp_events, поэтому сразу учитываем выданный опыт.
+    IF _total_xp IS NOT NULL THEN
+        UPDATE user_stats SET
+            experience_points = experience_points + _total_xp,
+            updated_at = NOW()
+        WHERE telegram_id = _telegram_id;
+    END IF;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.user_quests_get(TEXT, TEXT, INTEGER);
\ No newline at end of file
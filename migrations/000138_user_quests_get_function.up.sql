@@ -0,0 +1,148 @@
+-- возвращает задания пользователя (активные, выполненные, истёкшие), новые первыми.
+CREATE OR REPLACE FUNCTION public.user_quests_get(
+    _telegram_id TEXT,
+    _status TEXT DEFAULT NULL,
+    _limit INTEGER DEFAULT 100
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    WITH user_quests_with_status AS (
+        SELECT
+            uq.*,
+            CASE
+                WHEN uq.completed_at IS NOT NULL THEN 'completed'
+                WHEN uq.expires_at IS NOT NULL AND uq.expires_at <= NOW() THEN 'expired'
+                ELSE 'active'
+            END AS status
+        FROM user_quests uq
+        WHERE uq.telegram_id = _telegram_id
+    ),
+    filtered AS (
+        SELECT *
+        FROM user_quests_with_status uq
+        WHERE _status IS NULL OR uq.status = _status
+        ORDER BY uq.assigned_at DESC, uq.id DESC
+        LIMIT _limit
+    )
+    SELECT COALESCE(
+        JSONB_AGG(
+            JSONB_STRIP_NULLS(
+                JSONB_BUILD_OBJECT(
+                    'id', uq.id,
+                    'quest_id', q.id,
+                    'name', q.name,
+                    'description', q.description,
+                    'cadence', q.cadence,
+                    'status', uq.status,
+                    'reward_amount', q.reward_amount,
+                    'reward_experience_points', q.reward_experience_points,
+                    'assigned_at', uq.assigned_at,
+                    'expires_at', uq.expires_at,
+                    'completed_at', uq.completed_at
+                )
+            ) || JSONB_BUILD_OBJECT(
+                'requirements', JSONB_STRIP_NULLS(
+                    JSONB_BUILD_OBJECT(
+                        'words_learned_need',
+                            CASE WHEN q.words_learned_need > 0
+                                THEN q.words_learned_need
+                            END,
+                        'tasks_completed_need',
+                            CASE WHEN q.tasks_completed_need > 0
+                                THEN q.tasks_completed_need
+                            END,
+                        'lessons_finished_need',
+                            CASE WHEN q.lessons_finished_need > 0
+                                THEN q.lessons_finished_need
+                            END,
+                        'words_translate_need',
+                            CASE WHEN q.words_translate_need > 0
+                                THEN q.words_translate_need
+                            END,
+                        'dialog_completed_need',
+                            CASE WHEN q.dialog_completed_need > 0
+                                THEN q.dialog_completed_need
+                            END,
+                        'experience_points_need',
+                            CASE WHEN q.experience_points_need > 0
+                                THEN q.experience_points_need
+                            END
+                    )
+                ),
+                'progress', JSONB_STRIP_NULLS(
+                    JSONB_BUILD_OBJECT(
+                        'words_learned',
+                            CASE WHEN q.words_learned_need > 0
+                                THEN uq.words_learned
+                            END,
+                        'tasks_completed',
+                            CASE WHEN q.tasks_completed_need > 0
+                                THEN uq.tasks_completed
+                            END,
+                        'lessons_finished',
+                            CASE WHEN q.lessons_finished_need > 0
+                                THEN uq.lessons_finished
+                            END,
+                        'words_translate',
+                            CASE WHEN q.words_translate_need > 0
+                                THEN uq.words_translate
+                            END,
+                        'dialog_completed',
+                            CASE WHEN q.dialog_completed_need > 0
+                                THEN uq.dialog_completed
+                            END,
+                        'experience_points',
+                            CASE WHEN q.experience_points_need > 0
+                                THEN uq.experience_points
+                            END
+                    )
+                ),
+                'progress_percent', JSONB_STRIP_NULLS(
+                    JSONB_BUILD_OBJECT(
+                        'words_learned',
+                            CASE WHEN q.words_learned_need > 0
+                                THEN LEAST(ROUND((uq.words_learned::NUMERIC / NULLIF(q.words_learned_need,0)) * 100), 100)::INTEGER
+                            END,
+                        'tasks_completed',
+                            CASE WHEN q.tasks_completed_need > 0
+                                THEN LEAST(ROUND((uq.tasks_completed::NUMERIC / NULLIF(q.tasks_completed_need,0)) * 100), 100)::INTEGER
+                            END,
+                        'lessons_finished',
+                            CASE WHEN q.lessons_finished_need > 0
+                                THEN LEAST(ROUND((uq.lessons_finished::NUMERIC / NULLIF(q.lessons_finished_need,0)) * 100), 100)::INTEGER
+                            END,
+                        'words_translate',
+                            CASE WHEN q.words_translate_need > 0
+                                THEN LEAST(ROUND((uq.words_translate::NUMERIC / NULLIF(q.words_translate_need,0)) * 100), 100)::INTEGER
+                            END,
+                        'dialog_completed',
+                            CASE WHEN q.dialog_completed_need > 0
+                                THEN LEAST(ROUND((uq.dialog_completed::NUMERIC / NULLIF(q.dialog_completed_need,0)) * 100), 100)::INTEGER
+                            END,
+                        'experience_points',
+                            CASE WHEN q.experience_points_need > 0
+                                THEN LEAST(ROUND((uq.experience_points::NUMERIC / NULLIF(q.experience_points_need,0)) * 100), 100)::INTEGER
+                            END
+                    )
+                )
+            )
+            ORDER BY uq.assigned_at DESC, uq.id DESC
+        ),
+        '[]'::JSONB
+    )
+    INTO _response
+    FROM filtered uq
+    INNER JOIN quests q ON uq.quest_id = q.id;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS daily_task_difficulty;
\ No newline at end of file
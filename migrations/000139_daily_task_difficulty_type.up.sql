@@ -0,0 +1,2 @@
+-- уровень сложности ежедневного задания: лёгкое, среднее, сложное.
+CREATE TYPE daily_task_difficulty AS ENUM ('easy', 'medium', 'hard');
\ No newline at end of file
@@ -0,0 +1,8 @@
+ALTER TABLE user_daily_tasks
+    DROP COLUMN IF EXISTS assignment_reason,
+    DROP COLUMN IF EXISTS difficulty;
+
+DROP INDEX IF EXISTS idx_daily_tasks_is_active_difficulty;
+
+ALTER TABLE daily_tasks
+    DROP COLUMN IF EXISTS difficulty;
\ No newline at end of file
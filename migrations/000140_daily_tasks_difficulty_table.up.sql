@@ -0,0 +1,22 @@
+-- сложность ежедневного задания.
+ALTER TABLE daily_tasks
+    ADD COLUMN IF NOT EXISTS difficulty daily_task_difficulty NOT NULL DEFAULT 'medium';
+
+-- сложность уже созданных заданий определяем по сумме требований.
+UPDATE daily_tasks SET
+    difficulty = CASE
+        WHEN words_learned_need + tasks_completed_need + lessons_finished_need
+            + words_translate_need + dialog_completed_need + experience_points_need <= 7 THEN 'easy'::daily_task_difficulty
+        WHEN words_learned_need + tasks_completed_need + lessons_finished_need
+            + words_translate_need + dialog_completed_need + experience_points_need <= 14 THEN 'medium'::daily_task_difficulty
+        ELSE 'hard'::daily_task_difficulty
+    END,
+    updated_at = NOW();
+
+-- Быстрее выбор активных задач нужной сложности.
+CREATE INDEX IF NOT EXISTS idx_daily_tasks_is_active_difficulty ON daily_tasks (is_active, difficulty);
+
+-- сложность назначенного задания и причина назначения (для аналитики).
+ALTER TABLE user_daily_tasks
+    ADD COLUMN IF NOT EXISTS difficulty daily_task_difficulty,
+    ADD COLUMN IF NOT EXISTS assignment_reason JSONB;
\ No newline at end of file
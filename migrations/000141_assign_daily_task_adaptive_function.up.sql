@@ -0,0 +1,443 @@
+DROP FUNCTION IF EXISTS public.assign_daily_task(TEXT);
+
+-- _level_weight, _completion_rate_weight - веса уровня пользователя и доли выполненных заданий при выборе сложности.
+-- _max_level - уровень, начиная с которого вклад уровня максимальный.
+-- _completion_rate_days - за сколько последних дней считается доля выполненных заданий.
+-- _medium_threshold, _hard_threshold - пороги оценки для среднего и сложного задания.
+CREATE OR REPLACE FUNCTION public.assign_daily_task(
+    _telegram_id TEXT,
+    _level_weight NUMERIC DEFAULT 0.6,
+    _completion_rate_weight NUMERIC DEFAULT 0.4,
+    _max_level BIGINT DEFAULT 20,
+    _completion_rate_days INTEGER DEFAULT 14,
+    _medium_threshold NUMERIC DEFAULT 0.35,
+    _hard_threshold NUMERIC DEFAULT 0.7
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _today DATE;
+    _udt_today_id BIGINT;
+    _picked_task_id BIGINT;
+    _prev_id BIGINT;
+    _prev_date DATE;
+    _prev_completed BOOLEAN;
+    _words_learned_need BIGINT;
+    _tasks_completed_need BIGINT;
+    _lessons_finished_need BIGINT;
+    _words_translate_need BIGINT;
+    _dialog_completed_need BIGINT;
+    _experience_points_need BIGINT;
+    _completed_now BOOLEAN;
+    _level BIGINT;
+    _assigned_count BIGINT;
+    _completed_count BIGINT;
+    _completion_rate NUMERIC;
+    _level_score NUMERIC;
+    _score NUMERIC;
+    _target_difficulty daily_task_difficulty;
+    _picked_difficulty daily_task_difficulty;
+    _picked_is_recent BOOLEAN;
+    _assignment_reason JSONB;
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- сегодняшний день по часовому поясу пользователя.
+    _today := public.user_local_date(_telegram_id);
+
+    PERFORM PG_ADVISORY_XACT_LOCK(HASHTEXT(_telegram_id));
+
+    -- блокируем строку в таблице user_stats.
+    SELECT
+        level
+    INTO _level
+    FROM user_stats
+    WHERE telegram_id = _telegram_id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'user_stats row is missing and cannot be created (no users row?) for %', _telegram_id;
+    END IF;
+
+    -- если на сегодня уже есть назначение ежедневное название, то возвращаем.
+    SELECT udt.id
+    INTO _udt_today_id
+    FROM user_daily_tasks udt
+    WHERE udt.telegram_id = _telegram_id
+    AND udt.task_date = _today
+    ORDER BY udt.occurred_at DESC
+    LIMIT 1;
+
+    IF _udt_today_id IS NOT NULL THEN
+        SELECT JSONB_BUILD_OBJECT(
+            'id', udt.id,
+            'date', TO_CHAR(_today, 'YYYY-MM-DD"T"00:00:00"Z"'),
+            'is_completed', udt.is_completed,
+            'requirements', JSONB_STRIP_NULLS(
+                JSONB_BUILD_OBJECT(
+                    'words_learned_need',
+                        CASE WHEN dt.words_learned_need > 0
+                            THEN dt.words_learned_need
+                        END,
+                    'tasks_completed_need',
+                        CASE WHEN dt.tasks_completed_need > 0
+                            THEN dt.tasks_completed_need
+                        END,
+                    'lessons_finished_need',
+                        CASE WHEN dt.lessons_finished_need > 0
+                            THEN dt.lessons_finished_need
+                        END,
+                    'words_translate_need',
+                        CASE WHEN dt.words_translate_need > 0
+                            THEN dt.words_translate_need
+                        END,
+                    'dialog_completed_need',
+                        CASE WHEN dt.dialog_completed_need > 0
+                            THEN dt.dialog_completed_need
+                        END,
+                    'experience_points_need',
+                        CASE WHEN dt.experience_points_need > 0
+                            THEN dt.experience_points_need
+                        END
+                )
+            ),
+            'progress',
+            JSONB_STRIP_NULLS(
+                JSONB_BUILD_OBJECT(
+                    'words_learned',
+                        CASE WHEN dt.words_learned_need > 0
+                            THEN udt.words_learned
+                        END,
+                    'tasks_completed',
+                        CASE WHEN dt.tasks_completed_need > 0
+                            THEN udt.tasks_completed
+                        END,
+                    'lessons_finished',
+                        CASE WHEN dt.lessons_finished_need > 0
+                            THEN udt.lessons_finished
+                        END,
+                    'words_translate',
+                        CASE WHEN dt.words_translate_need > 0
+                            THEN udt.words_translate
+                        END,
+                    'dialog_completed',
+                        CASE WHEN dt.dialog_completed_need > 0
+                            THEN udt.dialog_completed
+                        END,
+                    'experience_points',
+                        CASE WHEN dt.experience_points_need > 0
+                            THEN udt.experience_points
+                        END
+                )
+            ),
+            'progress_percent',
+            JSONB_STRIP_NULLS(
+                JSONB_BUILD_OBJECT(
+                    'words_learned',
+                        CASE WHEN dt.words_learned_need > 0
+                            THEN LEAST(ROUND((udt.words_learned::NUMERIC / NULLIF(dt.words_learned_need,0)) * 100), 100)::INTEGER
+                        END,
+                    'tasks_completed',
+                        CASE WHEN dt.tasks_completed_need > 0
+                            THEN LEAST(ROUND((udt.tasks_completed::NUMERIC / NULLIF(dt.tasks_completed_need,0)) * 100), 100)::INTEGER
+                        END,
+                    'lessons_finished',
+                        CASE WHEN dt.lessons_finished_need > 0
+                            THEN LEAST(ROUND((udt.lessons_finished::NUMERIC / NULLIF(dt.lessons_finished_need,0)) * 100), 100)::INTEGER
+                        END,
+                    'words_translate',
+                        CASE WHEN dt.words_translate_need > 0
+                            THEN LEAST(ROUND((udt.words_translate::NUMERIC / NULLIF(dt.words_translate_need,0)) * 100), 100)::INTEGER
+                        END,
+                    'dialog_completed',
+                        CASE WHEN dt.dialog_completed_need > 0
+                            THEN LEAST(ROUND((udt.dialog_completed::NUMERIC / NULLIF(dt.dialog_completed_need,0)) * 100), 100)::INTEGER
+                        END,
+                    'experience_points',
+                        CASE WHEN dt.experience_points_need > 0
+                            THEN LEAST(ROUND((udt.experience_points::NUMERIC / NULLIF(dt.experience_points_need,0)) * 100), 100)::INTEGER
+                        END
+                )
+            )
+        )
+        INTO _response
+        FROM user_daily_tasks udt
+        INNER JOIN daily_tasks dt ON udt.daily_task_id = dt.id
+        WHERE udt.id = _udt_today_id;
+
+        RETURN _response;
+    END IF;
+
+    -- вердикт по вчерашнему/последнему ежедневному заданию.
+    SELECT
+        udt.id,
+        udt.task_date AS d,
+        udt.is_completed,
+        dt.words_learned_need,
+        dt.tasks_completed_need,
+        dt.lessons_finished_need,
+        dt.words_translate_need,
+        dt.dialog_completed_need,
+        dt.experience_points_need
+    INTO
+        _prev_id,
+        _prev_date,
+        _prev_completed,
+        _words_learned_need,
+        _tasks_completed_need,
+        _lessons_finished_need,
+        _words_translate_need,
+        _dialog_completed_need,
+        _experience_points_need
+    FROM user_daily_tasks udt
+    INNER JOIN daily_tasks dt ON udt.daily_task_id = dt.id
+    WHERE udt.telegram_id = _telegram_id
+    AND udt.task_date < _today
+    ORDER BY udt.occurred_at DESC
+    LIMIT 1
+    FOR UPDATE;
+
+    IF _prev_id IS NOT NULL THEN
+        -- если is_completed ещё не выставлен корректно — вычислим по факту прогресса vs требований.
+        IF _prev_completed IS DISTINCT FROM TRUE THEN
+            SELECT
+                (
+                    (_words_learned_need = 0 OR udt.words_learned >= _words_learned_need)
+                    AND (_tasks_completed_need = 0 OR udt.tasks_completed >= _tasks_completed_need)
+                    AND (_lessons_finished_need = 0 OR udt.lessons_finished >= _lessons_finished_need)
+                    AND (_words_translate_need = 0 OR udt.words_translate >= _words_translate_need)
+                    AND (_dialog_completed_need = 0 OR udt.dialog_completed >= _dialog_completed_need)
+                    AND (_experience_points_need = 0 OR udt.experience_points >= _experience_points_need)
+                )
+            INTO _completed_now
+            FROM user_daily_tasks udt
+            WHERE udt.id = _prev_id
+            FOR UPDATE;
+
+            UPDATE user_daily_tasks SET
+                is_completed = _completed_now
+            WHERE id = _prev_id;
+
+            _prev_completed := _completed_now;
+        END IF;
+    END IF;
+
+    -- streak ежедневных заданий поддерживает daily_task_complete,
+    -- здесь только сбрасываем его, если пользователь пропустил день.
+    UPDATE user_stats SET
+        daily_task_streak_days = 0,
+        updated_at = NOW()
+    WHERE telegram_id = _telegram_id
+    AND daily_task_streak_days > 0
+    AND (
+        last_daily_task_streak_days IS NULL
+        OR last_daily_task_streak_days < _today - 1
+    );
+
+    -- доля выполненных ежедневных заданий за последние дни (вердикт по прошлым заданиям уже вынесен выше).
+    SELECT
+        COUNT(*),
+        COUNT(*) FILTER (WHERE is_completed)
+    INTO
+        _assigned_count,
+        _completed_count
+    FROM user_daily_tasks
+    WHERE telegram_id = _telegram_id
+    AND task_date >= _today - GREATEST(_completion_rate_days, 1)
+    AND task_date < _today;
+
+    _completion_rate := COALESCE(_completed_count::NUMERIC / NULLIF(_assigned_count, 0), 0);
+
+    -- вклад уровня: от 0 (первый уровень) до 1 (_max_level и выше).
+    _level_score := LEAST(
+        GREATEST(COALESCE(_level, 1) - 1, 0)::NUMERIC / GREATEST(_max_level - 1, 1),
+        1
+    );
+
+    _score := (_level_weight * _level_score + _completion_rate_weight * _completion_rate)
+        / NULLIF(_level_weight + _completion_rate_weight, 0);
+    _score := COALESCE(_score, 0);
+
+    _target_difficulty := CASE
+        WHEN _score >= _hard_threshold THEN 'hard'::daily_task_difficulty
+        WHEN _score >= _medium_threshold THEN 'medium'::daily_task_difficulty
+        ELSE 'easy'::daily_task_difficulty
+    END;
+
+    -- Найти ежедневное задание на сегодня: сначала нужной сложности, затем ближайшей (при равенстве - более лёгкой),
+    -- внутри сложности - с анти-повтором 4 дня (по локальной дате пользователя), среди равных - случайное.
+    WITH recent AS (
+        SELECT
+            DISTINCT daily_task_id
+        FROM user_daily_tasks
+        WHERE telegram_id = _telegram_id
+        AND task_date >= _today - 4
+    )
+    SELECT
+        dt.id,
+        dt.difficulty,
+        r.daily_task_id IS NOT NULL
+    INTO
+        _picked_task_id,
+        _picked_difficulty,
+        _picked_is_recent
+    FROM daily_tasks dt
+    LEFT JOIN recent r ON r.daily_task_id = dt.id
+    WHERE dt.is_active
+    ORDER BY
+        ABS(ARRAY_POSITION(ENUM_RANGE(NULL::daily_task_difficulty), dt.difficulty)
+            - ARRAY_POSITION(ENUM_RANGE(NULL::daily_task_difficulty), _target_difficulty)),
+        r.daily_task_id IS NOT NULL,
+        dt.difficulty,
+        RANDOM()
+    LIMIT 1;
+
+    IF _picked_task_id IS NULL THEN
+        RAISE EXCEPTION 'there are no active daily tasks';
+    END IF;
+
+    -- причина назначения (для аналитики).
+    _assignment_reason := JSONB_BUILD_OBJECT(
+        'level', COALESCE(_level, 1),
+        'completion_rate', ROUND(_completion_rate, 4),
+        'assigned_count', _assigned_count,
+        'completed_count', _completed_count,
+        'score', ROUND(_score, 4),
+        'target_difficulty', _target_difficulty,
+        'difficulty', _picked_difficulty,
+        'is_difficulty_fallback', _picked_difficulty <> _target_difficulty,
+        'is_recent_repeat', _picked_is_recent,
+        'weights', JSONB_BUILD_OBJECT(
+            'level_weight', _level_weight,
+            'completion_rate_weight', _completion_rate_weight,
+            'max_level', _max_level,
+            'completion_rate_days', _completion_rate_days,
+            'medium_threshold', _medium_threshold,
+            'hard_threshold', _hard_threshold
+        )
+    );
+
+    INSERT INTO user_daily_tasks(
+        daily_task_id,
+        telegram_id,
+        task_date,
+        difficulty,
+        assignment_reason,
+        occurred_at
+    )
+    VALUES (
+        _picked_task_id,
+        _telegram_id,
+        _today,
+        _picked_difficulty,
+        _assignment_reason,
+        NOW()
+    );
+
+    SELECT JSONB_BUILD_OBJECT(
+        'id', udt.id,
+        'date', TO_CHAR(_today, 'YYYY-MM-DD"T"00:00:00"Z"'),
+        'is_completed', udt.is_completed,
+        'requirements', JSONB_STRIP_NULLS(
+            JSONB_BUILD_OBJECT(
+                'words_learned_need',
+                    CASE WHEN dt.words_learned_need > 0
+                        THEN dt.words_learned_need
+                    END,
+                'tasks_completed_need',
+                    CASE WHEN dt.tasks_completed_need > 0
+                        THEN dt.tasks_completed_need
+                    END,
+                'lessons_finished_need',
+                    CASE WHEN dt.lessons_finished_need > 0
+                        THEN dt.lessons_finished_need
+                    END,
+                'words_translate_need',
+                    CASE WHEN dt.words_translate_need > 0
+                        THEN dt.words_translate_need
+                    END,
+                'dialog_completed_need',
+                    CASE WHEN dt.dialog_completed_need > 0
+                        THEN dt.dialog_completed_need
+                    END,
+                'experience_points_need',
+                    CASE WHEN dt.experience_points_need > 0
+                        THEN dt.experience_points_need
+                    END
+            )
+        ),
+        'progress',
+        JSONB_STRIP_NULLS(
+            JSONB_BUILD_OBJECT(
+                'words_learned',
+                    CASE WHEN dt.words_learned_need > 0
+                        THEN udt.words_learned
+                    END,
+                'tasks_completed',
+                    CASE WHEN dt.tasks_completed_need > 0
+                        THEN udt.tasks_completed
+                    END,
+                'lessons_finished',
+                    CASE WHEN dt.lessons_finished_need > 0
+                        THEN udt.lessons_finished
+                    END,
+                'words_translate',
+                    CASE WHEN dt.words_translate_need > 0
+                        THEN udt.words_translate
+                    END,
+                'dialog_completed',
+                    CASE WHEN dt.dialog_completed_need > 0
+                        THEN udt.dialog_completed
+                    END,
+                'experience_points',
+                    CASE WHEN dt.experience_points_need > 0
+                        THEN udt.experience_points
+                    END
+                )
+        ),
+        'progress_percent',
+        JSONB_STRIP_NULLS(
+            JSONB_BUILD_OBJECT(
+                'words_learned',
+                    CASE WHEN dt.words_learned_need > 0
+                        THEN LEAST(ROUND((udt.words_learned::NUMERIC / NULLIF(dt.words_learned_need,0)) * 100), 100)::INTEGER
+                    END,
+                'tasks_completed',
+                    CASE WHEN dt.tasks_completed_need > 0
+                        THEN LEAST(ROUND((udt.tasks_completed::NUMERIC / NULLIF(dt.tasks_completed_need,0)) * 100), 100)::INTEGER
+                    END,
+                'lessons_finished',
+                    CASE WHEN dt.lessons_finished_need > 0
+                        THEN LEAST(ROUND((udt.lessons_finished::NUMERIC / NULLIF(dt.lessons_finished_need,0)) * 100), 100)::INTEGER
+                    END,
+                'words_translate',
+                    CASE WHEN dt.words_translate_need > 0
+                        THEN LEAST(ROUND((udt.words_translate::NUMERIC / NULLIF(dt.words_translate_need,0)) * 100), 100)::INTEGER
+                    END,
+                'dialog_completed',
+                    CASE WHEN dt.dialog_completed_need > 0
+                        THEN LEAST(ROUND((udt.dialog_completed::NUMERIC / NULLIF(dt.dialog_completed_need,0)) * 100), 100)::INTEGER
+                    END,
+                'experience_points',
+                    CASE WHEN dt.experience_points_need > 0
+                        THEN LEAST(ROUND((udt.experience_points::NUMERIC / NULLIF(dt.experience_points_need,0)) * 100), 100)::INTEGER
+                    END
+            )
+        )
+    )
+    INTO _response
+    FROM user_daily_tasks udt
+    INNER JOIN daily_tasks dt ON udt.daily_task_id = dt.id
+    WHERE udt.telegram_id = _telegram_id
+    AND udt.task_date = _today
+    ORDER BY udt.occurred_at DESC
+    LIMIT 1;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS shop_item_type;
\ No newline at end of file
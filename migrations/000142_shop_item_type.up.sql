@@ -0,0 +1,2 @@
+-- тип товара магазина: ускоритель, заморозка streak, рамка (косметика), дни премиум-подписки.
+CREATE TYPE shop_item_type AS ENUM ('boost', 'streak_freeze', 'cosmetic_frame', 'premium_days');
\ No newline at end of file
@@ -0,0 +1,5 @@
+DELETE FROM event_types WHERE name = 'shop_purchase';
+
+DROP INDEX IF EXISTS idx_shop_items_is_active;
+
+DROP TABLE IF EXISTS shop_items;
\ No newline at end of file
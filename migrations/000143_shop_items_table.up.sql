@@ -0,0 +1,90 @@
+CREATE TABLE IF NOT EXISTS shop_items( -- Каталог товаров магазина, которые покупаются за внутреннюю валюту.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    name TEXT NOT NULL, -- Название товара.
+    description TEXT, -- Описание товара.
+    type shop_item_type NOT NULL, -- Тип товара.
+    value BIGINT CHECK (value IS NULL OR value > 0), -- Эффект одной единицы товара: минуты ускорителя, количество заморозок, дни подписки (NULL для рамки).
+    price NUMERIC(20, 2) NOT NULL CHECK (price > 0), -- Цена одной единицы товара.
+    stock BIGINT CHECK (stock IS NULL OR stock >= 0), -- Остаток товара (NULL - без ограничения).
+    per_user_limit BIGINT CHECK (per_user_limit IS NULL OR per_user_limit > 0), -- Сколько единиц товара может купить один пользователь (NULL - без ограничения).
+    available_from TIMESTAMP WITH TIME ZONE, -- С какого момента товар доступен (NULL - без ограничения).
+    available_until TIMESTAMP WITH TIME ZONE, -- До какого момента товар доступен (NULL - без ограничения).
+    is_active BOOLEAN NOT NULL DEFAULT TRUE, -- Продаётся ли товар.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    -- рамка не расходуется, остальные товары имеют эффект.
+    CHECK ((type = 'cosmetic_frame') = (value IS NULL)),
+    CHECK (available_from IS NULL OR available_until IS NULL OR available_from < available_until)
+);
+
+-- Быстрее фильтр активных товаров.
+CREATE INDEX IF NOT EXISTS idx_shop_items_is_active ON shop_items (is_active);
+
+INSERT INTO shop_items(
+    name,
+    description,
+    type,
+    value,
+    price
+) VALUES(
+    'Заморозка streak',
+    'Сохраняет streak, если вы пропустите день',
+    'streak_freeze',
+    1,
+    50.00
+);
+
+INSERT INTO shop_items(
+    name,
+    description,
+    type,
+    value,
+    price
+) VALUES(
+    'Ускоритель на 30 минут',
+    'Ускоритель опыта, действует 30 минут после активации',
+    'boost',
+    30,
+    30.00
+);
+
+INSERT INTO shop_items(
+    name,
+    description,
+    type,
+    value,
+    price
+) VALUES(
+    'Премиум на 7 дней',
+    'Продлевает премиум-подписку на 7 дней',
+    'premium_days',
+    7,
+    500.00
+);
+
+INSERT INTO shop_items(
+    name,
+    description,
+    type,
+    price,
+    per_user_limit
+) VALUES(
+    'Золотая рамка',
+    'Рамка для аватара в профиле',
+    'cosmetic_frame',
+    300.00,
+    1
+);
+
+-- событие, от имени которого списывается внутренняя валюта за покупку в магазине.
+INSERT INTO event_types(
+    name,
+    description,
+    notification_message,
+    is_send_notification
+) VALUES(
+    'shop_purchase',
+    'Событие по покупке товара в магазине за внутреннюю валюту',
+    'Покупка в магазине',
+    FALSE
+);
\ No newline at end of file
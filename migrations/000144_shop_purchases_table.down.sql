@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_shop_purchases_telegram_id_shop_item_id;
+
+DROP INDEX IF EXISTS idx_shop_purchases_telegram_id_idempotency_key_unique;
+
+DROP TABLE IF EXISTS shop_purchases;
\ No newline at end of file
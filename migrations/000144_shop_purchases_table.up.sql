@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS shop_purchases( -- Покупки товаров магазина пользователями.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    shop_item_id BIGINT NOT NULL, -- Идентификатор товара.
+    quantity BIGINT NOT NULL CHECK (quantity > 0), -- Количество купленных единиц.
+    unit_price NUMERIC(20, 2) NOT NULL CHECK (unit_price > 0), -- Цена одной единицы на момент покупки.
+    total_price NUMERIC(20, 2) NOT NULL CHECK (total_price > 0), -- Списанная сумма.
+    idempotency_key TEXT NOT NULL, -- Ключ идемпотентности от клиента (повторный запрос не списывает валюту повторно).
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата покупки.
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (shop_item_id) REFERENCES shop_items(id)
+);
+
+-- Идемпотентность покупки.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shop_purchases_telegram_id_idempotency_key_unique ON shop_purchases (telegram_id, idempotency_key);
+
+-- Быстрее подсчёт покупок товара пользователем (лимит на пользователя).
+CREATE INDEX IF NOT EXISTS idx_shop_purchases_telegram_id_shop_item_id ON shop_purchases (telegram_id, shop_item_id);
\ No newline at end of file
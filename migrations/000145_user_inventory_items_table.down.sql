@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_user_inventory_items_telegram_id_created_at;
+
+DROP TABLE IF EXISTS user_inventory_items;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS user_inventory_items( -- Инвентарь пользователя: купленные и использованные товары.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    shop_item_id BIGINT NOT NULL, -- Идентификатор товара.
+    shop_purchase_id BIGINT NOT NULL UNIQUE, -- Идентификатор покупки.
+    quantity BIGINT NOT NULL CHECK (quantity > 0), -- Сколько единиц получено.
+    consumed_quantity BIGINT NOT NULL DEFAULT 0 CHECK (consumed_quantity >= 0), -- Сколько единиц использовано.
+    active_until TIMESTAMP WITH TIME ZONE, -- До какого момента действует активированный ускоритель.
+    consumed_at TIMESTAMP WITH TIME ZONE, -- Когда товар использовали в последний раз.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    CHECK (consumed_quantity <= quantity),
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (shop_item_id) REFERENCES shop_items(id),
+    FOREIGN KEY (shop_purchase_id) REFERENCES shop_purchases(id)
+);
+
+-- Быстрее инвентарь пользователя.
+CREATE INDEX IF NOT EXISTS idx_user_inventory_items_telegram_id_created_at ON user_inventory_items (telegram_id, created_at DESC);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.shop_purchase(TEXT, BIGINT, BIGINT, TEXT);
\ No newline at end of file
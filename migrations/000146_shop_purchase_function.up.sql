@@ -0,0 +1,134 @@
+-- покупка товара магазина: проверяет доступность, остаток и лимит на пользователя,
+-- уменьшает остаток, создаёт покупку и кладёт товар в инвентарь пользователя.
+-- внутренняя валюта списывается сервисом в той же транзакции (только для status = 'created').
+-- status: created, duplicate (покупка с этим ключом уже была), idempotency_conflict (ключ уже использован для другой покупки),
+-- not_available, out_of_stock, limit_reached.
+CREATE OR REPLACE FUNCTION public.shop_purchase(
+    _telegram_id TEXT,
+    _shop_item_id BIGINT,
+    _quantity BIGINT,
+    _idempotency_key TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _item shop_items;
+    _purchase shop_purchases;
+    _inventory_item user_inventory_items;
+    _purchased BIGINT;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    IF _quantity IS NULL OR _quantity <= 0 THEN
+        RAISE EXCEPTION 'quantity must be positive';
+    END IF;
+
+    IF _idempotency_key IS NULL OR _idempotency_key = '' THEN
+        RAISE EXCEPTION 'idempotency_key IS NULL';
+    END IF;
+
+    -- покупки пользователя выполняются последовательно, повторный запрос увидит уже созданную покупку.
+    PERFORM PG_ADVISORY_XACT_LOCK(HASHTEXT('shop_purchase:' || _telegram_id));
+
+    SELECT *
+    INTO _purchase
+    FROM shop_purchases
+    WHERE telegram_id = _telegram_id
+    AND idempotency_key = _idempotency_key;
+
+    IF FOUND THEN
+        SELECT *
+        INTO _inventory_item
+        FROM user_inventory_items
+        WHERE shop_purchase_id = _purchase.id;
+
+        RETURN JSONB_BUILD_OBJECT(
+            'status', CASE
+                WHEN _purchase.shop_item_id = _shop_item_id AND _purchase.quantity = _quantity THEN 'duplicate'
+                ELSE 'idempotency_conflict'
+            END,
+            'purchase', TO_JSONB(_purchase),
+            'inventory_item', TO_JSONB(_inventory_item)
+        );
+    END IF;
+
+    -- блокируем товар (остаток).
+    SELECT *
+    INTO _item
+    FROM shop_items
+    WHERE id = _shop_item_id
+    FOR UPDATE;
+
+    IF NOT FOUND
+        OR NOT _item.is_active
+        OR (_item.available_from IS NOT NULL AND _item.available_from > NOW())
+        OR (_item.available_until IS NOT NULL AND _item.available_until <= NOW())
+    THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_available');
+    END IF;
+
+    IF _item.stock IS NOT NULL AND _item.stock < _quantity THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'out_of_stock');
+    END IF;
+
+    IF _item.per_user_limit IS NOT NULL THEN
+        SELECT
+            COALESCE(SUM(quantity), 0)
+        INTO _purchased
+        FROM shop_purchases
+        WHERE telegram_id = _telegram_id
+        AND shop_item_id = _shop_item_id;
+
+        IF _purchased + _quantity > _item.per_user_limit THEN
+            RETURN JSONB_BUILD_OBJECT('status', 'limit_reached');
+        END IF;
+    END IF;
+
+    IF _item.stock IS NOT NULL THEN
+        UPDATE shop_items SET
+            stock = stock - _quantity,
+            updated_at = NOW()
+        WHERE id = _shop_item_id;
+    END IF;
+
+    INSERT INTO shop_purchases(
+        telegram_id,
+        shop_item_id,
+        quantity,
+        unit_price,
+        total_price,
+        idempotency_key
+    ) VALUES(
+        _telegram_id,
+        _shop_item_id,
+        _quantity,
+        _item.price,
+        _item.price * _quantity,
+        _idempotency_key
+    )
+    RETURNING * INTO _purchase;
+
+    INSERT INTO user_inventory_items(
+        telegram_id,
+        shop_item_id,
+        shop_purchase_id,
+        quantity
+    ) VALUES(
+        _telegram_id,
+        _shop_item_id,
+        _purchase.id,
+        _quantity
+    )
+    RETURNING * INTO _inventory_item;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', 'created',
+        'purchase', TO_JSONB(_purchase),
+        'inventory_item', TO_JSONB(_inventory_item)
+    );
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.inventory_item_consume(TEXT, BIGINT, BIGINT);
\ No newline at end of file
@@ -0,0 +1,90 @@
+-- использование товара из инвентаря пользователя с применением эффекта:
+-- заморозки streak начисляются (не больше лимита), дни премиум-подписки продлевают подписку,
+-- ускоритель активируется (продлевается, если уже активен). Рамка не расходуется.
+-- status: consumed, not_found, not_consumable, not_enough, limit_reached.
+CREATE OR REPLACE FUNCTION public.inventory_item_consume(
+    _telegram_id TEXT,
+    _user_inventory_item_id BIGINT,
+    _quantity BIGINT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _inventory_item user_inventory_items;
+    _item shop_items;
+    _effect BIGINT;
+    _streak_freezes BIGINT;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    IF _quantity IS NULL OR _quantity <= 0 THEN
+        RAISE EXCEPTION 'quantity must be positive';
+    END IF;
+
+    SELECT *
+    INTO _inventory_item
+    FROM user_inventory_items
+    WHERE id = _user_inventory_item_id
+    AND telegram_id = _telegram_id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_found');
+    END IF;
+
+    SELECT *
+    INTO _item
+    FROM shop_items
+    WHERE id = _inventory_item.shop_item_id;
+
+    IF _item.type = 'cosmetic_frame' THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_consumable');
+    END IF;
+
+    IF _inventory_item.quantity - _inventory_item.consumed_quantity < _quantity THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_enough');
+    END IF;
+
+    _effect := _item.value * _quantity;
+
+    IF _item.type = 'streak_freeze' THEN
+        -- блокируем строку в таблице user_stats.
+        SELECT
+            streak_freezes
+        INTO _streak_freezes
+        FROM user_stats
+        WHERE telegram_id = _telegram_id
+        FOR UPDATE;
+
+        -- товар не расходуется, если все заморозки не помещаются в лимит.
+        IF _streak_freezes + _effect > public.streak_freezes_max() THEN
+            RETURN JSONB_BUILD_OBJECT('status', 'limit_reached');
+        END IF;
+
+        PERFORM public.streak_freeze_add(_telegram_id, _effect, 'freeze_purchase');
+    ELSIF _item.type = 'premium_days' THEN
+        PERFORM public.subscription_extend_days(_telegram_id, _effect::INTEGER);
+    END IF;
+
+    UPDATE user_inventory_items SET
+        consumed_quantity = consumed_quantity + _quantity,
+        active_until = CASE
+            WHEN _item.type = 'boost'
+                THEN GREATEST(COALESCE(active_until, NOW()), NOW()) + MAKE_INTERVAL(mins => _effect::INTEGER)
+            ELSE active_until
+        END,
+        consumed_at = NOW(),
+        updated_at = NOW()
+    WHERE id = _user_inventory_item_id
+    RETURNING * INTO _inventory_item;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', 'consumed',
+        'inventory_item', TO_JSONB(_inventory_item)
+    );
+END;
+$$;
\ No newline at end of file
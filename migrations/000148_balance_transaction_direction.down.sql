@@ -0,0 +1 @@
+DROP TYPE IF EXISTS balance_transaction_direction;
\ No newline at end of file
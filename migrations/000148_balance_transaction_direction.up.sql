@@ -0,0 +1,2 @@
+-- направление операции по балансу: начисление или списание.
+CREATE TYPE balance_transaction_direction AS ENUM ('credit', 'debit');
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_balance_transactions_telegram_id_id;
+
+ALTER TABLE balance_transactions
+    DROP COLUMN IF EXISTS direction;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- направление операции (сумма всегда хранится положительной).
+ALTER TABLE balance_transactions
+    ADD COLUMN IF NOT EXISTS direction balance_transaction_direction NOT NULL DEFAULT 'credit';
+
+-- списания до появления колонки: покупки заморозки и восстановления streak, покупки в магазине.
+UPDATE balance_transactions SET
+    direction = 'debit'::balance_transaction_direction
+WHERE source_type IN ('streak_freeze', 'streak_repair', 'shop_purchase');
+
+-- Быстрее постраничный вывод истории операций пользователя (курсор по id).
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_telegram_id_id ON balance_transactions (telegram_id, id DESC);
\ No newline at end of file
@@ -0,0 +1,5 @@
+DELETE FROM text_translations
+WHERE content_id IN (SELECT id FROM text_contents WHERE page = 'balance_transactions');
+
+DELETE FROM text_contents
+WHERE page = 'balance_transactions';
\ No newline at end of file
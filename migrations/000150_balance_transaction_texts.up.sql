@@ -0,0 +1,33 @@
+-- локализованные названия операций по балансу (код = balance_transaction_ + название типа события).
+INSERT INTO text_contents (code, page, description) VALUES
+('balance_transaction_daily_login', 'balance_transactions', 'Ежедневный вход'),
+('balance_transaction_mini_game_reward', 'balance_transactions', 'Награда за мини игру'),
+('balance_transaction_level_up_reward', 'balance_transactions', 'Награда за новый уровень'),
+('balance_transaction_achievement_reward', 'balance_transactions', 'Награда за достижение'),
+('balance_transaction_daily_task_completed', 'balance_transactions', 'Награда за ежедневное задание'),
+('balance_transaction_quest_completed', 'balance_transactions', 'Награда за задание'),
+('balance_transaction_streak_freeze_purchase', 'balance_transactions', 'Покупка заморозки streak'),
+('balance_transaction_streak_repair_purchase', 'balance_transactions', 'Восстановление streak'),
+('balance_transaction_shop_purchase', 'balance_transactions', 'Покупка в магазине')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO text_translations (content_id, lang, value) VALUES
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_daily_login'), 'ru', 'Ежедневный вход'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_daily_login'), 'en', 'Daily login'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_mini_game_reward'), 'ru', 'Награда за мини игру'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_mini_game_reward'), 'en', 'Mini game reward'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_level_up_reward'), 'ru', 'Награда за новый уровень'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_level_up_reward'), 'en', 'Level up reward'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_achievement_reward'), 'ru', 'Награда за достижение'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_achievement_reward'), 'en', 'Achievement reward'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_daily_task_completed'), 'ru', 'Награда за ежедневное задание'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_daily_task_completed'), 'en', 'Daily task reward'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_quest_completed'), 'ru', 'Награда за задание'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_quest_completed'), 'en', 'Quest reward'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_streak_freeze_purchase'), 'ru', 'Покупка заморозки streak'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_streak_freeze_purchase'), 'en', 'Streak freeze purchase'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_streak_repair_purchase'), 'ru', 'Восстановление streak'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_streak_repair_purchase'), 'en', 'Streak repair'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_shop_purchase'), 'ru', 'Покупка в магазине'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_shop_purchase'), 'en', 'Shop purchase')
+ON CONFLICT (content_id, lang) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE currency_rates
+    DROP CONSTRAINT IF EXISTS chk_currency_rates_rate_positive;
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- курс всегда положительный (на него делится сумма при конвертации).
+ALTER TABLE currency_rates
+    ADD CONSTRAINT chk_currency_rates_rate_positive CHECK (rate > 0);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS currency_rate_action;
\ No newline at end of file
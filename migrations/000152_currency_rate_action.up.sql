@@ -0,0 +1,2 @@
+-- действие с курсом валюты: создание, изменение, удаление.
+CREATE TYPE currency_rate_action AS ENUM ('create', 'update', 'delete');
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_currency_rate_history_currency_code_id;
+
+DROP TABLE IF EXISTS currency_rate_history;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS currency_rate_history( -- История изменений курсов валют (аудит).
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    currency_code VARCHAR(10) NOT NULL, -- Код валюты (запись курса может быть удалена).
+    action currency_rate_action NOT NULL, -- Действие с курсом.
+    old_rate NUMERIC(20,6), -- Курс до изменения (NULL при создании).
+    new_rate NUMERIC(20,6), -- Курс после изменения (NULL при удалении).
+    changed_by TEXT, -- Telegram id администратора, изменившего курс.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW() -- Дата создания записи.
+);
+
+-- Быстрее история изменений курса валюты.
+CREATE INDEX IF NOT EXISTS idx_currency_rate_history_currency_code_id ON currency_rate_history (currency_code, id DESC);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TYPE IF EXISTS ledger_reconciliation_job_status;
+DROP TYPE IF EXISTS ledger_reconciliation_issue_type;
\ No newline at end of file
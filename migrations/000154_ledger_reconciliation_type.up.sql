@@ -0,0 +1,3 @@
+CREATE TYPE ledger_reconciliation_job_status AS ENUM ('pending', 'running', 'completed', 'failed');
+-- тип расхождения: баланс не равен сумме операций или нарушена цепочка balance_after.
+CREATE TYPE ledger_reconciliation_issue_type AS ENUM ('balance_drift', 'broken_chain');
\ No newline at end of file
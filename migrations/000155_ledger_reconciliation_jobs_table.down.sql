@@ -0,0 +1,10 @@
+DELETE FROM text_translations
+WHERE content_id IN (SELECT id FROM text_contents WHERE code = 'balance_transaction_ledger_correction');
+
+DELETE FROM text_contents WHERE code = 'balance_transaction_ledger_correction';
+
+DELETE FROM event_types WHERE name = 'ledger_correction';
+
+DROP INDEX IF EXISTS idx_ledger_reconciliation_jobs_status_unfinished;
+
+DROP TABLE IF EXISTS ledger_reconciliation_jobs;
\ No newline at end of file
@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS ledger_reconciliation_jobs( -- Задачи сверки user_balances с журналом balance_transactions.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    telegram_id TEXT, -- Telegram id пользователя (NULL = все пользователи).
+    dry_run BOOLEAN NOT NULL DEFAULT TRUE, -- Только найти расхождения, не записывая корректирующие операции.
+    status ledger_reconciliation_job_status NOT NULL DEFAULT 'pending', -- Статус задачи.
+    cursor_user_id BIGINT NOT NULL DEFAULT 0, -- users.id последнего обработанного пользователя (для продолжения с места остановки).
+    processed_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей обработано.
+    total_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей нужно обработать.
+    drift_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей с балансом, не равным сумме операций.
+    broken_chain_users BIGINT NOT NULL DEFAULT 0, -- Сколько пользователей с нарушенной цепочкой balance_after.
+    corrected_users BIGINT NOT NULL DEFAULT 0, -- Скольким пользователям записана корректирующая операция.
+    drift_amount NUMERIC(20, 2) NOT NULL DEFAULT 0, -- Сумма расхождений по модулю.
+    error TEXT, -- Текст ошибки (для status = 'failed').
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    finished_at TIMESTAMP WITH TIME ZONE, -- Дата завершения задачи.
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id)
+);
+
+-- Поиск незавершённых задач воркером.
+CREATE INDEX IF NOT EXISTS idx_ledger_reconciliation_jobs_status_unfinished ON ledger_reconciliation_jobs (id) WHERE status IN ('pending', 'running');
+
+-- событие, от имени которого записывается корректирующая операция по итогам сверки.
+INSERT INTO event_types(
+    name,
+    description,
+    notification_message,
+    is_send_notification
+) VALUES(
+    'ledger_correction',
+    'Событие по корректировке журнала операций по итогам сверки баланса',
+    'Корректировка баланса',
+    FALSE
+);
+
+INSERT INTO text_contents (code, page, description) VALUES
+('balance_transaction_ledger_correction', 'balance_transactions', 'Корректировка баланса')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO text_translations (content_id, lang, value) VALUES
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_ledger_correction'), 'ru', 'Корректировка баланса'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_ledger_correction'), 'en', 'Balance correction')
+ON CONFLICT (content_id, lang) DO NOTHING;
\ No newline at end of file
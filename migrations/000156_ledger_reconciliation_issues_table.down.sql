@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_ledger_reconciliation_issues_job_id_id;
+
+DROP TABLE IF EXISTS ledger_reconciliation_issues;
\ No newline at end of file
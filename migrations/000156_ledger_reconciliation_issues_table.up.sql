@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS ledger_reconciliation_issues( -- Расхождения, найденные задачами сверки.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    job_id BIGINT NOT NULL, -- Идентификатор задачи сверки.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    type ledger_reconciliation_issue_type NOT NULL, -- Тип расхождения.
+    expected_balance NUMERIC(20, 2) NOT NULL, -- Ожидаемое значение (сумма операций или balance_after по цепочке).
+    actual_balance NUMERIC(20, 2) NOT NULL, -- Фактическое значение (user_balances.balance или записанный balance_after).
+    difference NUMERIC(20, 2) NOT NULL, -- actual_balance - expected_balance.
+    transaction_id BIGINT, -- Первая операция с нарушенной цепочкой (для type = 'broken_chain').
+    broken_links BIGINT NOT NULL DEFAULT 0, -- Сколько операций нарушают цепочку (для type = 'broken_chain').
+    correction_transaction_id BIGINT, -- Корректирующая операция (для type = 'balance_drift', если задача не dry run).
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    FOREIGN KEY (job_id) REFERENCES ledger_reconciliation_jobs(id) ON DELETE CASCADE,
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (transaction_id) REFERENCES balance_transactions(id),
+    FOREIGN KEY (correction_transaction_id) REFERENCES balance_transactions(id)
+);
+
+-- Постраничный вывод расхождений задачи.
+CREATE INDEX IF NOT EXISTS idx_ledger_reconciliation_issues_job_id_id ON ledger_reconciliation_issues (job_id, id);
\ No newline at end of file
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS trg_balance_transactions_forbid_change ON balance_transactions;
+
+DROP FUNCTION IF EXISTS public.balance_transactions_forbid_change();
+
+ALTER TABLE user_balances
+    DROP CONSTRAINT IF EXISTS check_user_balances_balance_non_negative;
+
+ALTER TABLE balance_transactions
+    DROP CONSTRAINT IF EXISTS check_balance_transactions_balance_after,
+    DROP CONSTRAINT IF EXISTS check_balance_transactions_amount_positive;
\ No newline at end of file
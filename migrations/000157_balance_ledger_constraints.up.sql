@@ -0,0 +1,30 @@
+-- NOT VALID: существующие записи не проверяются (их находит сверка), новые записи — проверяются.
+ALTER TABLE balance_transactions
+    ADD CONSTRAINT check_balance_transactions_amount_positive CHECK (amount > 0) NOT VALID,
+    ADD CONSTRAINT check_balance_transactions_balance_after CHECK (balance_after IS NOT NULL AND balance_after >= 0) NOT VALID;
+
+ALTER TABLE user_balances
+    ADD CONSTRAINT check_user_balances_balance_non_negative CHECK (balance >= 0) NOT VALID;
+
+-- журнал операций только дополняется: ошибки исправляются корректирующей операцией, а не правкой истории.
+CREATE OR REPLACE FUNCTION public.balance_transactions_forbid_change() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND NEW.telegram_id IS NOT DISTINCT FROM OLD.telegram_id
+        AND NEW.amount IS NOT DISTINCT FROM OLD.amount
+        AND NEW.direction IS NOT DISTINCT FROM OLD.direction
+        AND NEW.balance_after IS NOT DISTINCT FROM OLD.balance_after THEN
+        RETURN NEW;
+    END IF;
+
+    RAISE EXCEPTION 'balance_transactions is append-only: % of transaction % is not allowed', TG_OP, OLD.id;
+END;
+$$;
+
+CREATE TRIGGER trg_balance_transactions_forbid_change
+    BEFORE UPDATE OR DELETE ON balance_transactions
+    FOR EACH ROW
+EXECUTE FUNCTION public.balance_transactions_forbid_change();
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.ledger_reconciliation_user(BIGINT, TEXT, BOOLEAN);
\ No newline at end of file
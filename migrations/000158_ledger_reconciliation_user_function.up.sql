@@ -0,0 +1,174 @@
+CREATE OR REPLACE FUNCTION public.ledger_reconciliation_user(
+    _job_id BIGINT,
+    _telegram_id TEXT,
+    _dry_run BOOLEAN
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _balance NUMERIC(20, 2);
+    _ledger_balance NUMERIC(20, 2);
+    _difference NUMERIC(20, 2);
+    _chain RECORD;
+    _event_type_id BIGINT;
+    _correction_transaction_id BIGINT;
+    _is_drift BOOLEAN := FALSE;
+    _is_broken_chain BOOLEAN := FALSE;
+BEGIN
+    IF _job_id IS NULL THEN
+        RAISE EXCEPTION 'job_id IS NULL';
+    END IF;
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+    IF _dry_run IS NULL THEN
+        RAISE EXCEPTION 'dry_run IS NULL';
+    END IF;
+
+    -- блокируем баланс так же, как add/reduce user balance, чтобы сверять его
+    -- с журналом без параллельных начислений и списаний.
+    SELECT balance
+    INTO _balance
+    FROM user_balances
+    WHERE telegram_id = _telegram_id
+    FOR UPDATE;
+
+    _balance := COALESCE(_balance, 0);
+
+    SELECT COALESCE(SUM(CASE WHEN direction = 'credit' THEN amount ELSE -amount END), 0)
+    INTO _ledger_balance
+    FROM balance_transactions
+    WHERE telegram_id = _telegram_id;
+
+    --
+    -- balance drift.
+    --
+    _difference := _balance - _ledger_balance;
+
+    IF _difference <> 0 THEN
+        _is_drift := TRUE;
+
+        -- корректирующая операция приводит журнал к фактическому балансу
+        -- (отрицательный баланс не исправляем — его нужно разбирать вручную).
+        IF NOT _dry_run AND _balance >= 0 THEN
+            SELECT id
+            INTO _event_type_id
+            FROM event_types
+            WHERE name = 'ledger_correction';
+
+            IF _event_type_id IS NULL THEN
+                RAISE EXCEPTION 'event type ledger_correction does not exist';
+            END IF;
+
+            INSERT INTO balance_transactions(
+                event_type_id,
+                telegram_id,
+                amount,
+                description,
+                balance_after,
+                source_type,
+                source_id,
+                direction
+            ) VALUES(
+                _event_type_id,
+                _telegram_id,
+                ABS(_difference),
+                FORMAT('Корректировка по итогам сверки #%s', _job_id),
+                _balance,
+                'ledger_correction',
+                _job_id,
+                CASE WHEN _difference > 0 THEN 'credit' ELSE 'debit' END::balance_transaction_direction
+            )
+            RETURNING id INTO _correction_transaction_id;
+        END IF;
+
+        INSERT INTO ledger_reconciliation_issues(
+            job_id,
+            telegram_id,
+            type,
+            expected_balance,
+            actual_balance,
+            difference,
+            correction_transaction_id
+        ) VALUES(
+            _job_id,
+            _telegram_id,
+            'balance_drift',
+            _ledger_balance,
+            _balance,
+            _difference,
+            _correction_transaction_id
+        );
+    END IF;
+
+    --
+    -- broken chain.
+    --
+    -- balance_after каждой операции должен быть равен balance_after предыдущей операции
+    -- плюс/минус сумма (для первой операции предыдущий баланс равен 0).
+    -- операции без balance_after (до появления аудита) пропускаются.
+    WITH ordered AS (
+        SELECT
+            bt.id,
+            bt.balance_after,
+            CASE WHEN bt.direction = 'credit' THEN bt.amount ELSE -bt.amount END AS signed_amount,
+            LAG(bt.balance_after) OVER (ORDER BY bt.id) AS prev_balance_after,
+            ROW_NUMBER() OVER (ORDER BY bt.id) AS rn
+        FROM balance_transactions bt
+        WHERE bt.telegram_id = _telegram_id
+        AND (_correction_transaction_id IS NULL OR bt.id <> _correction_transaction_id)
+    ),
+    broken AS (
+        SELECT
+            o.id,
+            CASE WHEN o.rn = 1 THEN o.signed_amount ELSE o.prev_balance_after + o.signed_amount END AS expected_balance,
+            o.balance_after AS actual_balance
+        FROM ordered o
+        WHERE o.balance_after IS NOT NULL
+        AND (o.rn = 1 OR o.prev_balance_after IS NOT NULL)
+        AND o.balance_after <> CASE WHEN o.rn = 1 THEN o.signed_amount ELSE o.prev_balance_after + o.signed_amount END
+    )
+    SELECT
+        b.id,
+        b.expected_balance,
+        b.actual_balance,
+        COUNT(*) OVER () AS broken_links
+    INTO _chain
+    FROM broken b
+    ORDER BY b.id
+    LIMIT 1;
+
+    IF _chain.id IS NOT NULL THEN
+        _is_broken_chain := TRUE;
+
+        INSERT INTO ledger_reconciliation_issues(
+            job_id,
+            telegram_id,
+            type,
+            expected_balance,
+            actual_balance,
+            difference,
+            transaction_id,
+            broken_links
+        ) VALUES(
+            _job_id,
+            _telegram_id,
+            'broken_chain',
+            _chain.expected_balance,
+            _chain.actual_balance,
+            _chain.actual_balance - _chain.expected_balance,
+            _chain.id,
+            _chain.broken_links
+        );
+    END IF;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'is_drift', _is_drift,
+        'is_broken_chain', _is_broken_chain,
+        'is_corrected', _correction_transaction_id IS NOT NULL,
+        'drift_amount', ABS(_difference)
+    );
+END;
+$$;
\ No newline at end of file
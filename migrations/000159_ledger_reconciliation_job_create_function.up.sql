@@ -0,0 +1,42 @@
+CREATE OR REPLACE FUNCTION public.ledger_reconciliation_job_create(
+    _src JSONB
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _telegram_id TEXT;
+    _total_users BIGINT;
+    _job ledger_reconciliation_jobs;
+BEGIN
+    IF _src IS NULL THEN
+        RAISE EXCEPTION 'src IS NULL';
+    END IF;
+
+    _telegram_id := NULLIF(_src->>'telegram_id', '');
+
+    -- для сверки одного пользователя обрабатывается ровно одна запись,
+    -- иначе — все пользователи.
+    IF _telegram_id IS NOT NULL THEN
+        _total_users := 1;
+    ELSE
+        SELECT COUNT(*)
+        INTO _total_users
+        FROM users;
+    END IF;
+
+    INSERT INTO ledger_reconciliation_jobs(
+        telegram_id,
+        dry_run,
+        total_users
+    ) VALUES(
+        _telegram_id,
+        COALESCE((_src->>'dry_run')::BOOLEAN, TRUE),
+        _total_users
+    )
+    RETURNING * INTO _job;
+
+    RETURN TO_JSONB(_job);
+END;
+$$;
\ No newline at end of file
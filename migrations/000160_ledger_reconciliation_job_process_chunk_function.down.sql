@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.ledger_reconciliation_job_process_chunk(BIGINT, INTEGER);
\ No newline at end of file
@@ -0,0 +1,107 @@
+CREATE OR REPLACE FUNCTION public.ledger_reconciliation_job_process_chunk(
+    _job_id BIGINT, -- NULL = самая старая незавершённая задача.
+    _chunk_size INTEGER
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _job ledger_reconciliation_jobs;
+    _user RECORD;
+    _result JSONB;
+    _last_user_id BIGINT;
+    _processed BIGINT := 0;
+    _drift_users BIGINT := 0;
+    _broken_chain_users BIGINT := 0;
+    _corrected_users BIGINT := 0;
+    _drift_amount NUMERIC(20, 2) := 0;
+    _has_more BOOLEAN;
+BEGIN
+    IF _chunk_size IS NULL OR _chunk_size <= 0 THEN
+        RAISE EXCEPTION 'chunk_size IS NULL OR <= 0';
+    END IF;
+
+    -- блокируем задачу, чтобы несколько экземпляров не обрабатывали её одновременно.
+    SELECT *
+    INTO _job
+    FROM ledger_reconciliation_jobs
+    WHERE (_job_id IS NULL OR id = _job_id)
+    AND status IN ('pending', 'running')
+    ORDER BY id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED;
+
+    IF NOT FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    _last_user_id := _job.cursor_user_id;
+
+    BEGIN
+        FOR _user IN
+            SELECT
+                u.id,
+                u.telegram_id
+            FROM users u
+            WHERE u.id > _job.cursor_user_id
+            AND (_job.telegram_id IS NULL OR u.telegram_id = _job.telegram_id)
+            ORDER BY u.id
+            LIMIT _chunk_size
+        LOOP
+            _result := public.ledger_reconciliation_user(
+                _job.id,
+                _user.telegram_id,
+                _job.dry_run
+            );
+
+            IF (_result->>'is_drift')::BOOLEAN THEN
+                _drift_users := _drift_users + 1;
+                _drift_amount := _drift_amount + (_result->>'drift_amount')::NUMERIC;
+            END IF;
+            IF (_result->>'is_broken_chain')::BOOLEAN THEN
+                _broken_chain_users := _broken_chain_users + 1;
+            END IF;
+            IF (_result->>'is_corrected')::BOOLEAN THEN
+                _corrected_users := _corrected_users + 1;
+            END IF;
+
+            _processed := _processed + 1;
+            _last_user_id := _user.id;
+        END LOOP;
+
+        SELECT EXISTS(
+            SELECT 1
+            FROM users u
+            WHERE u.id > _last_user_id
+            AND (_job.telegram_id IS NULL OR u.telegram_id = _job.telegram_id)
+        ) INTO _has_more;
+
+        UPDATE ledger_reconciliation_jobs SET
+            status = CASE WHEN _has_more THEN 'running' ELSE 'completed' END::ledger_reconciliation_job_status,
+            cursor_user_id = _last_user_id,
+            processed_users = processed_users + _processed,
+            total_users = GREATEST(total_users, processed_users + _processed),
+            drift_users = drift_users + _drift_users,
+            broken_chain_users = broken_chain_users + _broken_chain_users,
+            corrected_users = corrected_users + _corrected_users,
+            drift_amount = drift_amount + _drift_amount,
+            updated_at = NOW(),
+            finished_at = CASE WHEN _has_more THEN NULL ELSE NOW() END
+        WHERE id = _job.id
+        RETURNING * INTO _job;
+    EXCEPTION
+        WHEN OTHERS THEN
+            -- изменения текущей пачки откатываются, задача помечается как упавшая.
+            UPDATE ledger_reconciliation_jobs SET
+                status = 'failed',
+                error = SQLERRM,
+                updated_at = NOW(),
+                finished_at = NOW()
+            WHERE id = _job.id
+            RETURNING * INTO _job;
+    END;
+
+    RETURN TO_JSONB(_job);
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE OR REPLACE FUNCTION public.ledger_reconciliation_job_get(
+    _id BIGINT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _job ledger_reconciliation_jobs;
+BEGIN
+    IF _id IS NULL THEN
+        RAISE EXCEPTION 'id IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _job
+    FROM ledger_reconciliation_jobs
+    WHERE id = _id;
+
+    IF NOT FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    RETURN TO_JSONB(_job);
+END;
+$$;
\ No newline at end of file
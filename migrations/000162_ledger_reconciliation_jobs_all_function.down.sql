@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.ledger_reconciliation_jobs_all(INTEGER);
\ No newline at end of file
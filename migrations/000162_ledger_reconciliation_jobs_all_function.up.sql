@@ -0,0 +1,23 @@
+CREATE OR REPLACE FUNCTION public.ledger_reconciliation_jobs_all(
+    _limit INTEGER
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    IF _limit IS NULL OR _limit <= 0 THEN
+        RAISE EXCEPTION 'limit IS NULL OR <= 0';
+    END IF;
+
+    RETURN COALESCE((
+        SELECT JSONB_AGG(TO_JSONB(j) ORDER BY j.id DESC)
+        FROM (
+            SELECT *
+            FROM ledger_reconciliation_jobs
+            ORDER BY id DESC
+            LIMIT _limit
+        ) j
+    ), '[]'::JSONB);
+END;
+$$;
\ No newline at end of file
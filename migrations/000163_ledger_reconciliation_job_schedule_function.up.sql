@@ -0,0 +1,35 @@
+CREATE OR REPLACE FUNCTION public.ledger_reconciliation_job_schedule(
+    _interval_minutes INTEGER
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    IF _interval_minutes IS NULL OR _interval_minutes <= 0 THEN
+        RAISE EXCEPTION 'interval_minutes IS NULL OR <= 0';
+    END IF;
+
+    -- несколько экземпляров приложения не должны создать задачу одновременно.
+    PERFORM PG_ADVISORY_XACT_LOCK(HASHTEXT('ledger_reconciliation_job_schedule'));
+
+    -- плановая сверка всех пользователей создаётся, только если за интервал не было ни одной
+    -- сверки всех пользователей и нет незавершённой.
+    IF EXISTS(
+        SELECT 1
+        FROM ledger_reconciliation_jobs
+        WHERE telegram_id IS NULL
+        AND (
+            status IN ('pending', 'running')
+            OR created_at > NOW() - MAKE_INTERVAL(mins => _interval_minutes)
+        )
+    ) THEN
+        RETURN NULL;
+    END IF;
+
+    -- плановая сверка только находит расхождения, исправляет их администратор.
+    RETURN public.ledger_reconciliation_job_create(
+        JSONB_BUILD_OBJECT('dry_run', TRUE)
+    );
+END;
+$$;
\ No newline at end of file
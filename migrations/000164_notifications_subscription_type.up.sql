@@ -0,0 +1,2 @@
+-- тип уведомления о подписке (напоминание об окончании, окончание подписки).
+ALTER TYPE notifications_type ADD VALUE IF NOT EXISTS 'subscription';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS subscription_history_action;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- действие в истории подписки: оформление по тарифу, продление на дни (награды), окончание.
+CREATE TYPE subscription_history_action AS ENUM ('subscribe', 'extend', 'expire');
\ No newline at end of file
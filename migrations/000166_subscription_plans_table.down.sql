@@ -0,0 +1 @@
+DROP TABLE IF EXISTS subscription_plans;
\ No newline at end of file
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS subscription_plans( -- Тарифы подписки.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    code TEXT NOT NULL UNIQUE, -- Код тарифа (trial, monthly, yearly).
+    name TEXT NOT NULL, -- Название тарифа.
+    duration_days INTEGER NOT NULL CHECK (duration_days > 0), -- Длительность подписки в днях.
+    grace_period_days INTEGER NOT NULL DEFAULT 0 CHECK (grace_period_days >= 0), -- Сколько дней доступ сохраняется после окончания подписки.
+    is_trial BOOLEAN NOT NULL DEFAULT FALSE, -- Пробный тариф (оформляется один раз).
+    is_active BOOLEAN NOT NULL DEFAULT TRUE, -- Доступен ли тариф для оформления.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW() -- Дата обновления записи.
+);
+
+INSERT INTO subscription_plans(
+    code,
+    name,
+    duration_days,
+    grace_period_days,
+    is_trial
+) VALUES
+('trial', 'Пробный период', 7, 0, TRUE),
+('monthly', 'Подписка на месяц', 30, 3, FALSE),
+('yearly', 'Подписка на год', 365, 7, FALSE)
+ON CONFLICT (code) DO NOTHING;
\ No newline at end of file
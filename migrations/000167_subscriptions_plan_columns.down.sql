@@ -0,0 +1,11 @@
+ALTER TABLE subscription_history
+    DROP COLUMN IF EXISTS plan_id,
+    DROP COLUMN IF EXISTS action;
+
+DROP INDEX IF EXISTS idx_subscriptions_active_expires_at;
+
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS trial_used_at,
+    DROP COLUMN IF EXISTS reminder_sent_at,
+    DROP COLUMN IF EXISTS grace_expires_at,
+    DROP COLUMN IF EXISTS plan_id;
\ No newline at end of file
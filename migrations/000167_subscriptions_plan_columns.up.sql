@@ -0,0 +1,12 @@
+ALTER TABLE subscriptions
+    ADD COLUMN IF NOT EXISTS plan_id BIGINT REFERENCES subscription_plans(id), -- Тариф последнего оформления подписки.
+    ADD COLUMN IF NOT EXISTS grace_expires_at TIMESTAMP, -- Дата окончания льготного периода (expires_at + grace_period_days тарифа).
+    ADD COLUMN IF NOT EXISTS reminder_sent_at TIMESTAMP, -- Дата отправки напоминания об окончании (сбрасывается при продлении).
+    ADD COLUMN IF NOT EXISTS trial_used_at TIMESTAMP; -- Дата оформления пробного периода.
+
+-- Поиск подписок, которые пора напомнить или завершить.
+CREATE INDEX IF NOT EXISTS idx_subscriptions_active_expires_at ON subscriptions (expires_at) WHERE is_active;
+
+ALTER TABLE subscription_history
+    ADD COLUMN IF NOT EXISTS action subscription_history_action NOT NULL DEFAULT 'extend', -- Действие.
+    ADD COLUMN IF NOT EXISTS plan_id BIGINT REFERENCES subscription_plans(id); -- Тариф (для action = 'subscribe').
\ No newline at end of file
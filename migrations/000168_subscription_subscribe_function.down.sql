@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.subscription_subscribe(TEXT, TEXT);
\ No newline at end of file
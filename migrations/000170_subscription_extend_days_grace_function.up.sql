@@ -0,0 +1,70 @@
+CREATE OR REPLACE FUNCTION public.subscription_extend_days(
+    _telegram_id TEXT,
+    _days INTEGER
+) RETURNS subscriptions
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _s subscriptions; -- subscription.
+    _now TIMESTAMP; -- action time.
+    _exp TIMESTAMP; -- expires_at.
+    _grace_period_days INTEGER; -- льготный период тарифа подписки.
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+    IF _days IS NULL OR _days <= 0 THEN
+        RAISE EXCEPTION 'days IS NULL OR <= 0';
+    END IF;
+
+    _now = NOW();
+
+    SELECT *
+    INTO _s
+    FROM subscriptions
+    WHERE telegram_id = _telegram_id
+    FOR UPDATE;
+
+    -- активная подписка продлевается от даты окончания, иначе начинается сейчас.
+    IF _s.is_active AND _s.expires_at > _now THEN
+        _exp = _s.expires_at + MAKE_INTERVAL(days => _days);
+    ELSE
+        _exp = _now + MAKE_INTERVAL(days => _days);
+    END IF;
+
+    -- льготный период берётся из тарифа последнего оформления (без тарифа его нет).
+    SELECT COALESCE((
+        SELECT grace_period_days
+        FROM subscription_plans
+        WHERE id = _s.plan_id
+    ), 0)
+    INTO _grace_period_days;
+
+    UPDATE subscriptions SET
+        subscribed_at = CASE WHEN _s.is_active AND _s.expires_at > _now THEN subscribed_at ELSE _now END,
+        expires_at = _exp,
+        grace_expires_at = _exp + MAKE_INTERVAL(days => _grace_period_days),
+        reminder_sent_at = NULL,
+        is_active = TRUE,
+        updated_at = NOW()
+    WHERE telegram_id = _telegram_id
+    RETURNING * INTO _s;
+
+    -- create subscription history.
+    INSERT INTO subscription_history(
+        telegram_id,
+        action_time,
+        expires_at,
+        action
+    ) VALUES(
+        _telegram_id,
+        _now,
+        _exp,
+        'extend'
+    );
+
+    RETURN _s;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- подписка действует до окончания льготного периода, выключает её cron окончания подписок
+-- (он же записывает историю), поэтому проверка ничего не изменяет.
+CREATE OR REPLACE FUNCTION public.subscription_exists(_telegram_id TEXT) RETURNS BOOLEAN
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _s subscriptions;
+BEGIN
+    SELECT *
+    FROM subscriptions
+    WHERE telegram_id = _telegram_id
+    INTO _s;
+
+    RETURN COALESCE(_s.is_active AND NOW() < COALESCE(_s.grace_expires_at, _s.expires_at), FALSE);
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.subscriptions_expire(INTEGER);
\ No newline at end of file
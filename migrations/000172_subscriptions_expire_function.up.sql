@@ -0,0 +1,64 @@
+CREATE OR REPLACE FUNCTION public.subscriptions_expire(
+    _limit INTEGER
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _result JSONB;
+BEGIN
+    IF _limit IS NULL OR _limit <= 0 THEN
+        RAISE EXCEPTION 'limit IS NULL OR <= 0';
+    END IF;
+
+    -- подписки, у которых закончился льготный период (без него — сама подписка).
+    -- SKIP LOCKED: несколько экземпляров приложения обрабатывают разные подписки.
+    WITH expired AS (
+        SELECT
+            s.id
+        FROM subscriptions s
+        WHERE s.is_active
+        AND COALESCE(s.grace_expires_at, s.expires_at) <= NOW()
+        ORDER BY s.expires_at, s.id
+        LIMIT _limit
+        FOR UPDATE SKIP LOCKED
+    ),
+    updated AS (
+        UPDATE subscriptions s SET
+            is_active = FALSE,
+            updated_at = NOW()
+        FROM expired e
+        WHERE s.id = e.id
+        RETURNING
+            s.telegram_id,
+            s.plan_id,
+            s.expires_at
+    ),
+    history AS (
+        INSERT INTO subscription_history(
+            telegram_id,
+            action_time,
+            expires_at,
+            action,
+            plan_id
+        )
+        SELECT
+            u.telegram_id,
+            NOW(),
+            u.expires_at,
+            'expire',
+            u.plan_id
+        FROM updated u
+    )
+    SELECT COALESCE(JSONB_AGG(JSONB_BUILD_OBJECT(
+        'telegram_id', u.telegram_id,
+        'plan_id', u.plan_id,
+        'expires_at', u.expires_at::TIMESTAMPTZ -- время без пояса в JSON не разбирается как time.Time.
+    ) ORDER BY u.expires_at), '[]'::JSONB)
+    INTO _result
+    FROM updated u;
+
+    RETURN _result;
+END;
+$$;
\ No newline at end of file
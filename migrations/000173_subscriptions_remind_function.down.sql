@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.subscriptions_remind(INTEGER, INTEGER);
\ No newline at end of file
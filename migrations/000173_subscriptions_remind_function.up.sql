@@ -0,0 +1,54 @@
+CREATE OR REPLACE FUNCTION public.subscriptions_remind(
+    _remind_before_hours INTEGER,
+    _limit INTEGER
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _result JSONB;
+BEGIN
+    IF _remind_before_hours IS NULL OR _remind_before_hours <= 0 THEN
+        RAISE EXCEPTION 'remind_before_hours IS NULL OR <= 0';
+    END IF;
+    IF _limit IS NULL OR _limit <= 0 THEN
+        RAISE EXCEPTION 'limit IS NULL OR <= 0';
+    END IF;
+
+    -- напоминание отправляется один раз за период подписки:
+    -- при продлении reminder_sent_at сбрасывается.
+    WITH due AS (
+        SELECT
+            s.id
+        FROM subscriptions s
+        WHERE s.is_active
+        AND s.reminder_sent_at IS NULL
+        AND s.expires_at > NOW()
+        AND s.expires_at <= NOW() + MAKE_INTERVAL(hours => _remind_before_hours)
+        ORDER BY s.expires_at, s.id
+        LIMIT _limit
+        FOR UPDATE SKIP LOCKED
+    ),
+    updated AS (
+        UPDATE subscriptions s SET
+            reminder_sent_at = NOW(),
+            updated_at = NOW()
+        FROM due d
+        WHERE s.id = d.id
+        RETURNING
+            s.telegram_id,
+            s.plan_id,
+            s.expires_at
+    )
+    SELECT COALESCE(JSONB_AGG(JSONB_BUILD_OBJECT(
+        'telegram_id', u.telegram_id,
+        'plan_id', u.plan_id,
+        'expires_at', u.expires_at::TIMESTAMPTZ -- время без пояса в JSON не разбирается как time.Time.
+    ) ORDER BY u.expires_at), '[]'::JSONB)
+    INTO _result
+    FROM updated u;
+
+    RETURN _result;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS payment_status;
\ No newline at end of file
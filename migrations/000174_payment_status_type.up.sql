@@ -0,0 +1,2 @@
+-- статус платежа: создан счёт, оплачен и выдан, оплачен но не выдан (требует возврата), возвращён.
+CREATE TYPE payment_status AS ENUM ('pending', 'paid', 'failed', 'refunded');
\ No newline at end of file
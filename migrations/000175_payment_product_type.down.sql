@@ -0,0 +1 @@
+DROP TYPE IF EXISTS payment_product_type;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- что покупается за Telegram Stars: тариф подписки или товар магазина.
+CREATE TYPE payment_product_type AS ENUM ('subscription_plan', 'shop_item');
\ No newline at end of file
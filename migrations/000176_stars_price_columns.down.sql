@@ -0,0 +1,5 @@
+ALTER TABLE shop_items
+    DROP COLUMN IF EXISTS price_stars;
+
+ALTER TABLE subscription_plans
+    DROP COLUMN IF EXISTS price_stars;
\ No newline at end of file
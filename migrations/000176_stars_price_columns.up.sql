@@ -0,0 +1,19 @@
+ALTER TABLE subscription_plans
+    ADD COLUMN IF NOT EXISTS price_stars BIGINT CHECK (price_stars IS NULL OR price_stars > 0); -- Цена тарифа в Telegram Stars (NULL - не продаётся).
+
+ALTER TABLE shop_items
+    ADD COLUMN IF NOT EXISTS price_stars BIGINT CHECK (price_stars IS NULL OR price_stars > 0); -- Цена одной единицы товара в Telegram Stars (NULL - не продаётся за Stars).
+
+UPDATE subscription_plans SET
+    price_stars = CASE code
+        WHEN 'monthly' THEN 250
+        WHEN 'yearly' THEN 2000
+    END,
+    updated_at = NOW()
+WHERE code IN ('monthly', 'yearly');
+
+UPDATE shop_items SET
+    price_stars = 50,
+    updated_at = NOW()
+WHERE type = 'premium_days'
+AND value = 7;
\ No newline at end of file
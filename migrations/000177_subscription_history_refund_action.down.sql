@@ -0,0 +1,17 @@
+-- значение перечисления нельзя удалить, поэтому пересоздаём тип без 'refund'.
+DELETE FROM subscription_history WHERE action::TEXT = 'refund';
+
+ALTER TABLE subscription_history
+    ALTER COLUMN action DROP DEFAULT;
+
+ALTER TYPE subscription_history_action RENAME TO subscription_history_action_old;
+
+CREATE TYPE subscription_history_action AS ENUM ('subscribe', 'extend', 'expire');
+
+ALTER TABLE subscription_history
+    ALTER COLUMN action TYPE subscription_history_action USING action::TEXT::subscription_history_action;
+
+ALTER TABLE subscription_history
+    ALTER COLUMN action SET DEFAULT 'extend';
+
+DROP TYPE IF EXISTS subscription_history_action_old;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- действие в истории подписки: возврат платежа за подписку.
+ALTER TYPE subscription_history_action ADD VALUE IF NOT EXISTS 'refund';
\ No newline at end of file
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS payments( -- Платежи в Telegram Stars за подписку и товары магазина.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    product_type payment_product_type NOT NULL, -- Что покупается.
+    subscription_plan_id BIGINT, -- Тариф подписки (для product_type = 'subscription_plan').
+    shop_item_id BIGINT, -- Товар магазина (для product_type = 'shop_item').
+    quantity BIGINT NOT NULL DEFAULT 1 CHECK (quantity > 0), -- Количество единиц товара.
+    title TEXT NOT NULL, -- Название в счёте.
+    description TEXT NOT NULL, -- Описание в счёте.
+    amount BIGINT NOT NULL CHECK (amount > 0), -- Сумма в Telegram Stars.
+    currency TEXT NOT NULL DEFAULT 'XTR', -- Валюта (Telegram Stars).
+    status payment_status NOT NULL DEFAULT 'pending', -- Статус платежа.
+    invoice_payload TEXT NOT NULL UNIQUE, -- Payload счёта, по нему Telegram присылает pre_checkout_query и successful_payment.
+    telegram_payment_charge_id TEXT UNIQUE, -- Идентификатор платежа в Telegram (нужен для возврата).
+    provider_payment_charge_id TEXT, -- Идентификатор платежа у провайдера.
+    shop_purchase_id BIGINT, -- Покупка, созданная при выдаче товара магазина.
+    error TEXT, -- Причина, по которой оплаченный товар не выдан (status = 'failed').
+    paid_at TIMESTAMP WITH TIME ZONE, -- Дата оплаты.
+    refunded_at TIMESTAMP WITH TIME ZONE, -- Дата возврата.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    CHECK ((product_type = 'subscription_plan') = (subscription_plan_id IS NOT NULL)),
+    CHECK ((product_type = 'shop_item') = (shop_item_id IS NOT NULL)),
+    CHECK (status = 'pending' OR telegram_payment_charge_id IS NOT NULL),
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (subscription_plan_id) REFERENCES subscription_plans(id),
+    FOREIGN KEY (shop_item_id) REFERENCES shop_items(id),
+    FOREIGN KEY (shop_purchase_id) REFERENCES shop_purchases(id)
+);
+
+-- Быстрее платежи пользователя.
+CREATE INDEX IF NOT EXISTS idx_payments_telegram_id_created_at ON payments (telegram_id, created_at DESC);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.payment_create(TEXT, TEXT, TEXT, BIGINT, BIGINT, TEXT);
\ No newline at end of file
@@ -0,0 +1,129 @@
+-- создание платежа (счёта в Telegram Stars): проверяет, что тариф или товар продаётся за Stars, и фиксирует цену.
+-- пробный тариф не продаётся, остаток и лимит товара на пользователя проверяются ещё раз при выдаче.
+-- status: created, not_available, out_of_stock, limit_reached.
+CREATE OR REPLACE FUNCTION public.payment_create(
+    _telegram_id TEXT,
+    _product_type TEXT,
+    _plan_code TEXT,
+    _shop_item_id BIGINT,
+    _quantity BIGINT,
+    _invoice_payload TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _plan subscription_plans;
+    _item shop_items;
+    _payment payments;
+    _purchased BIGINT;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    IF _invoice_payload IS NULL OR _invoice_payload = '' THEN
+        RAISE EXCEPTION 'invoice_payload IS NULL';
+    END IF;
+
+    IF _product_type = 'subscription_plan' THEN
+        SELECT *
+        INTO _plan
+        FROM subscription_plans
+        WHERE code = _plan_code;
+
+        IF NOT FOUND
+            OR NOT _plan.is_active
+            OR _plan.is_trial
+            OR _plan.price_stars IS NULL
+        THEN
+            RETURN JSONB_BUILD_OBJECT('status', 'not_available');
+        END IF;
+
+        INSERT INTO payments(
+            telegram_id,
+            product_type,
+            subscription_plan_id,
+            quantity,
+            title,
+            description,
+            amount,
+            invoice_payload
+        ) VALUES(
+            _telegram_id,
+            'subscription_plan',
+            _plan.id,
+            1,
+            _plan.name,
+            'Премиум-подписка на ' || _plan.duration_days || ' дн.',
+            _plan.price_stars,
+            _invoice_payload
+        )
+        RETURNING * INTO _payment;
+    ELSIF _product_type = 'shop_item' THEN
+        IF _quantity IS NULL OR _quantity <= 0 THEN
+            RAISE EXCEPTION 'quantity must be positive';
+        END IF;
+
+        SELECT *
+        INTO _item
+        FROM shop_items
+        WHERE id = _shop_item_id;
+
+        IF NOT FOUND
+            OR NOT _item.is_active
+            OR _item.price_stars IS NULL
+            OR (_item.available_from IS NOT NULL AND _item.available_from > NOW())
+            OR (_item.available_until IS NOT NULL AND _item.available_until <= NOW())
+        THEN
+            RETURN JSONB_BUILD_OBJECT('status', 'not_available');
+        END IF;
+
+        IF _item.stock IS NOT NULL AND _item.stock < _quantity THEN
+            RETURN JSONB_BUILD_OBJECT('status', 'out_of_stock');
+        END IF;
+
+        IF _item.per_user_limit IS NOT NULL THEN
+            SELECT
+                COALESCE(SUM(quantity), 0)
+            INTO _purchased
+            FROM shop_purchases
+            WHERE telegram_id = _telegram_id
+            AND shop_item_id = _shop_item_id;
+
+            IF _purchased + _quantity > _item.per_user_limit THEN
+                RETURN JSONB_BUILD_OBJECT('status', 'limit_reached');
+            END IF;
+        END IF;
+
+        INSERT INTO payments(
+            telegram_id,
+            product_type,
+            shop_item_id,
+            quantity,
+            title,
+            description,
+            amount,
+            invoice_payload
+        ) VALUES(
+            _telegram_id,
+            'shop_item',
+            _item.id,
+            _quantity,
+            _item.name,
+            COALESCE(_item.description, _item.name),
+            _item.price_stars * _quantity,
+            _invoice_payload
+        )
+        RETURNING * INTO _payment;
+    ELSE
+        RAISE EXCEPTION 'unknown product_type %', _product_type;
+    END IF;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', 'created',
+        'payment', TO_JSONB(_payment)
+    );
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.payment_fulfil(TEXT, TEXT, TEXT, TEXT, BIGINT);
\ No newline at end of file
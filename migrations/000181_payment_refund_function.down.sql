@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.payment_refund(BIGINT);
\ No newline at end of file
@@ -0,0 +1,86 @@
+-- возврат платежа: отзывает выданное и помечает платёж возвращённым.
+-- подписка сокращается на длительность тарифа (но не раньше текущего момента), закончившуюся отключит cron.
+-- неиспользованные единицы товара списываются из инвентаря.
+-- Stars возвращает сервис через Bot API в той же транзакции, при ошибке изменения откатываются.
+CREATE OR REPLACE FUNCTION public.payment_refund(
+    _payment_id BIGINT
+) RETURNS payments
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _payment payments;
+    _plan subscription_plans;
+    _now TIMESTAMP; -- action time.
+    _exp TIMESTAMP; -- expires_at.
+BEGIN
+    IF _payment_id IS NULL THEN
+        RAISE EXCEPTION 'payment_id IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _payment
+    FROM payments
+    WHERE id = _payment_id
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'payment % does not exist', _payment_id;
+    END IF;
+
+    IF _payment.status NOT IN ('paid', 'failed') THEN
+        RAISE EXCEPTION 'payment % with status % can not be refunded', _payment_id, _payment.status;
+    END IF;
+
+    -- у платежа со статусом failed выданного нет.
+    IF _payment.status = 'paid' THEN
+        IF _payment.product_type = 'subscription_plan' THEN
+            SELECT *
+            INTO _plan
+            FROM subscription_plans
+            WHERE id = _payment.subscription_plan_id;
+
+            _now = NOW();
+
+            UPDATE subscriptions SET
+                expires_at = GREATEST(_now, expires_at - MAKE_INTERVAL(days => _plan.duration_days)),
+                grace_expires_at = GREATEST(_now, grace_expires_at - MAKE_INTERVAL(days => _plan.duration_days)),
+                updated_at = NOW()
+            WHERE telegram_id = _payment.telegram_id
+            RETURNING expires_at INTO _exp;
+
+            -- create subscription history.
+            INSERT INTO subscription_history(
+                telegram_id,
+                action_time,
+                expires_at,
+                action,
+                plan_id
+            ) VALUES(
+                _payment.telegram_id,
+                _now,
+                _exp,
+                'refund',
+                _plan.id
+            );
+        ELSIF _payment.shop_purchase_id IS NOT NULL THEN
+            UPDATE user_inventory_items SET
+                consumed_quantity = quantity,
+                consumed_at = NOW(),
+                updated_at = NOW()
+            WHERE shop_purchase_id = _payment.shop_purchase_id
+            AND consumed_quantity < quantity;
+        END IF;
+    END IF;
+
+    UPDATE payments SET
+        status = 'refunded',
+        refunded_at = NOW(),
+        updated_at = NOW()
+    WHERE id = _payment.id
+    RETURNING * INTO _payment;
+
+    RETURN _payment;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS subscription_plan_entitlements;
\ No newline at end of file
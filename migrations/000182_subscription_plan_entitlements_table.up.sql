@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS subscription_plan_entitlements( -- Возможности, которые даёт тариф подписки.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    plan_id BIGINT NOT NULL, -- Тариф подписки.
+    code TEXT NOT NULL, -- Код возможности (premium_content, streak_repair).
+    limit_value BIGINT CHECK (limit_value IS NULL OR limit_value > 0), -- Ограничение использования (NULL - без ограничения).
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    UNIQUE (plan_id, code),
+    FOREIGN KEY (plan_id) REFERENCES subscription_plans(id) ON DELETE CASCADE
+);
+
+-- пробный период даёт только премиум-контент, платные тарифы ещё и восстановление streak.
+INSERT INTO subscription_plan_entitlements(
+    plan_id,
+    code
+)
+SELECT p.id, e.code
+FROM subscription_plans p
+JOIN (
+    VALUES
+    ('trial', 'premium_content'),
+    ('monthly', 'premium_content'),
+    ('monthly', 'streak_repair'),
+    ('yearly', 'premium_content'),
+    ('yearly', 'streak_repair')
+) AS e(plan_code, code) ON e.plan_code = p.code
+ON CONFLICT (plan_id, code) DO NOTHING;
\ No newline at end of file
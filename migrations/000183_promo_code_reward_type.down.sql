@@ -0,0 +1 @@
+DROP TYPE IF EXISTS promo_code_reward_type;
\ No newline at end of file
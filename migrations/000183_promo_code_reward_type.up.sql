@@ -0,0 +1,2 @@
+-- награда за промокод: внутренняя валюта, дни подписки или товар магазина.
+CREATE TYPE promo_code_reward_type AS ENUM ('internal_currency', 'subscription_days', 'shop_item');
\ No newline at end of file
@@ -0,0 +1,16 @@
+DELETE FROM text_translations
+WHERE content_id IN (SELECT id FROM text_contents WHERE code = 'balance_transaction_promo_code_reward');
+
+DELETE FROM text_contents WHERE code = 'balance_transaction_promo_code_reward';
+
+DELETE FROM event_types WHERE name = 'promo_code_reward';
+
+DROP INDEX IF EXISTS idx_promo_code_redemptions_batch_id_telegram_id;
+
+DROP TABLE IF EXISTS promo_code_redemptions;
+
+DROP INDEX IF EXISTS idx_promo_codes_batch_id;
+
+DROP TABLE IF EXISTS promo_codes;
+
+DROP TABLE IF EXISTS promo_code_batches;
\ No newline at end of file
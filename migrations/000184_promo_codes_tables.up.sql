@@ -0,0 +1,76 @@
+CREATE TABLE IF NOT EXISTS promo_code_batches( -- Партии промокодов: награда и правила активации общие для всех кодов партии.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    name TEXT NOT NULL, -- Название партии (для отчёта).
+    reward_type promo_code_reward_type NOT NULL, -- Тип награды.
+    amount NUMERIC(20, 2) CHECK (amount IS NULL OR amount > 0), -- Сумма внутренней валюты (для reward_type = 'internal_currency').
+    subscription_days INTEGER CHECK (subscription_days IS NULL OR subscription_days > 0), -- Дни подписки (для reward_type = 'subscription_days').
+    shop_item_id BIGINT, -- Товар магазина (для reward_type = 'shop_item').
+    quantity BIGINT CHECK (quantity IS NULL OR quantity > 0), -- Количество единиц товара (для reward_type = 'shop_item').
+    max_redemptions BIGINT CHECK (max_redemptions IS NULL OR max_redemptions > 0), -- Сколько раз можно активировать каждый код партии (NULL - без ограничения).
+    per_user_limit BIGINT NOT NULL DEFAULT 1 CHECK (per_user_limit > 0), -- Сколько раз один пользователь может активировать коды партии.
+    expires_at TIMESTAMP WITH TIME ZONE, -- До какого момента коды можно активировать (NULL - без ограничения).
+    is_active BOOLEAN NOT NULL DEFAULT TRUE, -- Можно ли активировать коды партии.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    CHECK ((reward_type = 'internal_currency') = (amount IS NOT NULL)),
+    CHECK ((reward_type = 'subscription_days') = (subscription_days IS NOT NULL)),
+    CHECK ((reward_type = 'shop_item') = (shop_item_id IS NOT NULL AND quantity IS NOT NULL)),
+    FOREIGN KEY (shop_item_id) REFERENCES shop_items(id)
+);
+
+CREATE TABLE IF NOT EXISTS promo_codes( -- Промокоды.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    batch_id BIGINT NOT NULL, -- Партия промокода.
+    code TEXT NOT NULL UNIQUE CHECK (code = UPPER(code)), -- Код (хранится в верхнем регистре).
+    redemptions_count BIGINT NOT NULL DEFAULT 0 CHECK (redemptions_count >= 0), -- Сколько раз код активирован.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    FOREIGN KEY (batch_id) REFERENCES promo_code_batches(id) ON DELETE CASCADE
+);
+
+-- Коды партии (отчёт).
+CREATE INDEX IF NOT EXISTS idx_promo_codes_batch_id ON promo_codes (batch_id);
+
+CREATE TABLE IF NOT EXISTS promo_code_redemptions( -- Активации промокодов пользователями.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    promo_code_id BIGINT NOT NULL, -- Промокод.
+    batch_id BIGINT NOT NULL, -- Партия промокода (лимит на пользователя считается по партии).
+    telegram_id TEXT NOT NULL, -- Telegram id пользователя.
+    reward_type promo_code_reward_type NOT NULL, -- Тип выданной награды.
+    amount NUMERIC(20, 2), -- Выданная сумма внутренней валюты.
+    subscription_days INTEGER, -- Выданные дни подписки.
+    shop_item_id BIGINT, -- Выданный товар магазина.
+    quantity BIGINT, -- Количество выданных единиц товара.
+    shop_purchase_id BIGINT, -- Покупка, созданная при выдаче товара магазина.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата активации.
+    FOREIGN KEY (promo_code_id) REFERENCES promo_codes(id) ON DELETE CASCADE,
+    FOREIGN KEY (batch_id) REFERENCES promo_code_batches(id) ON DELETE CASCADE,
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (shop_item_id) REFERENCES shop_items(id),
+    FOREIGN KEY (shop_purchase_id) REFERENCES shop_purchases(id)
+);
+
+-- Подсчёт активаций партии пользователем (лимит на пользователя).
+CREATE INDEX IF NOT EXISTS idx_promo_code_redemptions_batch_id_telegram_id ON promo_code_redemptions (batch_id, telegram_id);
+
+-- событие, от имени которого начисляется внутренняя валюта за промокод.
+INSERT INTO event_types(
+    name,
+    description,
+    notification_message,
+    is_send_notification
+) VALUES(
+    'promo_code_reward',
+    'Событие по начислению награды за активацию промокода',
+    'Награда за промокод',
+    FALSE
+);
+
+INSERT INTO text_contents (code, page, description) VALUES
+('balance_transaction_promo_code_reward', 'balance_transactions', 'Награда за промокод')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO text_translations (content_id, lang, value) VALUES
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_promo_code_reward'), 'ru', 'Награда за промокод'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_promo_code_reward'), 'en', 'Promo code reward')
+ON CONFLICT (content_id, lang) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.promo_code_redeem(TEXT, TEXT);
\ No newline at end of file
@@ -0,0 +1,120 @@
+-- активация промокода: блокирует код, проверяет партию (активность, срок, лимит активаций кода
+-- и лимит на пользователя), выдаёт дни подписки и товар магазина и записывает активацию.
+-- внутренняя валюта начисляется сервисом в той же транзакции (только для status = 'redeemed').
+-- status: redeemed, not_found, inactive, expired, exhausted, limit_reached, reward_unavailable.
+CREATE OR REPLACE FUNCTION public.promo_code_redeem(
+    _telegram_id TEXT,
+    _code TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _promo_code promo_codes;
+    _batch promo_code_batches;
+    _redemption promo_code_redemptions;
+    _redemption_id BIGINT;
+    _redeemed BIGINT;
+    _purchase JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    -- блокируем код, активации одного кода выполняются последовательно.
+    SELECT *
+    INTO _promo_code
+    FROM promo_codes
+    WHERE code = UPPER(TRIM(_code))
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_found');
+    END IF;
+
+    SELECT *
+    INTO _batch
+    FROM promo_code_batches
+    WHERE id = _promo_code.batch_id;
+
+    IF NOT _batch.is_active THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'inactive');
+    END IF;
+
+    IF _batch.expires_at IS NOT NULL AND _batch.expires_at <= NOW() THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'expired');
+    END IF;
+
+    IF _batch.max_redemptions IS NOT NULL AND _promo_code.redemptions_count >= _batch.max_redemptions THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'exhausted');
+    END IF;
+
+    -- активации пользователем кодов одной партии выполняются последовательно (лимит на пользователя).
+    PERFORM PG_ADVISORY_XACT_LOCK(HASHTEXT('promo_code_redeem:' || _batch.id || ':' || _telegram_id));
+
+    SELECT
+        COUNT(*)
+    INTO _redeemed
+    FROM promo_code_redemptions
+    WHERE batch_id = _batch.id
+    AND telegram_id = _telegram_id;
+
+    IF _redeemed >= _batch.per_user_limit THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'limit_reached');
+    END IF;
+
+    _redemption_id := NEXTVAL(PG_GET_SERIAL_SEQUENCE('promo_code_redemptions', 'id'));
+
+    IF _batch.reward_type = 'subscription_days' THEN
+        PERFORM public.subscription_extend_days(_telegram_id, _batch.subscription_days);
+    ELSIF _batch.reward_type = 'shop_item' THEN
+        -- товар выдаётся покупкой без списания валюты (остаток и лимит товара на пользователя учитываются).
+        _purchase := public.shop_purchase(
+            _telegram_id,
+            _batch.shop_item_id,
+            _batch.quantity,
+            'promo_code:' || _redemption_id
+        );
+
+        IF _purchase->>'status' <> 'created' THEN
+            RETURN JSONB_BUILD_OBJECT('status', 'reward_unavailable');
+        END IF;
+    END IF;
+
+    INSERT INTO promo_code_redemptions(
+        id,
+        promo_code_id,
+        batch_id,
+        telegram_id,
+        reward_type,
+        amount,
+        subscription_days,
+        shop_item_id,
+        quantity,
+        shop_purchase_id
+    ) VALUES(
+        _redemption_id,
+        _promo_code.id,
+        _batch.id,
+        _telegram_id,
+        _batch.reward_type,
+        _batch.amount,
+        _batch.subscription_days,
+        _batch.shop_item_id,
+        _batch.quantity,
+        (_purchase->'purchase'->>'id')::BIGINT
+    )
+    RETURNING * INTO _redemption;
+
+    UPDATE promo_codes SET
+        redemptions_count = redemptions_count + 1,
+        updated_at = NOW()
+    WHERE id = _promo_code.id;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', 'redeemed',
+        'redemption', TO_JSONB(_redemption) || JSONB_BUILD_OBJECT('code', _promo_code.code)
+    );
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TYPE IF EXISTS referral_status;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- статус реферала: ожидает выполнения условия, награда выдана, отклонён (антифрод).
+CREATE TYPE referral_status AS ENUM ('pending', 'rewarded', 'rejected');
\ No newline at end of file
@@ -0,0 +1,12 @@
+DELETE FROM text_translations
+WHERE content_id IN (SELECT id FROM text_contents WHERE code = 'balance_transaction_referral_reward');
+
+DELETE FROM text_contents WHERE code = 'balance_transaction_referral_reward';
+
+DELETE FROM event_types WHERE name = 'referral_reward';
+
+DROP INDEX IF EXISTS idx_referrals_referrer_telegram_id_status;
+
+DROP TABLE IF EXISTS referrals;
+
+DROP TABLE IF EXISTS referral_codes;
\ No newline at end of file
@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS referral_codes( -- Реферальные коды пользователей (передаются в Mini App параметром startapp).
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    telegram_id TEXT NOT NULL UNIQUE, -- Telegram id владельца кода.
+    code TEXT NOT NULL UNIQUE, -- Код (в верхнем регистре).
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    FOREIGN KEY (telegram_id) REFERENCES users(telegram_id),
+    CONSTRAINT check_referral_codes_code_upper CHECK (code = UPPER(code))
+);
+
+CREATE TABLE IF NOT EXISTS referrals( -- Приглашённые пользователи.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    referrer_telegram_id TEXT NOT NULL, -- Telegram id пригласившего пользователя.
+    invitee_telegram_id TEXT NOT NULL UNIQUE, -- Telegram id приглашённого пользователя (приглашается только один раз).
+    code TEXT NOT NULL, -- Реферальный код, по которому пришёл пользователь.
+    status referral_status NOT NULL DEFAULT 'pending', -- Статус.
+    reject_reason TEXT, -- Причина отклонения.
+    referrer_reward NUMERIC(20, 2), -- Награда пригласившему.
+    invitee_reward NUMERIC(20, 2), -- Награда приглашённому.
+    rewarded_at TIMESTAMP WITH TIME ZONE, -- Время выдачи наград.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата обновления записи.
+    FOREIGN KEY (referrer_telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (invitee_telegram_id) REFERENCES users(telegram_id),
+    CONSTRAINT check_referrals_not_self CHECK (referrer_telegram_id <> invitee_telegram_id)
+);
+
+-- Статистика и лимит наград пригласившего.
+CREATE INDEX IF NOT EXISTS idx_referrals_referrer_telegram_id_status ON referrals (referrer_telegram_id, status);
+
+-- событие, от имени которого начисляется внутренняя валюта за приглашение.
+INSERT INTO event_types(
+    name,
+    description,
+    notification_message,
+    is_send_notification
+) VALUES(
+    'referral_reward',
+    'Событие по начислению награды за приглашение друга',
+    'Награда за приглашение друга',
+    FALSE
+);
+
+INSERT INTO text_contents (code, page, description) VALUES
+('balance_transaction_referral_reward', 'balance_transactions', 'Награда за приглашение друга')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO text_translations (content_id, lang, value) VALUES
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_referral_reward'), 'ru', 'Награда за приглашение друга'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_referral_reward'), 'en', 'Referral reward')
+ON CONFLICT (content_id, lang) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.referral_code_get_or_create(TEXT, TEXT);
\ No newline at end of file
@@ -0,0 +1,49 @@
+-- реферальный код пользователя: возвращает существующий код или сохраняет переданный.
+-- status: ok, code_taken (переданный код уже занят другим пользователем, нужно сгенерировать другой).
+CREATE OR REPLACE FUNCTION public.referral_code_get_or_create(
+    _telegram_id TEXT,
+    _code TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _referral_code referral_codes;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _referral_code
+    FROM referral_codes
+    WHERE telegram_id = _telegram_id;
+
+    IF NOT FOUND THEN
+        INSERT INTO referral_codes(
+            telegram_id,
+            code
+        ) VALUES(
+            _telegram_id,
+            UPPER(_code)
+        )
+        ON CONFLICT DO NOTHING;
+
+        -- код мог быть создан параллельным запросом.
+        SELECT *
+        INTO _referral_code
+        FROM referral_codes
+        WHERE telegram_id = _telegram_id;
+
+        IF NOT FOUND THEN
+            RETURN JSONB_BUILD_OBJECT('status', 'code_taken');
+        END IF;
+    END IF;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', 'ok',
+        'referral_code', TO_JSONB(_referral_code)
+    );
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.referral_attribute(TEXT, TEXT);
\ No newline at end of file
@@ -0,0 +1,61 @@
+-- привязка нового пользователя к пригласившему по реферальному коду.
+-- status: attributed, not_found, self_referral, referrer_banned, already_attributed.
+CREATE OR REPLACE FUNCTION public.referral_attribute(
+    _invitee_telegram_id TEXT,
+    _code TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _referral_code referral_codes;
+    _referral referrals;
+BEGIN
+    IF _invitee_telegram_id IS NULL THEN
+        RAISE EXCEPTION 'invitee_telegram_id IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _referral_code
+    FROM referral_codes
+    WHERE code = UPPER(TRIM(_code));
+
+    IF NOT FOUND THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_found');
+    END IF;
+
+    IF _referral_code.telegram_id = _invitee_telegram_id THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'self_referral');
+    END IF;
+
+    IF EXISTS(
+        SELECT 1
+        FROM users_blacklist
+        WHERE telegram_id = _referral_code.telegram_id
+    ) THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'referrer_banned');
+    END IF;
+
+    INSERT INTO referrals(
+        referrer_telegram_id,
+        invitee_telegram_id,
+        code
+    ) VALUES(
+        _referral_code.telegram_id,
+        _invitee_telegram_id,
+        _referral_code.code
+    )
+    ON CONFLICT (invitee_telegram_id) DO NOTHING
+    RETURNING * INTO _referral;
+
+    IF _referral.id IS NULL THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'already_attributed');
+    END IF;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', 'attributed',
+        'referral', TO_JSONB(_referral)
+    );
+END;
+$$;
\ No newline at end of file
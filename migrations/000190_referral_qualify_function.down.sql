@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.referral_qualify(TEXT, BIGINT, BIGINT, BIGINT, NUMERIC, NUMERIC);
\ No newline at end of file
@@ -0,0 +1,109 @@
+-- проверка условия реферальной программы для приглашённого пользователя:
+-- достигнут уровень _min_level или серия _min_streak_days дней (0 отключает условие).
+-- при выполнении условия реферал блокируется и помечается rewarded (награды начисляет сервис в той же транзакции)
+-- или rejected, если один из пользователей забанен или пригласивший получил награды за _max_rewarded рефералов (0 - без лимита).
+-- status: rewarded, rejected, not_qualified, not_found (нет ожидающего реферала).
+CREATE OR REPLACE FUNCTION public.referral_qualify(
+    _invitee_telegram_id TEXT,
+    _min_level BIGINT,
+    _min_streak_days BIGINT,
+    _max_rewarded BIGINT,
+    _referrer_reward NUMERIC,
+    _invitee_reward NUMERIC
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _referral referrals;
+    _level BIGINT;
+    _streak_days BIGINT;
+    _rewarded BIGINT;
+    _reject_reason TEXT;
+BEGIN
+    IF _invitee_telegram_id IS NULL THEN
+        RAISE EXCEPTION 'invitee_telegram_id IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _referral
+    FROM referrals
+    WHERE invitee_telegram_id = _invitee_telegram_id
+    AND status = 'pending'
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_found');
+    END IF;
+
+    SELECT
+        COALESCE(MAX(level_number), 1)
+    INTO _level
+    FROM user_level_history
+    WHERE telegram_id = _invitee_telegram_id;
+
+    SELECT
+        COALESCE(MAX(streak_days), 0)
+    INTO _streak_days
+    FROM user_stats
+    WHERE telegram_id = _invitee_telegram_id;
+
+    IF NOT (
+        (_min_level > 0 AND _level >= _min_level) OR
+        (_min_streak_days > 0 AND _streak_days >= _min_streak_days)
+    ) THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_qualified');
+    END IF;
+
+    -- награды пригласившего выдаются последовательно (лимит наград).
+    PERFORM PG_ADVISORY_XACT_LOCK(HASHTEXT('referral_reward:' || _referral.referrer_telegram_id));
+
+    IF EXISTS(
+        SELECT 1
+        FROM users_blacklist
+        WHERE telegram_id IN (_referral.referrer_telegram_id, _referral.invitee_telegram_id)
+    ) THEN
+        _reject_reason := 'banned';
+    ELSIF _max_rewarded > 0 THEN
+        SELECT
+            COUNT(*)
+        INTO _rewarded
+        FROM referrals
+        WHERE referrer_telegram_id = _referral.referrer_telegram_id
+        AND status = 'rewarded';
+
+        IF _rewarded >= _max_rewarded THEN
+            _reject_reason := 'referrer_limit_reached';
+        END IF;
+    END IF;
+
+    IF _reject_reason IS NOT NULL THEN
+        UPDATE referrals SET
+            status = 'rejected',
+            reject_reason = _reject_reason,
+            updated_at = NOW()
+        WHERE id = _referral.id
+        RETURNING * INTO _referral;
+
+        RETURN JSONB_BUILD_OBJECT(
+            'status', 'rejected',
+            'referral', TO_JSONB(_referral)
+        );
+    END IF;
+
+    UPDATE referrals SET
+        status = 'rewarded',
+        referrer_reward = _referrer_reward,
+        invitee_reward = _invitee_reward,
+        rewarded_at = NOW(),
+        updated_at = NOW()
+    WHERE id = _referral.id
+    RETURNING * INTO _referral;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', 'rewarded',
+        'referral', TO_JSONB(_referral)
+    );
+END;
+$$;
\ No newline at end of file
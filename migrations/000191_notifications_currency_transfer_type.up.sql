@@ -0,0 +1,2 @@
+-- тип уведомления о переводе внутренней валюты (отправителю и получателю).
+ALTER TYPE notifications_type ADD VALUE IF NOT EXISTS 'currency_transfer';
\ No newline at end of file
@@ -0,0 +1,16 @@
+DELETE FROM text_translations
+WHERE content_id IN (
+    SELECT id
+    FROM text_contents
+    WHERE code IN ('balance_transaction_currency_transfer_sent', 'balance_transaction_currency_transfer_received')
+);
+
+DELETE FROM text_contents WHERE code IN ('balance_transaction_currency_transfer_sent', 'balance_transaction_currency_transfer_received');
+
+DELETE FROM event_types WHERE name IN ('currency_transfer_sent', 'currency_transfer_received');
+
+DROP INDEX IF EXISTS idx_currency_transfers_recipient_telegram_id;
+
+DROP INDEX IF EXISTS idx_currency_transfers_sender_telegram_id_created_at;
+
+DROP TABLE IF EXISTS currency_transfers;
\ No newline at end of file
@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS currency_transfers( -- Переводы внутренней валюты между пользователями.
+    id BIGSERIAL PRIMARY KEY, -- Уникальный идентификатор.
+    sender_telegram_id TEXT NOT NULL, -- Telegram id отправителя.
+    recipient_telegram_id TEXT NOT NULL, -- Telegram id получателя.
+    amount NUMERIC(20, 2) NOT NULL, -- Сумма, которую получает получатель.
+    fee NUMERIC(20, 2) NOT NULL DEFAULT 0, -- Комиссия, которую дополнительно платит отправитель.
+    comment TEXT, -- Комментарий отправителя.
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- Дата создания записи.
+    FOREIGN KEY (sender_telegram_id) REFERENCES users(telegram_id),
+    FOREIGN KEY (recipient_telegram_id) REFERENCES users(telegram_id),
+    CONSTRAINT check_currency_transfers_not_self CHECK (sender_telegram_id <> recipient_telegram_id),
+    CONSTRAINT check_currency_transfers_amount_positive CHECK (amount > 0),
+    CONSTRAINT check_currency_transfers_fee_not_negative CHECK (fee >= 0)
+);
+
+-- Дневные лимиты отправителя.
+CREATE INDEX IF NOT EXISTS idx_currency_transfers_sender_telegram_id_created_at ON currency_transfers (sender_telegram_id, created_at);
+
+-- Переводы, полученные пользователем.
+CREATE INDEX IF NOT EXISTS idx_currency_transfers_recipient_telegram_id ON currency_transfers (recipient_telegram_id);
+
+-- события, от имени которых списывается и начисляется внутренняя валюта при переводе.
+INSERT INTO event_types(
+    name,
+    description,
+    notification_message,
+    is_send_notification
+) VALUES(
+    'currency_transfer_sent',
+    'Событие по списанию внутренней валюты при переводе другу',
+    'Перевод другу',
+    FALSE
+), (
+    'currency_transfer_received',
+    'Событие по начислению внутренней валюты при переводе от друга',
+    'Перевод от друга',
+    FALSE
+);
+
+INSERT INTO text_contents (code, page, description) VALUES
+('balance_transaction_currency_transfer_sent', 'balance_transactions', 'Перевод другу'),
+('balance_transaction_currency_transfer_received', 'balance_transactions', 'Перевод от друга')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO text_translations (content_id, lang, value) VALUES
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_currency_transfer_sent'), 'ru', 'Перевод другу'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_currency_transfer_sent'), 'en', 'Transfer to a friend'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_currency_transfer_received'), 'ru', 'Перевод от друга'),
+((SELECT id FROM text_contents WHERE code = 'balance_transaction_currency_transfer_received'), 'en', 'Transfer from a friend')
+ON CONFLICT (content_id, lang) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS public.currency_transfer_create(TEXT, TEXT, NUMERIC, TEXT, BIGINT, BIGINT, BIGINT, BIGINT);
\ No newline at end of file
@@ -0,0 +1,92 @@
+-- оформление подписки по тарифу.
+-- возвращает NULL, если тариф отключён или пробный период уже был оформлен.
+CREATE OR REPLACE FUNCTION public.subscription_subscribe(
+    _telegram_id TEXT,
+    _plan_code TEXT
+) RETURNS subscriptions
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _s subscriptions; -- subscription.
+    _p subscription_plans; -- plan.
+    _now TIMESTAMP; -- action time.
+    _exp TIMESTAMP; -- expires_at.
+    _is_extension BOOLEAN; -- подписка ещё не закончилась.
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+    IF _plan_code IS NULL THEN
+        RAISE EXCEPTION 'plan_code IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _p
+    FROM subscription_plans
+    WHERE code = _plan_code;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'subscription plan % does not exist', _plan_code;
+    END IF;
+
+    -- отключённый тариф не оформляется.
+    IF NOT _p.is_active THEN
+        RETURN NULL;
+    END IF;
+
+    _now = NOW();
+
+    SELECT *
+    INTO _s
+    FROM subscriptions
+    WHERE telegram_id = _telegram_id
+    FOR UPDATE;
+
+    -- пробный период оформляется один раз: проверка после блокировки подписки,
+    -- чтобы параллельные запросы не оформили его дважды.
+    IF _p.is_trial AND _s.trial_used_at IS NOT NULL THEN
+        RETURN NULL;
+    END IF;
+
+    -- оставшееся время не теряется: активная подписка продлевается от даты окончания,
+    -- закончившаяся (в том числе в льготном периоде) начинается сейчас.
+    _is_extension := _s.is_active AND _s.expires_at > _now;
+
+    IF _is_extension THEN
+        _exp = _s.expires_at + MAKE_INTERVAL(days => _p.duration_days);
+    ELSE
+        _exp = _now + MAKE_INTERVAL(days => _p.duration_days);
+    END IF;
+
+    UPDATE subscriptions SET
+        plan_id = _p.id,
+        subscribed_at = CASE WHEN _is_extension THEN subscribed_at ELSE _now END,
+        expires_at = _exp,
+        grace_expires_at = _exp + MAKE_INTERVAL(days => _p.grace_period_days),
+        reminder_sent_at = NULL,
+        trial_used_at = CASE WHEN _p.is_trial THEN _now ELSE trial_used_at END,
+        is_active = TRUE,
+        updated_at = NOW()
+    WHERE telegram_id = _telegram_id
+    RETURNING * INTO _s;
+
+    -- create subscription history.
+    INSERT INTO subscription_history(
+        telegram_id,
+        action_time,
+        expires_at,
+        action,
+        plan_id
+    ) VALUES(
+        _telegram_id,
+        _now,
+        _exp,
+        'subscribe',
+        _p.id
+    );
+
+    RETURN _s;
+END;
+$$;
\ No newline at end of file
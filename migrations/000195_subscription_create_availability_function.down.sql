@@ -0,0 +1,10 @@
+-- оформление подписки без указания тарифа — это месячный тариф.
+CREATE OR REPLACE FUNCTION public.subscription_create(_telegram_id TEXT) RETURNS subscriptions
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    RETURN public.subscription_subscribe(_telegram_id, 'monthly');
+END;
+$$;
\ No newline at end of file
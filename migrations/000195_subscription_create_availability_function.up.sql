@@ -0,0 +1,18 @@
+-- оформление подписки без указания тарифа — это месячный тариф.
+CREATE OR REPLACE FUNCTION public.subscription_create(_telegram_id TEXT) RETURNS subscriptions
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _s subscriptions; -- subscription.
+BEGIN
+    _s := public.subscription_subscribe(_telegram_id, 'monthly');
+
+    IF _s.id IS NULL THEN
+        RAISE EXCEPTION 'subscription plan monthly is not available';
+    END IF;
+
+    RETURN _s;
+END;
+$$;
\ No newline at end of file
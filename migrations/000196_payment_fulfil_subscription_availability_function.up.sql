@@ -0,0 +1,97 @@
+-- выдача оплаченного платежа (successful_payment): оформляет подписку по тарифу или кладёт товар в инвентарь.
+-- идемпотентна: платёж блокируется, повторное уведомление об оплате возвращает уже обработанный платёж.
+-- товар выдаётся через shop_purchase с ключом идемпотентности платежа, внутренняя валюта не списывается.
+-- если товар или подписку выдать нельзя (закончился, превышен лимит, тариф отключён), платёж помечается failed и подлежит возврату.
+-- status: paid, failed, duplicate (платёж уже обработан), not_found.
+CREATE OR REPLACE FUNCTION public.payment_fulfil(
+    _invoice_payload TEXT,
+    _telegram_payment_charge_id TEXT,
+    _provider_payment_charge_id TEXT,
+    _currency TEXT,
+    _total_amount BIGINT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _payment payments;
+    _plan_code TEXT;
+    _purchase JSONB;
+    _subscription subscriptions;
+    _status payment_status := 'paid';
+    _error TEXT;
+BEGIN
+    IF _invoice_payload IS NULL THEN
+        RAISE EXCEPTION 'invoice_payload IS NULL';
+    END IF;
+
+    IF _telegram_payment_charge_id IS NULL OR _telegram_payment_charge_id = '' THEN
+        RAISE EXCEPTION 'telegram_payment_charge_id IS NULL';
+    END IF;
+
+    SELECT *
+    INTO _payment
+    FROM payments
+    WHERE invoice_payload = _invoice_payload
+    FOR UPDATE;
+
+    IF NOT FOUND THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'not_found');
+    END IF;
+
+    IF _payment.status <> 'pending' THEN
+        RETURN JSONB_BUILD_OBJECT(
+            'status', 'duplicate',
+            'payment', TO_JSONB(_payment)
+        );
+    END IF;
+
+    IF _payment.currency <> _currency OR _payment.amount <> _total_amount THEN
+        _status := 'failed';
+        _error := 'amount mismatch: expected ' || _payment.amount || ' ' || _payment.currency
+            || ', got ' || _total_amount || ' ' || _currency;
+    ELSIF _payment.product_type = 'subscription_plan' THEN
+        SELECT
+            code
+        INTO _plan_code
+        FROM subscription_plans
+        WHERE id = _payment.subscription_plan_id;
+
+        _subscription := public.subscription_subscribe(_payment.telegram_id, _plan_code);
+
+        IF _subscription.id IS NULL THEN
+            _status := 'failed';
+            _error := 'subscription plan ' || _plan_code || ' is not available';
+        END IF;
+    ELSE
+        _purchase := public.shop_purchase(
+            _payment.telegram_id,
+            _payment.shop_item_id,
+            _payment.quantity,
+            'payment:' || _payment.invoice_payload
+        );
+
+        IF _purchase->>'status' NOT IN ('created', 'duplicate') THEN
+            _status := 'failed';
+            _error := 'shop purchase ' || (_purchase->>'status');
+        END IF;
+    END IF;
+
+    UPDATE payments SET
+        status = _status,
+        telegram_payment_charge_id = _telegram_payment_charge_id,
+        provider_payment_charge_id = _provider_payment_charge_id,
+        shop_purchase_id = (_purchase->'purchase'->>'id')::BIGINT,
+        error = _error,
+        paid_at = NOW(),
+        updated_at = NOW()
+    WHERE id = _payment.id
+    RETURNING * INTO _payment;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', _status,
+        'payment', TO_JSONB(_payment)
+    );
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,144 @@
+CREATE OR REPLACE FUNCTION public.achievement_progress_get(
+    _telegram_id TEXT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _response JSONB;
+BEGIN
+    IF _telegram_id IS NULL THEN
+        RAISE EXCEPTION 'telegram_id IS NULL';
+    END IF;
+
+    WITH metrics AS (
+        SELECT
+            us.streak_days,
+            us.daily_task_streak_days,
+            us.words_learned,
+            us.tasks_completed,
+            us.lessons_finished,
+            us.words_translate,
+            us.dialog_completed,
+            us.experience_points,
+            us.level
+        FROM user_stats us
+        WHERE us.telegram_id = _telegram_id
+    ),
+    criteria AS (
+        -- по одной строке на каждый заданный критерий типа достижения.
+        SELECT
+            a.id AS achievement_id,
+            c.metric,
+            c.current,
+            c.need,
+            ROUND(LEAST(c.current::NUMERIC / c.need, 1) * 100, 2) AS percent
+        FROM achievements a
+        INNER JOIN achievement_types at ON a.achievement_type_id = at.id
+        INNER JOIN metrics m ON TRUE
+        CROSS JOIN LATERAL (
+            VALUES
+                ('streak_days', m.streak_days, at.streak_days_need),
+                ('daily_task_streak_days', m.daily_task_streak_days, at.daily_task_streak_days_need),
+                ('words_learned', m.words_learned, at.words_learned_need),
+                ('tasks_completed', m.tasks_completed, at.tasks_completed_need),
+                ('lessons_finished', m.lessons_finished, at.lessons_finished_need),
+                ('words_translate', m.words_translate, at.words_translate_need),
+                ('dialog_completed', m.dialog_completed, at.dialog_completed_need),
+                ('experience_points', m.experience_points, at.experience_points_need),
+                ('level', m.level, at.level_need)
+        ) AS c(metric, current, need)
+        WHERE at.is_active
+        AND c.need IS NOT NULL
+        AND c.need > 0
+    ),
+    progress AS (
+        SELECT
+            a.id AS achievement_id,
+            a.name,
+            a.description,
+            at.name AS achievement_type,
+            a.is_secret,
+            a.available_from,
+            a.available_to,
+            a.tier_group,
+            a.tier_level,
+            ua.unlocked_at,
+            -- общий прогресс — среднее по критериям (каждый ограничен 100%).
+            CASE
+                WHEN ua.id IS NOT NULL THEN 100
+                ELSE COALESCE(ROUND(AVG(cr.percent), 2), 100)
+            END AS overall_percent,
+            COALESCE(
+                JSONB_AGG(
+                    JSONB_BUILD_OBJECT(
+                        'metric', cr.metric,
+                        'current', cr.current,
+                        'need', cr.need,
+                        'percent', cr.percent
+                    )
+                    ORDER BY cr.metric
+                ) FILTER (WHERE cr.metric IS NOT NULL),
+                '[]'::JSONB
+            ) AS criteria
+        FROM achievements a
+        INNER JOIN achievement_types at ON a.achievement_type_id = at.id
+        LEFT JOIN criteria cr ON cr.achievement_id = a.id
+        LEFT JOIN user_achievements ua ON ua.achievement_id = a.id
+        AND ua.telegram_id = _telegram_id
+        WHERE at.is_active
+        GROUP BY a.id, a.name, a.description, at.name, ua.id, ua.unlocked_at
+    ),
+    visible AS (
+        -- неполученные достижения с закончившимся окном больше не показываются.
+        -- цепочка ступеней показывается одним значком: ближайшая неполученная ступень,
+        -- а если получены все ступени — самая старшая.
+        SELECT
+            p.*,
+            COUNT(*) OVER (PARTITION BY p.tier_group) AS tier_count,
+            COUNT(p.unlocked_at) OVER (PARTITION BY p.tier_group) AS tier_unlocked_count,
+            ROW_NUMBER() OVER (
+                PARTITION BY COALESCE('tier:' || p.tier_group, 'achievement:' || p.achievement_id)
+                ORDER BY
+                    (p.unlocked_at IS NOT NULL),
+                    CASE WHEN p.unlocked_at IS NULL THEN p.tier_level ELSE -p.tier_level END
+            ) AS rn
+        FROM progress p
+        WHERE p.unlocked_at IS NOT NULL
+        OR p.available_to IS NULL
+        OR p.available_to > NOW()
+    )
+    SELECT COALESCE(
+        JSONB_AGG(
+            JSONB_BUILD_OBJECT(
+                'achievement_id', p.achievement_id,
+                -- секретное достижение до получения показывается как "???".
+                'name', CASE WHEN p.is_secret AND p.unlocked_at IS NULL THEN '???' ELSE p.name END,
+                'description', CASE WHEN p.is_secret AND p.unlocked_at IS NULL THEN NULL ELSE p.description END,
+                'achievement_type', CASE WHEN p.is_secret AND p.unlocked_at IS NULL THEN '???' ELSE p.achievement_type END,
+                'is_secret', p.is_secret,
+                'is_unlocked', p.unlocked_at IS NOT NULL,
+                'unlocked_at', p.unlocked_at,
+                'is_available', (p.available_from IS NULL OR p.available_from <= NOW()) AND (p.available_to IS NULL OR p.available_to > NOW()),
+                'available_from', p.available_from,
+                'available_to', p.available_to,
+                'tier_group', p.tier_group,
+                'tier_level', p.tier_level,
+                'tier_count', CASE WHEN p.tier_group IS NOT NULL THEN p.tier_count END,
+                'tier_unlocked_count', CASE WHEN p.tier_group IS NOT NULL THEN p.tier_unlocked_count END,
+                'overall_percent', CASE WHEN p.is_secret AND p.unlocked_at IS NULL THEN 0 ELSE p.overall_percent END,
+                'criteria', CASE WHEN p.is_secret AND p.unlocked_at IS NULL THEN '[]'::JSONB ELSE p.criteria END
+            )
+            -- сначала неполученные, ближайшие к выполнению — выше.
+            ORDER BY (p.unlocked_at IS NOT NULL), p.overall_percent DESC, p.achievement_id
+        ),
+        '[]'::JSONB
+    )
+    INTO _response
+    FROM visible p
+    WHERE p.rn = 1;
+
+    RETURN _response;
+END;
+$$;
\ No newline at end of file
@@ -0,0 +1,141 @@
+-- создание перевода внутренней валюты между пользователями.
+-- балансы отправителя и получателя блокируются в порядке telegram id, чтобы встречные переводы не приводили к deadlock.
+-- списание и начисление выполняются в той же транзакции после создания перевода.
+-- 0 в min_account_age_days, daily_limit_amount и daily_limit_count отключает соответствующую проверку.
+-- дневные лимиты сбрасываются в полночь по часовому поясу отправителя.
+-- status: created, self_transfer, recipient_not_found, sender_banned, recipient_banned, account_too_young,
+-- daily_amount_limit_reached, daily_count_limit_reached, insufficient_balance.
+CREATE OR REPLACE FUNCTION public.currency_transfer_create(
+    _sender_telegram_id TEXT,
+    _recipient_telegram_id TEXT,
+    _amount NUMERIC,
+    _comment TEXT,
+    _fee_percent BIGINT,
+    _min_account_age_days BIGINT,
+    _daily_limit_amount BIGINT,
+    _daily_limit_count BIGINT
+) RETURNS JSONB
+    SECURITY DEFINER
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _fee NUMERIC(20, 2);
+    _sender_created_at TIMESTAMP WITH TIME ZONE;
+    _sender_timezone TEXT;
+    _day_start TIMESTAMP WITH TIME ZONE;
+    _sender_balance NUMERIC(20, 2);
+    _sent_amount NUMERIC(20, 2);
+    _sent_count BIGINT;
+    _transfer currency_transfers;
+BEGIN
+    IF _sender_telegram_id IS NULL OR _recipient_telegram_id IS NULL THEN
+        RAISE EXCEPTION 'sender_telegram_id or recipient_telegram_id IS NULL';
+    END IF;
+
+    IF _amount IS NULL OR _amount <= 0 THEN
+        RAISE EXCEPTION 'amount must be positive';
+    END IF;
+
+    IF _fee_percent < 0 OR _fee_percent > 100 THEN
+        RAISE EXCEPTION 'fee_percent must be between 0 and 100';
+    END IF;
+
+    IF _sender_telegram_id = _recipient_telegram_id THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'self_transfer');
+    END IF;
+
+    IF NOT EXISTS(
+        SELECT 1
+        FROM users
+        WHERE telegram_id = _recipient_telegram_id
+    ) THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'recipient_not_found');
+    END IF;
+
+    -- блокировка балансов в детерминированном порядке.
+    PERFORM 1
+    FROM user_balances
+    WHERE telegram_id IN (_sender_telegram_id, _recipient_telegram_id)
+    ORDER BY telegram_id
+    FOR UPDATE;
+
+    IF EXISTS(
+        SELECT 1
+        FROM users_blacklist
+        WHERE telegram_id = _sender_telegram_id
+    ) THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'sender_banned');
+    END IF;
+
+    IF EXISTS(
+        SELECT 1
+        FROM users_blacklist
+        WHERE telegram_id = _recipient_telegram_id
+    ) THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'recipient_banned');
+    END IF;
+
+    SELECT
+        created_at,
+        COALESCE(timezone, 'Europe/Moscow')
+    INTO
+        _sender_created_at,
+        _sender_timezone
+    FROM users
+    WHERE telegram_id = _sender_telegram_id;
+
+    IF _min_account_age_days > 0 AND _sender_created_at > NOW() - MAKE_INTERVAL(days => _min_account_age_days::INT) THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'account_too_young');
+    END IF;
+
+    -- начало текущих суток по часовому поясу отправителя.
+    _day_start := public.user_local_date(_sender_telegram_id)::TIMESTAMP AT TIME ZONE _sender_timezone;
+
+    -- переводы отправителя за текущие сутки (отправитель заблокирован, поэтому подсчёт не гонится с параллельными переводами).
+    SELECT COALESCE(SUM(amount), 0), COUNT(*)
+    INTO _sent_amount, _sent_count
+    FROM currency_transfers
+    WHERE sender_telegram_id = _sender_telegram_id
+      AND created_at >= _day_start;
+
+    IF _daily_limit_count > 0 AND _sent_count >= _daily_limit_count THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'daily_count_limit_reached');
+    END IF;
+
+    IF _daily_limit_amount > 0 AND _sent_amount + _amount > _daily_limit_amount THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'daily_amount_limit_reached');
+    END IF;
+
+    _fee := ROUND(_amount * _fee_percent / 100, 2);
+
+    SELECT balance
+    INTO _sender_balance
+    FROM user_balances
+    WHERE telegram_id = _sender_telegram_id;
+
+    IF _sender_balance IS NULL OR _sender_balance < _amount + _fee THEN
+        RETURN JSONB_BUILD_OBJECT('status', 'insufficient_balance');
+    END IF;
+
+    INSERT INTO currency_transfers(
+        sender_telegram_id,
+        recipient_telegram_id,
+        amount,
+        fee,
+        comment
+    ) VALUES(
+        _sender_telegram_id,
+        _recipient_telegram_id,
+        _amount,
+        _fee,
+        NULLIF(TRIM(_comment), '')
+    )
+    RETURNING * INTO _transfer;
+
+    RETURN JSONB_BUILD_OBJECT(
+        'status', 'created',
+        'transfer', TO_JSONB(_transfer)
+    );
+END;
+$$;
\ No newline at end of file
@@ -3,6 +3,9 @@ package apperrors
 import "errors"
 
 var (
-	ErrAchievementAlreadyExists = errors.New("achievement already exists")
-	ErrAchievementDoesNotExist  = errors.New("achievement does not exist")
+	ErrAchievementAlreadyExists          = errors.New("achievement already exists")
+	ErrAchievementDoesNotExist           = errors.New("achievement does not exist")
+	ErrAchievementInvalidAvailableWindow = errors.New("achievement available_from must be before available_to")
+	ErrAchievementRewardDoesNotExist     = errors.New("achievement reward does not exist")
+	ErrAchievementTierAlreadyExists      = errors.New("achievement with this tier group and tier level already exists")
 )
@@ -0,0 +1,5 @@
+package apperrors
+
+import "errors"
+
+var ErrAchievementEvaluationJobDoesNotExist = errors.New("achievement evaluation job does not exist")
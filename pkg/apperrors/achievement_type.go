@@ -3,6 +3,8 @@ package apperrors
 import "errors"
 
 var (
-	ErrAchievementTypeDoesNotExist  = errors.New("achievement type does not exist")
-	ErrAchievementTypeAlreadyExists = errors.New("achievement type already exists")
+	ErrAchievementTypeDoesNotExist    = errors.New("achievement type does not exist")
+	ErrAchievementTypeAlreadyExists   = errors.New("achievement type already exists")
+	ErrAchievementTypeInUse           = errors.New("achievement type is used by achievement")
+	ErrAchievementTypeCriteriaIsEmpty = errors.New("achievement type must have at least one criterion")
 )
@@ -0,0 +1,8 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrAggregateRebuildJobDoesNotExist  = errors.New("aggregate rebuild job does not exist")
+	ErrAggregateRebuildInvalidWeekRange = errors.New("week_from must not be after week_to")
+)
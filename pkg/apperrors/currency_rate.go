@@ -0,0 +1,10 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrCurrencyRateAlreadyExists   = errors.New("currency rate already exists")
+	ErrCurrencyRateDoesNotExist    = errors.New("currency rate does not exist")
+	ErrCurrencyRateMustBePositive  = errors.New("currency rate must be positive")
+	ErrConvertAmountMustBePositive = errors.New("convert amount must be positive")
+)
@@ -0,0 +1,15 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrCurrencyTransferAmountIsInvalid         = errors.New("currency transfer amount must be positive with at most two decimal places")
+	ErrCurrencyTransferToSelf                  = errors.New("currency transfer to yourself is not allowed")
+	ErrCurrencyTransferRecipientDoesNotExist   = errors.New("currency transfer recipient does not exist")
+	ErrCurrencyTransferSenderBanned            = errors.New("currency transfer sender is banned")
+	ErrCurrencyTransferRecipientBanned         = errors.New("currency transfer recipient is banned")
+	ErrCurrencyTransferAccountTooYoung         = errors.New("account is too young to transfer currency")
+	ErrCurrencyTransferDailyAmountLimitReached = errors.New("daily currency transfer amount limit reached")
+	ErrCurrencyTransferDailyCountLimitReached  = errors.New("daily currency transfer count limit reached")
+	ErrCurrencyTransferInsufficientBalance     = errors.New("insufficient balance for currency transfer")
+)
@@ -0,0 +1,9 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrDailyTaskDoesNotExist         = errors.New("daily task does not exist")
+	ErrDailyTaskRequirementsAreEmpty = errors.New("daily task requirements are empty")
+	ErrDailyTaskLastActive           = errors.New("at least one daily task must stay active")
+)
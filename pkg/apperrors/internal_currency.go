@@ -0,0 +1,8 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrBalanceTransactionDateRangeIsInvalid   = errors.New("balance transactions date from must be before date to")
+	ErrBalanceTransactionsExportLimitExceeded = errors.New("too many balance transactions to export, narrow the filters")
+)
@@ -0,0 +1,5 @@
+package apperrors
+
+import "errors"
+
+var ErrLeagueMemberDoesNotExist = errors.New("league member does not exist")
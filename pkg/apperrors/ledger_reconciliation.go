@@ -0,0 +1,5 @@
+package apperrors
+
+import "errors"
+
+var ErrLedgerReconciliationJobDoesNotExist = errors.New("ledger reconciliation job does not exist")
@@ -0,0 +1,11 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrLevelDoesNotExist       = errors.New("level does not exist")
+	ErrLevelAlreadyExists      = errors.New("level already exists")
+	ErrLevelThresholdConflict  = errors.New("required experience must grow strictly with level number")
+	ErrLevelInUse              = errors.New("level is already reached by users")
+	ErrLevelRewardDoesNotExist = errors.New("level reward does not exist")
+)
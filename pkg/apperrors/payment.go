@@ -0,0 +1,10 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrPaymentDoesNotExist           = errors.New("payment does not exist")
+	ErrPaymentIsNotRefundable        = errors.New("payment is not refundable")
+	ErrPaymentProductIsNotAvailable  = errors.New("payment product is not available for telegram stars")
+	ErrPaymentWebhookSecretIsInvalid = errors.New("payment webhook secret token is invalid")
+)
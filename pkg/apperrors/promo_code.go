@@ -0,0 +1,17 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrPromoCodeDoesNotExist            = errors.New("promo code does not exist")
+	ErrPromoCodeAlreadyExists           = errors.New("promo code already exists")
+	ErrPromoCodeIsNotActive             = errors.New("promo code is not active")
+	ErrPromoCodeExpired                 = errors.New("promo code has expired")
+	ErrPromoCodeExhausted               = errors.New("promo code redemption limit is exhausted")
+	ErrPromoCodeRedemptionLimitReached  = errors.New("promo code redemption limit per user reached")
+	ErrPromoCodeRewardIsNotAvailable    = errors.New("promo code reward is not available")
+	ErrPromoCodeAmountMustBePositive    = errors.New("promo code amount must be positive")
+	ErrPromoCodeExpiresAtMustBeInFuture = errors.New("promo code expires at must be in the future")
+	ErrPromoCodeBatchDoesNotExist       = errors.New("promo code batch does not exist")
+	ErrPromoCodeGenerationFailed        = errors.New("failed to generate unique promo codes")
+)
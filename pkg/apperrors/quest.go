@@ -0,0 +1,8 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrQuestRequirementsAreEmpty       = errors.New("quest requirements are empty")
+	ErrQuestRewardAmountMustBePositive = errors.New("quest reward amount must be positive")
+)
@@ -0,0 +1,5 @@
+package apperrors
+
+import "errors"
+
+var ErrReferralCodeGenerationFailed = errors.New("failed to generate unique referral code")
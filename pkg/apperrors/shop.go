@@ -0,0 +1,14 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrShopItemDoesNotExist                = errors.New("shop item does not exist")
+	ErrShopItemInUse                       = errors.New("shop item is in use by purchases")
+	ErrShopItemIsNotAvailable              = errors.New("shop item is not available")
+	ErrShopItemOutOfStock                  = errors.New("shop item is out of stock")
+	ErrShopItemPurchaseLimitReached        = errors.New("shop item purchase limit reached")
+	ErrShopItemPriceMustBePositive         = errors.New("shop item price must be positive")
+	ErrShopItemAvailabilityWindowIsInvalid = errors.New("shop item available from must be before available until")
+	ErrIdempotencyKeyConflict              = errors.New("idempotency key was already used for another purchase")
+)
@@ -0,0 +1,9 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrStreakFreezeLimitReached      = errors.New("streak freeze limit reached")
+	ErrStreakRepairUnavailable       = errors.New("streak repair unavailable")
+	ErrStreakProtectionPriceIsNotSet = errors.New("streak protection price is not set")
+)
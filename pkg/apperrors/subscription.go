@@ -2,4 +2,10 @@ package apperrors
 
 import "errors"
 
-var ErrSubscriptionDoesNotExist = errors.New("subscription does not exist")
+var (
+	ErrSubscriptionDoesNotExist     = errors.New("subscription does not exist")
+	ErrSubscriptionPlanDoesNotExist = errors.New("subscription plan does not exist")
+	ErrSubscriptionPlanNotAvailable = errors.New("subscription plan is not available")
+	ErrSubscriptionTrialAlreadyUsed = errors.New("subscription trial was already used")
+	ErrPremiumRequired              = errors.New("premium subscription required")
+)
@@ -0,0 +1,9 @@
+package apperrors
+
+import "errors"
+
+var (
+	ErrInventoryItemDoesNotExist    = errors.New("inventory item does not exist")
+	ErrInventoryItemIsNotConsumable = errors.New("inventory item is not consumable")
+	ErrInventoryItemNotEnough       = errors.New("not enough inventory item quantity")
+)
@@ -7,6 +7,7 @@ import (
 	"github.com/allegro/bigcache"
 	"github.com/go-jedi/lingramm_backend/config"
 	"github.com/go-jedi/lingramm_backend/pkg/bigcache/admin"
+	currencyrate "github.com/go-jedi/lingramm_backend/pkg/bigcache/currency_rate"
 	"github.com/go-jedi/lingramm_backend/pkg/bigcache/iterator"
 	localizedtext "github.com/go-jedi/lingramm_backend/pkg/bigcache/localized_text"
 	"github.com/go-jedi/lingramm_backend/pkg/bigcache/user"
@@ -14,6 +15,7 @@ import (
 
 type BigCache struct {
 	Admin         admin.IAdmin
+	CurrencyRate  currencyrate.ICurrencyRate
 	Iterator      iterator.IIterator
 	LocalizedText localizedtext.ILocalizedText
 	User          user.IUser
@@ -46,6 +48,7 @@ func New(cfg config.BigCacheConfig) (*BigCache, error) {
 	bc.bigCache = bigCache
 
 	bc.Admin = admin.New(bigCache)
+	bc.CurrencyRate = currencyrate.New(bigCache)
 	bc.Iterator = iterator.New(bigCache)
 	bc.LocalizedText = localizedtext.New(bigCache)
 	bc.User = user.New(bigCache)
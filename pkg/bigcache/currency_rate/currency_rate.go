@@ -0,0 +1,84 @@
+package currencyrate
+
+import (
+	"errors"
+
+	"github.com/allegro/bigcache"
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const (
+	prefixCurrencyRate = "currency_rate:"
+	prefixCurrencyCode = "currency_code:"
+)
+
+//go:generate mockery --name=ICurrencyRate --output=mocks --case=underscore
+type ICurrencyRate interface {
+	Set(key string, val currencyrate.CurrencyRate) error
+	Get(key string) (currencyrate.CurrencyRate, error)
+	Delete(key string) error
+}
+
+type CurrencyRate struct {
+	prefixCurrencyRate string
+	prefixCurrencyCode string
+	bigCache           *bigcache.BigCache
+}
+
+func New(bigCache *bigcache.BigCache) *CurrencyRate {
+	return &CurrencyRate{
+		prefixCurrencyRate: prefixCurrencyRate,
+		prefixCurrencyCode: prefixCurrencyCode,
+		bigCache:           bigCache,
+	}
+}
+
+// Set stores currency rate in BigCache using MessagePack serialization.
+func (c *CurrencyRate) Set(key string, val currencyrate.CurrencyRate) error {
+	b, err := msgpack.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	return c.bigCache.Set(c.getPrefixCurrencyRate()+c.getPrefixCurrencyCode()+key, b)
+}
+
+// Get retrieves currency rate from BigCache and deserializes it using MessagePack.
+func (c *CurrencyRate) Get(key string) (currencyrate.CurrencyRate, error) {
+	var result currencyrate.CurrencyRate
+
+	data, err := c.bigCache.Get(c.getPrefixCurrencyRate() + c.getPrefixCurrencyCode() + key)
+	if err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	if err := msgpack.Unmarshal(data, &result); err != nil {
+		return currencyrate.CurrencyRate{}, err
+	}
+
+	return result, nil
+}
+
+// Delete removes currency rate from the cache by key.
+func (c *CurrencyRate) Delete(key string) error {
+	err := c.bigCache.Delete(c.getPrefixCurrencyRate() + c.getPrefixCurrencyCode() + key)
+	if err != nil {
+		if errors.Is(err, bigcache.ErrEntryNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// getPrefixCurrencyRate get prefix currency rate.
+func (c *CurrencyRate) getPrefixCurrencyRate() string {
+	return c.prefixCurrencyRate
+}
+
+// getPrefixCurrencyCode get prefix currency code.
+func (c *CurrencyRate) getPrefixCurrencyCode() string {
+	return c.prefixCurrencyCode
+}
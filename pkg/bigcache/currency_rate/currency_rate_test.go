@@ -0,0 +1,200 @@
+package currencyrate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/allegro/bigcache"
+	currencyrate "github.com/go-jedi/lingramm_backend/internal/domain/currency_rate"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCache(t *testing.T) *CurrencyRate {
+	config := bigcache.DefaultConfig(10 * time.Minute)
+
+	cache, err := bigcache.NewBigCache(config)
+	if err != nil {
+		t.Fatalf("failed to create bigcache: %v", err)
+	}
+
+	return New(cache)
+}
+
+func TestSet(t *testing.T) {
+	type in struct {
+		key string
+		val currencyrate.CurrencyRate
+	}
+
+	type want struct {
+		currencyRate currencyrate.CurrencyRate
+		err          error
+	}
+
+	var (
+		key              = "USD"
+		testCurrencyRate = currencyrate.CurrencyRate{
+			ID:           1,
+			CurrencyCode: key,
+			Rate:         decimal.RequireFromString("0.012500"),
+		}
+	)
+
+	tests := []struct {
+		name string
+		in   in
+		want want
+	}{
+		{
+			name: "ok",
+			in: in{
+				key: key,
+				val: testCurrencyRate,
+			},
+			want: want{
+				currencyRate: testCurrencyRate,
+				err:          nil,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache := setupCache(t)
+
+			err := cache.Set(test.in.key, test.in.val)
+			assert.NoError(t, err)
+
+			got, err := cache.Get(test.in.key)
+			assert.Equal(t, test.want.err, err)
+
+			assert.Equal(t, test.want.currencyRate.ID, got.ID)
+			assert.Equal(t, test.want.currencyRate.CurrencyCode, got.CurrencyCode)
+			assert.True(t, test.want.currencyRate.Rate.Equal(got.Rate))
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	type in struct {
+		key string
+		val currencyrate.CurrencyRate
+	}
+
+	type want struct {
+		currencyRate currencyrate.CurrencyRate
+		err          error
+	}
+
+	var (
+		key              = "USD"
+		testCurrencyRate = currencyrate.CurrencyRate{
+			ID:           1,
+			CurrencyCode: key,
+			Rate:         decimal.RequireFromString("0.012500"),
+		}
+	)
+
+	tests := []struct {
+		name string
+		in   in
+		want want
+	}{
+		{
+			name: "ok",
+			in: in{
+				key: key,
+				val: testCurrencyRate,
+			},
+			want: want{
+				currencyRate: testCurrencyRate,
+				err:          nil,
+			},
+		},
+		{
+			name: "not found",
+			in: in{
+				key: key,
+			},
+			want: want{
+				currencyRate: currencyrate.CurrencyRate{},
+				err:          bigcache.ErrEntryNotFound,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache := setupCache(t)
+
+			switch test.name {
+			case "ok":
+				err := cache.Set(test.in.key, test.in.val)
+				assert.NoError(t, err)
+
+				got, err := cache.Get(test.in.key)
+				assert.Equal(t, test.want.err, err)
+
+				assert.Equal(t, test.want.currencyRate.ID, got.ID)
+				assert.Equal(t, test.want.currencyRate.CurrencyCode, got.CurrencyCode)
+				assert.True(t, test.want.currencyRate.Rate.Equal(got.Rate))
+			default:
+				_, err := cache.Get(test.in.key)
+				assert.Equal(t, test.want.err, err)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type in struct {
+		key string
+		val currencyrate.CurrencyRate
+	}
+
+	type want struct {
+		err error
+	}
+
+	var (
+		key              = "USD"
+		testCurrencyRate = currencyrate.CurrencyRate{
+			ID:           1,
+			CurrencyCode: key,
+			Rate:         decimal.RequireFromString("0.012500"),
+		}
+	)
+
+	tests := []struct {
+		name string
+		in   in
+		want want
+	}{
+		{
+			name: "ok",
+			in: in{
+				key: key,
+				val: testCurrencyRate,
+			},
+			want: want{
+				err: bigcache.ErrEntryNotFound,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache := setupCache(t)
+
+			err := cache.Set(test.in.key, test.in.val)
+			assert.NoError(t, err)
+
+			err = cache.Delete(test.in.key)
+			assert.NoError(t, err)
+
+			_, err = cache.Get(test.in.key)
+			assert.Equal(t, test.want.err, err)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package achievementprogress
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	userachievement "github.com/go-jedi/lingramm_backend/internal/domain/user_achievement"
+	"github.com/redis/go-redis/v9"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const (
+	prefixAchievementProgress = "achievement_progress:"
+	prefixTelegramID          = "telegram_id:"
+)
+
+//go:generate mockery --name=IAchievementProgress --output=mocks --case=underscore
+type IAchievementProgress interface {
+	Set(ctx context.Context, key string, val []userachievement.Progress) error
+	Get(ctx context.Context, key string) ([]userachievement.Progress, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type AchievementProgress struct {
+	queryTimeout              int64
+	expiration                int64
+	client                    *redis.Client
+	prefixAchievementProgress string
+	prefixTelegramID          string
+}
+
+func New(cfg config.AchievementProgressConfig, client *redis.Client) *AchievementProgress {
+	return &AchievementProgress{
+		client:                    client,
+		prefixAchievementProgress: prefixAchievementProgress,
+		prefixTelegramID:          prefixTelegramID,
+		queryTimeout:              cfg.QueryTimeout,
+		expiration:                cfg.Expiration,
+	}
+}
+
+// Set stores user achievement progress in Redis using MessagePack serialization.
+func (c *AchievementProgress) Set(ctx context.Context, key string, val []userachievement.Progress) error {
+	b, err := msgpack.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.queryTimeout)*time.Second)
+	defer cancel()
+
+	return c.client.Set(
+		ctxTimeout,
+		c.getRedisKey(key),
+		b,
+		c.getExpiration(),
+	).Err()
+}
+
+// Get retrieves user achievement progress from Redis (redis.Nil if there is no entry).
+func (c *AchievementProgress) Get(ctx context.Context, key string) ([]userachievement.Progress, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.queryTimeout)*time.Second)
+	defer cancel()
+
+	data, err := c.client.Get(ctxTimeout, c.getRedisKey(key)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []userachievement.Progress
+	if err := msgpack.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete removes user achievement progress from the cache by key.
+func (c *AchievementProgress) Delete(ctx context.Context, key string) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.queryTimeout)*time.Second)
+	defer cancel()
+
+	return c.client.Del(ctxTimeout, c.getRedisKey(key)).Err()
+}
+
+// getRedisKey get redis key.
+func (c *AchievementProgress) getRedisKey(key string) string {
+	return c.getPrefixAchievementProgress() + c.getPrefixTelegramID() + key
+}
+
+// getPrefixAchievementProgress get prefix achievement progress.
+func (c *AchievementProgress) getPrefixAchievementProgress() string {
+	return c.prefixAchievementProgress
+}
+
+// getPrefixTelegramID get prefix telegram id.
+func (c *AchievementProgress) getPrefixTelegramID() string {
+	return c.prefixTelegramID
+}
+
+// getExpiration get expiration date for row in cache.
+func (c *AchievementProgress) getExpiration() time.Duration {
+	return time.Duration(c.expiration) * time.Second
+}
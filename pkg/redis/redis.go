@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"github.com/go-jedi/lingramm_backend/config"
+	achievementprogress "github.com/go-jedi/lingramm_backend/pkg/redis/achievement_progress"
 	refreshtoken "github.com/go-jedi/lingramm_backend/pkg/redis/refresh_token"
+	subscriptionsnapshot "github.com/go-jedi/lingramm_backend/pkg/redis/subscription_snapshot"
 	undeletefileachievement "github.com/go-jedi/lingramm_backend/pkg/redis/un_delete_file_achievement"
 	undeletefileaward "github.com/go-jedi/lingramm_backend/pkg/redis/un_delete_file_award"
 	undeletefileclient "github.com/go-jedi/lingramm_backend/pkg/redis/un_delete_file_client"
@@ -18,7 +20,9 @@ import (
 var ErrRedisPingFailed = errors.New("redis ping failed")
 
 type Redis struct {
+	AchievementProgress     achievementprogress.IAchievementProgress
 	RefreshToken            refreshtoken.IRefreshToken
+	SubscriptionSnapshot    subscriptionsnapshot.ISubscriptionSnapshot
 	UnDeleteFileAchievement undeletefileachievement.IUnDeleteFileAchievement
 	UnDeleteFileAward       undeletefileaward.IUnDeleteFileAward
 	UnDeleteFileClient      undeletefileclient.IUnDeleteFileClient
@@ -48,7 +52,9 @@ func New(ctx context.Context, cfg config.RedisConfig) (*Redis, error) {
 		return nil, fmt.Errorf("%w: %v", ErrRedisPingFailed, err)
 	}
 
+	r.AchievementProgress = achievementprogress.New(cfg.AchievementProgress, c)
 	r.RefreshToken = refreshtoken.New(cfg.RefreshToken, c)
+	r.SubscriptionSnapshot = subscriptionsnapshot.New(cfg.SubscriptionSnapshot, c)
 	r.UnDeleteFileAchievement = undeletefileachievement.New(cfg.UnDeleteFileAchievement, c)
 	r.UnDeleteFileAward = undeletefileaward.New(cfg.UnDeleteFileAward, c)
 	r.UnDeleteFileClient = undeletefileclient.New(cfg.UnDeleteFileClient, c)
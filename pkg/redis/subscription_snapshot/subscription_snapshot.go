@@ -0,0 +1,105 @@
+package subscriptionsnapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	"github.com/go-jedi/lingramm_backend/internal/domain/subscription"
+	"github.com/redis/go-redis/v9"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const (
+	prefixSubscriptionSnapshot = "subscription_snapshot:"
+	prefixTelegramID           = "telegram_id:"
+)
+
+//go:generate mockery --name=ISubscriptionSnapshot --output=mocks --case=underscore
+type ISubscriptionSnapshot interface {
+	Set(ctx context.Context, key string, val subscription.Snapshot) error
+	Get(ctx context.Context, key string) (subscription.Snapshot, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type SubscriptionSnapshot struct {
+	queryTimeout               int64
+	expiration                 int64
+	client                     *redis.Client
+	prefixSubscriptionSnapshot string
+	prefixTelegramID           string
+}
+
+func New(cfg config.SubscriptionSnapshotConfig, client *redis.Client) *SubscriptionSnapshot {
+	return &SubscriptionSnapshot{
+		client:                     client,
+		prefixSubscriptionSnapshot: prefixSubscriptionSnapshot,
+		prefixTelegramID:           prefixTelegramID,
+		queryTimeout:               cfg.QueryTimeout,
+		expiration:                 cfg.Expiration,
+	}
+}
+
+// Set stores user subscription snapshot in Redis using MessagePack serialization.
+func (c *SubscriptionSnapshot) Set(ctx context.Context, key string, val subscription.Snapshot) error {
+	b, err := msgpack.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.queryTimeout)*time.Second)
+	defer cancel()
+
+	return c.client.Set(
+		ctxTimeout,
+		c.getRedisKey(key),
+		b,
+		c.getExpiration(),
+	).Err()
+}
+
+// Get retrieves user subscription snapshot from Redis (redis.Nil if there is no entry).
+func (c *SubscriptionSnapshot) Get(ctx context.Context, key string) (subscription.Snapshot, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.queryTimeout)*time.Second)
+	defer cancel()
+
+	data, err := c.client.Get(ctxTimeout, c.getRedisKey(key)).Bytes()
+	if err != nil {
+		return subscription.Snapshot{}, err
+	}
+
+	var result subscription.Snapshot
+	if err := msgpack.Unmarshal(data, &result); err != nil {
+		return subscription.Snapshot{}, err
+	}
+
+	return result, nil
+}
+
+// Delete removes user subscription snapshot from the cache by key.
+func (c *SubscriptionSnapshot) Delete(ctx context.Context, key string) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(c.queryTimeout)*time.Second)
+	defer cancel()
+
+	return c.client.Del(ctxTimeout, c.getRedisKey(key)).Err()
+}
+
+// getRedisKey get redis key.
+func (c *SubscriptionSnapshot) getRedisKey(key string) string {
+	return c.getPrefixSubscriptionSnapshot() + c.getPrefixTelegramID() + key
+}
+
+// getPrefixSubscriptionSnapshot get prefix subscription snapshot.
+func (c *SubscriptionSnapshot) getPrefixSubscriptionSnapshot() string {
+	return c.prefixSubscriptionSnapshot
+}
+
+// getPrefixTelegramID get prefix telegram id.
+func (c *SubscriptionSnapshot) getPrefixTelegramID() string {
+	return c.prefixTelegramID
+}
+
+// getExpiration get expiration date for row in cache.
+func (c *SubscriptionSnapshot) getExpiration() time.Duration {
+	return time.Duration(c.expiration) * time.Second
+}
@@ -0,0 +1,224 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-jedi/lingramm_backend/config"
+)
+
+const (
+	defaultAPIURL  = "https://api.telegram.org"
+	defaultTimeout = 10
+)
+
+// CurrencyStars currency of payments in Telegram Stars.
+const CurrencyStars = "XTR"
+
+var (
+	ErrBotTokenIsRequired = errors.New("telegram bot token is required")
+	ErrRequestFailed      = errors.New("telegram bot api request failed")
+)
+
+// ITelegram defines the interface for the telegram bot api client.
+//
+//go:generate mockery --name=ITelegram --output=mocks --case=underscore
+type ITelegram interface {
+	CreateInvoiceLink(ctx context.Context, params CreateInvoiceLinkParams) (string, error)
+	AnswerPreCheckoutQuery(ctx context.Context, params AnswerPreCheckoutQueryParams) error
+	RefundStarPayment(ctx context.Context, params RefundStarPaymentParams) error
+}
+
+// Telegram is a minimal client of the Telegram Bot API.
+type Telegram struct {
+	client   *http.Client
+	botToken string
+	apiURL   string
+}
+
+// Make sure Telegram implements ITelegram.
+var _ ITelegram = (*Telegram)(nil)
+
+func New(cfg config.TelegramConfig) (*Telegram, error) {
+	t := &Telegram{
+		botToken: cfg.BotToken,
+		apiURL:   strings.TrimRight(cfg.APIURL, "/"),
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	t.client = &http.Client{
+		Timeout: time.Duration(timeout) * time.Second,
+	}
+
+	if err := t.init(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Telegram) init() error {
+	if t.botToken == "" {
+		return ErrBotTokenIsRequired
+	}
+
+	if t.apiURL == "" {
+		t.apiURL = defaultAPIURL
+	}
+
+	return nil
+}
+
+// CreateInvoiceLink creates a link for an invoice.
+func (t *Telegram) CreateInvoiceLink(ctx context.Context, params CreateInvoiceLinkParams) (string, error) {
+	var link string
+
+	if err := t.call(ctx, "createInvoiceLink", params, &link); err != nil {
+		return "", err
+	}
+
+	return link, nil
+}
+
+// AnswerPreCheckoutQuery responds to a pre checkout query, it must be sent within 10 seconds.
+func (t *Telegram) AnswerPreCheckoutQuery(ctx context.Context, params AnswerPreCheckoutQueryParams) error {
+	var ok bool
+	return t.call(ctx, "answerPreCheckoutQuery", params, &ok)
+}
+
+// RefundStarPayment refunds a successful payment in Telegram Stars.
+func (t *Telegram) RefundStarPayment(ctx context.Context, params RefundStarPaymentParams) error {
+	var ok bool
+	return t.call(ctx, "refundStarPayment", params, &ok)
+}
+
+// call send request to bot api method and decode result.
+func (t *Telegram) call(ctx context.Context, method string, params any, result any) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/bot%s/%s", t.apiURL, t.botToken, method)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		// the error contains url with bot token, so it is not wrapped.
+		return fmt.Errorf("%w: %s: %v", ErrRequestFailed, method, errors.Unwrap(err))
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var r apiResponse
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return fmt.Errorf("%w: %s: unexpected response with status %d", ErrRequestFailed, method, resp.StatusCode)
+	}
+
+	if !r.OK {
+		return fmt.Errorf("%w: %s: %d %s", ErrRequestFailed, method, r.ErrorCode, r.Description)
+	}
+
+	if result == nil || len(r.Result) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(r.Result, result)
+}
+
+type apiResponse struct {
+	OK          bool            `json:"ok"`
+	Result      json.RawMessage `json:"result,omitempty"`
+	ErrorCode   int             `json:"error_code,omitempty"`
+	Description string          `json:"description,omitempty"`
+}
+
+//
+// METHODS
+//
+
+// LabeledPrice represents a portion of the price, amount is in Telegram Stars for XTR currency.
+type LabeledPrice struct {
+	Label  string `json:"label"`
+	Amount int64  `json:"amount"`
+}
+
+type CreateInvoiceLinkParams struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Payload     string         `json:"payload"`
+	Currency    string         `json:"currency"`
+	Prices      []LabeledPrice `json:"prices"`
+}
+
+type AnswerPreCheckoutQueryParams struct {
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+	OK                 bool   `json:"ok"`
+	ErrorMessage       string `json:"error_message,omitempty"`
+}
+
+type RefundStarPaymentParams struct {
+	UserID                  int64  `json:"user_id"`
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+}
+
+//
+// UPDATES
+//
+
+// Update represents an incoming webhook update, only payment related fields are decoded.
+type Update struct {
+	UpdateID         int64             `json:"update_id"`
+	Message          *Message          `json:"message,omitempty"`
+	PreCheckoutQuery *PreCheckoutQuery `json:"pre_checkout_query,omitempty"`
+}
+
+type User struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name"`
+	Username  string `json:"username,omitempty"`
+}
+
+type Message struct {
+	MessageID         int64              `json:"message_id"`
+	From              *User              `json:"from,omitempty"`
+	SuccessfulPayment *SuccessfulPayment `json:"successful_payment,omitempty"`
+}
+
+type PreCheckoutQuery struct {
+	ID             string `json:"id"`
+	From           User   `json:"from"`
+	Currency       string `json:"currency"`
+	TotalAmount    int64  `json:"total_amount"`
+	InvoicePayload string `json:"invoice_payload"`
+}
+
+type SuccessfulPayment struct {
+	Currency                string `json:"currency"`
+	TotalAmount             int64  `json:"total_amount"`
+	InvoicePayload          string `json:"invoice_payload"`
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+	ProviderPaymentChargeID string `json:"provider_payment_charge_id"`
+}
@@ -0,0 +1,147 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-jedi/lingramm_backend/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBotToken = "123:TEST"
+
+// stubServer starts a local bot api server, handle receives method name and decoded request body.
+func stubServer(t *testing.T, handle func(method string, body map[string]any) (int, string)) *Telegram {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		prefix := "/bot" + testBotToken + "/"
+		if len(r.URL.Path) <= len(prefix) || r.URL.Path[:len(prefix)] != prefix {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":404,"description":"Not Found"}`))
+			return
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+
+		status, resp := handle(r.URL.Path[len(prefix):], body)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(resp))
+	}))
+	t.Cleanup(srv.Close)
+
+	tg, err := New(config.TelegramConfig{
+		BotToken: testBotToken,
+		APIURL:   srv.URL,
+	})
+	if err != nil {
+		t.Fatalf("failed to create telegram client: %v", err)
+	}
+
+	return tg
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(config.TelegramConfig{})
+	assert.ErrorIs(t, err, ErrBotTokenIsRequired)
+
+	tg, err := New(config.TelegramConfig{BotToken: testBotToken})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultAPIURL, tg.apiURL)
+}
+
+func TestCreateInvoiceLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		wantLink string
+		wantErr  bool
+	}{
+		{
+			name:     "ok",
+			status:   http.StatusOK,
+			response: `{"ok":true,"result":"https://t.me/$invoice"}`,
+			wantLink: "https://t.me/$invoice",
+		},
+		{
+			name:     "api error",
+			status:   http.StatusBadRequest,
+			response: `{"ok":false,"error_code":400,"description":"Bad Request: CURRENCY_INVALID"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "unexpected response",
+			status:   http.StatusBadGateway,
+			response: `<html>bad gateway</html>`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tg := stubServer(t, func(method string, body map[string]any) (int, string) {
+				assert.Equal(t, "createInvoiceLink", method)
+				assert.Equal(t, CurrencyStars, body["currency"])
+				assert.Equal(t, "payload", body["payload"])
+				return tt.status, tt.response
+			})
+
+			link, err := tg.CreateInvoiceLink(context.Background(), CreateInvoiceLinkParams{
+				Title:       "Monthly",
+				Description: "Monthly subscription",
+				Payload:     "payload",
+				Currency:    CurrencyStars,
+				Prices:      []LabeledPrice{{Label: "Monthly", Amount: 250}},
+			})
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrRequestFailed))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLink, link)
+		})
+	}
+}
+
+func TestAnswerPreCheckoutQuery(t *testing.T) {
+	tg := stubServer(t, func(method string, body map[string]any) (int, string) {
+		assert.Equal(t, "answerPreCheckoutQuery", method)
+		assert.Equal(t, "query-id", body["pre_checkout_query_id"])
+		assert.Equal(t, false, body["ok"])
+		assert.Equal(t, "expired", body["error_message"])
+		return http.StatusOK, `{"ok":true,"result":true}`
+	})
+
+	err := tg.AnswerPreCheckoutQuery(context.Background(), AnswerPreCheckoutQueryParams{
+		PreCheckoutQueryID: "query-id",
+		OK:                 false,
+		ErrorMessage:       "expired",
+	})
+	assert.NoError(t, err)
+}
+
+func TestRefundStarPayment(t *testing.T) {
+	tg := stubServer(t, func(method string, body map[string]any) (int, string) {
+		assert.Equal(t, "refundStarPayment", method)
+		assert.Equal(t, float64(42), body["user_id"])
+		assert.Equal(t, "charge-id", body["telegram_payment_charge_id"])
+		return http.StatusBadRequest, `{"ok":false,"error_code":400,"description":"Bad Request: CHARGE_ALREADY_REFUNDED"}`
+	})
+
+	err := tg.RefundStarPayment(context.Background(), RefundStarPaymentParams{
+		UserID:                  42,
+		TelegramPaymentChargeID: "charge-id",
+	})
+	assert.ErrorIs(t, err, ErrRequestFailed)
+	assert.Contains(t, err.Error(), "CHARGE_ALREADY_REFUNDED")
+}
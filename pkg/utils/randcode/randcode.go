@@ -0,0 +1,27 @@
+package randcode
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// Alphabet of generated codes, characters that are easy to confuse (0, O, 1, I, L) are excluded.
+const Alphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// Generate returns prefix followed by length random characters of the alphabet.
+// It uses crypto/rand, so codes can not be guessed from previously generated ones.
+func Generate(prefix string, length int) (string, error) {
+	b := make([]byte, length)
+	alphabetLen := big.NewInt(int64(len(Alphabet)))
+
+	for i := range b {
+		n, err := rand.Int(rand.Reader, alphabetLen)
+		if err != nil {
+			return "", err
+		}
+
+		b[i] = Alphabet[n.Int64()]
+	}
+
+	return prefix + string(b), nil
+}
@@ -0,0 +1,33 @@
+package randcode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	code, err := Generate("WELCOME", 10)
+	assert.NoError(t, err)
+	assert.Len(t, code, len("WELCOME")+10)
+	assert.True(t, strings.HasPrefix(code, "WELCOME"))
+
+	for _, r := range strings.TrimPrefix(code, "WELCOME") {
+		assert.True(t, strings.ContainsRune(Alphabet, r), "unexpected character %q", r)
+	}
+}
+
+func TestGenerateUnique(t *testing.T) {
+	seen := make(map[string]struct{}, 1000)
+
+	for range 1000 {
+		code, err := Generate("", 10)
+		assert.NoError(t, err)
+
+		_, ok := seen[code]
+		assert.False(t, ok, "duplicate code %s", code)
+
+		seen[code] = struct{}{}
+	}
+}